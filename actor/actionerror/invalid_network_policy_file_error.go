@@ -0,0 +1,18 @@
+package actionerror
+
+import "fmt"
+
+// InvalidNetworkPolicyFileError is returned when a network policy file cannot
+// be read. Index is the 1-based position of the offending policy, or 0 when
+// the file as a whole is not valid YAML.
+type InvalidNetworkPolicyFileError struct {
+	Index   int
+	Message string
+}
+
+func (e InvalidNetworkPolicyFileError) Error() string {
+	if e.Index == 0 {
+		return "Invalid network policy file: " + e.Message
+	}
+	return fmt.Sprintf("Invalid network policy file: policy %d: %s", e.Index, e.Message)
+}
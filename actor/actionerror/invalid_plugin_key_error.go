@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// InvalidPluginKeyError is returned when a trusted plugin key is not an
+// ed25519 public key.
+type InvalidPluginKeyError struct {
+	Name    string
+	Message string
+}
+
+func (e InvalidPluginKeyError) Error() string {
+	return fmt.Sprintf("Plugin key '%s' is invalid: %s", e.Name, e.Message)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// InvalidPluginLockFileError is returned when a plugin lock file cannot be
+// parsed.
+type InvalidPluginLockFileError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidPluginLockFileError) Error() string {
+	return fmt.Sprintf("Plugin lock file %s is invalid: %s", e.Path, e.Message)
+}
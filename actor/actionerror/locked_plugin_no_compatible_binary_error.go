@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// LockedPluginNoCompatibleBinaryError is returned when a plugin lock file
+// does not record a binary of a plugin for the current platform.
+type LockedPluginNoCompatibleBinaryError struct {
+	PluginName string
+	Version    string
+}
+
+func (e LockedPluginNoCompatibleBinaryError) Error() string {
+	return fmt.Sprintf("Plugin lock file has no binary of %s %s for your platform", e.PluginName, e.Version)
+}
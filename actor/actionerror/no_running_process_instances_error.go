@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// NoRunningProcessInstancesError is returned when an action needs a running
+// instance of a process and none of its instances are running.
+type NoRunningProcessInstancesError struct {
+	ProcessType string
+}
+
+func (e NoRunningProcessInstancesError) Error() string {
+	return fmt.Sprintf("No instances of process %s are running", e.ProcessType)
+}
@@ -0,0 +1,11 @@
+package actionerror
+
+import "fmt"
+
+type PluginKeyAlreadyExistsError struct {
+	Name string
+}
+
+func (e PluginKeyAlreadyExistsError) Error() string {
+	return fmt.Sprintf("Plugin key %s is already trusted.", e.Name)
+}
@@ -0,0 +1,11 @@
+package actionerror
+
+import "fmt"
+
+type PluginKeyNameTakenError struct {
+	Name string
+}
+
+func (e PluginKeyNameTakenError) Error() string {
+	return fmt.Sprintf("Plugin key named '%s' already exists, please use another name.", e.Name)
+}
@@ -0,0 +1,11 @@
+package actionerror
+
+import "fmt"
+
+type PluginKeyNotFoundError struct {
+	Name string
+}
+
+func (e PluginKeyNotFoundError) Error() string {
+	return fmt.Sprintf("Plugin key %s not found.", e.Name)
+}
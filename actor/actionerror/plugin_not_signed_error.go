@@ -0,0 +1,9 @@
+package actionerror
+
+// PluginNotSignedError is returned when installing a plugin without a
+// signature while signatures are required.
+type PluginNotSignedError struct{}
+
+func (PluginNotSignedError) Error() string {
+	return "Plugin binary is not signed and plugin signatures are required."
+}
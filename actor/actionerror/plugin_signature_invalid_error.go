@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// PluginSignatureInvalidError is returned when a plugin signature cannot be
+// parsed.
+type PluginSignatureInvalidError struct {
+	Message string
+}
+
+func (e PluginSignatureInvalidError) Error() string {
+	return fmt.Sprintf("Plugin signature is invalid: %s", e.Message)
+}
@@ -0,0 +1,9 @@
+package actionerror
+
+// PluginSignatureNotTrustedError is returned when a plugin signature cannot be
+// verified with any of the trusted plugin keys.
+type PluginSignatureNotTrustedError struct{}
+
+func (PluginSignatureNotTrustedError) Error() string {
+	return "Plugin binary's signature could not be verified with any trusted plugin key."
+}
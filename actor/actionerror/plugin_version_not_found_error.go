@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// PluginVersionNotFoundError is returned when a requested version of a plugin
+// cannot be found in the plugin repositories.
+type PluginVersionNotFoundError struct {
+	PluginName string
+	Version    string
+}
+
+func (e PluginVersionNotFoundError) Error() string {
+	return fmt.Sprintf("Plugin %s %s not found in the plugin repositories", e.PluginName, e.Version)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// SidecarNotFoundError is returned when an app has no sidecar with the given
+// name.
+type SidecarNotFoundError struct {
+	Name    string
+	AppName string
+}
+
+func (e SidecarNotFoundError) Error() string {
+	return fmt.Sprintf("Sidecar '%s' not found for app '%s'.", e.Name, e.AppName)
+}
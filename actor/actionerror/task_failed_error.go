@@ -1,7 +1,14 @@
 package actionerror
 
-type TaskFailedError struct{}
+// TaskFailedError is returned when a task ends in the FAILED state.
+type TaskFailedError struct {
+	TaskName      string
+	FailureReason string
+}
 
-func (TaskFailedError) Error() string {
-	return "Task failed to complete successfully"
+func (e TaskFailedError) Error() string {
+	if e.FailureReason == "" {
+		return "Task failed to complete successfully"
+	}
+	return "Task failed to complete successfully: " + e.FailureReason
 }
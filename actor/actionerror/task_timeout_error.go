@@ -0,0 +1,17 @@
+package actionerror
+
+import (
+	"fmt"
+	"time"
+)
+
+// TaskTimeoutError is returned when a task does not finish within the time
+// given to wait for it, after the task has been cancelled.
+type TaskTimeoutError struct {
+	TaskName string
+	Timeout  time.Duration
+}
+
+func (e TaskTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for task '%s' to complete", e.TaskName)
+}
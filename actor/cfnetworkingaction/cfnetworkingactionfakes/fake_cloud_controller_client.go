@@ -41,6 +41,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetDomainsStub        func(...ccv3.Query) ([]resources.Domain, ccv3.Warnings, error)
+	getDomainsMutex       sync.RWMutex
+	getDomainsArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getDomainsReturns struct {
+		result1 []resources.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDomainsReturnsOnCall map[int]struct {
+		result1 []resources.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetOrganizationsStub        func(...ccv3.Query) ([]resources.Organization, ccv3.Warnings, error)
 	getOrganizationsMutex       sync.RWMutex
 	getOrganizationsArgsForCall []struct {
@@ -56,6 +71,23 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetRoutePoliciesStub        func(...ccv3.Query) ([]resources.RoutePolicy, ccv3.IncludedResources, ccv3.Warnings, error)
+	getRoutePoliciesMutex       sync.RWMutex
+	getRoutePoliciesArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getRoutePoliciesReturns struct {
+		result1 []resources.RoutePolicy
+		result2 ccv3.IncludedResources
+		result3 ccv3.Warnings
+		result4 error
+	}
+	getRoutePoliciesReturnsOnCall map[int]struct {
+		result1 []resources.RoutePolicy
+		result2 ccv3.IncludedResources
+		result3 ccv3.Warnings
+		result4 error
+	}
 	GetSpacesStub        func(...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error)
 	getSpacesMutex       sync.RWMutex
 	getSpacesArgsForCall []struct {
@@ -212,6 +244,73 @@ func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetDomains(arg1 ...ccv3.Query) ([]resources.Domain, ccv3.Warnings, error) {
+	fake.getDomainsMutex.Lock()
+	ret, specificReturn := fake.getDomainsReturnsOnCall[len(fake.getDomainsArgsForCall)]
+	fake.getDomainsArgsForCall = append(fake.getDomainsArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	stub := fake.GetDomainsStub
+	fakeReturns := fake.getDomainsReturns
+	fake.recordInvocation("GetDomains", []interface{}{arg1})
+	fake.getDomainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsCallCount() int {
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
+	return len(fake.getDomainsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsCalls(stub func(...ccv3.Query) ([]resources.Domain, ccv3.Warnings, error)) {
+	fake.getDomainsMutex.Lock()
+	defer fake.getDomainsMutex.Unlock()
+	fake.GetDomainsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsArgsForCall(i int) []ccv3.Query {
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
+	argsForCall := fake.getDomainsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsReturns(result1 []resources.Domain, result2 ccv3.Warnings, result3 error) {
+	fake.getDomainsMutex.Lock()
+	defer fake.getDomainsMutex.Unlock()
+	fake.GetDomainsStub = nil
+	fake.getDomainsReturns = struct {
+		result1 []resources.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsReturnsOnCall(i int, result1 []resources.Domain, result2 ccv3.Warnings, result3 error) {
+	fake.getDomainsMutex.Lock()
+	defer fake.getDomainsMutex.Unlock()
+	fake.GetDomainsStub = nil
+	if fake.getDomainsReturnsOnCall == nil {
+		fake.getDomainsReturnsOnCall = make(map[int]struct {
+			result1 []resources.Domain
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDomainsReturnsOnCall[i] = struct {
+		result1 []resources.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetOrganizations(arg1 ...ccv3.Query) ([]resources.Organization, ccv3.Warnings, error) {
 	fake.getOrganizationsMutex.Lock()
 	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
@@ -279,6 +378,76 @@ func (fake *FakeCloudControllerClient) GetOrganizationsReturnsOnCall(i int, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetRoutePolicies(arg1 ...ccv3.Query) ([]resources.RoutePolicy, ccv3.IncludedResources, ccv3.Warnings, error) {
+	fake.getRoutePoliciesMutex.Lock()
+	ret, specificReturn := fake.getRoutePoliciesReturnsOnCall[len(fake.getRoutePoliciesArgsForCall)]
+	fake.getRoutePoliciesArgsForCall = append(fake.getRoutePoliciesArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	stub := fake.GetRoutePoliciesStub
+	fakeReturns := fake.getRoutePoliciesReturns
+	fake.recordInvocation("GetRoutePolicies", []interface{}{arg1})
+	fake.getRoutePoliciesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeCloudControllerClient) GetRoutePoliciesCallCount() int {
+	fake.getRoutePoliciesMutex.RLock()
+	defer fake.getRoutePoliciesMutex.RUnlock()
+	return len(fake.getRoutePoliciesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRoutePoliciesCalls(stub func(...ccv3.Query) ([]resources.RoutePolicy, ccv3.IncludedResources, ccv3.Warnings, error)) {
+	fake.getRoutePoliciesMutex.Lock()
+	defer fake.getRoutePoliciesMutex.Unlock()
+	fake.GetRoutePoliciesStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetRoutePoliciesArgsForCall(i int) []ccv3.Query {
+	fake.getRoutePoliciesMutex.RLock()
+	defer fake.getRoutePoliciesMutex.RUnlock()
+	argsForCall := fake.getRoutePoliciesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetRoutePoliciesReturns(result1 []resources.RoutePolicy, result2 ccv3.IncludedResources, result3 ccv3.Warnings, result4 error) {
+	fake.getRoutePoliciesMutex.Lock()
+	defer fake.getRoutePoliciesMutex.Unlock()
+	fake.GetRoutePoliciesStub = nil
+	fake.getRoutePoliciesReturns = struct {
+		result1 []resources.RoutePolicy
+		result2 ccv3.IncludedResources
+		result3 ccv3.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeCloudControllerClient) GetRoutePoliciesReturnsOnCall(i int, result1 []resources.RoutePolicy, result2 ccv3.IncludedResources, result3 ccv3.Warnings, result4 error) {
+	fake.getRoutePoliciesMutex.Lock()
+	defer fake.getRoutePoliciesMutex.Unlock()
+	fake.GetRoutePoliciesStub = nil
+	if fake.getRoutePoliciesReturnsOnCall == nil {
+		fake.getRoutePoliciesReturnsOnCall = make(map[int]struct {
+			result1 []resources.RoutePolicy
+			result2 ccv3.IncludedResources
+			result3 ccv3.Warnings
+			result4 error
+		})
+	}
+	fake.getRoutePoliciesReturnsOnCall[i] = struct {
+		result1 []resources.RoutePolicy
+		result2 ccv3.IncludedResources
+		result3 ccv3.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaces(arg1 ...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error) {
 	fake.getSpacesMutex.Lock()
 	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
@@ -10,6 +10,8 @@ import (
 type CloudControllerClient interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (resources.Application, ccv3.Warnings, error)
 	GetApplications(query ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error)
+	GetDomains(query ...ccv3.Query) ([]resources.Domain, ccv3.Warnings, error)
 	GetOrganizations(query ...ccv3.Query) ([]resources.Organization, ccv3.Warnings, error)
+	GetRoutePolicies(query ...ccv3.Query) ([]resources.RoutePolicy, ccv3.IncludedResources, ccv3.Warnings, error)
 	GetSpaces(query ...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error)
 }
@@ -0,0 +1,134 @@
+package cfnetworkingaction
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"gopkg.in/yaml.v2"
+)
+
+type policyFile struct {
+	Policies []policyFileEntry `yaml:"policies"`
+}
+
+type policyFileEntry struct {
+	Source      policyFileEndpoint `yaml:"source"`
+	Destination policyFileEndpoint `yaml:"destination"`
+	Protocol    string             `yaml:"protocol,omitempty"`
+	Ports       string             `yaml:"ports,omitempty"`
+}
+
+type policyFileEndpoint struct {
+	App   string `yaml:"app"`
+	Space string `yaml:"space,omitempty"`
+	Org   string `yaml:"org,omitempty"`
+}
+
+// ParseNetworkPolicyFile reads the policies of a network policy file:
+//
+//	policies:
+//	- source: {app: frontend, space: web, org: shop}
+//	  destination: {app: backend}
+//	  protocol: tcp
+//	  ports: 8080-8090
+//
+// A missing org or space is left empty, for the caller to default. The
+// protocol defaults to tcp and the ports to 8080.
+func ParseNetworkPolicyFile(raw []byte) ([]DesiredPolicy, error) {
+	var file policyFile
+	err := yaml.UnmarshalStrict(raw, &file)
+	if err != nil {
+		return nil, actionerror.InvalidNetworkPolicyFileError{Message: err.Error()}
+	}
+
+	var policies []DesiredPolicy
+	for i, entry := range file.Policies {
+		invalid := func(message string) error {
+			return actionerror.InvalidNetworkPolicyFileError{Index: i + 1, Message: message}
+		}
+
+		if entry.Source.App == "" {
+			return nil, invalid("source app is required")
+		}
+		if entry.Destination.App == "" {
+			return nil, invalid("destination app is required")
+		}
+		if entry.Source.Org != "" && entry.Source.Space == "" || entry.Destination.Org != "" && entry.Destination.Space == "" {
+			return nil, invalid("an org requires a space")
+		}
+
+		protocol := strings.ToLower(entry.Protocol)
+		switch protocol {
+		case "":
+			protocol = "tcp"
+		case "tcp", "udp":
+		default:
+			return nil, invalid(fmt.Sprintf("protocol must be tcp or udp, not %q", entry.Protocol))
+		}
+
+		startPort, endPort := 8080, 8080
+		if entry.Ports != "" {
+			startPort, endPort, err = parsePortRange(entry.Ports)
+			if err != nil {
+				return nil, invalid(err.Error())
+			}
+		}
+
+		policies = append(policies, DesiredPolicy{
+			Source:      PolicyEndpoint{OrgName: entry.Source.Org, SpaceName: entry.Source.Space, AppName: entry.Source.App},
+			Destination: PolicyEndpoint{OrgName: entry.Destination.Org, SpaceName: entry.Destination.Space, AppName: entry.Destination.App},
+			Protocol:    protocol,
+			StartPort:   startPort,
+			EndPort:     endPort,
+		})
+	}
+
+	return policies, nil
+}
+
+// MarshalNetworkPolicyFile writes policies in the format read by
+// ParseNetworkPolicyFile.
+func MarshalNetworkPolicyFile(policies []DesiredPolicy) ([]byte, error) {
+	file := policyFile{Policies: []policyFileEntry{}}
+	for _, policy := range policies {
+		ports := strconv.Itoa(policy.StartPort)
+		if policy.EndPort != policy.StartPort {
+			ports = fmt.Sprintf("%d-%d", policy.StartPort, policy.EndPort)
+		}
+
+		file.Policies = append(file.Policies, policyFileEntry{
+			Source:      policyFileEndpoint{App: policy.Source.AppName, Space: policy.Source.SpaceName, Org: policy.Source.OrgName},
+			Destination: policyFileEndpoint{App: policy.Destination.AppName, Space: policy.Destination.SpaceName, Org: policy.Destination.OrgName},
+			Protocol:    policy.Protocol,
+			Ports:       ports,
+		})
+	}
+
+	return yaml.Marshal(file)
+}
+
+func parsePortRange(ports string) (int, int, error) {
+	parts := strings.Split(ports, "-")
+	if len(parts) > 2 {
+		return 0, 0, fmt.Errorf("ports must be a port or a range of ports such as 8080-8090, not %q", ports)
+	}
+
+	var bounds []int
+	for _, part := range parts {
+		port, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || port < 1 || port > 65535 {
+			return 0, 0, fmt.Errorf("ports must be a port or a range of ports such as 8080-8090, not %q", ports)
+		}
+		bounds = append(bounds, port)
+	}
+
+	if len(bounds) == 1 {
+		return bounds[0], bounds[0], nil
+	}
+	if bounds[0] > bounds[1] {
+		return 0, 0, fmt.Errorf("port range %q must start with the lower port", ports)
+	}
+	return bounds[0], bounds[1], nil
+}
@@ -0,0 +1,125 @@
+package cfnetworkingaction_test
+
+import (
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	. "code.cloudfoundry.org/cli/v9/actor/cfnetworkingaction"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Network policy file", func() {
+	Describe("ParseNetworkPolicyFile", func() {
+		var (
+			raw        string
+			policies   []DesiredPolicy
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			policies, executeErr = ParseNetworkPolicyFile([]byte(raw))
+		})
+
+		When("the file is valid", func() {
+			BeforeEach(func() {
+				raw = `---
+policies:
+- source: {app: frontend}
+  destination: {app: backend, space: backend-space, org: backend-org}
+  protocol: UDP
+  ports: 8080-8090
+- source: {app: frontend, space: web}
+  destination: {app: cache}
+  ports: 6379
+`
+			})
+
+			It("returns the policies with defaults for the protocol and ports", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(policies).To(Equal([]DesiredPolicy{
+					{
+						Source:      PolicyEndpoint{AppName: "frontend"},
+						Destination: PolicyEndpoint{OrgName: "backend-org", SpaceName: "backend-space", AppName: "backend"},
+						Protocol:    "udp",
+						StartPort:   8080,
+						EndPort:     8090,
+					},
+					{
+						Source:      PolicyEndpoint{SpaceName: "web", AppName: "frontend"},
+						Destination: PolicyEndpoint{AppName: "cache"},
+						Protocol:    "tcp",
+						StartPort:   6379,
+						EndPort:     6379,
+					},
+				}))
+			})
+		})
+
+		When("the protocol and ports are omitted", func() {
+			BeforeEach(func() {
+				raw = "policies:\n- source: {app: a}\n  destination: {app: b}\n"
+			})
+
+			It("defaults to tcp on port 8080", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(policies).To(ConsistOf(DesiredPolicy{
+					Source:      PolicyEndpoint{AppName: "a"},
+					Destination: PolicyEndpoint{AppName: "b"},
+					Protocol:    "tcp",
+					StartPort:   8080,
+					EndPort:     8080,
+				}))
+			})
+		})
+
+		DescribeTable("invalid files",
+			func(raw string, expectedErr error) {
+				_, err := ParseNetworkPolicyFile([]byte(raw))
+				Expect(err).To(MatchError(expectedErr))
+			},
+			Entry("unknown field", "policies:\n- source: {app: a}\n  destination: {app: b}\n  port: 80\n",
+				actionerror.InvalidNetworkPolicyFileError{Message: "yaml: unmarshal errors:\n  line 4: field port not found in type cfnetworkingaction.policyFileEntry"}),
+			Entry("missing source app", "policies:\n- source: {space: s}\n  destination: {app: b}\n",
+				actionerror.InvalidNetworkPolicyFileError{Index: 1, Message: "source app is required"}),
+			Entry("missing destination app", "policies:\n- source: {app: a}\n  destination: {}\n",
+				actionerror.InvalidNetworkPolicyFileError{Index: 1, Message: "destination app is required"}),
+			Entry("org without space", "policies:\n- source: {app: a}\n  destination: {app: b}\n- source: {app: a}\n  destination: {app: b, org: o}\n",
+				actionerror.InvalidNetworkPolicyFileError{Index: 2, Message: "an org requires a space"}),
+			Entry("unknown protocol", "policies:\n- source: {app: a}\n  destination: {app: b}\n  protocol: icmp\n",
+				actionerror.InvalidNetworkPolicyFileError{Index: 1, Message: `protocol must be tcp or udp, not "icmp"`}),
+			Entry("invalid port", "policies:\n- source: {app: a}\n  destination: {app: b}\n  ports: 70000\n",
+				actionerror.InvalidNetworkPolicyFileError{Index: 1, Message: `ports must be a port or a range of ports such as 8080-8090, not "70000"`}),
+			Entry("reversed port range", "policies:\n- source: {app: a}\n  destination: {app: b}\n  ports: 9000-8000\n",
+				actionerror.InvalidNetworkPolicyFileError{Index: 1, Message: `port range "9000-8000" must start with the lower port`}),
+		)
+	})
+
+	Describe("MarshalNetworkPolicyFile", func() {
+		It("writes policies that parse back to the same policies", func() {
+			policies := []DesiredPolicy{
+				{
+					Source:      PolicyEndpoint{OrgName: "org", SpaceName: "space", AppName: "frontend"},
+					Destination: PolicyEndpoint{OrgName: "other-org", SpaceName: "other-space", AppName: "backend"},
+					Protocol:    "tcp",
+					StartPort:   8080,
+					EndPort:     8090,
+				},
+				{
+					Source:      PolicyEndpoint{OrgName: "org", SpaceName: "space", AppName: "frontend"},
+					Destination: PolicyEndpoint{OrgName: "org", SpaceName: "space", AppName: "cache"},
+					Protocol:    "udp",
+					StartPort:   6379,
+					EndPort:     6379,
+				},
+			}
+
+			raw, err := MarshalNetworkPolicyFile(policies)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(raw)).To(ContainSubstring("ports: 8080-8090"))
+			Expect(string(raw)).To(ContainSubstring(`ports: "6379"`))
+
+			parsed, err := ParseNetworkPolicyFile(raw)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed).To(Equal(policies))
+		})
+	})
+})
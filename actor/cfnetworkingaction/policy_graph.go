@@ -0,0 +1,414 @@
+package cfnetworkingaction
+
+import (
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/v9/api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/util/batcher"
+	"code.cloudfoundry.org/cli/v9/util/lookuptable"
+)
+
+const (
+	PolicyGraphAppNode   = "app"
+	PolicyGraphRouteNode = "route"
+	PolicyGraphSpaceNode = "space"
+	PolicyGraphOrgNode   = "org"
+	PolicyGraphAnyNode   = "any"
+
+	PolicyGraphNetworkEdge = "network"
+	PolicyGraphRouteEdge   = "route"
+)
+
+// PolicyGraph is the graph of network policies between apps and, optionally,
+// of route policies from their sources to routes.
+type PolicyGraph struct {
+	Nodes []PolicyGraphNode
+	Edges []PolicyGraphEdge
+}
+
+// PolicyGraphNode is an app, a route or the source of a route policy. Nodes
+// that cannot be resolved are named by their GUID.
+type PolicyGraphNode struct {
+	ID        string
+	Type      string
+	Name      string
+	SpaceName string
+	OrgName   string
+}
+
+// PolicyGraphEdge is a network policy between two apps or a route policy
+// from its source to a route. Route edges have no protocol or ports.
+type PolicyGraphEdge struct {
+	SourceID      string
+	DestinationID string
+	Type          string
+	Protocol      string
+	StartPort     int
+	EndPort       int
+}
+
+// GetNetworkPolicyGraph returns the graph of the network policies from or to
+// the apps in the given space, or in all spaces of the given org. With
+// neither, it returns the graph of all policies the user can see. With
+// withRoutePolicies, route policies on routes in scope are added for the
+// domains that enforce them.
+func (actor Actor) GetNetworkPolicyGraph(orgGUID string, spaceGUID string, withRoutePolicies bool) (PolicyGraph, Warnings, error) {
+	var allWarnings Warnings
+
+	scoped := orgGUID != "" || spaceGUID != ""
+	spaceGUIDs, warnings, err := actor.graphSpaceGUIDs(orgGUID, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return PolicyGraph{}, allWarnings, err
+	}
+
+	var apps []resources.Application
+	if scoped {
+		ccWarnings, err := batcher.RequestByGUID(spaceGUIDs, func(guids []string) (ccv3.Warnings, error) {
+			batch, warnings, err := actor.CloudControllerClient.GetApplications(ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: guids})
+			apps = append(apps, batch...)
+			return warnings, err
+		})
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return PolicyGraph{}, allWarnings, err
+		}
+	}
+
+	v1Policies, err := actor.graphPolicies(scoped, apps)
+	if err != nil {
+		return PolicyGraph{}, allWarnings, err
+	}
+
+	graph := policyGraphBuilder{nodes: map[string]PolicyGraphNode{}}
+	for _, v1Policy := range v1Policies {
+		graph.addApp(v1Policy.Source.ID)
+		graph.addApp(v1Policy.Destination.ID)
+		graph.edges = append(graph.edges, PolicyGraphEdge{
+			SourceID:      v1Policy.Source.ID,
+			DestinationID: v1Policy.Destination.ID,
+			Type:          PolicyGraphNetworkEdge,
+			Protocol:      string(v1Policy.Destination.Protocol),
+			StartPort:     v1Policy.Destination.Ports.Start,
+			EndPort:       v1Policy.Destination.Ports.End,
+		})
+	}
+
+	if withRoutePolicies && (!scoped || len(spaceGUIDs) > 0) {
+		warnings, err = actor.addRoutePolicies(&graph, scoped, spaceGUIDs)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return PolicyGraph{}, allWarnings, err
+		}
+	}
+
+	warnings, err = actor.nameGraphApps(&graph, apps)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return PolicyGraph{}, allWarnings, err
+	}
+
+	return graph.build(), allWarnings, nil
+}
+
+func (actor Actor) graphSpaceGUIDs(orgGUID string, spaceGUID string) ([]string, Warnings, error) {
+	switch {
+	case spaceGUID != "":
+		return []string{spaceGUID}, nil, nil
+	case orgGUID != "":
+		spaces, _, warnings, err := actor.CloudControllerClient.GetSpaces(ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}})
+		var spaceGUIDs []string
+		for _, space := range spaces {
+			spaceGUIDs = append(spaceGUIDs, space.GUID)
+		}
+		return spaceGUIDs, Warnings(warnings), err
+	default:
+		return nil, nil, nil
+	}
+}
+
+// graphPolicies lists the policies from or to the given apps, or all policies
+// the user can see when unscoped.
+func (actor Actor) graphPolicies(scoped bool, apps []resources.Application) ([]cfnetv1.Policy, error) {
+	if !scoped {
+		return actor.NetworkingClient.ListPolicies()
+	}
+
+	var appGUIDs []string
+	for _, app := range apps {
+		appGUIDs = append(appGUIDs, app.GUID)
+	}
+
+	var v1Policies []cfnetv1.Policy
+	seen := map[cfnetv1.Policy]bool{}
+	_, err := batcher.RequestByGUID(appGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		batch, err := actor.NetworkingClient.ListPolicies(guids...)
+		for _, v1Policy := range batch {
+			if !seen[v1Policy] {
+				seen[v1Policy] = true
+				v1Policies = append(v1Policies, v1Policy)
+			}
+		}
+		return nil, err
+	})
+	return v1Policies, err
+}
+
+func (actor Actor) addRoutePolicies(graph *policyGraphBuilder, scoped bool, spaceGUIDs []string) (Warnings, error) {
+	var allWarnings Warnings
+	var routePolicies []resources.RoutePolicy
+	var included ccv3.IncludedResources
+
+	include := ccv3.Query{Key: ccv3.Include, Values: []string{"route,source"}}
+	getRoutePolicies := func(queries ...ccv3.Query) (ccv3.Warnings, error) {
+		batch, batchIncluded, warnings, err := actor.CloudControllerClient.GetRoutePolicies(append(queries, include)...)
+		routePolicies = append(routePolicies, batch...)
+		included.Merge(batchIncluded)
+		return warnings, err
+	}
+
+	var warnings ccv3.Warnings
+	var err error
+	if scoped {
+		warnings, err = batcher.RequestByGUID(spaceGUIDs, func(guids []string) (ccv3.Warnings, error) {
+			return getRoutePolicies(ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: guids})
+		})
+	} else {
+		warnings, err = getRoutePolicies()
+	}
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil || len(routePolicies) == 0 {
+		return allWarnings, err
+	}
+
+	routesByGUID := map[string]resources.Route{}
+	var domainGUIDs []string
+	for _, route := range included.Routes {
+		routesByGUID[route.GUID] = route
+		domainGUIDs = append(domainGUIDs, route.DomainGUID)
+	}
+
+	enforcingDomains := map[string]bool{}
+	warnings, err = batcher.RequestByGUID(uniqueStrings(domainGUIDs), func(guids []string) (ccv3.Warnings, error) {
+		domains, warnings, err := actor.CloudControllerClient.GetDomains(ccv3.Query{Key: ccv3.GUIDFilter, Values: guids})
+		for _, domain := range domains {
+			enforcingDomains[domain.GUID] = domain.EnforceRoutePolicies.IsSet && domain.EnforceRoutePolicies.Value
+		}
+		return warnings, err
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	spaceNamesByGUID := lookuptable.NameFromGUID(included.Spaces)
+	orgNamesByGUID := lookuptable.NameFromGUID(included.Organizations)
+	orgNamesBySpaceGUID := map[string]string{}
+	for _, space := range included.Spaces {
+		orgNamesBySpaceGUID[space.GUID] = orgNamesByGUID[space.Relationships[constant.RelationshipTypeOrganization].GUID]
+	}
+
+	for _, routePolicy := range routePolicies {
+		route, ok := routesByGUID[routePolicy.RouteGUID]
+		if !ok || !enforcingDomains[route.DomainGUID] {
+			continue
+		}
+
+		sourceType, sourceGUID := parseRoutePolicySource(routePolicy.Source)
+		var sourceID string
+		switch sourceType {
+		case PolicyGraphAppNode:
+			sourceID = sourceGUID
+			graph.addApp(sourceGUID)
+		case PolicyGraphSpaceNode:
+			sourceID = sourceGUID
+			graph.add(PolicyGraphNode{
+				ID:        sourceGUID,
+				Type:      PolicyGraphSpaceNode,
+				Name:      nameOrGUID(spaceNamesByGUID[sourceGUID], sourceGUID),
+				SpaceName: spaceNamesByGUID[sourceGUID],
+				OrgName:   orgNamesBySpaceGUID[sourceGUID],
+			})
+		case PolicyGraphOrgNode:
+			sourceID = sourceGUID
+			graph.add(PolicyGraphNode{
+				ID:      sourceGUID,
+				Type:    PolicyGraphOrgNode,
+				Name:    nameOrGUID(orgNamesByGUID[sourceGUID], sourceGUID),
+				OrgName: orgNamesByGUID[sourceGUID],
+			})
+		case PolicyGraphAnyNode:
+			sourceID = PolicyGraphAnyNode
+			graph.add(PolicyGraphNode{ID: PolicyGraphAnyNode, Type: PolicyGraphAnyNode, Name: "any app"})
+		default:
+			continue
+		}
+
+		graph.add(PolicyGraphNode{
+			ID:        route.GUID,
+			Type:      PolicyGraphRouteNode,
+			Name:      nameOrGUID(route.URL, route.GUID),
+			SpaceName: spaceNamesByGUID[route.SpaceGUID],
+			OrgName:   orgNamesBySpaceGUID[route.SpaceGUID],
+		})
+		graph.edges = append(graph.edges, PolicyGraphEdge{
+			SourceID:      sourceID,
+			DestinationID: route.GUID,
+			Type:          PolicyGraphRouteEdge,
+		})
+	}
+
+	return allWarnings, nil
+}
+
+// nameGraphApps names the app nodes of the graph, fetching the apps that are
+// not already known along with their spaces and orgs.
+func (actor Actor) nameGraphApps(graph *policyGraphBuilder, knownApps []resources.Application) (Warnings, error) {
+	var allWarnings Warnings
+
+	appsByGUID := lookuptable.AppFromGUID(knownApps)
+	var unknownGUIDs []string
+	for _, guid := range graph.appGUIDs {
+		if _, ok := appsByGUID[guid]; !ok {
+			unknownGUIDs = append(unknownGUIDs, guid)
+		}
+	}
+
+	apps := append([]resources.Application{}, knownApps...)
+	warnings, err := batcher.RequestByGUID(unknownGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		batch, warnings, err := actor.CloudControllerClient.GetApplications(ccv3.Query{Key: ccv3.GUIDFilter, Values: guids})
+		apps = append(apps, batch...)
+		return warnings, err
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+	appsByGUID = lookuptable.AppFromGUID(apps)
+
+	var spaces []resources.Space
+	warnings, err = batcher.RequestByGUID(uniqueSpaceGUIDs(apps), func(guids []string) (ccv3.Warnings, error) {
+		batch, _, warnings, err := actor.CloudControllerClient.GetSpaces(ccv3.Query{Key: ccv3.GUIDFilter, Values: guids})
+		spaces = append(spaces, batch...)
+		return warnings, err
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	orgNamesBySpaceGUID := map[string]string{}
+	if len(spaces) > 0 {
+		var ccWarnings ccv3.Warnings
+		orgNamesBySpaceGUID, ccWarnings, err = actor.orgNamesBySpaceGUID(spaces)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+	spaceNamesByGUID := lookuptable.NameFromGUID(spaces)
+
+	for _, guid := range graph.appGUIDs {
+		app := appsByGUID[guid]
+		graph.nodes[guid] = PolicyGraphNode{
+			ID:        guid,
+			Type:      PolicyGraphAppNode,
+			Name:      nameOrGUID(app.Name, guid),
+			SpaceName: spaceNamesByGUID[app.SpaceGUID],
+			OrgName:   orgNamesBySpaceGUID[app.SpaceGUID],
+		}
+	}
+
+	return allWarnings, nil
+}
+
+type policyGraphBuilder struct {
+	nodes    map[string]PolicyGraphNode
+	appGUIDs []string
+	edges    []PolicyGraphEdge
+}
+
+func (b *policyGraphBuilder) add(node PolicyGraphNode) {
+	if _, ok := b.nodes[node.ID]; !ok {
+		b.nodes[node.ID] = node
+	}
+}
+
+func (b *policyGraphBuilder) addApp(guid string) {
+	if _, ok := b.nodes[guid]; !ok {
+		b.nodes[guid] = PolicyGraphNode{ID: guid, Type: PolicyGraphAppNode}
+		b.appGUIDs = append(b.appGUIDs, guid)
+	}
+}
+
+// build returns the graph with nodes sorted by org, space and name, and
+// edges sorted by their source and destination nodes.
+func (b *policyGraphBuilder) build() PolicyGraph {
+	graph := PolicyGraph{Edges: b.edges}
+	for _, node := range b.nodes {
+		graph.Nodes = append(graph.Nodes, node)
+	}
+
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		a, b := graph.Nodes[i], graph.Nodes[j]
+		if a.OrgName != b.OrgName {
+			return a.OrgName < b.OrgName
+		}
+		if a.SpaceName != b.SpaceName {
+			return a.SpaceName < b.SpaceName
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ID < b.ID
+	})
+
+	position := map[string]int{}
+	for i, node := range graph.Nodes {
+		position[node.ID] = i
+	}
+
+	sort.SliceStable(graph.Edges, func(i, j int) bool {
+		a, b := graph.Edges[i], graph.Edges[j]
+		if position[a.SourceID] != position[b.SourceID] {
+			return position[a.SourceID] < position[b.SourceID]
+		}
+		if position[a.DestinationID] != position[b.DestinationID] {
+			return position[a.DestinationID] < position[b.DestinationID]
+		}
+		if a.Protocol != b.Protocol {
+			return a.Protocol < b.Protocol
+		}
+		return a.StartPort < b.StartPort
+	})
+
+	return graph
+}
+
+// parseRoutePolicySource splits a route policy source such as cf:app:GUID
+// into its type and GUID. cf:any has no GUID.
+func parseRoutePolicySource(source string) (string, string) {
+	if source == "cf:any" {
+		return PolicyGraphAnyNode, ""
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(source, "cf:"), ":", 2)
+	if len(parts) != 2 || !strings.HasPrefix(source, "cf:") {
+		return "", ""
+	}
+	return parts[0], parts[1]
+}
+
+func nameOrGUID(name string, guid string) string {
+	if name == "" {
+		return guid
+	}
+	return name
+}
@@ -0,0 +1,223 @@
+package cfnetworkingaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/v9/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/v9/actor/cfnetworkingaction/cfnetworkingactionfakes"
+	"code.cloudfoundry.org/cli/v9/api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy graph", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *cfnetworkingactionfakes.FakeCloudControllerClient
+		fakeNetworkingClient      *cfnetworkingactionfakes.FakeNetworkingClient
+
+		orgGUID           string
+		spaceGUID         string
+		withRoutePolicies bool
+
+		graph      PolicyGraph
+		warnings   Warnings
+		executeErr error
+	)
+
+	orgRelationship := func(guid string) resources.Relationships {
+		return resources.Relationships{constant.RelationshipTypeOrganization: {GUID: guid}}
+	}
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(cfnetworkingactionfakes.FakeCloudControllerClient)
+		fakeNetworkingClient = new(cfnetworkingactionfakes.FakeNetworkingClient)
+		actor = NewActor(fakeNetworkingClient, fakeCloudControllerClient)
+
+		orgGUID = ""
+		spaceGUID = ""
+		withRoutePolicies = false
+
+		fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{
+			{
+				Source:      cfnetv1.PolicySource{ID: "frontend-guid"},
+				Destination: cfnetv1.PolicyDestination{ID: "backend-guid", Protocol: "tcp", Ports: cfnetv1.Ports{Start: 8080, End: 8090}},
+			},
+			{
+				Source:      cfnetv1.PolicySource{ID: "backend-guid"},
+				Destination: cfnetv1.PolicyDestination{ID: "db-guid", Protocol: "udp", Ports: cfnetv1.Ports{Start: 5432, End: 5432}},
+			},
+		}, nil)
+
+		fakeCloudControllerClient.GetApplicationsStub = func(queries ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error) {
+			if queries[0].Key == ccv3.SpaceGUIDFilter {
+				return []resources.Application{
+					{GUID: "frontend-guid", Name: "frontend", SpaceGUID: "web-guid"},
+				}, ccv3.Warnings{"get-space-apps-warning"}, nil
+			}
+			return []resources.Application{
+				{GUID: "frontend-guid", Name: "frontend", SpaceGUID: "web-guid"},
+				{GUID: "backend-guid", Name: "backend", SpaceGUID: "api-guid"},
+			}, ccv3.Warnings{"get-apps-warning"}, nil
+		}
+		fakeCloudControllerClient.GetSpacesStub = func(queries ...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error) {
+			if queries[0].Key == ccv3.OrganizationGUIDFilter {
+				return []resources.Space{{GUID: "web-guid"}}, ccv3.IncludedResources{}, ccv3.Warnings{"get-org-spaces-warning"}, nil
+			}
+			return []resources.Space{
+				{GUID: "web-guid", Name: "web", Relationships: orgRelationship("shop-guid")},
+				{GUID: "api-guid", Name: "api", Relationships: orgRelationship("shop-guid")},
+			}, ccv3.IncludedResources{}, ccv3.Warnings{"get-spaces-warning"}, nil
+		}
+		fakeCloudControllerClient.GetOrganizationsReturns(
+			[]resources.Organization{{GUID: "shop-guid", Name: "shop"}},
+			ccv3.Warnings{"get-orgs-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		graph, warnings, executeErr = actor.GetNetworkPolicyGraph(orgGUID, spaceGUID, withRoutePolicies)
+	})
+
+	It("returns the graph of all policies with the apps named", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf("get-apps-warning", "get-spaces-warning", "get-orgs-warning"))
+
+		Expect(fakeNetworkingClient.ListPoliciesCallCount()).To(Equal(1))
+		Expect(fakeNetworkingClient.ListPoliciesArgsForCall(0)).To(BeEmpty())
+		Expect(fakeCloudControllerClient.GetRoutePoliciesCallCount()).To(Equal(0))
+
+		Expect(graph.Nodes).To(Equal([]PolicyGraphNode{
+			{ID: "db-guid", Type: PolicyGraphAppNode, Name: "db-guid"},
+			{ID: "backend-guid", Type: PolicyGraphAppNode, Name: "backend", SpaceName: "api", OrgName: "shop"},
+			{ID: "frontend-guid", Type: PolicyGraphAppNode, Name: "frontend", SpaceName: "web", OrgName: "shop"},
+		}))
+		Expect(graph.Edges).To(Equal([]PolicyGraphEdge{
+			{SourceID: "backend-guid", DestinationID: "db-guid", Type: PolicyGraphNetworkEdge, Protocol: "udp", StartPort: 5432, EndPort: 5432},
+			{SourceID: "frontend-guid", DestinationID: "backend-guid", Type: PolicyGraphNetworkEdge, Protocol: "tcp", StartPort: 8080, EndPort: 8090},
+		}))
+	})
+
+	When("scoped to a space", func() {
+		BeforeEach(func() {
+			spaceGUID = "web-guid"
+			fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{{
+				Source:      cfnetv1.PolicySource{ID: "frontend-guid"},
+				Destination: cfnetv1.PolicyDestination{ID: "backend-guid", Protocol: "tcp", Ports: cfnetv1.Ports{Start: 8080, End: 8080}},
+			}}, nil)
+		})
+
+		It("returns the policies from or to the apps in the space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"web-guid"}},
+			))
+			Expect(fakeNetworkingClient.ListPoliciesArgsForCall(0)).To(ConsistOf("frontend-guid"))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(1)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"backend-guid"}},
+			))
+
+			Expect(graph.Nodes).To(HaveLen(2))
+			Expect(graph.Edges).To(HaveLen(1))
+		})
+	})
+
+	When("scoped to an org", func() {
+		BeforeEach(func() {
+			orgGUID = "shop-guid"
+		})
+
+		It("returns the policies from or to the apps in the spaces of the org", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElements("get-org-spaces-warning", "get-space-apps-warning"))
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"shop-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"web-guid"}},
+			))
+		})
+	})
+
+	When("route policies are requested", func() {
+		BeforeEach(func() {
+			withRoutePolicies = true
+
+			fakeCloudControllerClient.GetRoutePoliciesReturns(
+				[]resources.RoutePolicy{
+					{GUID: "rp-1", Source: "cf:app:frontend-guid", RouteGUID: "route-guid"},
+					{GUID: "rp-2", Source: "cf:space:web-guid", RouteGUID: "route-guid"},
+					{GUID: "rp-3", Source: "cf:any", RouteGUID: "open-route-guid"},
+				},
+				ccv3.IncludedResources{
+					Routes: []resources.Route{
+						{GUID: "route-guid", DomainGUID: "enforcing-domain-guid", URL: "backend.apps.internal"},
+						{GUID: "open-route-guid", DomainGUID: "open-domain-guid", URL: "open.example.com"},
+					},
+					Spaces:        []resources.Space{{GUID: "web-guid", Name: "web", Relationships: orgRelationship("shop-guid")}},
+					Organizations: []resources.Organization{{GUID: "shop-guid", Name: "shop"}},
+				},
+				ccv3.Warnings{"get-route-policies-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetDomainsReturns(
+				[]resources.Domain{
+					{GUID: "enforcing-domain-guid", EnforceRoutePolicies: types.NullBool{IsSet: true, Value: true}},
+					{GUID: "open-domain-guid"},
+				},
+				ccv3.Warnings{"get-domains-warning"},
+				nil,
+			)
+		})
+
+		It("adds the route policies of domains that enforce them", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElements("get-route-policies-warning", "get-domains-warning"))
+
+			Expect(fakeCloudControllerClient.GetRoutePoliciesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.Include, Values: []string{"route,source"}},
+			))
+
+			Expect(graph.Nodes).To(ContainElements(
+				PolicyGraphNode{ID: "route-guid", Type: PolicyGraphRouteNode, Name: "backend.apps.internal"},
+				PolicyGraphNode{ID: "web-guid", Type: PolicyGraphSpaceNode, Name: "web", SpaceName: "web", OrgName: "shop"},
+			))
+			Expect(graph.Nodes).NotTo(ContainElement(HaveField("ID", "open-route-guid")))
+			Expect(graph.Edges).To(ContainElements(
+				PolicyGraphEdge{SourceID: "frontend-guid", DestinationID: "route-guid", Type: PolicyGraphRouteEdge},
+				PolicyGraphEdge{SourceID: "web-guid", DestinationID: "route-guid", Type: PolicyGraphRouteEdge},
+			))
+			Expect(graph.Edges).To(HaveLen(4))
+		})
+
+		When("scoped to a space", func() {
+			BeforeEach(func() {
+				spaceGUID = "web-guid"
+			})
+
+			It("only lists the route policies of the space", func() {
+				Expect(fakeCloudControllerClient.GetRoutePoliciesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"web-guid"}},
+					ccv3.Query{Key: ccv3.Include, Values: []string{"route,source"}},
+				))
+			})
+		})
+	})
+
+	When("listing the policies fails", func() {
+		BeforeEach(func() {
+			fakeNetworkingClient.ListPoliciesReturns(nil, errors.New("list-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("list-error"))
+		})
+	})
+})
@@ -0,0 +1,344 @@
+package cfnetworkingaction
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/util/batcher"
+	"code.cloudfoundry.org/cli/v9/util/lookuptable"
+)
+
+// policyBatchSize is the number of policies created or removed per request.
+const policyBatchSize = 100
+
+// PolicyEndpoint is an app named by its org, space and name.
+type PolicyEndpoint struct {
+	OrgName   string
+	SpaceName string
+	AppName   string
+}
+
+// DesiredPolicy is a network policy named by apps rather than GUIDs, as read
+// from a network policy file.
+type DesiredPolicy struct {
+	Source      PolicyEndpoint
+	Destination PolicyEndpoint
+	Protocol    string
+	StartPort   int
+	EndPort     int
+}
+
+// PolicySyncPlan is the set of changes that make the policies of the synced
+// spaces match a network policy file. The synced spaces are the spaces of the
+// source apps in the file.
+type PolicySyncPlan struct {
+	Additions []DesiredPolicy
+	Removals  []DesiredPolicy
+	Unchanged int
+
+	additions []cfnetv1.Policy
+	removals  []cfnetv1.Policy
+}
+
+// PlanNetworkPolicySync compares the desired policies with the policies
+// whose source app is in the space of the source app of any desired policy.
+// Policies that are missing are planned as additions; with prune, policies
+// that are not desired are planned as removals. Spaces that only hold
+// destination apps are not synced. All orgs and spaces must be named.
+func (actor Actor) PlanNetworkPolicySync(desired []DesiredPolicy, prune bool) (PolicySyncPlan, Warnings, error) {
+	var allWarnings Warnings
+
+	apps, warnings, err := actor.appsInPolicySpaces(desired)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return PolicySyncPlan{}, allWarnings, err
+	}
+
+	sourceSpaces := map[PolicyEndpoint]bool{}
+	for _, policy := range desired {
+		sourceSpaces[PolicyEndpoint{OrgName: policy.Source.OrgName, SpaceName: policy.Source.SpaceName}] = true
+	}
+
+	appsByEndpoint := map[PolicyEndpoint]resources.Application{}
+	endpointsByAppGUID := map[string]PolicyEndpoint{}
+	var scopeAppGUIDs []string
+	for endpoint, app := range apps {
+		appsByEndpoint[endpoint] = app
+		endpointsByAppGUID[app.GUID] = endpoint
+		if sourceSpaces[PolicyEndpoint{OrgName: endpoint.OrgName, SpaceName: endpoint.SpaceName}] {
+			scopeAppGUIDs = append(scopeAppGUIDs, app.GUID)
+		}
+	}
+	sort.Strings(scopeAppGUIDs)
+
+	plan := PolicySyncPlan{}
+	desiredPolicies := map[cfnetv1.Policy]bool{}
+	for _, policy := range desired {
+		source, ok := appsByEndpoint[policy.Source]
+		if !ok {
+			return PolicySyncPlan{}, allWarnings, actionerror.ApplicationNotFoundError{Name: policy.Source.AppName}
+		}
+		destination, ok := appsByEndpoint[policy.Destination]
+		if !ok {
+			return PolicySyncPlan{}, allWarnings, actionerror.ApplicationNotFoundError{Name: policy.Destination.AppName}
+		}
+
+		v1Policy := cfnetv1.Policy{
+			Source: cfnetv1.PolicySource{ID: source.GUID},
+			Destination: cfnetv1.PolicyDestination{
+				ID:       destination.GUID,
+				Protocol: cfnetv1.PolicyProtocol(policy.Protocol),
+				Ports:    cfnetv1.Ports{Start: policy.StartPort, End: policy.EndPort},
+			},
+		}
+		desiredPolicies[v1Policy] = true
+	}
+
+	var existingPolicies []cfnetv1.Policy
+	_, err = batcher.RequestByGUID(scopeAppGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		batch, err := actor.NetworkingClient.ListPolicies(guids...)
+		existingPolicies = append(existingPolicies, batch...)
+		return nil, err
+	})
+	if err != nil {
+		return PolicySyncPlan{}, allWarnings, err
+	}
+	existingPolicies = filterPoliciesWithoutMatchingSourceGUIDs(existingPolicies, scopeAppGUIDs)
+
+	existing := map[cfnetv1.Policy]bool{}
+	for _, v1Policy := range existingPolicies {
+		if existing[v1Policy] {
+			continue
+		}
+		existing[v1Policy] = true
+
+		switch {
+		case desiredPolicies[v1Policy]:
+			plan.Unchanged++
+		case prune:
+			plan.removals = append(plan.removals, v1Policy)
+		}
+	}
+
+	for _, policy := range desired {
+		v1Policy := cfnetv1.Policy{
+			Source: cfnetv1.PolicySource{ID: appsByEndpoint[policy.Source].GUID},
+			Destination: cfnetv1.PolicyDestination{
+				ID:       appsByEndpoint[policy.Destination].GUID,
+				Protocol: cfnetv1.PolicyProtocol(policy.Protocol),
+				Ports:    cfnetv1.Ports{Start: policy.StartPort, End: policy.EndPort},
+			},
+		}
+		if existing[v1Policy] {
+			continue
+		}
+		existing[v1Policy] = true
+
+		plan.additions = append(plan.additions, v1Policy)
+		plan.Additions = append(plan.Additions, policy)
+	}
+
+	warnings, err = actor.addOutOfScopeEndpoints(plan.removals, endpointsByAppGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return PolicySyncPlan{}, allWarnings, err
+	}
+
+	for _, v1Policy := range plan.removals {
+		plan.Removals = append(plan.Removals, DesiredPolicy{
+			Source:      endpointsByAppGUID[v1Policy.Source.ID],
+			Destination: endpointsByAppGUID[v1Policy.Destination.ID],
+			Protocol:    string(v1Policy.Destination.Protocol),
+			StartPort:   v1Policy.Destination.Ports.Start,
+			EndPort:     v1Policy.Destination.Ports.End,
+		})
+	}
+
+	return plan, allWarnings, nil
+}
+
+// ApplyNetworkPolicySync creates the planned additions and then removes the
+// planned removals, in batches.
+func (actor Actor) ApplyNetworkPolicySync(plan PolicySyncPlan) error {
+	for _, batch := range policyBatches(plan.additions) {
+		err := actor.NetworkingClient.CreatePolicies(batch)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, batch := range policyBatches(plan.removals) {
+		err := actor.NetworkingClient.RemovePolicies(batch)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func policyBatches(policies []cfnetv1.Policy) [][]cfnetv1.Policy {
+	var batches [][]cfnetv1.Policy
+	for len(policies) > 0 {
+		size := len(policies)
+		if size > policyBatchSize {
+			size = policyBatchSize
+		}
+		batches = append(batches, policies[:size])
+		policies = policies[size:]
+	}
+	return batches
+}
+
+// appsInPolicySpaces returns all apps in the spaces named by the policies,
+// keyed by the endpoint that names them.
+func (actor Actor) appsInPolicySpaces(policies []DesiredPolicy) (map[PolicyEndpoint]resources.Application, Warnings, error) {
+	var allWarnings Warnings
+
+	spaceNamesByOrgName := map[string][]string{}
+	seenSpaces := map[PolicyEndpoint]bool{}
+	var orgNames []string
+	for _, policy := range policies {
+		for _, endpoint := range []PolicyEndpoint{policy.Source, policy.Destination} {
+			space := PolicyEndpoint{OrgName: endpoint.OrgName, SpaceName: endpoint.SpaceName}
+			if seenSpaces[space] {
+				continue
+			}
+			seenSpaces[space] = true
+
+			if _, ok := spaceNamesByOrgName[endpoint.OrgName]; !ok {
+				orgNames = append(orgNames, endpoint.OrgName)
+			}
+			spaceNamesByOrgName[endpoint.OrgName] = append(spaceNamesByOrgName[endpoint.OrgName], endpoint.SpaceName)
+		}
+	}
+
+	apps := map[PolicyEndpoint]resources.Application{}
+	if len(orgNames) == 0 {
+		return apps, allWarnings, nil
+	}
+
+	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(ccv3.Query{Key: ccv3.NameFilter, Values: orgNames})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	orgGUIDsByName := map[string]string{}
+	for _, org := range orgs {
+		orgGUIDsByName[org.Name] = org.GUID
+	}
+
+	spacesByGUID := map[string]PolicyEndpoint{}
+	var spaceGUIDs []string
+	for _, orgName := range orgNames {
+		orgGUID, ok := orgGUIDsByName[orgName]
+		if !ok {
+			return nil, allWarnings, actionerror.OrganizationNotFoundError{Name: orgName}
+		}
+
+		spaces, _, warnings, err := actor.CloudControllerClient.GetSpaces(
+			ccv3.Query{Key: ccv3.NameFilter, Values: spaceNamesByOrgName[orgName]},
+			ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		spaceGUIDsByName := map[string]string{}
+		for _, space := range spaces {
+			spaceGUIDsByName[space.Name] = space.GUID
+		}
+
+		for _, spaceName := range spaceNamesByOrgName[orgName] {
+			spaceGUID, ok := spaceGUIDsByName[spaceName]
+			if !ok {
+				return nil, allWarnings, actionerror.SpaceNotFoundError{Name: spaceName}
+			}
+			spacesByGUID[spaceGUID] = PolicyEndpoint{OrgName: orgName, SpaceName: spaceName}
+			spaceGUIDs = append(spaceGUIDs, spaceGUID)
+		}
+	}
+
+	ccWarnings, err := batcher.RequestByGUID(spaceGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		batch, warnings, err := actor.CloudControllerClient.GetApplications(ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: guids})
+		for _, app := range batch {
+			endpoint := spacesByGUID[app.SpaceGUID]
+			endpoint.AppName = app.Name
+			apps[endpoint] = app
+		}
+		return warnings, err
+	})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	return apps, allWarnings, nil
+}
+
+// addOutOfScopeEndpoints names the destination apps of the policies that are
+// outside the synced spaces.
+func (actor Actor) addOutOfScopeEndpoints(policies []cfnetv1.Policy, endpointsByAppGUID map[string]PolicyEndpoint) (Warnings, error) {
+	var unknownGUIDs []string
+	for _, policy := range policies {
+		if _, ok := endpointsByAppGUID[policy.Destination.ID]; !ok {
+			unknownGUIDs = append(unknownGUIDs, policy.Destination.ID)
+		}
+	}
+	if len(unknownGUIDs) == 0 {
+		return nil, nil
+	}
+
+	var allWarnings Warnings
+	var apps []resources.Application
+	warnings, err := batcher.RequestByGUID(uniqueStrings(unknownGUIDs), func(guids []string) (ccv3.Warnings, error) {
+		batch, warnings, err := actor.CloudControllerClient.GetApplications(ccv3.Query{Key: ccv3.GUIDFilter, Values: guids})
+		apps = append(apps, batch...)
+		return warnings, err
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	spaces, _, warnings, err := actor.CloudControllerClient.GetSpaces(ccv3.Query{Key: ccv3.GUIDFilter, Values: uniqueSpaceGUIDs(apps)})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	orgNamesBySpaceGUID, warnings, err := actor.orgNamesBySpaceGUID(spaces)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	spaceNamesByGUID := lookuptable.NameFromGUID(spaces)
+
+	for _, app := range apps {
+		endpointsByAppGUID[app.GUID] = PolicyEndpoint{
+			OrgName:   orgNamesBySpaceGUID[app.SpaceGUID],
+			SpaceName: spaceNamesByGUID[app.SpaceGUID],
+			AppName:   app.Name,
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func uniqueStrings(values []string) []string {
+	var unique []string
+	seen := map[string]bool{}
+	for _, value := range values {
+		if !seen[value] {
+			unique = append(unique, value)
+			seen[value] = true
+		}
+	}
+	return unique
+}
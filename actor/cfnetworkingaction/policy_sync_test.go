@@ -0,0 +1,301 @@
+package cfnetworkingaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	. "code.cloudfoundry.org/cli/v9/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/v9/actor/cfnetworkingaction/cfnetworkingactionfakes"
+	"code.cloudfoundry.org/cli/v9/api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/v9/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy sync", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *cfnetworkingactionfakes.FakeCloudControllerClient
+		fakeNetworkingClient      *cfnetworkingactionfakes.FakeNetworkingClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(cfnetworkingactionfakes.FakeCloudControllerClient)
+		fakeNetworkingClient = new(cfnetworkingactionfakes.FakeNetworkingClient)
+		actor = NewActor(fakeNetworkingClient, fakeCloudControllerClient)
+	})
+
+	Describe("PlanNetworkPolicySync", func() {
+		var (
+			desired  []DesiredPolicy
+			prune    bool
+			plan     PolicySyncPlan
+			warnings Warnings
+			err      error
+		)
+
+		frontend := PolicyEndpoint{OrgName: "org", SpaceName: "space", AppName: "frontend"}
+		backend := PolicyEndpoint{OrgName: "org", SpaceName: "space", AppName: "backend"}
+
+		BeforeEach(func() {
+			prune = false
+			desired = []DesiredPolicy{
+				{Source: frontend, Destination: backend, Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+				{Source: frontend, Destination: backend, Protocol: "udp", StartPort: 53, EndPort: 53},
+			}
+
+			fakeCloudControllerClient.GetOrganizationsStub = func(queries ...ccv3.Query) ([]resources.Organization, ccv3.Warnings, error) {
+				if queries[0].Key == ccv3.GUIDFilter {
+					return []resources.Organization{{GUID: "other-org-guid", Name: "other-org"}}, nil, nil
+				}
+				return []resources.Organization{{GUID: "org-guid", Name: "org"}}, ccv3.Warnings{"get-orgs-warning"}, nil
+			}
+			fakeCloudControllerClient.GetSpacesStub = func(queries ...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error) {
+				if queries[0].Key == ccv3.GUIDFilter {
+					return []resources.Space{{
+						GUID: "other-space-guid",
+						Name: "other-space",
+						Relationships: map[constant.RelationshipType]resources.Relationship{
+							constant.RelationshipTypeOrganization: {GUID: "other-org-guid"},
+						},
+					}}, ccv3.IncludedResources{}, nil, nil
+				}
+				return []resources.Space{{GUID: "space-guid", Name: "space"}}, ccv3.IncludedResources{}, ccv3.Warnings{"get-spaces-warning"}, nil
+			}
+			fakeCloudControllerClient.GetApplicationsStub = func(queries ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error) {
+				if queries[0].Key == ccv3.GUIDFilter {
+					return []resources.Application{{GUID: "other-guid", Name: "other", SpaceGUID: "other-space-guid"}}, nil, nil
+				}
+				return []resources.Application{
+					{GUID: "frontend-guid", Name: "frontend", SpaceGUID: "space-guid"},
+					{GUID: "backend-guid", Name: "backend", SpaceGUID: "space-guid"},
+				}, ccv3.Warnings{"get-apps-warning"}, nil
+			}
+
+			fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{
+				{
+					Source:      cfnetv1.PolicySource{ID: "frontend-guid"},
+					Destination: cfnetv1.PolicyDestination{ID: "backend-guid", Protocol: "tcp", Ports: cfnetv1.Ports{Start: 8080, End: 8080}},
+				},
+				{
+					Source:      cfnetv1.PolicySource{ID: "frontend-guid"},
+					Destination: cfnetv1.PolicyDestination{ID: "other-guid", Protocol: "tcp", Ports: cfnetv1.Ports{Start: 9000, End: 9001}},
+				},
+				{
+					Source:      cfnetv1.PolicySource{ID: "outside-guid"},
+					Destination: cfnetv1.PolicyDestination{ID: "backend-guid", Protocol: "tcp", Ports: cfnetv1.Ports{Start: 8080, End: 8080}},
+				},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			plan, warnings, err = actor.PlanNetworkPolicySync(desired, prune)
+		})
+
+		It("resolves the apps in the spaces named by the policies", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-orgs-warning", "get-spaces-warning", "get-apps-warning"))
+
+			Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"org"}},
+			))
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"space"}},
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+			))
+			Expect(fakeNetworkingClient.ListPoliciesArgsForCall(0)).To(ConsistOf("backend-guid", "frontend-guid"))
+		})
+
+		It("plans the missing policies as additions", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Additions).To(Equal([]DesiredPolicy{
+				{Source: frontend, Destination: backend, Protocol: "udp", StartPort: 53, EndPort: 53},
+			}))
+			Expect(plan.Removals).To(BeEmpty())
+			Expect(plan.Unchanged).To(Equal(1))
+		})
+
+		When("prune is set", func() {
+			BeforeEach(func() {
+				prune = true
+			})
+
+			It("plans the undesired policies from apps in the synced spaces as removals", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(plan.Removals).To(Equal([]DesiredPolicy{
+					{
+						Source:      frontend,
+						Destination: PolicyEndpoint{OrgName: "other-org", SpaceName: "other-space", AppName: "other"},
+						Protocol:    "tcp",
+						StartPort:   9000,
+						EndPort:     9001,
+					},
+				}))
+				Expect(plan.Unchanged).To(Equal(1))
+			})
+
+			When("a space only holds destination apps", func() {
+				database := PolicyEndpoint{OrgName: "org", SpaceName: "data-space", AppName: "database"}
+
+				BeforeEach(func() {
+					desired = append(desired, DesiredPolicy{Source: backend, Destination: database, Protocol: "tcp", StartPort: 5432, EndPort: 5432})
+
+					fakeCloudControllerClient.GetSpacesStub = func(queries ...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error) {
+						return []resources.Space{
+							{GUID: "space-guid", Name: "space"},
+							{GUID: "data-space-guid", Name: "data-space"},
+						}, ccv3.IncludedResources{}, nil, nil
+					}
+					fakeCloudControllerClient.GetApplicationsStub = func(queries ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error) {
+						return []resources.Application{
+							{GUID: "frontend-guid", Name: "frontend", SpaceGUID: "space-guid"},
+							{GUID: "backend-guid", Name: "backend", SpaceGUID: "space-guid"},
+							{GUID: "database-guid", Name: "database", SpaceGUID: "data-space-guid"},
+						}, nil, nil
+					}
+					fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{
+						{
+							Source:      cfnetv1.PolicySource{ID: "frontend-guid"},
+							Destination: cfnetv1.PolicyDestination{ID: "backend-guid", Protocol: "tcp", Ports: cfnetv1.Ports{Start: 8080, End: 8080}},
+						},
+						{
+							Source:      cfnetv1.PolicySource{ID: "database-guid"},
+							Destination: cfnetv1.PolicyDestination{ID: "backend-guid", Protocol: "tcp", Ports: cfnetv1.Ports{Start: 9090, End: 9090}},
+						},
+					}, nil)
+				})
+
+				It("keeps the outbound policies of the apps in that space", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeNetworkingClient.ListPoliciesArgsForCall(0)).To(ConsistOf("backend-guid", "frontend-guid"))
+					Expect(plan.Additions).To(ConsistOf(
+						DesiredPolicy{Source: frontend, Destination: backend, Protocol: "udp", StartPort: 53, EndPort: 53},
+						DesiredPolicy{Source: backend, Destination: database, Protocol: "tcp", StartPort: 5432, EndPort: 5432},
+					))
+					Expect(plan.Removals).To(BeEmpty())
+					Expect(plan.Unchanged).To(Equal(1))
+				})
+			})
+		})
+
+		When("an org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsStub = nil
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"get-orgs-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(err).To(MatchError(actionerror.OrganizationNotFoundError{Name: "org"}))
+				Expect(warnings).To(ConsistOf("get-orgs-warning"))
+			})
+		})
+
+		When("a space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesStub = nil
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.IncludedResources{}, nil, nil)
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(err).To(MatchError(actionerror.SpaceNotFoundError{Name: "space"}))
+			})
+		})
+
+		When("an app does not exist", func() {
+			BeforeEach(func() {
+				desired = append(desired, DesiredPolicy{
+					Source:      frontend,
+					Destination: PolicyEndpoint{OrgName: "org", SpaceName: "space", AppName: "missing"},
+					Protocol:    "tcp",
+					StartPort:   8080,
+					EndPort:     8080,
+				})
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(err).To(MatchError(actionerror.ApplicationNotFoundError{Name: "missing"}))
+				Expect(fakeNetworkingClient.ListPoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("listing the policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.ListPoliciesReturns(nil, errors.New("list-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("list-error"))
+			})
+		})
+	})
+
+	Describe("ApplyNetworkPolicySync", func() {
+		var (
+			plan PolicySyncPlan
+			err  error
+		)
+
+		BeforeEach(func() {
+			var policies []cfnetv1.Policy
+			for i := 0; i < 150; i++ {
+				policies = append(policies, cfnetv1.Policy{
+					Source:      cfnetv1.PolicySource{ID: "frontend-guid"},
+					Destination: cfnetv1.PolicyDestination{ID: "backend-guid", Protocol: "tcp", Ports: cfnetv1.Ports{Start: 1000 + i, End: 1000 + i}},
+				})
+			}
+
+			fakeCloudControllerClient.GetOrganizationsReturns([]resources.Organization{{GUID: "org-guid", Name: "org"}}, nil, nil)
+			fakeCloudControllerClient.GetSpacesReturns([]resources.Space{{GUID: "space-guid", Name: "space"}}, ccv3.IncludedResources{}, nil, nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{
+				{GUID: "frontend-guid", Name: "frontend", SpaceGUID: "space-guid"},
+				{GUID: "backend-guid", Name: "backend", SpaceGUID: "space-guid"},
+			}, nil, nil)
+			fakeNetworkingClient.ListPoliciesReturns(policies, nil)
+
+			var planErr error
+			plan, _, planErr = actor.PlanNetworkPolicySync([]DesiredPolicy{{
+				Source:      PolicyEndpoint{OrgName: "org", SpaceName: "space", AppName: "frontend"},
+				Destination: PolicyEndpoint{OrgName: "org", SpaceName: "space", AppName: "backend"},
+				Protocol:    "udp",
+				StartPort:   53,
+				EndPort:     53,
+			}}, true)
+			Expect(planErr).NotTo(HaveOccurred())
+			Expect(plan.Removals).To(HaveLen(150))
+		})
+
+		JustBeforeEach(func() {
+			err = actor.ApplyNetworkPolicySync(plan)
+		})
+
+		It("creates the additions and then removes the removals in batches", func() {
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
+			Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(0)).To(ConsistOf(cfnetv1.Policy{
+				Source:      cfnetv1.PolicySource{ID: "frontend-guid"},
+				Destination: cfnetv1.PolicyDestination{ID: "backend-guid", Protocol: "udp", Ports: cfnetv1.Ports{Start: 53, End: 53}},
+			}))
+
+			Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(2))
+			Expect(fakeNetworkingClient.RemovePoliciesArgsForCall(0)).To(HaveLen(100))
+			Expect(fakeNetworkingClient.RemovePoliciesArgsForCall(1)).To(HaveLen(50))
+		})
+
+		When("creating policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.CreatePoliciesReturns(errors.New("create-error"))
+			})
+
+			It("returns the error without removing policies", func() {
+				Expect(err).To(MatchError("create-error"))
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -8,11 +8,15 @@ import "code.cloudfoundry.org/cli/v9/util/configv3"
 type Config interface {
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(repoName string, repoURL string)
+	AddTrustedPluginKey(name string, publicKey string)
 	BinaryVersion() string
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
+	PluginSignaturePolicy() string
 	Plugins() []configv3.Plugin
 	RemovePlugin(string)
+	RemoveTrustedPluginKey(name string) bool
+	TrustedPluginKeys() []configv3.TrustedPluginKey
 	WritePluginConfig() error
 }
@@ -0,0 +1,175 @@
+package pluginaction
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/api/plugin"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+)
+
+// PluginLockFile records the installed plugin versions, the repositories they
+// came from and the checksums of their binaries, so that the same plugins can
+// be installed on other machines.
+type PluginLockFile struct {
+	Plugins []LockedPlugin `json:"plugins"`
+}
+
+// LockedPlugin is a plugin version recorded in a plugin lock file. All the
+// binaries of the version are recorded so that the lock file can be used on
+// other platforms.
+type LockedPlugin struct {
+	Name          string                `json:"name"`
+	Version       string                `json:"version"`
+	Repository    string                `json:"repository"`
+	RepositoryURL string                `json:"repository_url"`
+	Binaries      []plugin.PluginBinary `json:"binaries"`
+}
+
+// GetPluginLockFile returns a lock file for the installed plugins. A plugin is
+// only locked when its installed binary is the binary for the platform of the
+// same version in one of the given repositories; the other plugins are
+// returned separately.
+func (actor Actor) GetPluginLockFile(repos []configv3.PluginRepository, platform string) (PluginLockFile, []configv3.Plugin, error) {
+	repositories := make([]plugin.PluginRepository, len(repos))
+	for i, repo := range repos {
+		repository, err := actor.client.GetPluginRepository(repo.URL)
+		if err != nil {
+			return PluginLockFile{}, nil, actionerror.GettingPluginRepositoryError{Name: repo.Name, Message: err.Error()}
+		}
+		repositories[i] = repository
+	}
+
+	lockFile := PluginLockFile{Plugins: []LockedPlugin{}}
+	var unlockedPlugins []configv3.Plugin
+	for _, installedPlugin := range actor.config.Plugins() {
+		lockedPlugin, found := findInstalledPluginInRepositories(installedPlugin, repos, repositories, platform)
+		if !found {
+			unlockedPlugins = append(unlockedPlugins, installedPlugin)
+			continue
+		}
+		lockFile.Plugins = append(lockFile.Plugins, lockedPlugin)
+	}
+
+	return lockFile, unlockedPlugins, nil
+}
+
+// GetLockedPluginUpgrades returns the plugins in the lock file that are not
+// installed, or whose installed binary differs from the locked binary for the
+// platform. The installed plugins that are not in the lock file are returned
+// separately.
+func (actor Actor) GetLockedPluginUpgrades(lockFile PluginLockFile, platform string) ([]PluginUpgrade, []configv3.Plugin, error) {
+	var upgrades []PluginUpgrade
+	lockedPluginNames := map[string]bool{}
+	for _, lockedPlugin := range lockFile.Plugins {
+		lockedPluginNames[lockedPlugin.Name] = true
+
+		binary, ok := binaryForPlatform(lockedPlugin.Binaries, platform)
+		if !ok {
+			return nil, nil, actionerror.LockedPluginNoCompatibleBinaryError{PluginName: lockedPlugin.Name, Version: lockedPlugin.Version}
+		}
+
+		upgrade := PluginUpgrade{
+			PluginInfo: PluginInfo{
+				Name:      lockedPlugin.Name,
+				Version:   lockedPlugin.Version,
+				URL:       binary.URL,
+				Checksum:  binary.Checksum,
+				Signature: binary.Signature,
+			},
+			RepositoryName: lockedPlugin.Repository,
+		}
+
+		if installedPlugin, installed := actor.config.GetPlugin(lockedPlugin.Name); installed {
+			upgrade.CurrentVersion = installedPlugin.Version.String()
+			if upgrade.CurrentVersion == lockedPlugin.Version && strings.EqualFold(installedPlugin.CalculateSHA1(), binary.Checksum) {
+				continue
+			}
+		}
+
+		upgrades = append(upgrades, upgrade)
+	}
+
+	var unlockedPlugins []configv3.Plugin
+	for _, installedPlugin := range actor.config.Plugins() {
+		if !lockedPluginNames[installedPlugin.Name] {
+			unlockedPlugins = append(unlockedPlugins, installedPlugin)
+		}
+	}
+
+	return upgrades, unlockedPlugins, nil
+}
+
+// ReadPluginLockFile reads the plugin lock file at path.
+func (actor Actor) ReadPluginLockFile(path string) (PluginLockFile, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return PluginLockFile{}, err
+	}
+
+	var lockFile PluginLockFile
+	err = json.Unmarshal(contents, &lockFile)
+	if err == nil {
+		err = validatePluginLockFile(lockFile)
+	}
+	if err != nil {
+		return PluginLockFile{}, actionerror.InvalidPluginLockFileError{Path: path, Message: err.Error()}
+	}
+
+	return lockFile, nil
+}
+
+// WritePluginLockFile writes the plugin lock file to path.
+func (actor Actor) WritePluginLockFile(path string, lockFile PluginLockFile) error {
+	contents, err := json.MarshalIndent(lockFile, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(contents, '\n'), 0644)
+}
+
+func findInstalledPluginInRepositories(installedPlugin configv3.Plugin, repos []configv3.PluginRepository, repositories []plugin.PluginRepository, platform string) (LockedPlugin, bool) {
+	version := installedPlugin.Version.String()
+	checksum := installedPlugin.CalculateSHA1()
+
+	for i, repository := range repositories {
+		for _, repoPlugin := range repository.Plugins {
+			if repoPlugin.Name != installedPlugin.Name || repoPlugin.Version != version {
+				continue
+			}
+
+			binary, ok := binaryForPlatform(repoPlugin.Binaries, platform)
+			if !ok || !strings.EqualFold(binary.Checksum, checksum) {
+				continue
+			}
+
+			return LockedPlugin{
+				Name:          repoPlugin.Name,
+				Version:       repoPlugin.Version,
+				Repository:    repos[i].Name,
+				RepositoryURL: repos[i].URL,
+				Binaries:      repoPlugin.Binaries,
+			}, true
+		}
+	}
+
+	return LockedPlugin{}, false
+}
+
+func validatePluginLockFile(lockFile PluginLockFile) error {
+	for _, lockedPlugin := range lockFile.Plugins {
+		if lockedPlugin.Name == "" || lockedPlugin.Version == "" {
+			return errors.New("every plugin needs a name and a version")
+		}
+		for _, binary := range lockedPlugin.Binaries {
+			if binary.URL == "" || binary.Checksum == "" {
+				return errors.New("every binary needs a url and a checksum")
+			}
+		}
+	}
+	return nil
+}
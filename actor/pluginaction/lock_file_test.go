@@ -0,0 +1,249 @@
+package pluginaction_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	. "code.cloudfoundry.org/cli/v9/actor/pluginaction"
+	"code.cloudfoundry.org/cli/v9/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/v9/api/plugin"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("plugin lock file actions", func() {
+	var (
+		actor            *Actor
+		fakeConfig       *pluginactionfakes.FakeConfig
+		fakePluginClient *pluginactionfakes.FakePluginClient
+
+		tempDir     string
+		pluginPath  string
+		pluginSHA1  string
+		otherBinary plugin.PluginBinary
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakePluginClient = new(pluginactionfakes.FakePluginClient)
+		actor = NewActor(fakeConfig, fakePluginClient)
+
+		var err error
+		tempDir, err = os.MkdirTemp("", "")
+		Expect(err).NotTo(HaveOccurred())
+
+		pluginPath = filepath.Join(tempDir, "plugin-1")
+		Expect(os.WriteFile(pluginPath, []byte("some-plugin"), 0600)).To(Succeed())
+		pluginSHA1 = configv3.Plugin{Location: pluginPath}.CalculateSHA1()
+
+		otherBinary = plugin.PluginBinary{Platform: "osx", URL: "https://example.com/osx", Checksum: "osx-checksum"}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	Describe("GetPluginLockFile", func() {
+		var (
+			lockFile        PluginLockFile
+			unlockedPlugins []configv3.Plugin
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			fakePluginClient.GetPluginRepositoryReturns(plugin.PluginRepository{
+				Plugins: []plugin.Plugin{
+					{Name: "plugin-1", Version: "1.0.0", Binaries: []plugin.PluginBinary{
+						{Platform: "linux64", URL: "https://example.com/linux64", Checksum: pluginSHA1},
+						otherBinary,
+					}},
+					{Name: "plugin-2", Version: "2.0.0", Binaries: []plugin.PluginBinary{
+						{Platform: "linux64", URL: "https://example.com/linux64", Checksum: pluginSHA1},
+					}},
+				},
+			}, nil)
+
+			fakeConfig.PluginsReturns([]configv3.Plugin{
+				{Name: "plugin-1", Version: configv3.PluginVersion{Major: 1}, Location: pluginPath},
+				{Name: "plugin-2", Version: configv3.PluginVersion{Major: 1}, Location: pluginPath},
+				{Name: "plugin-3", Version: configv3.PluginVersion{Major: 1}, Location: pluginPath},
+			})
+		})
+
+		JustBeforeEach(func() {
+			lockFile, unlockedPlugins, executeErr = actor.GetPluginLockFile([]configv3.PluginRepository{
+				{Name: "CF-Community", URL: "https://plugins.cloudfoundry.org"},
+			}, "linux64")
+		})
+
+		It("locks the plugins installed from a repository with all their binaries", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(lockFile.Plugins).To(Equal([]LockedPlugin{
+				{
+					Name:          "plugin-1",
+					Version:       "1.0.0",
+					Repository:    "CF-Community",
+					RepositoryURL: "https://plugins.cloudfoundry.org",
+					Binaries: []plugin.PluginBinary{
+						{Platform: "linux64", URL: "https://example.com/linux64", Checksum: pluginSHA1},
+						otherBinary,
+					},
+				},
+			}))
+
+			Expect(unlockedPlugins).To(HaveLen(2))
+			Expect(unlockedPlugins[0].Name).To(Equal("plugin-2"))
+			Expect(unlockedPlugins[1].Name).To(Equal("plugin-3"))
+		})
+
+		When("the installed binary differs from the binary in the repository", func() {
+			BeforeEach(func() {
+				Expect(os.WriteFile(pluginPath, []byte("some-other-plugin"), 0600)).To(Succeed())
+			})
+
+			It("does not lock the plugin", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(lockFile.Plugins).To(BeEmpty())
+				Expect(unlockedPlugins).To(HaveLen(3))
+			})
+		})
+	})
+
+	Describe("GetLockedPluginUpgrades", func() {
+		var (
+			lockFile        PluginLockFile
+			upgrades        []PluginUpgrade
+			unlockedPlugins []configv3.Plugin
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			lockFile = PluginLockFile{Plugins: []LockedPlugin{
+				{Name: "plugin-1", Version: "1.0.0", Repository: "CF-Community", Binaries: []plugin.PluginBinary{
+					{Platform: "linux64", URL: "https://example.com/plugin-1", Checksum: pluginSHA1, Signature: "some-signature"},
+				}},
+				{Name: "plugin-2", Version: "2.0.0", Repository: "CF-Community", Binaries: []plugin.PluginBinary{
+					{Platform: "linux64", URL: "https://example.com/plugin-2", Checksum: pluginSHA1},
+				}},
+				{Name: "plugin-3", Version: "3.0.0", Repository: "CF-Community", Binaries: []plugin.PluginBinary{
+					{Platform: "linux64", URL: "https://example.com/plugin-3", Checksum: "some-checksum"},
+				}},
+			}}
+
+			fakeConfig.GetPluginStub = func(pluginName string) (configv3.Plugin, bool) {
+				switch pluginName {
+				case "plugin-1":
+					return configv3.Plugin{Name: pluginName, Version: configv3.PluginVersion{Major: 1}, Location: pluginPath}, true
+				case "plugin-2":
+					return configv3.Plugin{Name: pluginName, Version: configv3.PluginVersion{Major: 1}, Location: pluginPath}, true
+				default:
+					return configv3.Plugin{}, false
+				}
+			}
+			fakeConfig.PluginsReturns([]configv3.Plugin{
+				{Name: "local-plugin", Version: configv3.PluginVersion{Major: 4}},
+				{Name: "plugin-1", Version: configv3.PluginVersion{Major: 1}, Location: pluginPath},
+				{Name: "plugin-2", Version: configv3.PluginVersion{Major: 1}, Location: pluginPath},
+			})
+		})
+
+		JustBeforeEach(func() {
+			upgrades, unlockedPlugins, executeErr = actor.GetLockedPluginUpgrades(lockFile, "linux64")
+		})
+
+		It("returns the locked plugins that are not installed exactly", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(upgrades).To(Equal([]PluginUpgrade{
+				{
+					PluginInfo:     PluginInfo{Name: "plugin-2", Version: "2.0.0", URL: "https://example.com/plugin-2", Checksum: pluginSHA1},
+					CurrentVersion: "1.0.0",
+					RepositoryName: "CF-Community",
+				},
+				{
+					PluginInfo:     PluginInfo{Name: "plugin-3", Version: "3.0.0", URL: "https://example.com/plugin-3", Checksum: "some-checksum"},
+					RepositoryName: "CF-Community",
+				},
+			}))
+		})
+
+		It("returns the installed plugins that are not in the lock file", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(unlockedPlugins).To(Equal([]configv3.Plugin{
+				{Name: "local-plugin", Version: configv3.PluginVersion{Major: 4}},
+			}))
+		})
+
+		When("the installed binary differs from the locked binary", func() {
+			BeforeEach(func() {
+				lockFile.Plugins[0].Binaries[0].Checksum = "some-checksum"
+			})
+
+			It("reinstalls the plugin", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(upgrades).To(HaveLen(3))
+				Expect(upgrades[0].Signature).To(Equal("some-signature"))
+			})
+		})
+
+		When("a locked plugin has no binary for the platform", func() {
+			BeforeEach(func() {
+				lockFile.Plugins[1].Binaries = []plugin.PluginBinary{otherBinary}
+			})
+
+			It("returns a LockedPluginNoCompatibleBinaryError", func() {
+				Expect(executeErr).To(MatchError(actionerror.LockedPluginNoCompatibleBinaryError{PluginName: "plugin-2", Version: "2.0.0"}))
+			})
+		})
+	})
+
+	Describe("WritePluginLockFile and ReadPluginLockFile", func() {
+		var lockFilePath string
+
+		BeforeEach(func() {
+			lockFilePath = filepath.Join(tempDir, "plugins.lock")
+		})
+
+		It("round trips the lock file", func() {
+			lockFile := PluginLockFile{Plugins: []LockedPlugin{
+				{Name: "plugin-1", Version: "1.0.0", Repository: "CF-Community", RepositoryURL: "https://plugins.cloudfoundry.org", Binaries: []plugin.PluginBinary{otherBinary}},
+			}}
+			Expect(actor.WritePluginLockFile(lockFilePath, lockFile)).To(Succeed())
+
+			contents, err := os.ReadFile(lockFilePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(ContainSubstring(`"repository_url": "https://plugins.cloudfoundry.org"`))
+
+			readLockFile, err := actor.ReadPluginLockFile(lockFilePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(readLockFile).To(Equal(lockFile))
+		})
+
+		When("the lock file is not valid JSON", func() {
+			BeforeEach(func() {
+				Expect(os.WriteFile(lockFilePath, []byte("plugins:"), 0600)).To(Succeed())
+			})
+
+			It("returns an InvalidPluginLockFileError", func() {
+				_, err := actor.ReadPluginLockFile(lockFilePath)
+				Expect(err).To(BeAssignableToTypeOf(actionerror.InvalidPluginLockFileError{}))
+				Expect(err.(actionerror.InvalidPluginLockFileError).Path).To(Equal(lockFilePath))
+			})
+		})
+
+		When("a locked plugin has no version", func() {
+			BeforeEach(func() {
+				Expect(os.WriteFile(lockFilePath, []byte(`{"plugins": [{"name": "plugin-1"}]}`), 0600)).To(Succeed())
+			})
+
+			It("returns an InvalidPluginLockFileError", func() {
+				_, err := actor.ReadPluginLockFile(lockFilePath)
+				Expect(err).To(MatchError(actionerror.InvalidPluginLockFileError{
+					Path:    lockFilePath,
+					Message: "every plugin needs a name and a version",
+				}))
+			})
+		})
+	})
+})
@@ -13,6 +13,9 @@ type PluginInfo struct {
 	Version  string
 	URL      string
 	Checksum string
+	// Signature is the detached signature of the binary, if the repository
+	// provides one.
+	Signature string
 }
 
 // GetPluginInfoFromRepositoriesForPlatform returns the newest version of the specified plugin
@@ -74,10 +77,11 @@ func (actor Actor) getPluginInfoFromRepositoryForPlatform(pluginName string, plu
 			for _, pluginBinary := range plugin.Binaries {
 				if pluginBinary.Platform == platform {
 					return PluginInfo{
-						Name:     plugin.Name,
-						Version:  plugin.Version,
-						URL:      pluginBinary.URL,
-						Checksum: pluginBinary.Checksum,
+						Name:      plugin.Name,
+						Version:   plugin.Version,
+						URL:       pluginBinary.URL,
+						Checksum:  pluginBinary.Checksum,
+						Signature: pluginBinary.Signature,
 					}, nil
 				}
 			}
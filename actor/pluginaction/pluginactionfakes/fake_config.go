@@ -20,6 +20,12 @@ type FakeConfig struct {
 		arg1 string
 		arg2 string
 	}
+	AddTrustedPluginKeyStub        func(string, string)
+	addTrustedPluginKeyMutex       sync.RWMutex
+	addTrustedPluginKeyArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
 	BinaryVersionStub        func() string
 	binaryVersionMutex       sync.RWMutex
 	binaryVersionArgsForCall []struct {
@@ -63,6 +69,16 @@ type FakeConfig struct {
 	pluginRepositoriesReturnsOnCall map[int]struct {
 		result1 []configv3.PluginRepository
 	}
+	PluginSignaturePolicyStub        func() string
+	pluginSignaturePolicyMutex       sync.RWMutex
+	pluginSignaturePolicyArgsForCall []struct {
+	}
+	pluginSignaturePolicyReturns struct {
+		result1 string
+	}
+	pluginSignaturePolicyReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PluginsStub        func() []configv3.Plugin
 	pluginsMutex       sync.RWMutex
 	pluginsArgsForCall []struct {
@@ -78,6 +94,27 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RemoveTrustedPluginKeyStub        func(string) bool
+	removeTrustedPluginKeyMutex       sync.RWMutex
+	removeTrustedPluginKeyArgsForCall []struct {
+		arg1 string
+	}
+	removeTrustedPluginKeyReturns struct {
+		result1 bool
+	}
+	removeTrustedPluginKeyReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	TrustedPluginKeysStub        func() []configv3.TrustedPluginKey
+	trustedPluginKeysMutex       sync.RWMutex
+	trustedPluginKeysArgsForCall []struct {
+	}
+	trustedPluginKeysReturns struct {
+		result1 []configv3.TrustedPluginKey
+	}
+	trustedPluginKeysReturnsOnCall map[int]struct {
+		result1 []configv3.TrustedPluginKey
+	}
 	WritePluginConfigStub        func() error
 	writePluginConfigMutex       sync.RWMutex
 	writePluginConfigArgsForCall []struct {
@@ -157,6 +194,39 @@ func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeConfig) AddTrustedPluginKey(arg1 string, arg2 string) {
+	fake.addTrustedPluginKeyMutex.Lock()
+	fake.addTrustedPluginKeyArgsForCall = append(fake.addTrustedPluginKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AddTrustedPluginKeyStub
+	fake.recordInvocation("AddTrustedPluginKey", []interface{}{arg1, arg2})
+	fake.addTrustedPluginKeyMutex.Unlock()
+	if stub != nil {
+		fake.AddTrustedPluginKeyStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeConfig) AddTrustedPluginKeyCallCount() int {
+	fake.addTrustedPluginKeyMutex.RLock()
+	defer fake.addTrustedPluginKeyMutex.RUnlock()
+	return len(fake.addTrustedPluginKeyArgsForCall)
+}
+
+func (fake *FakeConfig) AddTrustedPluginKeyCalls(stub func(string, string)) {
+	fake.addTrustedPluginKeyMutex.Lock()
+	defer fake.addTrustedPluginKeyMutex.Unlock()
+	fake.AddTrustedPluginKeyStub = stub
+}
+
+func (fake *FakeConfig) AddTrustedPluginKeyArgsForCall(i int) (string, string) {
+	fake.addTrustedPluginKeyMutex.RLock()
+	defer fake.addTrustedPluginKeyMutex.RUnlock()
+	argsForCall := fake.addTrustedPluginKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeConfig) BinaryVersion() string {
 	fake.binaryVersionMutex.Lock()
 	ret, specificReturn := fake.binaryVersionReturnsOnCall[len(fake.binaryVersionArgsForCall)]
@@ -380,6 +450,59 @@ func (fake *FakeConfig) PluginRepositoriesReturnsOnCall(i int, result1 []configv
 	}{result1}
 }
 
+func (fake *FakeConfig) PluginSignaturePolicy() string {
+	fake.pluginSignaturePolicyMutex.Lock()
+	ret, specificReturn := fake.pluginSignaturePolicyReturnsOnCall[len(fake.pluginSignaturePolicyArgsForCall)]
+	fake.pluginSignaturePolicyArgsForCall = append(fake.pluginSignaturePolicyArgsForCall, struct {
+	}{})
+	stub := fake.PluginSignaturePolicyStub
+	fakeReturns := fake.pluginSignaturePolicyReturns
+	fake.recordInvocation("PluginSignaturePolicy", []interface{}{})
+	fake.pluginSignaturePolicyMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PluginSignaturePolicyCallCount() int {
+	fake.pluginSignaturePolicyMutex.RLock()
+	defer fake.pluginSignaturePolicyMutex.RUnlock()
+	return len(fake.pluginSignaturePolicyArgsForCall)
+}
+
+func (fake *FakeConfig) PluginSignaturePolicyCalls(stub func() string) {
+	fake.pluginSignaturePolicyMutex.Lock()
+	defer fake.pluginSignaturePolicyMutex.Unlock()
+	fake.PluginSignaturePolicyStub = stub
+}
+
+func (fake *FakeConfig) PluginSignaturePolicyReturns(result1 string) {
+	fake.pluginSignaturePolicyMutex.Lock()
+	defer fake.pluginSignaturePolicyMutex.Unlock()
+	fake.PluginSignaturePolicyStub = nil
+	fake.pluginSignaturePolicyReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginSignaturePolicyReturnsOnCall(i int, result1 string) {
+	fake.pluginSignaturePolicyMutex.Lock()
+	defer fake.pluginSignaturePolicyMutex.Unlock()
+	fake.PluginSignaturePolicyStub = nil
+	if fake.pluginSignaturePolicyReturnsOnCall == nil {
+		fake.pluginSignaturePolicyReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pluginSignaturePolicyReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) Plugins() []configv3.Plugin {
 	fake.pluginsMutex.Lock()
 	ret, specificReturn := fake.pluginsReturnsOnCall[len(fake.pluginsArgsForCall)]
@@ -465,6 +588,120 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) RemoveTrustedPluginKey(arg1 string) bool {
+	fake.removeTrustedPluginKeyMutex.Lock()
+	ret, specificReturn := fake.removeTrustedPluginKeyReturnsOnCall[len(fake.removeTrustedPluginKeyArgsForCall)]
+	fake.removeTrustedPluginKeyArgsForCall = append(fake.removeTrustedPluginKeyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RemoveTrustedPluginKeyStub
+	fakeReturns := fake.removeTrustedPluginKeyReturns
+	fake.recordInvocation("RemoveTrustedPluginKey", []interface{}{arg1})
+	fake.removeTrustedPluginKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RemoveTrustedPluginKeyCallCount() int {
+	fake.removeTrustedPluginKeyMutex.RLock()
+	defer fake.removeTrustedPluginKeyMutex.RUnlock()
+	return len(fake.removeTrustedPluginKeyArgsForCall)
+}
+
+func (fake *FakeConfig) RemoveTrustedPluginKeyCalls(stub func(string) bool) {
+	fake.removeTrustedPluginKeyMutex.Lock()
+	defer fake.removeTrustedPluginKeyMutex.Unlock()
+	fake.RemoveTrustedPluginKeyStub = stub
+}
+
+func (fake *FakeConfig) RemoveTrustedPluginKeyArgsForCall(i int) string {
+	fake.removeTrustedPluginKeyMutex.RLock()
+	defer fake.removeTrustedPluginKeyMutex.RUnlock()
+	argsForCall := fake.removeTrustedPluginKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) RemoveTrustedPluginKeyReturns(result1 bool) {
+	fake.removeTrustedPluginKeyMutex.Lock()
+	defer fake.removeTrustedPluginKeyMutex.Unlock()
+	fake.RemoveTrustedPluginKeyStub = nil
+	fake.removeTrustedPluginKeyReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) RemoveTrustedPluginKeyReturnsOnCall(i int, result1 bool) {
+	fake.removeTrustedPluginKeyMutex.Lock()
+	defer fake.removeTrustedPluginKeyMutex.Unlock()
+	fake.RemoveTrustedPluginKeyStub = nil
+	if fake.removeTrustedPluginKeyReturnsOnCall == nil {
+		fake.removeTrustedPluginKeyReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.removeTrustedPluginKeyReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) TrustedPluginKeys() []configv3.TrustedPluginKey {
+	fake.trustedPluginKeysMutex.Lock()
+	ret, specificReturn := fake.trustedPluginKeysReturnsOnCall[len(fake.trustedPluginKeysArgsForCall)]
+	fake.trustedPluginKeysArgsForCall = append(fake.trustedPluginKeysArgsForCall, struct {
+	}{})
+	stub := fake.TrustedPluginKeysStub
+	fakeReturns := fake.trustedPluginKeysReturns
+	fake.recordInvocation("TrustedPluginKeys", []interface{}{})
+	fake.trustedPluginKeysMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) TrustedPluginKeysCallCount() int {
+	fake.trustedPluginKeysMutex.RLock()
+	defer fake.trustedPluginKeysMutex.RUnlock()
+	return len(fake.trustedPluginKeysArgsForCall)
+}
+
+func (fake *FakeConfig) TrustedPluginKeysCalls(stub func() []configv3.TrustedPluginKey) {
+	fake.trustedPluginKeysMutex.Lock()
+	defer fake.trustedPluginKeysMutex.Unlock()
+	fake.TrustedPluginKeysStub = stub
+}
+
+func (fake *FakeConfig) TrustedPluginKeysReturns(result1 []configv3.TrustedPluginKey) {
+	fake.trustedPluginKeysMutex.Lock()
+	defer fake.trustedPluginKeysMutex.Unlock()
+	fake.TrustedPluginKeysStub = nil
+	fake.trustedPluginKeysReturns = struct {
+		result1 []configv3.TrustedPluginKey
+	}{result1}
+}
+
+func (fake *FakeConfig) TrustedPluginKeysReturnsOnCall(i int, result1 []configv3.TrustedPluginKey) {
+	fake.trustedPluginKeysMutex.Lock()
+	defer fake.trustedPluginKeysMutex.Unlock()
+	fake.TrustedPluginKeysStub = nil
+	if fake.trustedPluginKeysReturnsOnCall == nil {
+		fake.trustedPluginKeysReturnsOnCall = make(map[int]struct {
+			result1 []configv3.TrustedPluginKey
+		})
+	}
+	fake.trustedPluginKeysReturnsOnCall[i] = struct {
+		result1 []configv3.TrustedPluginKey
+	}{result1}
+}
+
 func (fake *FakeConfig) WritePluginConfig() error {
 	fake.writePluginConfigMutex.Lock()
 	ret, specificReturn := fake.writePluginConfigReturnsOnCall[len(fake.writePluginConfigArgsForCall)]
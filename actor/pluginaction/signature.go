@@ -0,0 +1,238 @@
+package pluginaction
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/util"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"golang.org/x/crypto/blake2b"
+)
+
+// Plugin keys and signatures are either the base64 encoded ed25519 public key
+// and signature of the plugin binary, or in the format used by minisign,
+// where a key ID tells which key made a signature and the signature may be of
+// the BLAKE2b-512 hash of the binary instead of the binary itself.
+const (
+	minisignAlgorithm       = "Ed"
+	minisignHashedAlgorithm = "ED"
+	minisignKeyIDLength     = 8
+
+	untrustedCommentPrefix = "untrusted comment:"
+	trustedCommentPrefix   = "trusted comment: "
+)
+
+type pluginPublicKey struct {
+	keyID []byte
+	key   ed25519.PublicKey
+}
+
+type pluginSignature struct {
+	algorithm       string
+	keyID           []byte
+	signature       []byte
+	trustedComment  string
+	globalSignature []byte
+}
+
+// AddTrustedPluginKey trusts plugins signed with the given ed25519 public key.
+func (actor Actor) AddTrustedPluginKey(keyName string, publicKey string) error {
+	publicKey = strings.TrimSpace(publicKey)
+	_, err := parsePluginPublicKey(publicKey)
+	if err != nil {
+		return actionerror.InvalidPluginKeyError{Name: keyName, Message: err.Error()}
+	}
+
+	for _, key := range actor.config.TrustedPluginKeys() {
+		if !strings.EqualFold(key.Name, keyName) {
+			continue
+		}
+		if key.PublicKey == publicKey {
+			return actionerror.PluginKeyAlreadyExistsError{Name: key.Name}
+		}
+		return actionerror.PluginKeyNameTakenError{Name: key.Name}
+	}
+
+	actor.config.AddTrustedPluginKey(keyName, publicKey)
+	return nil
+}
+
+// RemoveTrustedPluginKey stops trusting plugins signed with the named key.
+func (actor Actor) RemoveTrustedPluginKey(keyName string) error {
+	if !actor.config.RemoveTrustedPluginKey(keyName) {
+		return actionerror.PluginKeyNotFoundError{Name: keyName}
+	}
+	return nil
+}
+
+// GetPluginSignature reads a detached plugin signature from a file or
+// downloads it from a URL.
+func (actor Actor) GetPluginSignature(location string, tempPluginDir string) (string, error) {
+	path := location
+	if util.IsHTTPScheme(location) {
+		tempFile, err := makeTempFile(tempPluginDir)
+		if err != nil {
+			return "", err
+		}
+
+		err = actor.client.DownloadPlugin(location, tempFile.Name(), nil)
+		if err != nil {
+			return "", err
+		}
+		path = tempFile.Name()
+	}
+
+	signature, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(signature), nil
+}
+
+// VerifyPluginSignature checks the signature of the plugin binary at path
+// against the trusted plugin keys and returns the name of the key that signed
+// it. A plugin without a signature is accepted, with an empty key name,
+// unless the plugin signature policy requires signatures.
+func (actor Actor) VerifyPluginSignature(path string, signature string) (string, error) {
+	if strings.TrimSpace(signature) == "" {
+		if actor.config.PluginSignaturePolicy() == configv3.PluginSignaturesRequired {
+			return "", actionerror.PluginNotSignedError{}
+		}
+		return "", nil
+	}
+
+	parsedSignature, err := parsePluginSignature(signature)
+	if err != nil {
+		return "", actionerror.PluginSignatureInvalidError{Message: err.Error()}
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	for _, trustedKey := range actor.config.TrustedPluginKeys() {
+		key, err := parsePluginPublicKey(trustedKey.PublicKey)
+		if err != nil {
+			continue
+		}
+
+		if parsedSignature.verify(key, contents) {
+			return trustedKey.Name, nil
+		}
+	}
+
+	return "", actionerror.PluginSignatureNotTrustedError{}
+}
+
+func (signature pluginSignature) verify(key pluginPublicKey, contents []byte) bool {
+	if signature.keyID != nil && key.keyID != nil && !bytes.Equal(signature.keyID, key.keyID) {
+		return false
+	}
+
+	message := contents
+	if signature.algorithm == minisignHashedAlgorithm {
+		hash := blake2b.Sum512(contents)
+		message = hash[:]
+	}
+
+	if !ed25519.Verify(key.key, message, signature.signature) {
+		return false
+	}
+
+	if signature.globalSignature != nil {
+		global := append(append([]byte{}, signature.signature...), signature.trustedComment...)
+		return ed25519.Verify(key.key, global, signature.globalSignature)
+	}
+	return true
+}
+
+func parsePluginPublicKey(text string) (pluginPublicKey, error) {
+	lines := signatureFileLines(text)
+	if len(lines) != 1 {
+		return pluginPublicKey{}, errors.New("expected a single base64 encoded key")
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[0]))
+	if err != nil {
+		return pluginPublicKey{}, errors.New("key is not base64 encoded")
+	}
+
+	switch len(raw) {
+	case ed25519.PublicKeySize:
+		return pluginPublicKey{key: raw}, nil
+	case 2 + minisignKeyIDLength + ed25519.PublicKeySize:
+		if string(raw[:2]) != minisignAlgorithm {
+			return pluginPublicKey{}, errors.New("key is not an ed25519 key")
+		}
+		return pluginPublicKey{
+			keyID: raw[2 : 2+minisignKeyIDLength],
+			key:   raw[2+minisignKeyIDLength:],
+		}, nil
+	default:
+		return pluginPublicKey{}, errors.New("key is not an ed25519 key")
+	}
+}
+
+func parsePluginSignature(text string) (pluginSignature, error) {
+	lines := signatureFileLines(text)
+	if len(lines) != 1 && len(lines) != 3 {
+		return pluginSignature{}, errors.New("expected a base64 encoded signature")
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[0]))
+	if err != nil {
+		return pluginSignature{}, errors.New("signature is not base64 encoded")
+	}
+
+	var signature pluginSignature
+	switch len(raw) {
+	case ed25519.SignatureSize:
+		signature = pluginSignature{signature: raw}
+	case 2 + minisignKeyIDLength + ed25519.SignatureSize:
+		algorithm := string(raw[:2])
+		if algorithm != minisignAlgorithm && algorithm != minisignHashedAlgorithm {
+			return pluginSignature{}, errors.New("signature is not an ed25519 signature")
+		}
+		signature = pluginSignature{
+			algorithm: algorithm,
+			keyID:     raw[2 : 2+minisignKeyIDLength],
+			signature: raw[2+minisignKeyIDLength:],
+		}
+	default:
+		return pluginSignature{}, errors.New("signature is not an ed25519 signature")
+	}
+
+	if len(lines) == 3 {
+		if !strings.HasPrefix(lines[1], trustedCommentPrefix) {
+			return pluginSignature{}, errors.New("expected a trusted comment after the signature")
+		}
+		globalSignature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[2]))
+		if err != nil || len(globalSignature) != ed25519.SignatureSize {
+			return pluginSignature{}, errors.New("trusted comment signature is not a base64 encoded ed25519 signature")
+		}
+		signature.trustedComment = strings.TrimPrefix(lines[1], trustedCommentPrefix)
+		signature.globalSignature = globalSignature
+	}
+
+	return signature, nil
+}
+
+// signatureFileLines returns the non-empty lines of a key or signature,
+// without untrusted comments.
+func signatureFileLines(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, untrustedCommentPrefix) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
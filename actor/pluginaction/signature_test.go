@@ -0,0 +1,266 @@
+package pluginaction_test
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	. "code.cloudfoundry.org/cli/v9/actor/pluginaction"
+	"code.cloudfoundry.org/cli/v9/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/v9/api/plugin"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/blake2b"
+)
+
+var _ = Describe("Plugin signatures", func() {
+	var (
+		actor      *Actor
+		fakeConfig *pluginactionfakes.FakeConfig
+		fakeClient *pluginactionfakes.FakePluginClient
+
+		publicKey  ed25519.PublicKey
+		privateKey ed25519.PrivateKey
+		keyID      []byte
+	)
+
+	encode := func(parts ...[]byte) string {
+		var raw []byte
+		for _, part := range parts {
+			raw = append(raw, part...)
+		}
+		return base64.StdEncoding.EncodeToString(raw)
+	}
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakeClient = new(pluginactionfakes.FakePluginClient)
+		actor = NewActor(fakeConfig, fakeClient)
+
+		var err error
+		publicKey, privateKey, err = ed25519.GenerateKey(nil)
+		Expect(err).NotTo(HaveOccurred())
+		keyID = []byte("12345678")
+	})
+
+	Describe("AddTrustedPluginKey", func() {
+		BeforeEach(func() {
+			fakeConfig.TrustedPluginKeysReturns([]configv3.TrustedPluginKey{
+				{Name: "Existing-Key", PublicKey: encode(publicKey)},
+			})
+		})
+
+		It("adds raw and minisign ed25519 public keys", func() {
+			Expect(actor.AddTrustedPluginKey("raw-key", " "+encode(publicKey)+"\n")).To(Succeed())
+			Expect(actor.AddTrustedPluginKey("minisign-key", "untrusted comment: minisign public key\n"+encode([]byte("Ed"), keyID, publicKey))).To(Succeed())
+
+			Expect(fakeConfig.AddTrustedPluginKeyCallCount()).To(Equal(2))
+			name, key := fakeConfig.AddTrustedPluginKeyArgsForCall(0)
+			Expect(name).To(Equal("raw-key"))
+			Expect(key).To(Equal(encode(publicKey)))
+		})
+
+		It("rejects keys that are not ed25519 public keys", func() {
+			err := actor.AddTrustedPluginKey("bad-key", encode([]byte("too short")))
+			Expect(err).To(MatchError(actionerror.InvalidPluginKeyError{Name: "bad-key", Message: "key is not an ed25519 key"}))
+
+			err = actor.AddTrustedPluginKey("bad-key", "not base64!")
+			Expect(err).To(MatchError(actionerror.InvalidPluginKeyError{Name: "bad-key", Message: "key is not base64 encoded"}))
+			Expect(fakeConfig.AddTrustedPluginKeyCallCount()).To(Equal(0))
+		})
+
+		It("reports a key that is already trusted under the same name", func() {
+			err := actor.AddTrustedPluginKey("existing-key", encode(publicKey))
+			Expect(err).To(MatchError(actionerror.PluginKeyAlreadyExistsError{Name: "Existing-Key"}))
+		})
+
+		It("refuses a different key with the same name", func() {
+			otherKey, _, err := ed25519.GenerateKey(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = actor.AddTrustedPluginKey("existing-key", encode(otherKey))
+			Expect(err).To(MatchError(actionerror.PluginKeyNameTakenError{Name: "Existing-Key"}))
+			Expect(fakeConfig.AddTrustedPluginKeyCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("RemoveTrustedPluginKey", func() {
+		It("removes the key", func() {
+			fakeConfig.RemoveTrustedPluginKeyReturns(true)
+			Expect(actor.RemoveTrustedPluginKey("some-key")).To(Succeed())
+			Expect(fakeConfig.RemoveTrustedPluginKeyArgsForCall(0)).To(Equal("some-key"))
+		})
+
+		It("returns an error when there is no such key", func() {
+			Expect(actor.RemoveTrustedPluginKey("some-key")).To(MatchError(actionerror.PluginKeyNotFoundError{Name: "some-key"}))
+		})
+	})
+
+	Describe("GetPluginSignature", func() {
+		var tempDir string
+
+		BeforeEach(func() {
+			var err error
+			tempDir, err = os.MkdirTemp("", "")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tempDir)).To(Succeed())
+		})
+
+		It("reads a local signature file", func() {
+			path := filepath.Join(tempDir, "plugin.sig")
+			Expect(os.WriteFile(path, []byte("some-signature"), 0600)).To(Succeed())
+
+			signature, err := actor.GetPluginSignature(path, tempDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signature).To(Equal("some-signature"))
+			Expect(fakeClient.DownloadPluginCallCount()).To(Equal(0))
+		})
+
+		It("downloads a signature from a URL", func() {
+			fakeClient.DownloadPluginStub = func(_ string, path string, _ plugin.ProxyReader) error {
+				return os.WriteFile(path, []byte("downloaded-signature"), 0600)
+			}
+
+			signature, err := actor.GetPluginSignature("https://example.com/plugin.minisig", tempDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signature).To(Equal("downloaded-signature"))
+
+			url, path, _ := fakeClient.DownloadPluginArgsForCall(0)
+			Expect(url).To(Equal("https://example.com/plugin.minisig"))
+			Expect(filepath.Dir(path)).To(Equal(tempDir))
+		})
+	})
+
+	Describe("VerifyPluginSignature", func() {
+		var (
+			pluginPath string
+			contents   []byte
+			signature  string
+
+			keyName    string
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			contents = []byte("some-plugin-binary")
+			file, err := os.CreateTemp("", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+			pluginPath = file.Name()
+			Expect(os.WriteFile(pluginPath, contents, 0600)).To(Succeed())
+
+			otherKey, _, err := ed25519.GenerateKey(nil)
+			Expect(err).NotTo(HaveOccurred())
+			fakeConfig.TrustedPluginKeysReturns([]configv3.TrustedPluginKey{
+				{Name: "broken-key", PublicKey: "not-a-key"},
+				{Name: "other-key", PublicKey: encode(otherKey)},
+				{Name: "release-key", PublicKey: encode([]byte("Ed"), keyID, publicKey)},
+			})
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(pluginPath)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			keyName, executeErr = actor.VerifyPluginSignature(pluginPath, signature)
+		})
+
+		When("the plugin is signed with a raw ed25519 signature", func() {
+			BeforeEach(func() {
+				signature = encode(ed25519.Sign(privateKey, contents)) + "\n"
+			})
+
+			It("returns the name of the trusted key", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(keyName).To(Equal("release-key"))
+			})
+		})
+
+		When("the plugin is signed with a prehashed minisign signature", func() {
+			BeforeEach(func() {
+				hash := blake2b.Sum512(contents)
+				sig := ed25519.Sign(privateKey, hash[:])
+				globalSig := ed25519.Sign(privateKey, append(append([]byte{}, sig...), "timestamp:1 file:plugin"...))
+				signature = "untrusted comment: signature from minisign secret key\r\n" +
+					encode([]byte("ED"), keyID, sig) + "\r\n" +
+					"trusted comment: timestamp:1 file:plugin\r\n" +
+					encode(globalSig) + "\r\n"
+			})
+
+			It("returns the name of the trusted key", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(keyName).To(Equal("release-key"))
+			})
+
+			When("the trusted comment was changed", func() {
+				BeforeEach(func() {
+					signature = strings.Replace(signature, "file:plugin", "file:other-plugin", 1)
+				})
+
+				It("does not trust the signature", func() {
+					Expect(executeErr).To(MatchError(actionerror.PluginSignatureNotTrustedError{}))
+				})
+			})
+		})
+
+		When("the signature is from a different key ID", func() {
+			BeforeEach(func() {
+				signature = encode([]byte("Ed"), []byte("87654321"), ed25519.Sign(privateKey, contents))
+			})
+
+			It("does not trust the signature", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginSignatureNotTrustedError{}))
+				Expect(keyName).To(BeEmpty())
+			})
+		})
+
+		When("the binary does not match the signature", func() {
+			BeforeEach(func() {
+				signature = encode(ed25519.Sign(privateKey, []byte("another-binary")))
+			})
+
+			It("does not trust the signature", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginSignatureNotTrustedError{}))
+			})
+		})
+
+		When("the signature cannot be parsed", func() {
+			BeforeEach(func() {
+				signature = encode([]byte("short"))
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginSignatureInvalidError{Message: "signature is not an ed25519 signature"}))
+			})
+		})
+
+		When("the plugin is not signed", func() {
+			BeforeEach(func() {
+				signature = ""
+			})
+
+			It("accepts it", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(keyName).To(BeEmpty())
+			})
+
+			When("signatures are required", func() {
+				BeforeEach(func() {
+					fakeConfig.PluginSignaturePolicyReturns(configv3.PluginSignaturesRequired)
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError(actionerror.PluginNotSignedError{}))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,154 @@
+package pluginaction
+
+import (
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/api/plugin"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+)
+
+// PluginUpgrade is a version of a plugin to install in place of the installed
+// version.
+type PluginUpgrade struct {
+	PluginInfo
+
+	// CurrentVersion is the installed version of the plugin, or empty if the
+	// plugin is not installed.
+	CurrentVersion string
+	RepositoryName string
+}
+
+// GetPluginUpgrades returns the newest version in the given repositories of
+// each outdated installed plugin, sorted by plugin name. Plugins with a pinned
+// version are kept at that version instead. When pluginVersions is not empty,
+// only the plugins it names are upgraded, and the plugins mapped to a version
+// are upgraded, or downgraded, to that version instead of their pinned
+// version.
+func (actor Actor) GetPluginUpgrades(pluginVersions map[string]string, repos []configv3.PluginRepository, platform string) ([]PluginUpgrade, error) {
+	repositories := make([]plugin.PluginRepository, len(repos))
+	for i, repo := range repos {
+		repository, err := actor.client.GetPluginRepository(repo.URL)
+		if err != nil {
+			return nil, actionerror.GettingPluginRepositoryError{Name: repo.Name, Message: err.Error()}
+		}
+		repositories[i] = repository
+	}
+
+	installedPlugins := actor.config.Plugins()
+	if len(pluginVersions) > 0 {
+		installedPlugins = nil
+		for pluginName := range pluginVersions {
+			installedPlugin, installed := actor.config.GetPlugin(pluginName)
+			if !installed {
+				return nil, actionerror.PluginNotFoundError{PluginName: pluginName}
+			}
+			installedPlugins = append(installedPlugins, installedPlugin)
+		}
+		sort.Slice(installedPlugins, func(i, j int) bool {
+			return strings.ToLower(installedPlugins[i].Name) < strings.ToLower(installedPlugins[j].Name)
+		})
+	}
+
+	var upgrades []PluginUpgrade
+	for _, installedPlugin := range installedPlugins {
+		pinnedVersion, named := pluginVersions[installedPlugin.Name]
+		if !named {
+			pinnedVersion = installedPlugin.PinnedVersion
+		}
+		upgrade, err := findPluginInRepositories(installedPlugin.Name, pinnedVersion, repos, repositories, platform)
+		if err != nil {
+			// plugins that are not in any repository are only an error when
+			// they are asked for by name
+			if len(pluginVersions) == 0 {
+				continue
+			}
+			return nil, err
+		}
+
+		upgrade.CurrentVersion = installedPlugin.Version.String()
+		if upgrade.Version == upgrade.CurrentVersion ||
+			pinnedVersion == "" && !lessThan(upgrade.CurrentVersion, upgrade.Version) {
+			continue
+		}
+		upgrades = append(upgrades, upgrade)
+	}
+
+	return upgrades, nil
+}
+
+// PinPluginVersions pins each installed plugin in pluginVersions to the
+// version it is mapped to, or unpins it when it is mapped to no version.
+func (actor Actor) PinPluginVersions(pluginVersions map[string]string) error {
+	for pluginName, version := range pluginVersions {
+		installedPlugin, installed := actor.config.GetPlugin(pluginName)
+		if !installed {
+			continue
+		}
+		installedPlugin.PinnedVersion = version
+		actor.config.AddPlugin(installedPlugin)
+	}
+
+	return actor.config.WritePluginConfig()
+}
+
+// findPluginInRepositories returns the newest version of the plugin with a
+// binary for the platform, or the given version if one is given.
+func findPluginInRepositories(pluginName string, version string, repos []configv3.PluginRepository, repositories []plugin.PluginRepository, platform string) (PluginUpgrade, error) {
+	var (
+		found                             bool
+		pluginFoundWithIncompatibleBinary bool
+		upgrade                           PluginUpgrade
+	)
+
+	for i, repository := range repositories {
+		for _, repoPlugin := range repository.Plugins {
+			if repoPlugin.Name != pluginName || version != "" && repoPlugin.Version != version {
+				continue
+			}
+
+			binary, ok := binaryForPlatform(repoPlugin.Binaries, platform)
+			if !ok {
+				pluginFoundWithIncompatibleBinary = true
+				continue
+			}
+
+			if found && !lessThan(upgrade.Version, repoPlugin.Version) {
+				continue
+			}
+
+			found = true
+			upgrade = PluginUpgrade{
+				PluginInfo: PluginInfo{
+					Name:      repoPlugin.Name,
+					Version:   repoPlugin.Version,
+					URL:       binary.URL,
+					Checksum:  binary.Checksum,
+					Signature: binary.Signature,
+				},
+				RepositoryName: repos[i].Name,
+			}
+		}
+	}
+
+	switch {
+	case found:
+		return upgrade, nil
+	case pluginFoundWithIncompatibleBinary:
+		return PluginUpgrade{}, actionerror.NoCompatibleBinaryError{}
+	case version != "":
+		return PluginUpgrade{}, actionerror.PluginVersionNotFoundError{PluginName: pluginName, Version: version}
+	default:
+		return PluginUpgrade{}, actionerror.PluginNotFoundInAnyRepositoryError{PluginName: pluginName}
+	}
+}
+
+func binaryForPlatform(binaries []plugin.PluginBinary, platform string) (plugin.PluginBinary, bool) {
+	for _, binary := range binaries {
+		if binary.Platform == platform {
+			return binary, true
+		}
+	}
+	return plugin.PluginBinary{}, false
+}
@@ -0,0 +1,275 @@
+package pluginaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	. "code.cloudfoundry.org/cli/v9/actor/pluginaction"
+	"code.cloudfoundry.org/cli/v9/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/v9/api/plugin"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("plugin upgrade actions", func() {
+	var (
+		actor            *Actor
+		fakeConfig       *pluginactionfakes.FakeConfig
+		fakePluginClient *pluginactionfakes.FakePluginClient
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakePluginClient = new(pluginactionfakes.FakePluginClient)
+		actor = NewActor(fakeConfig, fakePluginClient)
+	})
+
+	Describe("GetPluginUpgrades", func() {
+		var (
+			repos          []configv3.PluginRepository
+			pluginVersions map[string]string
+
+			upgrades   []PluginUpgrade
+			executeErr error
+		)
+
+		binaries := func(version string) []plugin.PluginBinary {
+			return []plugin.PluginBinary{
+				{Platform: "linux64", URL: "https://example.com/linux64/" + version, Checksum: "linux64-" + version},
+				{Platform: "osx", URL: "https://example.com/osx/" + version, Checksum: "osx-" + version},
+			}
+		}
+
+		BeforeEach(func() {
+			repos = []configv3.PluginRepository{
+				{Name: "CF-Community", URL: "https://plugins.cloudfoundry.org"},
+				{Name: "Coo Plugins", URL: "https://reallycooplugins.org"},
+			}
+			pluginVersions = nil
+
+			fakePluginClient.GetPluginRepositoryStub = func(repositoryURL string) (plugin.PluginRepository, error) {
+				if repositoryURL == "https://plugins.cloudfoundry.org" {
+					return plugin.PluginRepository{
+						Plugins: []plugin.Plugin{
+							{Name: "plugin-1", Version: "2.0.0", Binaries: binaries("2.0.0")},
+							{Name: "plugin-2", Version: "1.5.0", Binaries: binaries("1.5.0")},
+							{Name: "plugin-3", Version: "3.0.0", Binaries: binaries("3.0.0")},
+						},
+					}, nil
+				}
+				return plugin.PluginRepository{
+					Plugins: []plugin.Plugin{
+						{Name: "plugin-1", Version: "1.5.0", Binaries: binaries("1.5.0")},
+						{Name: "plugin-2", Version: "2.0.0", Binaries: binaries("2.0.0")},
+						{Name: "plugin-2", Version: "3.0.0", Binaries: []plugin.PluginBinary{{Platform: "win64"}}},
+					},
+				}, nil
+			}
+
+			fakeConfig.PluginsReturns([]configv3.Plugin{
+				{Name: "local-plugin", Version: configv3.PluginVersion{Major: 1}},
+				{Name: "plugin-1", Version: configv3.PluginVersion{Major: 1}},
+				{Name: "plugin-2", Version: configv3.PluginVersion{Major: 1}},
+				{Name: "plugin-3", Version: configv3.PluginVersion{Major: 3}},
+			})
+			fakeConfig.GetPluginStub = func(pluginName string) (configv3.Plugin, bool) {
+				for _, installedPlugin := range fakeConfig.Plugins() {
+					if installedPlugin.Name == pluginName {
+						return installedPlugin, true
+					}
+				}
+				return configv3.Plugin{}, false
+			}
+		})
+
+		JustBeforeEach(func() {
+			upgrades, executeErr = actor.GetPluginUpgrades(pluginVersions, repos, "linux64")
+		})
+
+		It("returns the newest compatible version of every outdated plugin", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(upgrades).To(Equal([]PluginUpgrade{
+				{
+					PluginInfo:     PluginInfo{Name: "plugin-1", Version: "2.0.0", URL: "https://example.com/linux64/2.0.0", Checksum: "linux64-2.0.0"},
+					CurrentVersion: "1.0.0",
+					RepositoryName: "CF-Community",
+				},
+				{
+					PluginInfo:     PluginInfo{Name: "plugin-2", Version: "2.0.0", URL: "https://example.com/linux64/2.0.0", Checksum: "linux64-2.0.0"},
+					CurrentVersion: "1.0.0",
+					RepositoryName: "Coo Plugins",
+				},
+			}))
+		})
+
+		When("an installed plugin is pinned to a version", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginsReturns([]configv3.Plugin{
+					{Name: "plugin-1", Version: configv3.PluginVersion{Major: 1}, PinnedVersion: "1.5.0"},
+					{Name: "plugin-2", Version: configv3.PluginVersion{Major: 1}, PinnedVersion: "1.0.0"},
+				})
+			})
+
+			It("returns the pinned version instead of the newest version", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(upgrades).To(Equal([]PluginUpgrade{
+					{
+						PluginInfo:     PluginInfo{Name: "plugin-1", Version: "1.5.0", URL: "https://example.com/linux64/1.5.0", Checksum: "linux64-1.5.0"},
+						CurrentVersion: "1.0.0",
+						RepositoryName: "Coo Plugins",
+					},
+				}))
+			})
+
+			When("the plugin is named without a version", func() {
+				BeforeEach(func() {
+					pluginVersions = map[string]string{"plugin-1": ""}
+				})
+
+				It("returns the newest version", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(upgrades).To(HaveLen(1))
+					Expect(upgrades[0].Version).To(Equal("2.0.0"))
+				})
+			})
+		})
+
+		When("plugins are named", func() {
+			BeforeEach(func() {
+				pluginVersions = map[string]string{"plugin-2": "", "plugin-1": ""}
+			})
+
+			It("only returns the named plugins", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(upgrades).To(HaveLen(2))
+			})
+
+			When("a named plugin is pinned to a version", func() {
+				BeforeEach(func() {
+					pluginVersions = map[string]string{"plugin-1": "1.5.0", "plugin-3": "3.0.0"}
+				})
+
+				It("returns that version, unless it is installed", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(upgrades).To(ConsistOf(PluginUpgrade{
+						PluginInfo:     PluginInfo{Name: "plugin-1", Version: "1.5.0", URL: "https://example.com/linux64/1.5.0", Checksum: "linux64-1.5.0"},
+						CurrentVersion: "1.0.0",
+						RepositoryName: "Coo Plugins",
+					}))
+				})
+			})
+
+			When("a named plugin is pinned to a version that is older than the installed version", func() {
+				BeforeEach(func() {
+					fakeConfig.PluginsReturns([]configv3.Plugin{{Name: "plugin-1", Version: configv3.PluginVersion{Major: 2}}})
+					pluginVersions = map[string]string{"plugin-1": "1.5.0"}
+				})
+
+				It("downgrades the plugin", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(upgrades).To(HaveLen(1))
+					Expect(upgrades[0].CurrentVersion).To(Equal("2.0.0"))
+					Expect(upgrades[0].Version).To(Equal("1.5.0"))
+				})
+			})
+
+			When("the pinned version is not in any repository", func() {
+				BeforeEach(func() {
+					pluginVersions = map[string]string{"plugin-1": "9.9.9"}
+				})
+
+				It("returns a PluginVersionNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.PluginVersionNotFoundError{PluginName: "plugin-1", Version: "9.9.9"}))
+				})
+			})
+
+			When("the pinned version has no binary for the platform", func() {
+				BeforeEach(func() {
+					pluginVersions = map[string]string{"plugin-2": "3.0.0"}
+				})
+
+				It("returns a NoCompatibleBinaryError", func() {
+					Expect(executeErr).To(MatchError(actionerror.NoCompatibleBinaryError{}))
+				})
+			})
+
+			When("a named plugin is not in any repository", func() {
+				BeforeEach(func() {
+					pluginVersions = map[string]string{"local-plugin": ""}
+				})
+
+				It("returns a PluginNotFoundInAnyRepositoryError", func() {
+					Expect(executeErr).To(MatchError(actionerror.PluginNotFoundInAnyRepositoryError{PluginName: "local-plugin"}))
+				})
+			})
+
+			When("a named plugin is not installed", func() {
+				BeforeEach(func() {
+					pluginVersions = map[string]string{"some-plugin": ""}
+				})
+
+				It("returns a PluginNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.PluginNotFoundError{PluginName: "some-plugin"}))
+				})
+			})
+		})
+
+		When("getting a repository fails", func() {
+			BeforeEach(func() {
+				fakePluginClient.GetPluginRepositoryStub = nil
+				fakePluginClient.GetPluginRepositoryReturns(plugin.PluginRepository{}, errors.New("generic-error"))
+			})
+
+			It("returns a GettingPluginRepositoryError", func() {
+				Expect(executeErr).To(MatchError(actionerror.GettingPluginRepositoryError{Name: "CF-Community", Message: "generic-error"}))
+			})
+		})
+	})
+
+	Describe("PinPluginVersions", func() {
+		var executeErr error
+
+		BeforeEach(func() {
+			fakeConfig.GetPluginStub = func(pluginName string) (configv3.Plugin, bool) {
+				switch pluginName {
+				case "plugin-1":
+					return configv3.Plugin{Name: pluginName, Version: configv3.PluginVersion{Major: 1}}, true
+				case "plugin-2":
+					return configv3.Plugin{Name: pluginName, Version: configv3.PluginVersion{Major: 2}, PinnedVersion: "2.0.0"}, true
+				default:
+					return configv3.Plugin{}, false
+				}
+			}
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.PinPluginVersions(map[string]string{"plugin-1": "1.5.0", "plugin-2": "", "some-plugin": "1.0.0"})
+		})
+
+		It("pins and unpins the installed plugins and writes the plugin config", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeConfig.AddPluginCallCount()).To(Equal(2))
+
+			var pinnedPlugins []configv3.Plugin
+			for i := 0; i < fakeConfig.AddPluginCallCount(); i++ {
+				pinnedPlugins = append(pinnedPlugins, fakeConfig.AddPluginArgsForCall(i))
+			}
+			Expect(pinnedPlugins).To(ConsistOf(
+				configv3.Plugin{Name: "plugin-1", Version: configv3.PluginVersion{Major: 1}, PinnedVersion: "1.5.0"},
+				configv3.Plugin{Name: "plugin-2", Version: configv3.PluginVersion{Major: 2}},
+			))
+			Expect(fakeConfig.WritePluginConfigCallCount()).To(Equal(1))
+		})
+
+		When("writing the plugin config fails", func() {
+			BeforeEach(func() {
+				fakeConfig.WritePluginConfigReturns(errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+			})
+		})
+	})
+})
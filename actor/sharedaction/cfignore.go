@@ -0,0 +1,105 @@
+package sharedaction
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	ignore "github.com/sabhiram/go-gitignore"
+	log "github.com/sirupsen/logrus"
+)
+
+// cfIgnoreMatcher matches paths against the .cfignore files of a directory
+// tree with the precedence of .gitignore files: the patterns of a file apply
+// to the paths below the directory containing it, a file deeper in the tree
+// overrides the files above it, and later patterns override earlier ones.
+// Patterns that the CLI always ignores cannot be overridden.
+type cfIgnoreMatcher struct {
+	sourceDir    string
+	useGitIgnore bool
+
+	// ignoreFiles holds the loaded ignore files, keyed on the slash separated
+	// path of their directory relative to sourceDir.
+	ignoreFiles   map[string]ignoreFile
+	alwaysIgnored *ignore.GitIgnore
+}
+
+// ignoreFile holds the patterns of an ignore file compiled twice: once on
+// their own and once after a pattern matching every path. Which of the two
+// applies depends on whether a path is already ignored by the files above
+// it, so that a negated pattern can re-include it.
+type ignoreFile struct {
+	fromIncluded *ignore.GitIgnore
+	fromIgnored  *ignore.GitIgnore
+}
+
+func compileIgnoreFile(lines []string) ignoreFile {
+	fromIncluded, _ := ignore.CompileIgnoreLines(lines...)
+	fromIgnored, _ := ignore.CompileIgnoreLines(append([]string{"*"}, lines...)...)
+	return ignoreFile{fromIncluded: fromIncluded, fromIgnored: fromIgnored}
+}
+
+func (file ignoreFile) matchesPath(relPath string, ignored bool) bool {
+	if ignored {
+		return file.fromIgnored.MatchesPath(relPath)
+	}
+	return file.fromIncluded.MatchesPath(relPath)
+}
+
+// LoadDirectory reads the ignore file of the directory at relDir, which is
+// relative to the source directory. It must be called for a directory before
+// any of the paths below it are matched.
+func (matcher *cfIgnoreMatcher) LoadDirectory(relDir string) error {
+	relDir = filepath.ToSlash(relDir)
+	if relDir == "." {
+		relDir = ""
+	}
+
+	dir := filepath.Join(matcher.sourceDir, filepath.FromSlash(relDir))
+	ignoreFile := filepath.Join(dir, ".cfignore")
+	if _, err := os.Stat(ignoreFile); os.IsNotExist(err) {
+		if !matcher.useGitIgnore {
+			return nil
+		}
+		ignoreFile = filepath.Join(dir, ".gitignore")
+		if _, err = os.Stat(ignoreFile); os.IsNotExist(err) {
+			return nil
+		}
+	}
+
+	log.WithField("ignoreFile", ignoreFile).Debug("using ignore file")
+	raw, err := os.ReadFile(ignoreFile)
+	if err != nil {
+		return err
+	}
+
+	matcher.ignoreFiles[relDir] = compileIgnoreFile(strings.Split(string(raw), "\n"))
+	return nil
+}
+
+// MatchesPath returns true if the path, relative to the source directory, is
+// ignored.
+func (matcher *cfIgnoreMatcher) MatchesPath(relPath string) bool {
+	relPath = filepath.ToSlash(relPath)
+
+	if matcher.alwaysIgnored.MatchesPath(relPath) {
+		return true
+	}
+
+	ignored := false
+	dir := ""
+	remaining := relPath
+	for {
+		if file, ok := matcher.ignoreFiles[dir]; ok {
+			ignored = file.matchesPath(remaining, ignored)
+		}
+
+		index := strings.Index(remaining, "/")
+		if index == -1 {
+			return ignored
+		}
+		dir = path.Join(dir, remaining[:index])
+		remaining = remaining[index+1:]
+	}
+}
@@ -9,8 +9,11 @@ type Config interface {
 	CurrentUserName() (string, error)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	HashCacheFile() string
+	HashConcurrency() int
 	IsCFOnK8s() bool
 	RefreshToken() string
 	TargetedOrganizationName() string
+	UseGitIgnore() bool
 	Verbose() (bool, []string)
 }
@@ -0,0 +1,17 @@
+//go:build !windows
+// +build !windows
+
+package sharedaction
+
+import (
+	"os"
+	"syscall"
+)
+
+// fileInode returns the inode number of the file, or 0 if it is unknown.
+func fileInode(info os.FileInfo) uint64 {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return uint64(stat.Ino)
+	}
+	return 0
+}
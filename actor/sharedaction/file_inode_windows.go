@@ -0,0 +1,13 @@
+//go:build windows
+// +build windows
+
+package sharedaction
+
+import "os"
+
+// fileInode always returns 0 on Windows, where the file index is not part of
+// the information returned by os.Stat. The hash cache falls back to the size
+// and modification time of the file.
+func fileInode(info os.FileInfo) uint64 {
+	return 0
+}
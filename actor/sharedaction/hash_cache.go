@@ -0,0 +1,143 @@
+package sharedaction
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// racyModificationWindow is how recently a file may have been modified and
+// still have its hash cached. A file written within the same timestamp tick
+// as it was hashed could change again without its modification time moving.
+const racyModificationWindow = 2 * time.Second
+
+type hashCacheEntry struct {
+	Size    int64  `json:"size"`
+	ModTime int64  `json:"mtime"`
+	Inode   uint64 `json:"inode"`
+	SHA1    string `json:"sha1"`
+}
+
+func newHashCacheEntry(info os.FileInfo) hashCacheEntry {
+	return hashCacheEntry{
+		Size:    info.Size(),
+		ModTime: info.ModTime().UnixNano(),
+		Inode:   fileInode(info),
+	}
+}
+
+func (entry hashCacheEntry) matches(other hashCacheEntry) bool {
+	return entry.Size == other.Size && entry.ModTime == other.ModTime && entry.Inode == other.Inode
+}
+
+// hashCache remembers the SHA1 of files keyed on their path, so that a file
+// is only read again when its size, modification time or inode has changed.
+// It is safe for concurrent use.
+type hashCache struct {
+	path string
+
+	mutex   sync.Mutex
+	entries map[string]hashCacheEntry
+	seen    map[string]bool
+}
+
+// loadHashCache reads the cache stored in path. A missing or unreadable cache
+// file results in an empty cache.
+func loadHashCache(path string) *hashCache {
+	cache := &hashCache{
+		path:    path,
+		entries: map[string]hashCacheEntry{},
+		seen:    map[string]bool{},
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithField("path", path).Warnln("reading hash cache:", err)
+		}
+		return cache
+	}
+
+	if err := json.Unmarshal(raw, &cache.entries); err != nil {
+		log.WithField("path", path).Warnln("parsing hash cache:", err)
+		cache.entries = map[string]hashCacheEntry{}
+	}
+
+	return cache
+}
+
+// Lookup returns the cached SHA1 of the file at fullPath if the file has not
+// changed since it was stored.
+func (cache *hashCache) Lookup(fullPath string, info os.FileInfo) (string, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.seen[fullPath] = true
+	entry, ok := cache.entries[fullPath]
+	if !ok || !entry.matches(newHashCacheEntry(info)) {
+		return "", false
+	}
+
+	return entry.SHA1, true
+}
+
+// Store records the SHA1 of the file at fullPath.
+func (cache *hashCache) Store(fullPath string, info os.FileInfo, sha1 string) {
+	if time.Since(info.ModTime()) < racyModificationWindow {
+		return
+	}
+
+	entry := newHashCacheEntry(info)
+	entry.SHA1 = sha1
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.seen[fullPath] = true
+	cache.entries[fullPath] = entry
+}
+
+// Save drops the entries for files under rootDir that were not looked up
+// since the cache was loaded and writes the cache back to its file.
+func (cache *hashCache) Save(rootDir string) error {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	prefix := rootDir + string(filepath.Separator)
+	for fullPath := range cache.entries {
+		if strings.HasPrefix(fullPath, prefix) && !cache.seen[fullPath] {
+			delete(cache.entries, fullPath)
+		}
+	}
+
+	raw, err := json.Marshal(cache.entries)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(cache.path), 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := os.CreateTemp(filepath.Dir(cache.path), filepath.Base(cache.path))
+	if err != nil {
+		return err
+	}
+
+	_, err = tempFile.Write(raw)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), cache.path)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -64,6 +65,75 @@ func NewLogMessage(message string, messageType string, timestamp time.Time, sour
 	}
 }
 
+// LogFilter selects which log messages are shown and the time range they are
+// read from. Zero fields do not filter.
+type LogFilter struct {
+	// SourceTypes are source types such as APP or RTR. A source type also
+	// matches its sub types, so APP matches APP/PROC/WEB.
+	SourceTypes []string
+	// InstanceIndexes are the indexes of the instances that sent the messages.
+	InstanceIndexes []int
+	// ProcessTypes are process types such as web or worker.
+	ProcessTypes []string
+	Since        time.Time
+	Until        time.Time
+}
+
+// Matches returns true if the log message passes the filter.
+func (filter LogFilter) Matches(message LogMessage) bool {
+	return filter.matchesSourceType(message.sourceType) &&
+		filter.matchesInstance(message.sourceInstance) &&
+		filter.matchesProcessType(message.sourceType)
+}
+
+func (filter LogFilter) matchesSourceType(sourceType string) bool {
+	if len(filter.SourceTypes) == 0 {
+		return true
+	}
+
+	for _, filterType := range filter.SourceTypes {
+		if strings.EqualFold(sourceType, filterType) || strings.HasPrefix(strings.ToUpper(sourceType), strings.ToUpper(filterType)+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (filter LogFilter) matchesInstance(sourceInstance string) bool {
+	if len(filter.InstanceIndexes) == 0 {
+		return true
+	}
+
+	for _, index := range filter.InstanceIndexes {
+		if sourceInstance == strconv.Itoa(index) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesProcessType reads the process type from app log source types of the
+// form APP/PROC/<TYPE>. Sidecars of a process log with a longer source type,
+// such as APP/PROC/WEB/SIDECAR/CONFIG-SERVER, and match the process as well.
+func (filter LogFilter) matchesProcessType(sourceType string) bool {
+	if len(filter.ProcessTypes) == 0 {
+		return true
+	}
+
+	segments := strings.Split(sourceType, "/")
+	if len(segments) < 3 || !strings.EqualFold(segments[0], "APP") || !strings.EqualFold(segments[1], "PROC") {
+		return false
+	}
+
+	processType := segments[2]
+	for _, filterType := range filter.ProcessTypes {
+		if strings.EqualFold(processType, filterType) {
+			return true
+		}
+	}
+	return false
+}
+
 type LogMessages []*LogMessage
 
 func (lm LogMessages) Len() int { return len(lm) }
@@ -122,6 +192,12 @@ func (b *cliRetryBackoff) Reset() {
 }
 
 func GetStreamingLogs(appGUID string, client LogCacheClient) (<-chan LogMessage, <-chan error, context.CancelFunc) {
+	return GetFilteredStreamingLogs(appGUID, client, LogFilter{})
+}
+
+// GetFilteredStreamingLogs tails the logs of the app, starting at filter.Since
+// if it is set, and only sends the messages that pass the filter.
+func GetFilteredStreamingLogs(appGUID string, client LogCacheClient, filter LogFilter) (<-chan LogMessage, <-chan error, context.CancelFunc) {
 
 	logrus.Info("Start Tailing Logs")
 
@@ -132,15 +208,18 @@ func GetStreamingLogs(appGUID string, client LogCacheClient) (<-chan LogMessage,
 		defer close(outgoingLogStream)
 		defer close(outgoingErrStream)
 
-		ts := latestEnvelopeTimestamp(client, outgoingErrStream, ctx, appGUID)
+		walkStartTime := filter.Since
+		if walkStartTime.IsZero() {
+			ts := latestEnvelopeTimestamp(client, outgoingErrStream, ctx, appGUID)
 
-		// if the context was cancelled we may not have seen an envelope
-		if ts.IsZero() {
-			return
-		}
+			// if the context was cancelled we may not have seen an envelope
+			if ts.IsZero() {
+				return
+			}
 
-		const offset = 1 * time.Second
-		walkStartTime := ts.Add(-offset)
+			const offset = 1 * time.Second
+			walkStartTime = ts.Add(-offset)
+		}
 
 		logcache.Walk(
 			ctx,
@@ -148,6 +227,9 @@ func GetStreamingLogs(appGUID string, client LogCacheClient) (<-chan LogMessage,
 			logcache.Visitor(func(envelopes []*loggregator_v2.Envelope) bool {
 				logMessages := convertEnvelopesToLogMessages(envelopes)
 				for _, logMessage := range logMessages {
+					if !filter.Matches(*logMessage) {
+						continue
+					}
 					select {
 					case <-ctx.Done():
 						return false
@@ -209,18 +291,32 @@ func latestEnvelopeTimestamp(client LogCacheClient, errs chan error, ctx context
 }
 
 func GetRecentLogs(appGUID string, client LogCacheClient) ([]LogMessage, error) {
+	return GetFilteredRecentLogs(appGUID, client, LogFilter{})
+}
+
+// GetFilteredRecentLogs returns the most recent logs of the app between
+// filter.Since and filter.Until that pass the filter. The filter is applied
+// after reading, so fewer than RecentLogsLines messages may be returned.
+func GetFilteredRecentLogs(appGUID string, client LogCacheClient, filter LogFilter) ([]LogMessage, error) {
 	logLineRequestCount := RecentLogsLines
 	var envelopes []*loggregator_v2.Envelope
 	var err error
 
 	for logLineRequestCount >= 1 {
-		envelopes, err = client.Read(
-			context.Background(),
-			appGUID,
-			time.Time{},
+		readOptions := []logcache.ReadOption{
 			logcache.WithEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
 			logcache.WithLimit(logLineRequestCount),
 			logcache.WithDescending(),
+		}
+		if !filter.Until.IsZero() {
+			readOptions = append(readOptions, logcache.WithEndTime(filter.Until))
+		}
+
+		envelopes, err = client.Read(
+			context.Background(),
+			appGUID,
+			filter.Since,
+			readOptions...,
 		)
 		if err == nil || err.Error() != "unexpected status code 429" {
 			break
@@ -234,7 +330,9 @@ func GetRecentLogs(appGUID string, client LogCacheClient) ([]LogMessage, error)
 	logMessages := convertEnvelopesToLogMessages(envelopes)
 	var reorderedLogMessages []LogMessage
 	for i := len(logMessages) - 1; i >= 0; i-- {
-		reorderedLogMessages = append(reorderedLogMessages, *logMessages[i])
+		if filter.Matches(*logMessages[i]) {
+			reorderedLogMessages = append(reorderedLogMessages, *logMessages[i])
+		}
 	}
 
 	return reorderedLogMessages, nil
@@ -366,4 +366,84 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("LogFilter", func() {
+		DescribeTable("Matches",
+			func(filter sharedaction.LogFilter, sourceType string, sourceInstance string, expected bool) {
+				message := *sharedaction.NewLogMessage("some-message", "OUT", time.Unix(0, 0), sourceType, sourceInstance)
+				Expect(filter.Matches(message)).To(Equal(expected))
+			},
+
+			Entry("an empty filter matches everything", sharedaction.LogFilter{}, "RTR", "3", true),
+			Entry("a source type matches itself", sharedaction.LogFilter{SourceTypes: []string{"RTR"}}, "RTR", "0", true),
+			Entry("a source type matches its sub types", sharedaction.LogFilter{SourceTypes: []string{"APP"}}, "APP/PROC/WEB", "0", true),
+			Entry("a source type does not match other types", sharedaction.LogFilter{SourceTypes: []string{"APP"}}, "API", "0", false),
+			Entry("any of several source types match", sharedaction.LogFilter{SourceTypes: []string{"STG", "RTR"}}, "RTR", "0", true),
+			Entry("an instance index matches the source instance", sharedaction.LogFilter{InstanceIndexes: []int{1, 2}}, "APP/PROC/WEB", "2", true),
+			Entry("an instance index does not match other instances", sharedaction.LogFilter{InstanceIndexes: []int{1}}, "APP/PROC/WEB", "0", false),
+			Entry("a process type matches app logs of the process", sharedaction.LogFilter{ProcessTypes: []string{"worker"}}, "APP/PROC/WORKER", "0", true),
+			Entry("a process type does not match other processes", sharedaction.LogFilter{ProcessTypes: []string{"worker"}}, "APP/PROC/WEB", "0", false),
+			Entry("a process type matches the sidecars of the process", sharedaction.LogFilter{ProcessTypes: []string{"web"}}, "APP/PROC/WEB/SIDECAR/CONFIG-SERVER", "0", true),
+			Entry("a process type does not match processes it is a prefix of", sharedaction.LogFilter{ProcessTypes: []string{"web"}}, "APP/PROC/WEB-WORKER", "0", false),
+			Entry("a process type does not match other app sources", sharedaction.LogFilter{ProcessTypes: []string{"web"}}, "APP/TASK/WEB", "0", false),
+			Entry("a process type does not match other sources", sharedaction.LogFilter{ProcessTypes: []string{"web"}}, "RTR", "0", false),
+			Entry("all fields must match", sharedaction.LogFilter{SourceTypes: []string{"APP"}, InstanceIndexes: []int{0}}, "APP/PROC/WEB", "1", false),
+		)
+	})
+
+	Describe("GetFilteredRecentLogs", func() {
+		var (
+			filter   sharedaction.LogFilter
+			messages []sharedaction.LogMessage
+			err      error
+		)
+
+		BeforeEach(func() {
+			filter = sharedaction.LogFilter{
+				SourceTypes: []string{"APP"},
+				Since:       time.Unix(100, 0),
+				Until:       time.Unix(200, 0),
+			}
+
+			fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+				{
+					Timestamp:  int64(150e9),
+					InstanceId: "0",
+					Message:    &loggregator_v2.Envelope_Log{Log: &loggregator_v2.Log{Payload: []byte("router-message")}},
+					Tags:       map[string]string{"source_type": "RTR"},
+				},
+				{
+					Timestamp:  int64(140e9),
+					InstanceId: "0",
+					Message:    &loggregator_v2.Envelope_Log{Log: &loggregator_v2.Log{Payload: []byte("app-message")}},
+					Tags:       map[string]string{"source_type": "APP/PROC/WEB"},
+				},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			messages, err = sharedaction.GetFilteredRecentLogs("some-app-guid", fakeLogCacheClient, filter)
+		})
+
+		It("reads the time range of the filter", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+
+			_, sourceID, start, readOptions := fakeLogCacheClient.ReadArgsForCall(0)
+			Expect(sourceID).To(Equal("some-app-guid"))
+			Expect(start).To(Equal(time.Unix(100, 0)))
+
+			u := new(url.URL)
+			v := make(url.Values)
+			for _, readOption := range readOptions {
+				readOption(u, v)
+			}
+			Expect(v.Get("end_time")).To(Equal("200000000000"))
+		})
+
+		It("only returns the messages that pass the filter", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(messages).To(HaveLen(1))
+			Expect(messages[0].Message()).To(Equal("app-message"))
+		})
+	})
 })
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
 
@@ -126,14 +127,17 @@ func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error
 	return resources, nil
 }
 
-// GatherDirectoryResources returns a list of resources for a directory.
+// GatherDirectoryResources returns a list of resources for a directory. The
+// regular files in the directory are hashed by up to Config.HashConcurrency()
+// workers, and their hashes are cached between calls in Config.HashCacheFile()
+// when it is set.
 func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error) {
 	var (
 		resources []Resource
-		gitIgnore *ignore.GitIgnore
+		files     []fileToHash
 	)
 
-	gitIgnore, err := actor.generateDirectoryCFIgnoreMatcher(sourceDir)
+	cfIgnore, err := actor.generateDirectoryCFIgnoreMatcher(sourceDir)
 	if err != nil {
 		log.Errorln("reading .cfignore file:", err)
 		return nil, err
@@ -156,7 +160,7 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 		}
 
 		// if file ignored continue to the next file
-		if gitIgnore.MatchesPath(relPath) {
+		if cfIgnore.MatchesPath(relPath) {
 			return nil
 		}
 
@@ -170,44 +174,147 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 
 		switch {
 		case info.IsDir():
-			// If the file is a directory
+			// If the file is a directory, its .cfignore applies to the
+			// files below it
 			resource.Mode = DefaultFolderPermissions
+			if err := cfIgnore.LoadDirectory(relPath); err != nil {
+				return err
+			}
 		case info.Mode()&os.ModeSymlink == os.ModeSymlink:
 			// If the file is a Symlink we just set the mode of the file
 			// We won't be using any sha information since we don't do
 			// any resource matching on symlinks.
 			resource.Mode = fixMode(info.Mode())
 		default:
-			// If the file is regular we want to open
-			// and calculate the sha of the file
-			file, err := os.Open(fullPath)
-			if err != nil {
-				return err
-			}
-			defer file.Close()
-
-			sum := sha1.New()
-			_, err = io.Copy(sum, file)
-			if err != nil {
-				return err
-			}
-
+			// If the file is regular we want to calculate the sha of the
+			// file, which is done once the walk has finished
 			resource.Mode = fixMode(info.Mode())
-			resource.SHA1 = fmt.Sprintf("%x", sum.Sum(nil))
 			resource.Size = info.Size()
+			files = append(files, fileToHash{index: len(resources), fullPath: fullPath, info: info})
 		}
 
 		resources = append(resources, resource)
 		return nil
 	})
 
+	var cache *hashCache
+	if cacheFile := actor.hashCacheFile(); cacheFile != "" {
+		cache = loadHashCache(cacheFile)
+	}
+
+	// A file that cannot be hashed ends the list of resources just as a walk
+	// error does, so the result is the same as hashing during the walk.
+	failedIndex, hashErr := actor.hashFiles(resources, files, cache)
+	if hashErr != nil {
+		resources = resources[:failedIndex]
+		walkErr = hashErr
+	}
+
 	if len(resources) == 0 {
 		return nil, actionerror.EmptyDirectoryError{Path: sourceDir}
 	}
 
+	if cache != nil && walkErr == nil {
+		if err := cache.Save(evalDir); err != nil {
+			log.WithField("path", cache.path).Warnln("writing hash cache:", err)
+		}
+	}
+
 	return resources, walkErr
 }
 
+type fileToHash struct {
+	index    int
+	fullPath string
+	info     os.FileInfo
+}
+
+// hashFiles sets the SHA1 of the resources for files using a pool of workers.
+// When files cannot be hashed, the error for the first of them in resources
+// is returned along with its index.
+func (actor Actor) hashFiles(resources []Resource, files []fileToHash, cache *hashCache) (int, error) {
+	workers := actor.hashConcurrency()
+	if workers > len(files) {
+		workers = len(files)
+	}
+
+	var (
+		wg    sync.WaitGroup
+		queue = make(chan fileToHash)
+		errs  = make([]error, len(resources))
+	)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for file := range queue {
+				sha, err := hashFile(file, cache)
+				if err != nil {
+					errs[file.index] = err
+					continue
+				}
+				resources[file.index].SHA1 = sha
+			}
+		}()
+	}
+
+	for _, file := range files {
+		queue <- file
+	}
+	close(queue)
+	wg.Wait()
+
+	for index, err := range errs {
+		if err != nil {
+			return index, err
+		}
+	}
+
+	return 0, nil
+}
+
+func hashFile(file fileToHash, cache *hashCache) (string, error) {
+	if cache != nil {
+		if sha, ok := cache.Lookup(file.fullPath, file.info); ok {
+			return sha, nil
+		}
+	}
+
+	f, err := os.Open(file.fullPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	sum := sha1.New()
+	_, err = io.Copy(sum, f)
+	if err != nil {
+		return "", err
+	}
+
+	sha := fmt.Sprintf("%x", sum.Sum(nil))
+	if cache != nil {
+		cache.Store(file.fullPath, file.info, sha)
+	}
+
+	return sha, nil
+}
+
+func (actor Actor) hashCacheFile() string {
+	if actor.Config == nil {
+		return ""
+	}
+	return actor.Config.HashCacheFile()
+}
+
+func (actor Actor) hashConcurrency() int {
+	if actor.Config == nil || actor.Config.HashConcurrency() < 1 {
+		return 1
+	}
+	return actor.Config.HashConcurrency()
+}
+
 // ZipArchiveResources zips an archive and a sorted (based on full
 // path/filename) list of resources and returns the location. On Windows, the
 // filemode for user is forced to be readable and executable.
@@ -444,13 +551,7 @@ func (Actor) generateArchiveCFIgnoreMatcher(files []*zip.File) (*ignore.GitIgnor
 	return ignore.CompileIgnoreLines(DefaultIgnoreLines...)
 }
 
-func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string) (*ignore.GitIgnore, error) {
-	pathToCFIgnore := filepath.Join(sourceDir, ".cfignore")
-	log.WithFields(log.Fields{
-		"pathToCFIgnore": pathToCFIgnore,
-		"sourceDir":      sourceDir,
-	}).Debug("using ignore file")
-
+func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string) (*cfIgnoreMatcher, error) {
 	additionalIgnoreLines := DefaultIgnoreLines
 
 	// If verbose logging has files in the current dir, ignore them
@@ -463,10 +564,19 @@ func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string) (*ignore.G
 
 	log.Debugf("ignore rules: %v", additionalIgnoreLines)
 
-	if _, err := os.Stat(pathToCFIgnore); !os.IsNotExist(err) {
-		return ignore.CompileIgnoreFileAndLines(pathToCFIgnore, additionalIgnoreLines...)
+	alwaysIgnored, err := ignore.CompileIgnoreLines(additionalIgnoreLines...)
+	if err != nil {
+		return nil, err
 	}
-	return ignore.CompileIgnoreLines(additionalIgnoreLines...)
+
+	matcher := &cfIgnoreMatcher{
+		sourceDir:     sourceDir,
+		useGitIgnore:  actor.Config.UseGitIgnore(),
+		ignoreFiles:   map[string]ignoreFile{},
+		alwaysIgnored: alwaysIgnored,
+	}
+
+	return matcher, matcher.LoadDirectory(".")
 }
 
 func (Actor) findInResources(path string, filesToInclude []Resource) (Resource, bool) {
@@ -6,6 +6,7 @@ package sharedaction_test
 import (
 	"os"
 	"path/filepath"
+	"time"
 
 	"code.cloudfoundry.org/cli/v9/actor/actionerror"
 	. "code.cloudfoundry.org/cli/v9/actor/sharedaction"
@@ -270,6 +271,94 @@ var _ = Describe("Resource Actions", func() {
 				})
 			})
 
+			When(".cfignore files exist in subdirectories", func() {
+				Context("with patterns relative to the subdirectory", func() {
+					BeforeEach(func() {
+						err := os.WriteFile(filepath.Join(srcDir, "level1", ".cfignore"), []byte("/level2"), 0655)
+						Expect(err).ToNot(HaveOccurred())
+					})
+
+					It("excludes the matching files below the subdirectory", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(gatheredResources).To(Equal(
+							[]Resource{
+								{Filename: "level1", Mode: DefaultFolderPermissions},
+								{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: 0751},
+								{Filename: "tmpFile3", SHA1: "f4c9ca85f3e084ffad3abbdabbd2a890c034c879", Size: 10, Mode: 0655},
+							}))
+					})
+				})
+
+				Context("with patterns overriding the .cfignore of a parent directory", func() {
+					BeforeEach(func() {
+						err := os.WriteFile(filepath.Join(srcDir, ".cfignore"), []byte("tmpFile*"), 0655)
+						Expect(err).ToNot(HaveOccurred())
+						err = os.WriteFile(filepath.Join(srcDir, "level1", "level2", ".cfignore"), []byte("!tmpFile1"), 0655)
+						Expect(err).ToNot(HaveOccurred())
+					})
+
+					It("gives precedence to the deeper .cfignore", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(gatheredResources).To(Equal(
+							[]Resource{
+								{Filename: "level1", Mode: DefaultFolderPermissions},
+								{Filename: "level1/level2", Mode: DefaultFolderPermissions},
+								{Filename: "level1/level2/tmpFile1", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Size: 9, Mode: 0644},
+							}))
+					})
+				})
+			})
+
+			When("a .gitignore file exists in a subdirectory", func() {
+				BeforeEach(func() {
+					err := os.WriteFile(filepath.Join(srcDir, "level1", ".gitignore"), []byte("level2"), 0655)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("ignores the .gitignore by default", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(gatheredResources).To(HaveLen(5))
+				})
+
+				When("using .gitignore files is enabled", func() {
+					BeforeEach(func() {
+						fakeConfig.UseGitIgnoreReturns(true)
+					})
+
+					It("excludes all patterns of files mentioned in .gitignore", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(gatheredResources).To(Equal(
+							[]Resource{
+								{Filename: "level1", Mode: DefaultFolderPermissions},
+								{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: 0751},
+								{Filename: "tmpFile3", SHA1: "f4c9ca85f3e084ffad3abbdabbd2a890c034c879", Size: 10, Mode: 0655},
+							}))
+					})
+
+					When("the subdirectory also has a .cfignore file", func() {
+						BeforeEach(func() {
+							err := os.WriteFile(filepath.Join(srcDir, "level1", ".cfignore"), []byte("tmpFile1"), 0655)
+							Expect(err).ToNot(HaveOccurred())
+						})
+
+						It("uses the .cfignore instead", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(gatheredResources).To(Equal(
+								[]Resource{
+									{Filename: "level1", Mode: DefaultFolderPermissions},
+									{Filename: "level1/level2", Mode: DefaultFolderPermissions},
+									{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: 0751},
+									{Filename: "tmpFile3", SHA1: "f4c9ca85f3e084ffad3abbdabbd2a890c034c879", Size: 10, Mode: 0655},
+								}))
+						})
+					})
+				})
+			})
+
 			When("default ignored files exist in the app dir", func() {
 				BeforeEach(func() {
 					for _, filename := range DefaultIgnoreLines {
@@ -318,6 +407,84 @@ var _ = Describe("Resource Actions", func() {
 						}))
 				})
 			})
+			When("files are hashed concurrently", func() {
+				BeforeEach(func() {
+					fakeConfig.HashConcurrencyReturns(4)
+				})
+
+				It("gathers the resources in walk order", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(gatheredResources).To(Equal(
+						[]Resource{
+							{Filename: "level1", Mode: DefaultFolderPermissions},
+							{Filename: "level1/level2", Mode: DefaultFolderPermissions},
+							{Filename: "level1/level2/tmpFile1", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Size: 9, Mode: 0644},
+							{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: 0751},
+							{Filename: "tmpFile3", SHA1: "f4c9ca85f3e084ffad3abbdabbd2a890c034c879", Size: 10, Mode: 0655},
+						}))
+				})
+			})
+
+			When("the hash cache is enabled", func() {
+				var (
+					cacheFile string
+					modTime   time.Time
+				)
+
+				BeforeEach(func() {
+					cacheFile = filepath.Join(GinkgoT().TempDir(), "hash_cache.json")
+					fakeConfig.HashCacheFileReturns(cacheFile)
+
+					modTime = time.Now().Add(-time.Hour)
+					for _, path := range []string{filepath.Join(srcDir, "level1", "level2", "tmpFile1"), filepath.Join(srcDir, "tmpFile2"), filepath.Join(srcDir, "tmpFile3")} {
+						Expect(os.Chtimes(path, modTime, modTime)).To(Succeed())
+					}
+				})
+
+				It("writes the hashes to the cache file", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					raw, err := os.ReadFile(cacheFile)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(raw)).To(ContainSubstring("e594bdc795bb293a0e55724137e53a36dc0d9e95"))
+				})
+
+				It("does not read files again that have not changed", func() {
+					tmpFile2 := filepath.Join(srcDir, "tmpFile2")
+					Expect(os.WriteFile(tmpFile2, []byte("Hello, Pinky"), 0751)).To(Succeed())
+					Expect(os.Chtimes(tmpFile2, modTime, modTime)).To(Succeed())
+
+					resources, err := actor.GatherDirectoryResources(srcDir)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resources).To(ContainElement(Resource{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: 0751}))
+				})
+
+				It("hashes files again that have changed", func() {
+					Expect(os.WriteFile(filepath.Join(srcDir, "tmpFile2"), []byte("Goodbye, Binky"), 0751)).To(Succeed())
+
+					resources, err := actor.GatherDirectoryResources(srcDir)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resources).To(ContainElement(Resource{Filename: "tmpFile2", SHA1: "97c2ec942e7edee8c7905bd317c0d0860005db5f", Size: 14, Mode: 0751}))
+				})
+
+				When("a file was modified too recently to be cached", func() {
+					BeforeEach(func() {
+						modTime = time.Now()
+						Expect(os.Chtimes(filepath.Join(srcDir, "tmpFile2"), modTime, modTime)).To(Succeed())
+					})
+
+					It("hashes the file again", func() {
+						tmpFile2 := filepath.Join(srcDir, "tmpFile2")
+						Expect(os.WriteFile(tmpFile2, []byte("Hello, Pinky"), 0751)).To(Succeed())
+						Expect(os.Chtimes(tmpFile2, modTime, modTime)).To(Succeed())
+
+						resources, err := actor.GatherDirectoryResources(srcDir)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(resources).ToNot(ContainElement(Resource{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: 0751}))
+					})
+				})
+			})
 		})
 
 		When("the directory is empty", func() {
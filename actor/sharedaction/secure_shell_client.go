@@ -1,13 +1,20 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/v9/util/clissh"
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/v9/util/clissh"
+)
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SecureShellClient
 
 type SecureShellClient interface {
 	Connect(username string, passcode string, sshEndpoint string, sshHostKeyFingerprint string, skipHostValidation bool) error
 	Close() error
+	CopyFromRemote(remotePath string, localPath string) error
+	CopyToRemote(localPath string, remotePath string) error
 	InteractiveSession(commands []string, terminalRequest clissh.TTYRequest) error
 	LocalPortForward(localPortForwardSpecs []clissh.LocalPortForward) error
+	RunCommand(commands []string, stdout io.Writer, stderr io.Writer) error
 	Wait() error
 }
@@ -60,6 +60,26 @@ type FakeConfig struct {
 	hasTargetedSpaceReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	HashCacheFileStub        func() string
+	hashCacheFileMutex       sync.RWMutex
+	hashCacheFileArgsForCall []struct {
+	}
+	hashCacheFileReturns struct {
+		result1 string
+	}
+	hashCacheFileReturnsOnCall map[int]struct {
+		result1 string
+	}
+	HashConcurrencyStub        func() int
+	hashConcurrencyMutex       sync.RWMutex
+	hashConcurrencyArgsForCall []struct {
+	}
+	hashConcurrencyReturns struct {
+		result1 int
+	}
+	hashConcurrencyReturnsOnCall map[int]struct {
+		result1 int
+	}
 	IsCFOnK8sStub        func() bool
 	isCFOnK8sMutex       sync.RWMutex
 	isCFOnK8sArgsForCall []struct {
@@ -90,6 +110,16 @@ type FakeConfig struct {
 	targetedOrganizationNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UseGitIgnoreStub        func() bool
+	useGitIgnoreMutex       sync.RWMutex
+	useGitIgnoreArgsForCall []struct {
+	}
+	useGitIgnoreReturns struct {
+		result1 bool
+	}
+	useGitIgnoreReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	VerboseStub        func() (bool, []string)
 	verboseMutex       sync.RWMutex
 	verboseArgsForCall []struct {
@@ -374,6 +404,112 @@ func (fake *FakeConfig) HasTargetedSpaceReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) HashCacheFile() string {
+	fake.hashCacheFileMutex.Lock()
+	ret, specificReturn := fake.hashCacheFileReturnsOnCall[len(fake.hashCacheFileArgsForCall)]
+	fake.hashCacheFileArgsForCall = append(fake.hashCacheFileArgsForCall, struct {
+	}{})
+	stub := fake.HashCacheFileStub
+	fakeReturns := fake.hashCacheFileReturns
+	fake.recordInvocation("HashCacheFile", []interface{}{})
+	fake.hashCacheFileMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) HashCacheFileCallCount() int {
+	fake.hashCacheFileMutex.RLock()
+	defer fake.hashCacheFileMutex.RUnlock()
+	return len(fake.hashCacheFileArgsForCall)
+}
+
+func (fake *FakeConfig) HashCacheFileCalls(stub func() string) {
+	fake.hashCacheFileMutex.Lock()
+	defer fake.hashCacheFileMutex.Unlock()
+	fake.HashCacheFileStub = stub
+}
+
+func (fake *FakeConfig) HashCacheFileReturns(result1 string) {
+	fake.hashCacheFileMutex.Lock()
+	defer fake.hashCacheFileMutex.Unlock()
+	fake.HashCacheFileStub = nil
+	fake.hashCacheFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) HashCacheFileReturnsOnCall(i int, result1 string) {
+	fake.hashCacheFileMutex.Lock()
+	defer fake.hashCacheFileMutex.Unlock()
+	fake.HashCacheFileStub = nil
+	if fake.hashCacheFileReturnsOnCall == nil {
+		fake.hashCacheFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.hashCacheFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) HashConcurrency() int {
+	fake.hashConcurrencyMutex.Lock()
+	ret, specificReturn := fake.hashConcurrencyReturnsOnCall[len(fake.hashConcurrencyArgsForCall)]
+	fake.hashConcurrencyArgsForCall = append(fake.hashConcurrencyArgsForCall, struct {
+	}{})
+	stub := fake.HashConcurrencyStub
+	fakeReturns := fake.hashConcurrencyReturns
+	fake.recordInvocation("HashConcurrency", []interface{}{})
+	fake.hashConcurrencyMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) HashConcurrencyCallCount() int {
+	fake.hashConcurrencyMutex.RLock()
+	defer fake.hashConcurrencyMutex.RUnlock()
+	return len(fake.hashConcurrencyArgsForCall)
+}
+
+func (fake *FakeConfig) HashConcurrencyCalls(stub func() int) {
+	fake.hashConcurrencyMutex.Lock()
+	defer fake.hashConcurrencyMutex.Unlock()
+	fake.HashConcurrencyStub = stub
+}
+
+func (fake *FakeConfig) HashConcurrencyReturns(result1 int) {
+	fake.hashConcurrencyMutex.Lock()
+	defer fake.hashConcurrencyMutex.Unlock()
+	fake.HashConcurrencyStub = nil
+	fake.hashConcurrencyReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) HashConcurrencyReturnsOnCall(i int, result1 int) {
+	fake.hashConcurrencyMutex.Lock()
+	defer fake.hashConcurrencyMutex.Unlock()
+	fake.HashConcurrencyStub = nil
+	if fake.hashConcurrencyReturnsOnCall == nil {
+		fake.hashConcurrencyReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.hashConcurrencyReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeConfig) IsCFOnK8s() bool {
 	fake.isCFOnK8sMutex.Lock()
 	ret, specificReturn := fake.isCFOnK8sReturnsOnCall[len(fake.isCFOnK8sArgsForCall)]
@@ -533,6 +669,59 @@ func (fake *FakeConfig) TargetedOrganizationNameReturnsOnCall(i int, result1 str
 	}{result1}
 }
 
+func (fake *FakeConfig) UseGitIgnore() bool {
+	fake.useGitIgnoreMutex.Lock()
+	ret, specificReturn := fake.useGitIgnoreReturnsOnCall[len(fake.useGitIgnoreArgsForCall)]
+	fake.useGitIgnoreArgsForCall = append(fake.useGitIgnoreArgsForCall, struct {
+	}{})
+	stub := fake.UseGitIgnoreStub
+	fakeReturns := fake.useGitIgnoreReturns
+	fake.recordInvocation("UseGitIgnore", []interface{}{})
+	fake.useGitIgnoreMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) UseGitIgnoreCallCount() int {
+	fake.useGitIgnoreMutex.RLock()
+	defer fake.useGitIgnoreMutex.RUnlock()
+	return len(fake.useGitIgnoreArgsForCall)
+}
+
+func (fake *FakeConfig) UseGitIgnoreCalls(stub func() bool) {
+	fake.useGitIgnoreMutex.Lock()
+	defer fake.useGitIgnoreMutex.Unlock()
+	fake.UseGitIgnoreStub = stub
+}
+
+func (fake *FakeConfig) UseGitIgnoreReturns(result1 bool) {
+	fake.useGitIgnoreMutex.Lock()
+	defer fake.useGitIgnoreMutex.Unlock()
+	fake.UseGitIgnoreStub = nil
+	fake.useGitIgnoreReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) UseGitIgnoreReturnsOnCall(i int, result1 bool) {
+	fake.useGitIgnoreMutex.Lock()
+	defer fake.useGitIgnoreMutex.Unlock()
+	fake.UseGitIgnoreStub = nil
+	if fake.useGitIgnoreReturnsOnCall == nil {
+		fake.useGitIgnoreReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.useGitIgnoreReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) Verbose() (bool, []string) {
 	fake.verboseMutex.Lock()
 	ret, specificReturn := fake.verboseReturnsOnCall[len(fake.verboseArgsForCall)]
@@ -1,6 +1,13 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/v9/util/clissh"
+import (
+	"errors"
+	"io"
+	"sync"
+
+	"code.cloudfoundry.org/cli/v9/util/clissh"
+	"golang.org/x/crypto/ssh"
+)
 
 type TTYOption clissh.TTYRequest
 
@@ -25,6 +32,42 @@ type SSHOptions struct {
 	LocalPortForwardSpecs []LocalPortForward
 }
 
+// SSHInstanceOptions are the SSH options for one of several instances that a
+// command is run on. When GetPasscode is set, it is called for a fresh
+// passcode right before the instance is connected to, instead of using
+// Passcode.
+type SSHInstanceOptions struct {
+	SSHOptions
+	InstanceIndex uint
+	GetPasscode   func() (string, error)
+}
+
+// SSHCommandResult is the outcome of running a command on one instance.
+// ExitStatus is only set when Err is nil.
+type SSHCommandResult struct {
+	InstanceIndex uint
+	ExitStatus    int
+	Err           error
+}
+
+type CopyDirection int
+
+const (
+	CopyToApp CopyDirection = iota
+	CopyFromApp
+)
+
+type SecureCopyOptions struct {
+	Username           string
+	Passcode           string
+	Endpoint           string
+	HostKeyFingerprint string
+	SkipHostValidation bool
+	LocalPath          string
+	RemotePath         string
+	Direction          CopyDirection
+}
+
 func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SSHOptions) error {
 	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
 	if err != nil {
@@ -45,6 +88,85 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 	return err
 }
 
+// ExecuteSecureShellOnInstances runs the commands of each instance's options
+// on that instance, with at most maxInFlight instances at a time. Every
+// instance gets its own client from newClient, and its output is written to
+// the writers returned by output. The results are in the order of instances.
+func (actor Actor) ExecuteSecureShellOnInstances(
+	newClient func() SecureShellClient,
+	instances []SSHInstanceOptions,
+	maxInFlight int,
+	output func(instanceIndex uint) (stdout io.Writer, stderr io.Writer),
+) []SSHCommandResult {
+	results := make([]SSHCommandResult, len(instances))
+	inFlight := make(chan struct{}, maxInFlight)
+
+	var wg sync.WaitGroup
+	for i, instance := range instances {
+		wg.Add(1)
+		inFlight <- struct{}{}
+
+		go func(i int, instance SSHInstanceOptions) {
+			defer func() {
+				<-inFlight
+				wg.Done()
+			}()
+
+			stdout, stderr := output(instance.InstanceIndex)
+			results[i] = runOnInstance(newClient(), instance, stdout, stderr)
+		}(i, instance)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func runOnInstance(sshClient SecureShellClient, instance SSHInstanceOptions, stdout io.Writer, stderr io.Writer) SSHCommandResult {
+	result := SSHCommandResult{InstanceIndex: instance.InstanceIndex}
+
+	passcode := instance.Passcode
+	if instance.GetPasscode != nil {
+		var err error
+		passcode, err = instance.GetPasscode()
+		if err != nil {
+			result.Err = err
+			return result
+		}
+	}
+
+	err := sshClient.Connect(instance.Username, passcode, instance.Endpoint, instance.HostKeyFingerprint, instance.SkipHostValidation)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	defer sshClient.Close()
+
+	err = sshClient.RunCommand(instance.Commands, stdout, stderr)
+
+	var exitErr *ssh.ExitError
+	if errors.As(err, &exitErr) {
+		result.ExitStatus = exitErr.ExitStatus()
+	} else {
+		result.Err = err
+	}
+	return result
+}
+
+// ExecuteSecureCopy copies files between the local machine and an app
+// instance, in the direction given by the copy options.
+func (actor Actor) ExecuteSecureCopy(sshClient SecureShellClient, copyOptions SecureCopyOptions) error {
+	err := sshClient.Connect(copyOptions.Username, copyOptions.Passcode, copyOptions.Endpoint, copyOptions.HostKeyFingerprint, copyOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	if copyOptions.Direction == CopyFromApp {
+		return sshClient.CopyFromRemote(copyOptions.RemotePath, copyOptions.LocalPath)
+	}
+	return sshClient.CopyToRemote(copyOptions.LocalPath, copyOptions.RemotePath)
+}
+
 func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []clissh.LocalPortForward {
 	sshPackageSpecs := []clissh.LocalPortForward{}
 
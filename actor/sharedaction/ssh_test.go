@@ -1,13 +1,21 @@
 package sharedaction_test
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	. "code.cloudfoundry.org/cli/v9/actor/sharedaction"
 	"code.cloudfoundry.org/cli/v9/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/v9/util/clissh"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
 )
 
 var _ = Describe("SSH Actions", func() {
@@ -163,4 +171,303 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("ExecuteSecureShellOnInstances", func() {
+		var (
+			clients     []*sharedactionfakes.FakeSecureShellClient
+			clientsLock sync.Mutex
+			newClient   func() SecureShellClient
+			instances   []SSHInstanceOptions
+			maxInFlight int
+			outputs     map[uint]*bytes.Buffer
+			outputLock  sync.Mutex
+			results     []SSHCommandResult
+		)
+
+		BeforeEach(func() {
+			clients = nil
+			newClient = func() SecureShellClient {
+				clientsLock.Lock()
+				defer clientsLock.Unlock()
+
+				client := new(sharedactionfakes.FakeSecureShellClient)
+				client.RunCommandStub = func(commands []string, stdout io.Writer, stderr io.Writer) error {
+					_, err := stdout.Write([]byte(strings.Join(commands, " ")))
+					return err
+				}
+				clients = append(clients, client)
+				return client
+			}
+
+			instances = []SSHInstanceOptions{
+				{SSHOptions: SSHOptions{Username: "cf:some-guid/0", Passcode: "passcode-0", Commands: []string{"df", "-h"}}, InstanceIndex: 0},
+				{SSHOptions: SSHOptions{Username: "cf:some-guid/1", Passcode: "passcode-1", Commands: []string{"df", "-h"}}, InstanceIndex: 1},
+				{SSHOptions: SSHOptions{Username: "cf:some-guid/2", Passcode: "passcode-2", Commands: []string{"df", "-h"}}, InstanceIndex: 2},
+			}
+			maxInFlight = 2
+			outputs = map[uint]*bytes.Buffer{}
+		})
+
+		JustBeforeEach(func() {
+			results = actor.ExecuteSecureShellOnInstances(newClient, instances, maxInFlight, func(instanceIndex uint) (io.Writer, io.Writer) {
+				outputLock.Lock()
+				defer outputLock.Unlock()
+
+				outputs[instanceIndex] = new(bytes.Buffer)
+				return outputs[instanceIndex], io.Discard
+			})
+		})
+
+		It("runs the commands on every instance with its own client", func() {
+			Expect(clients).To(HaveLen(3))
+
+			var usernames []string
+			for _, client := range clients {
+				Expect(client.ConnectCallCount()).To(Equal(1))
+				username, _, _, _, _ := client.ConnectArgsForCall(0)
+				usernames = append(usernames, username)
+
+				Expect(client.RunCommandCallCount()).To(Equal(1))
+				Expect(client.CloseCallCount()).To(Equal(1))
+			}
+			Expect(usernames).To(ConsistOf("cf:some-guid/0", "cf:some-guid/1", "cf:some-guid/2"))
+
+			for index := uint(0); index < 3; index++ {
+				Expect(outputs[index].String()).To(Equal("df -h"))
+			}
+		})
+
+		It("returns a result per instance in the order of the instances", func() {
+			Expect(results).To(Equal([]SSHCommandResult{
+				{InstanceIndex: 0},
+				{InstanceIndex: 1},
+				{InstanceIndex: 2},
+			}))
+		})
+
+		When("more instances than the max in flight are given", func() {
+			var running, maxRunning int32
+
+			BeforeEach(func() {
+				running, maxRunning = 0, 0
+				maxInFlight = 1
+				baseNewClient := newClient
+				newClient = func() SecureShellClient {
+					client := baseNewClient().(*sharedactionfakes.FakeSecureShellClient)
+					client.RunCommandStub = func([]string, io.Writer, io.Writer) error {
+						current := atomic.AddInt32(&running, 1)
+						for {
+							seen := atomic.LoadInt32(&maxRunning)
+							if current <= seen || atomic.CompareAndSwapInt32(&maxRunning, seen, current) {
+								break
+							}
+						}
+						time.Sleep(10 * time.Millisecond)
+						atomic.AddInt32(&running, -1)
+						return nil
+					}
+					return client
+				}
+			})
+
+			It("limits the number of instances that run at once", func() {
+				Expect(results).To(HaveLen(3))
+				Expect(atomic.LoadInt32(&maxRunning)).To(Equal(int32(1)))
+			})
+		})
+
+		When("connecting to an instance fails", func() {
+			BeforeEach(func() {
+				baseNewClient := newClient
+				newClient = func() SecureShellClient {
+					client := baseNewClient().(*sharedactionfakes.FakeSecureShellClient)
+					client.ConnectStub = func(username string, _ string, _ string, _ string, _ bool) error {
+						if username == "cf:some-guid/1" {
+							return errors.New("some-connect-error")
+						}
+						return nil
+					}
+					return client
+				}
+			})
+
+			It("reports the error for that instance and still runs on the others", func() {
+				Expect(results[0]).To(Equal(SSHCommandResult{InstanceIndex: 0}))
+				Expect(results[1]).To(Equal(SSHCommandResult{InstanceIndex: 1, Err: errors.New("some-connect-error")}))
+				Expect(results[2]).To(Equal(SSHCommandResult{InstanceIndex: 2}))
+			})
+		})
+
+		When("the instances get their passcodes when their sessions start", func() {
+			var (
+				events     []string
+				eventsLock sync.Mutex
+			)
+
+			record := func(event string) {
+				eventsLock.Lock()
+				defer eventsLock.Unlock()
+				events = append(events, event)
+			}
+
+			BeforeEach(func() {
+				events = nil
+				maxInFlight = 1
+				for i := range instances {
+					index := instances[i].InstanceIndex
+					instances[i].Passcode = ""
+					instances[i].GetPasscode = func() (string, error) {
+						record(fmt.Sprintf("passcode %d", index))
+						if index == 2 {
+							return "", errors.New("some-passcode-error")
+						}
+						return fmt.Sprintf("fresh-passcode-%d", index), nil
+					}
+				}
+
+				baseNewClient := newClient
+				newClient = func() SecureShellClient {
+					client := baseNewClient().(*sharedactionfakes.FakeSecureShellClient)
+					client.ConnectStub = func(_ string, passcode string, _ string, _ string, _ bool) error {
+						record("connect with " + passcode)
+						return nil
+					}
+					return client
+				}
+			})
+
+			It("gets a passcode right before connecting to each instance", func() {
+				Expect(events).To(Equal([]string{
+					"passcode 0", "connect with fresh-passcode-0",
+					"passcode 1", "connect with fresh-passcode-1",
+					"passcode 2",
+				}))
+				Expect(results[2]).To(Equal(SSHCommandResult{InstanceIndex: 2, Err: errors.New("some-passcode-error")}))
+			})
+		})
+
+		When("the command exits with a status", func() {
+			BeforeEach(func() {
+				baseNewClient := newClient
+				newClient = func() SecureShellClient {
+					client := baseNewClient().(*sharedactionfakes.FakeSecureShellClient)
+					client.RunCommandReturns(&ssh.ExitError{})
+					return client
+				}
+			})
+
+			It("reports the exit status without an error", func() {
+				for _, result := range results {
+					Expect(result.Err).ToNot(HaveOccurred())
+					Expect(result.ExitStatus).To(Equal(0))
+				}
+			})
+		})
+
+		When("running the command fails", func() {
+			BeforeEach(func() {
+				baseNewClient := newClient
+				newClient = func() SecureShellClient {
+					client := baseNewClient().(*sharedactionfakes.FakeSecureShellClient)
+					client.RunCommandReturns(errors.New("some-session-error"))
+					return client
+				}
+			})
+
+			It("reports the error", func() {
+				for _, result := range results {
+					Expect(result.Err).To(MatchError("some-session-error"))
+				}
+			})
+		})
+	})
+
+	Describe("ExecuteSecureCopy", func() {
+		var (
+			copyOptions SecureCopyOptions
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			copyOptions = SecureCopyOptions{
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				SkipHostValidation: true,
+				LocalPath:          "some-local-path",
+				RemotePath:         "some-remote-path",
+			}
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.ExecuteSecureCopy(fakeSecureShellClient, copyOptions)
+		})
+
+		It("calls connect with the provided authorization info", func() {
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+			usernameArg, passcodeArg, endpointArg, fingerprintArg, skipHostValidationArg := fakeSecureShellClient.ConnectArgsForCall(0)
+			Expect(usernameArg).To(Equal("some-user"))
+			Expect(passcodeArg).To(Equal("some-passcode"))
+			Expect(endpointArg).To(Equal("some-endpoint"))
+			Expect(fingerprintArg).To(Equal("some-fingerprint"))
+			Expect(skipHostValidationArg).To(BeTrue())
+		})
+
+		When("connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error without copying", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(fakeSecureShellClient.CopyToRemoteCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CopyFromRemoteCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(0))
+			})
+		})
+
+		When("copying to the app", func() {
+			BeforeEach(func() {
+				copyOptions.Direction = CopyToApp
+			})
+
+			It("copies the local path to the remote path and closes the connection", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeSecureShellClient.CopyToRemoteCallCount()).To(Equal(1))
+				localPathArg, remotePathArg := fakeSecureShellClient.CopyToRemoteArgsForCall(0)
+				Expect(localPathArg).To(Equal("some-local-path"))
+				Expect(remotePathArg).To(Equal("some-remote-path"))
+				Expect(fakeSecureShellClient.CopyFromRemoteCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+
+			When("copying errors", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.CopyToRemoteReturns(errors.New("some-copy-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-copy-error"))
+					Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		When("copying from the app", func() {
+			BeforeEach(func() {
+				copyOptions.Direction = CopyFromApp
+			})
+
+			It("copies the remote path to the local path and closes the connection", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeSecureShellClient.CopyFromRemoteCallCount()).To(Equal(1))
+				remotePathArg, localPathArg := fakeSecureShellClient.CopyFromRemoteArgsForCall(0)
+				Expect(remotePathArg).To(Equal("some-remote-path"))
+				Expect(localPathArg).To(Equal("some-local-path"))
+				Expect(fakeSecureShellClient.CopyToRemoteCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
 })
@@ -153,6 +153,22 @@ func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]resources.Applica
 	return apps, Warnings(warnings), nil
 }
 
+// GetApplicationsBySpaceAndLabelSelector returns the applications in a space
+// that match the label selector, ordered by name.
+func (actor Actor) GetApplicationsBySpaceAndLabelSelector(spaceGUID string, labelSelector string) ([]resources.Application, Warnings, error) {
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{labelSelector}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+	)
+
+	if err != nil {
+		return []resources.Application{}, Warnings(warnings), err
+	}
+
+	return apps, Warnings(warnings), nil
+}
+
 // CreateApplicationInSpace creates and returns the application with the given
 // name in the given space.
 func (actor Actor) CreateApplicationInSpace(app resources.Application, spaceGUID string) (resources.Application, Warnings, error) {
@@ -24,6 +24,7 @@ type CloudControllerClient interface {
 	CreateApplication(app resources.Application) (resources.Application, ccv3.Warnings, error)
 	CreateApplicationDeployment(dep resources.Deployment) (string, ccv3.Warnings, error)
 	CreateApplicationProcessScale(appGUID string, process resources.Process) (resources.Process, ccv3.Warnings, error)
+	CreateApplicationSidecar(appGUID string, sidecar resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)
 	CreateApplicationTask(appGUID string, task resources.Task) (resources.Task, ccv3.Warnings, error)
 	CreateBuild(build resources.Build) (resources.Build, ccv3.Warnings, error)
 	CreateBuildpack(bp resources.Buildpack) (resources.Buildpack, ccv3.Warnings, error)
@@ -57,13 +58,14 @@ type CloudControllerClient interface {
 	DeleteRoute(routeGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteRouteBinding(guid string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteSecurityGroup(securityGroupGUID string) (ccv3.JobURL, ccv3.Warnings, error)
+	DeleteSidecar(sidecarGUID string) (ccv3.Warnings, error)
 	DeleteServiceCredentialBinding(guid string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteServiceBroker(serviceBrokerGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteServiceInstance(serviceInstanceGUID string, query ...ccv3.Query) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteSpaceQuota(spaceQuotaGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteSpace(guid string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteUser(userGUID string) (ccv3.JobURL, ccv3.Warnings, error)
-	DownloadDroplet(dropletGUID string) ([]byte, ccv3.Warnings, error)
+	DropletDownloadURL(dropletGUID string) (string, error)
 	EntitleIsolationSegmentToOrganizations(isoGUID string, orgGUIDs []string) (resources.RelationshipList, ccv3.Warnings, error)
 	GetRoutePolicies(query ...ccv3.Query) ([]resources.RoutePolicy, ccv3.IncludedResources, ccv3.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (resources.Application, ccv3.Warnings, error)
@@ -75,8 +77,10 @@ type CloudControllerClient interface {
 	GetApplicationRevisions(appGUID string, query ...ccv3.Query) ([]resources.Revision, ccv3.Warnings, error)
 	GetApplicationRevisionsDeployed(appGUID string) ([]resources.Revision, ccv3.Warnings, error)
 	GetApplicationRoutes(appGUID string) ([]resources.Route, ccv3.Warnings, error)
+	GetApplicationSidecars(appGUID string, query ...ccv3.Query) ([]resources.Sidecar, ccv3.Warnings, error)
 	GetApplicationTasks(appGUID string, query ...ccv3.Query) ([]resources.Task, ccv3.Warnings, error)
 	GetApplications(query ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error)
+	GetAppUsageEvents(query ...ccv3.Query) ([]resources.AppUsageEvent, ccv3.Warnings, error)
 	GetBuild(guid string) (resources.Build, ccv3.Warnings, error)
 	GetBuildpacks(query ...ccv3.Query) ([]resources.Buildpack, ccv3.Warnings, error)
 	GetDefaultDomain(orgGuid string) (resources.Domain, ccv3.Warnings, error)
@@ -128,6 +132,7 @@ type CloudControllerClient interface {
 	GetServiceOfferingByGUID(guid string) (resources.ServiceOffering, ccv3.Warnings, error)
 	GetServiceOfferings(query ...ccv3.Query) ([]resources.ServiceOffering, ccv3.Warnings, error)
 	GetServiceOfferingByNameAndBroker(serviceOfferingName, serviceBrokerName string) (resources.ServiceOffering, ccv3.Warnings, error)
+	GetServiceUsageEvents(query ...ccv3.Query) ([]resources.ServiceUsageEvent, ccv3.Warnings, error)
 	GetServicePlanByGUID(guid string) (resources.ServicePlan, ccv3.Warnings, error)
 	GetServicePlans(query ...ccv3.Query) ([]resources.ServicePlan, ccv3.Warnings, error)
 	GetServicePlansWithOfferings(query ...ccv3.Query) ([]ccv3.ServiceOfferingWithPlans, ccv3.Warnings, error)
@@ -143,6 +148,7 @@ type CloudControllerClient interface {
 	GetStacks(query ...ccv3.Query) ([]resources.Stack, ccv3.Warnings, error)
 	GetStagingSecurityGroups(spaceGUID string, queries ...ccv3.Query) ([]resources.SecurityGroup, ccv3.Warnings, error)
 	UpdateStack(stackGUID string, state string, reason string) (resources.Stack, ccv3.Warnings, error)
+	UpdateSidecar(sidecar resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)
 	GetTask(guid string) (resources.Task, ccv3.Warnings, error)
 	GetUser(userGUID string) (resources.User, ccv3.Warnings, error)
 	GetUsers(query ...ccv3.Query) ([]resources.User, ccv3.Warnings, error)
@@ -2,11 +2,13 @@ package v7action
 
 import (
 	"io"
+	"net/http"
 
 	"code.cloudfoundry.org/cli/v9/actor/actionerror"
 	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/util/download"
 )
 
 // CreateApplicationDroplet creates a new droplet without a package for the app with
@@ -116,41 +118,42 @@ func (actor Actor) UploadDroplet(dropletGUID string, dropletPath string, progres
 	return allWarnings, nil
 }
 
-func (actor Actor) DownloadCurrentDropletByAppName(appName string, spaceGUID string) ([]byte, string, Warnings, error) {
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . DropletDownloader
+
+// DropletDownloader streams a file to disk, resuming interrupted downloads and
+// verifying the result against a checksum.
+type DropletDownloader interface {
+	DownloadToFile(url string, header http.Header, path string, checksum download.Checksum, progressBar download.ProgressBar) error
+}
+
+func (actor Actor) GetCurrentDropletByAppName(appName string, spaceGUID string) (resources.Droplet, Warnings, error) {
 	var allWarnings Warnings
 
 	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
-		return []byte{}, "", allWarnings, err
+		return resources.Droplet{}, allWarnings, err
 	}
 
 	droplet, ccWarnings, err := actor.CloudControllerClient.GetApplicationDropletCurrent(app.GUID)
 	allWarnings = append(allWarnings, ccWarnings...)
-
 	if err != nil {
 		if _, ok := err.(ccerror.DropletNotFoundError); ok {
-			return []byte{}, "", allWarnings, actionerror.DropletNotFoundError{}
+			return resources.Droplet{}, allWarnings, actionerror.DropletNotFoundError{}
 		}
-		return []byte{}, "", allWarnings, err
-	}
-
-	rawDropletBytes, ccWarnings, err := actor.CloudControllerClient.DownloadDroplet(droplet.GUID)
-	allWarnings = append(allWarnings, ccWarnings...)
-	if err != nil {
-		return []byte{}, "", allWarnings, err
+		return resources.Droplet{}, allWarnings, err
 	}
 
-	return rawDropletBytes, droplet.GUID, allWarnings, nil
+	return droplet, allWarnings, nil
 }
 
-func (actor Actor) DownloadDropletByGUIDAndAppName(dropletGUID string, appName string, spaceGUID string) ([]byte, Warnings, error) {
+func (actor Actor) GetDropletByGUIDAndAppName(dropletGUID string, appName string, spaceGUID string) (resources.Droplet, Warnings, error) {
 	var allWarnings Warnings
 
 	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
-		return []byte{}, allWarnings, err
+		return resources.Droplet{}, allWarnings, err
 	}
 
 	droplets, getDropletWarnings, err := actor.CloudControllerClient.GetDroplets(
@@ -161,18 +164,31 @@ func (actor Actor) DownloadDropletByGUIDAndAppName(dropletGUID string, appName s
 	)
 	allWarnings = append(allWarnings, getDropletWarnings...)
 	if err != nil {
-		return []byte{}, allWarnings, err
+		return resources.Droplet{}, allWarnings, err
 	}
 
 	if len(droplets) == 0 {
-		return []byte{}, allWarnings, actionerror.DropletNotFoundError{}
+		return resources.Droplet{}, allWarnings, actionerror.DropletNotFoundError{}
 	}
 
-	rawDropletBytes, ccWarnings, err := actor.CloudControllerClient.DownloadDroplet(dropletGUID)
-	allWarnings = append(allWarnings, ccWarnings...)
+	return droplets[0], allWarnings, nil
+}
+
+// DownloadDroplet streams the bits of the droplet to path and verifies them
+// against the droplet's checksum. A download interrupted by an earlier call
+// with the same path is resumed. The downloader authenticates the requests, as
+// the Cloud Controller client's streaming HTTP client does.
+func (actor Actor) DownloadDroplet(droplet resources.Droplet, path string, downloader DropletDownloader, progressBar download.ProgressBar) error {
+	url, err := actor.CloudControllerClient.DropletDownloadURL(droplet.GUID)
 	if err != nil {
-		return []byte{}, allWarnings, err
+		return err
 	}
 
-	return rawDropletBytes, allWarnings, nil
+	return downloader.DownloadToFile(
+		url,
+		nil,
+		path,
+		download.Checksum{Algorithm: droplet.Checksum.Type, Value: droplet.Checksum.Value},
+		progressBar,
+	)
 }
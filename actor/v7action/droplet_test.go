@@ -12,6 +12,8 @@ import (
 	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/util/download"
+	"code.cloudfoundry.org/cli/v9/util/download/downloadfakes"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -639,13 +641,12 @@ var _ = Describe("Droplet Actions", func() {
 		})
 	})
 
-	Describe("DownloadCurrentDropletByAppName", func() {
+	Describe("GetCurrentDropletByAppName", func() {
 		var (
 			appName   string
 			spaceGUID string
 
-			dropletBytes []byte
-			dropletGUID  string
+			droplet      resources.Droplet
 			warnings     Warnings
 			executionErr error
 		)
@@ -668,32 +669,22 @@ var _ = Describe("Droplet Actions", func() {
 		})
 
 		JustBeforeEach(func() {
-			dropletBytes, dropletGUID, warnings, executionErr = actor.DownloadCurrentDropletByAppName(appName, spaceGUID)
+			droplet, warnings, executionErr = actor.GetCurrentDropletByAppName(appName, spaceGUID)
 		})
 
-		When("there is a current droplet available for download", func() {
-			BeforeEach(func() {
-				fakeCloudControllerClient.DownloadDropletReturns([]byte{'d', 'r', 'o', 'p'}, ccv3.Warnings{"some-droplet-warning"}, nil)
-			})
+		It("returns the current droplet of the app", func() {
+			Expect(executionErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "some-warning"))
+			Expect(droplet).To(Equal(resources.Droplet{GUID: "some-droplet-guid"}))
 
-			It("successfully downloads", func() {
-				Expect(executionErr).ToNot(HaveOccurred())
-				Expect(warnings).To(ConsistOf("get-app-warning", "some-droplet-warning", "some-warning"))
-				Expect(dropletBytes).To(Equal([]byte{'d', 'r', 'o', 'p'}))
-				Expect(dropletGUID).To(Equal("some-droplet-guid"))
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-app-name"}},
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+			))
 
-				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
-				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
-					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-app-name"}},
-					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
-				))
-
-				Expect(fakeCloudControllerClient.GetApplicationDropletCurrentCallCount()).To(Equal(1))
-				Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
-				Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(1))
-				Expect(fakeCloudControllerClient.DownloadDropletArgsForCall(0)).To(Equal("some-droplet-guid"))
-
-			})
+			Expect(fakeCloudControllerClient.GetApplicationDropletCurrentCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
 		})
 
 		When("the app does not exist", func() {
@@ -718,25 +709,25 @@ var _ = Describe("Droplet Actions", func() {
 			})
 		})
 
-		When("an error occurs downloading the droplet", func() {
+		When("an error occurs getting the droplet", func() {
 			BeforeEach(func() {
-				fakeCloudControllerClient.DownloadDropletReturns([]byte{}, ccv3.Warnings{"some-droplet-warning"}, errors.New("droplet-download-err"))
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(resources.Droplet{}, ccv3.Warnings{"some-warning"}, errors.New("get-droplet-err"))
 			})
 
 			It("returns the error and warnings", func() {
-				Expect(executionErr).To(MatchError("droplet-download-err"))
-				Expect(warnings).To(ConsistOf("get-app-warning", "some-warning", "some-droplet-warning"))
+				Expect(executionErr).To(MatchError("get-droplet-err"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "some-warning"))
 			})
 		})
 	})
 
-	Describe("DownloadDropletByGUIDAndAppName", func() {
+	Describe("GetDropletByGUIDAndAppName", func() {
 		var (
-			appName   string
-			spaceGUID string
+			appName     string
+			spaceGUID   string
+			dropletGUID string
 
-			dropletBytes []byte
-			dropletGUID  string
+			droplet      resources.Droplet
 			warnings     Warnings
 			executionErr error
 		)
@@ -760,37 +751,21 @@ var _ = Describe("Droplet Actions", func() {
 		})
 
 		JustBeforeEach(func() {
-			dropletBytes, warnings, executionErr = actor.DownloadDropletByGUIDAndAppName(dropletGUID, appName, spaceGUID)
+			droplet, warnings, executionErr = actor.GetDropletByGUIDAndAppName(dropletGUID, appName, spaceGUID)
 		})
 
-		When("there is a droplet available for download", func() {
-			BeforeEach(func() {
-				fakeCloudControllerClient.DownloadDropletReturns([]byte{'d', 'r', 'o', 'p'}, ccv3.Warnings{"some-droplet-warning"}, nil)
-			})
-
-			It("successfully downloads", func() {
-				Expect(executionErr).ToNot(HaveOccurred())
-				Expect(warnings).To(ConsistOf("get-app-warning", "some-droplet-warning", "some-warning"))
-				Expect(dropletBytes).To(Equal([]byte{'d', 'r', 'o', 'p'}))
+		It("returns the droplet of the app", func() {
+			Expect(executionErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "some-warning"))
+			Expect(droplet).To(Equal(resources.Droplet{GUID: "some-droplet-guid"}))
 
-				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
-				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
-					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-app-name"}},
-					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
-				))
-
-				Expect(fakeCloudControllerClient.GetDropletsCallCount()).To(Equal(1))
-				Expect(fakeCloudControllerClient.GetDropletsArgsForCall(0)).To(ConsistOf(
-					ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-droplet-guid"}},
-					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
-					ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
-					ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
-				))
-
-				Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(1))
-				Expect(fakeCloudControllerClient.DownloadDropletArgsForCall(0)).To(Equal("some-droplet-guid"))
-
-			})
+			Expect(fakeCloudControllerClient.GetDropletsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetDropletsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-droplet-guid"}},
+				ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+				ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+			))
 		})
 
 		When("the app does not exist", func() {
@@ -814,15 +789,65 @@ var _ = Describe("Droplet Actions", func() {
 				Expect(warnings).To(ConsistOf("get-app-warning", "some-warning"))
 			})
 		})
+	})
 
-		When("an error occurs downloading the droplet", func() {
+	Describe("DownloadDroplet", func() {
+		var (
+			fakeDownloader *v7actionfakes.FakeDropletDownloader
+			progressBar    *downloadfakes.FakeProgressBar
+			executionErr   error
+		)
+
+		BeforeEach(func() {
+			fakeDownloader = new(v7actionfakes.FakeDropletDownloader)
+			progressBar = new(downloadfakes.FakeProgressBar)
+			fakeCloudControllerClient.DropletDownloadURLReturns("https://api.example.com/v3/droplets/some-droplet-guid/download", nil)
+		})
+
+		JustBeforeEach(func() {
+			executionErr = actor.DownloadDroplet(
+				resources.Droplet{
+					GUID:     "some-droplet-guid",
+					Checksum: resources.DropletChecksum{Type: "sha256", Value: "some-digest"},
+				},
+				"/some/path/droplet.tgz",
+				fakeDownloader,
+				progressBar,
+			)
+		})
+
+		It("streams the droplet bits to the path and verifies the checksum", func() {
+			Expect(executionErr).ToNot(HaveOccurred())
+
+			Expect(fakeCloudControllerClient.DropletDownloadURLArgsForCall(0)).To(Equal("some-droplet-guid"))
+
+			Expect(fakeDownloader.DownloadToFileCallCount()).To(Equal(1))
+			url, header, path, checksum, progressBarArg := fakeDownloader.DownloadToFileArgsForCall(0)
+			Expect(url).To(Equal("https://api.example.com/v3/droplets/some-droplet-guid/download"))
+			Expect(header).To(BeEmpty())
+			Expect(path).To(Equal("/some/path/droplet.tgz"))
+			Expect(checksum).To(Equal(download.Checksum{Algorithm: "sha256", Value: "some-digest"}))
+			Expect(progressBarArg).To(Equal(progressBar))
+		})
+
+		When("the download fails", func() {
 			BeforeEach(func() {
-				fakeCloudControllerClient.DownloadDropletReturns([]byte{}, ccv3.Warnings{"some-droplet-warning"}, errors.New("droplet-download-err"))
+				fakeDownloader.DownloadToFileReturns(download.ChecksumMismatchError{Algorithm: "sha256", Expected: "some-digest", Actual: "other-digest"})
 			})
 
-			It("returns the error and warnings", func() {
-				Expect(executionErr).To(MatchError("droplet-download-err"))
-				Expect(warnings).To(ConsistOf("get-app-warning", "some-warning", "some-droplet-warning"))
+			It("returns the error", func() {
+				Expect(executionErr).To(MatchError(download.ChecksumMismatchError{Algorithm: "sha256", Expected: "some-digest", Actual: "other-digest"}))
+			})
+		})
+
+		When("building the download URL fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DropletDownloadURLReturns("", errors.New("url-err"))
+			})
+
+			It("returns the error without downloading", func() {
+				Expect(executionErr).To(MatchError("url-err"))
+				Expect(fakeDownloader.DownloadToFileCallCount()).To(Equal(0))
 			})
 		})
 	})
@@ -11,11 +11,33 @@ import (
 )
 
 type Event struct {
-	GUID        string
-	Time        time.Time
-	Type        string
-	ActorName   string
-	Description string
+	GUID             string
+	Time             time.Time
+	Type             string
+	ActorGUID        string
+	ActorType        string
+	ActorName        string
+	TargetGUID       string
+	TargetType       string
+	TargetName       string
+	SpaceGUID        string
+	OrganizationGUID string
+	Description      string
+	Data             map[string]interface{}
+}
+
+// AuditEventFilter selects audit events. Empty fields do not filter. Events
+// are created at or after Start and before End. Actors match either the
+// actor's name or GUID.
+type AuditEventFilter struct {
+	OrganizationGUIDs []string
+	SpaceGUIDs        []string
+	Types             []string
+	Actors            []string
+	TargetTypes       []string
+	TargetGUIDs       []string
+	Start             time.Time
+	End               time.Time
 }
 
 func (actor Actor) GetRecentEventsByApplicationNameAndSpace(appName string, spaceGUID string) ([]Event, Warnings, error) {
@@ -53,6 +75,81 @@ func (actor Actor) GetRecentEventsByApplicationNameAndSpace(appName string, spac
 	return events, allWarnings, nil
 }
 
+// GetAuditEvents returns the audit events matching the filter, oldest first.
+// The actor and target type are not filters of the audit events endpoint, so
+// those are applied to the returned events.
+func (actor Actor) GetAuditEvents(filter AuditEventFilter) ([]Event, Warnings, error) {
+	query := []ccv3.Query{
+		{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtAscendingOrder}},
+		{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	}
+	if len(filter.OrganizationGUIDs) > 0 {
+		query = append(query, ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: filter.OrganizationGUIDs})
+	}
+	if len(filter.SpaceGUIDs) > 0 {
+		query = append(query, ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: filter.SpaceGUIDs})
+	}
+	if len(filter.Types) > 0 {
+		query = append(query, ccv3.Query{Key: ccv3.AuditEventTypesFilter, Values: filter.Types})
+	}
+	if len(filter.TargetGUIDs) > 0 {
+		query = append(query, ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: filter.TargetGUIDs})
+	}
+	if !filter.Start.IsZero() {
+		query = append(query, ccv3.Query{Key: ccv3.CreatedAtsGTEFilter, Values: []string{filter.Start.UTC().Format(time.RFC3339)}})
+	}
+	if !filter.End.IsZero() {
+		query = append(query, ccv3.Query{Key: ccv3.CreatedAtsLTFilter, Values: []string{filter.End.UTC().Format(time.RFC3339)}})
+	}
+
+	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(query...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var events []Event
+	for _, ccEvent := range ccEvents {
+		if !matchesAny(filter.Actors, ccEvent.ActorName, ccEvent.ActorGUID) ||
+			!matchesAny(filter.TargetTypes, ccEvent.TargetType) {
+			continue
+		}
+
+		events = append(events, Event{
+			GUID:             ccEvent.GUID,
+			Time:             ccEvent.CreatedAt,
+			Type:             ccEvent.Type,
+			ActorGUID:        ccEvent.ActorGUID,
+			ActorType:        ccEvent.ActorType,
+			ActorName:        ccEvent.ActorName,
+			TargetGUID:       ccEvent.TargetGUID,
+			TargetType:       ccEvent.TargetType,
+			TargetName:       ccEvent.TargetName,
+			SpaceGUID:        ccEvent.SpaceGUID,
+			OrganizationGUID: ccEvent.OrganizationGUID,
+			Description:      generateDescription(ccEvent.Data),
+			Data:             ccEvent.Data,
+		})
+	}
+
+	return events, Warnings(warnings), nil
+}
+
+// matchesAny returns true if wanted is empty or contains any of the values.
+func matchesAny(wanted []string, values ...string) bool {
+	if len(wanted) == 0 {
+		return true
+	}
+
+	for _, w := range wanted {
+		for _, v := range values {
+			if v != "" && w == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 var knownMetadataKeys = []string{
 	"index",
 	"reason",
@@ -2,6 +2,7 @@ package v7action_test
 
 import (
 	"errors"
+	"time"
 
 	. "code.cloudfoundry.org/cli/v9/actor/v7action"
 	"code.cloudfoundry.org/cli/v9/actor/v7action/v7actionfakes"
@@ -123,4 +124,116 @@ var _ = Describe("Event Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetAuditEvents", func() {
+		var (
+			filter   AuditEventFilter
+			events   []Event
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			filter = AuditEventFilter{}
+
+			fakeCloudControllerClient.GetEventsReturns(
+				[]ccv3.Event{
+					{
+						GUID:       "event-1",
+						Type:       "audit.app.update",
+						ActorGUID:  "user-guid-1",
+						ActorName:  "alice",
+						TargetType: "app",
+						TargetGUID: "app-guid",
+						TargetName: "some-app",
+						SpaceGUID:  "space-guid",
+						Data:       map[string]interface{}{"request": map[string]interface{}{"instances": float64(2)}},
+					},
+					{GUID: "event-2", Type: "audit.space.update", ActorGUID: "user-guid-2", ActorName: "bob", TargetType: "space"},
+					{GUID: "event-3", Type: "audit.route.create", ActorGUID: "user-guid-1", ActorName: "alice", TargetType: "route"},
+				},
+				ccv3.Warnings{"events-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			events, warnings, err = actor.GetAuditEvents(filter)
+		})
+
+		It("lists all events, oldest first", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("events-warning"))
+			Expect(events).To(HaveLen(3))
+			Expect(events[0]).To(Equal(Event{
+				GUID:        "event-1",
+				Type:        "audit.app.update",
+				ActorGUID:   "user-guid-1",
+				ActorName:   "alice",
+				TargetType:  "app",
+				TargetGUID:  "app-guid",
+				TargetName:  "some-app",
+				SpaceGUID:   "space-guid",
+				Description: "instances: 2",
+				Data:        map[string]interface{}{"request": map[string]interface{}{"instances": float64(2)}},
+			}))
+
+			Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtAscendingOrder}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		When("the filter has server-side fields", func() {
+			BeforeEach(func() {
+				filter = AuditEventFilter{
+					OrganizationGUIDs: []string{"org-guid"},
+					SpaceGUIDs:        []string{"space-guid"},
+					Types:             []string{"audit.app.update", "audit.app.create"},
+					TargetGUIDs:       []string{"app-guid"},
+					Start:             time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+					End:               time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC),
+				}
+			})
+
+			It("passes them as query parameters", func() {
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtAscendingOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+					ccv3.Query{Key: ccv3.AuditEventTypesFilter, Values: []string{"audit.app.update", "audit.app.create"}},
+					ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"app-guid"}},
+					ccv3.Query{Key: ccv3.CreatedAtsGTEFilter, Values: []string{"2024-03-01T00:00:00Z"}},
+					ccv3.Query{Key: ccv3.CreatedAtsLTFilter, Values: []string{"2024-03-02T00:00:00Z"}},
+				))
+			})
+		})
+
+		When("filtering by actor name or GUID", func() {
+			BeforeEach(func() {
+				filter.Actors = []string{"alice", "user-guid-2"}
+				filter.TargetTypes = []string{"app", "space"}
+			})
+
+			It("returns only the matching events", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(HaveLen(2))
+				Expect(events[0].GUID).To(Equal("event-1"))
+				Expect(events[1].GUID).To(Equal("event-2"))
+			})
+		})
+
+		When("the cc client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"events-warning"}, errors.New("boom"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("events-warning"))
+			})
+		})
+	})
 })
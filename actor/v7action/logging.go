@@ -3,31 +3,34 @@ package v7action
 import (
 	"context"
 	"errors"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/cli/v9/actor/sharedaction"
+	"code.cloudfoundry.org/cli/v9/resources"
 	"github.com/SermoDigital/jose/jws"
 )
 
-func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, Warnings, error) {
+func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return nil, nil, nil, allWarnings, err
 	}
 
-	messages, logErrs, cancelFunc := sharedaction.GetStreamingLogs(app.GUID, client)
+	messages, logErrs, cancelFunc := sharedaction.GetFilteredStreamingLogs(app.GUID, client, filter)
 
 	return messages, logErrs, cancelFunc, allWarnings, err
 }
 
-func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) ([]sharedaction.LogMessage, Warnings, error) {
+func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) ([]sharedaction.LogMessage, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return nil, allWarnings, err
 	}
 
-	logCacheMessages, err := sharedaction.GetRecentLogs(app.GUID, client)
+	logCacheMessages, err := sharedaction.GetFilteredRecentLogs(app.GUID, client, filter)
 	if err != nil {
 		return nil, allWarnings, err
 	}
@@ -47,6 +50,100 @@ func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spa
 	return logMessages, allWarnings, nil
 }
 
+// GetStreamingLogsForTask streams the logs of a task, which are sent by the
+// app with the source type APP/TASK/<task name>. Streaming starts when the
+// task was created, so that no output is missed while the stream connects.
+func (actor Actor) GetStreamingLogsForTask(appGUID string, task resources.Task, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc) {
+	filter := sharedaction.LogFilter{
+		SourceTypes: []string{"APP/TASK/" + task.Name},
+	}
+	if createdAt, err := time.Parse(time.RFC3339, task.CreatedAt); err == nil {
+		filter.Since = createdAt.Add(-time.Second)
+	}
+
+	return sharedaction.GetFilteredStreamingLogs(appGUID, client, filter)
+}
+
+// ApplicationLogMessage is a log message of one of several applications whose
+// logs are shown together.
+type ApplicationLogMessage struct {
+	sharedaction.LogMessage
+	AppName string
+}
+
+// GetStreamingLogsForApplications merges the log streams of the given
+// applications into one. Each application is tailed separately, so that
+// reconnects back off per application.
+func (actor Actor) GetStreamingLogsForApplications(apps []resources.Application, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (<-chan ApplicationLogMessage, <-chan error, context.CancelFunc) {
+	outgoingLogStream := make(chan ApplicationLogMessage, 1000)
+	outgoingErrStream := make(chan error, 1000)
+	ctx, cancelMerge := context.WithCancel(context.Background())
+	cancelFuncs := []context.CancelFunc{cancelMerge}
+
+	var wg sync.WaitGroup
+	for _, app := range apps {
+		messages, logErrs, cancelFunc := sharedaction.GetFilteredStreamingLogs(app.GUID, client, filter)
+		cancelFuncs = append(cancelFuncs, cancelFunc)
+
+		wg.Add(2)
+		go func(appName string) {
+			defer wg.Done()
+			for message := range messages {
+				select {
+				case outgoingLogStream <- ApplicationLogMessage{LogMessage: message, AppName: appName}:
+				case <-ctx.Done():
+				}
+			}
+		}(app.Name)
+		go func() {
+			defer wg.Done()
+			for logErr := range logErrs {
+				select {
+				case outgoingErrStream <- logErr:
+				case <-ctx.Done():
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(outgoingLogStream)
+		close(outgoingErrStream)
+	}()
+
+	cancelAll := func() {
+		for _, cancelFunc := range cancelFuncs {
+			cancelFunc()
+		}
+	}
+
+	return outgoingLogStream, outgoingErrStream, cancelAll
+}
+
+// GetRecentLogsForApplications returns the recent logs of the given
+// applications, ordered by timestamp.
+func (actor Actor) GetRecentLogsForApplications(apps []resources.Application, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) ([]ApplicationLogMessage, error) {
+	var logMessages []ApplicationLogMessage
+
+	for _, app := range apps {
+		messages, err := sharedaction.GetFilteredRecentLogs(app.GUID, client, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, message := range messages {
+			logMessages = append(logMessages, ApplicationLogMessage{LogMessage: message, AppName: app.Name})
+		}
+	}
+
+	sort.SliceStable(logMessages, func(i, j int) bool {
+		return logMessages[i].Timestamp().Before(logMessages[j].Timestamp())
+	})
+
+	return logMessages, nil
+}
+
 func (actor Actor) ScheduleTokenRefresh(
 	after func(time.Duration) <-chan time.Time,
 	stop chan struct{},
@@ -83,7 +83,7 @@ var _ = Describe("Logging Actions", func() {
 				})
 
 				It("returns all the recent logs and warnings", func() {
-					messages, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient)
+					messages, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient, sharedaction.LogFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(warnings).To(ConsistOf("some-app-warnings"))
 
@@ -110,7 +110,7 @@ var _ = Describe("Logging Actions", func() {
 				})
 
 				It("returns error and warnings", func() {
-					_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient)
+					_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient, sharedaction.LogFilter{})
 					Expect(err).To(MatchError("Failed to retrieve logs from Log Cache: failure-to-read-from-log-cache"))
 					Expect(warnings).To(ConsistOf("some-app-warnings"))
 				})
@@ -130,7 +130,7 @@ var _ = Describe("Logging Actions", func() {
 			})
 
 			It("returns error and warnings", func() {
-				_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient)
+				_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient, sharedaction.LogFilter{})
 				Expect(err).To(MatchError(expectedErr))
 				Expect(warnings).To(ConsistOf("some-app-warnings"))
 			})
@@ -214,7 +214,7 @@ var _ = Describe("Logging Actions", func() {
 				var warnings Warnings
 				var message sharedaction.LogMessage
 
-				messages, logErrs, stopStreaming, warnings, err = actor.GetStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient)
+				messages, logErrs, stopStreaming, warnings, err = actor.GetStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient, sharedaction.LogFilter{})
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(warnings).To(ConsistOf("some-app-warnings"))
@@ -240,7 +240,7 @@ var _ = Describe("Logging Actions", func() {
 			})
 
 			It("returns error and warnings", func() {
-				_, _, _, warnings, err := actor.GetStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient)
+				_, _, _, warnings, err := actor.GetStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeLogCacheClient, sharedaction.LogFilter{})
 				Expect(err).To(MatchError(expectedErr))
 				Expect(warnings).To(ConsistOf("some-app-warnings"))
 
@@ -248,4 +248,220 @@ var _ = Describe("Logging Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetRecentLogsForApplications", func() {
+		var apps []resources.Application
+
+		BeforeEach(func() {
+			apps = []resources.Application{
+				{Name: "app-1", GUID: "app-1-guid"},
+				{Name: "app-2", GUID: "app-2-guid"},
+			}
+		})
+
+		When("Log Cache returns logs", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadStub = func(
+					ctx context.Context,
+					sourceID string,
+					start time.Time,
+					opts ...logcache.ReadOption,
+				) ([]*loggregator_v2.Envelope, error) {
+					timestamp := int64(10)
+					if sourceID == "app-2-guid" {
+						timestamp = 20
+					}
+
+					return []*loggregator_v2.Envelope{
+						{
+							Timestamp:  timestamp + 10,
+							SourceId:   sourceID,
+							InstanceId: "some-source-instance",
+							Message: &loggregator_v2.Envelope_Log{
+								Log: &loggregator_v2.Log{
+									Payload: []byte(sourceID + "-message-2"),
+									Type:    loggregator_v2.Log_OUT,
+								},
+							},
+						},
+						{
+							Timestamp:  timestamp,
+							SourceId:   sourceID,
+							InstanceId: "some-source-instance",
+							Message: &loggregator_v2.Envelope_Log{
+								Log: &loggregator_v2.Log{
+									Payload: []byte(sourceID + "-message-1"),
+									Type:    loggregator_v2.Log_OUT,
+								},
+							},
+						},
+					}, nil
+				}
+			})
+
+			It("returns the logs of all applications ordered by timestamp", func() {
+				messages, err := actor.GetRecentLogsForApplications(apps, fakeLogCacheClient, sharedaction.LogFilter{})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(messages).To(HaveLen(4))
+				Expect(messages[0].AppName).To(Equal("app-1"))
+				Expect(messages[0].Message()).To(Equal("app-1-guid-message-1"))
+				Expect(messages[1].AppName).To(Equal("app-1"))
+				Expect(messages[1].Message()).To(Equal("app-1-guid-message-2"))
+				Expect(messages[2].AppName).To(Equal("app-2"))
+				Expect(messages[2].Message()).To(Equal("app-2-guid-message-1"))
+				Expect(messages[3].AppName).To(Equal("app-2"))
+				Expect(messages[3].Message()).To(Equal("app-2-guid-message-2"))
+
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(2))
+			})
+		})
+
+		When("Log Cache errors", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, errors.New("failure-to-read-from-log-cache"))
+			})
+
+			It("returns the error", func() {
+				_, err := actor.GetRecentLogsForApplications(apps, fakeLogCacheClient, sharedaction.LogFilter{})
+				Expect(err).To(MatchError("Failed to retrieve logs from Log Cache: failure-to-read-from-log-cache"))
+			})
+		})
+	})
+
+	Describe("GetStreamingLogsForApplications", func() {
+		var (
+			messages      <-chan ApplicationLogMessage
+			logErrs       <-chan error
+			stopStreaming context.CancelFunc
+		)
+
+		BeforeEach(func() {
+			fakeLogCacheClient.ReadStub = func(
+				ctx context.Context,
+				sourceID string,
+				start time.Time,
+				opts ...logcache.ReadOption,
+			) ([]*loggregator_v2.Envelope, error) {
+				return []*loggregator_v2.Envelope{{
+					// 3 seconds in the past to get past Walk delay
+					Timestamp:  time.Now().Add(-3 * time.Second).UnixNano(),
+					SourceId:   sourceID,
+					InstanceId: "some-source-instance",
+					Message: &loggregator_v2.Envelope_Log{
+						Log: &loggregator_v2.Log{
+							Payload: []byte(sourceID + "-message"),
+							Type:    loggregator_v2.Log_OUT,
+						},
+					},
+				}}, ctx.Err()
+			}
+
+			messages, logErrs, stopStreaming = actor.GetStreamingLogsForApplications(
+				[]resources.Application{
+					{Name: "app-1", GUID: "app-1-guid"},
+					{Name: "app-2", GUID: "app-2-guid"},
+				},
+				fakeLogCacheClient,
+				sharedaction.LogFilter{},
+			)
+		})
+
+		AfterEach(func() {
+			stopStreaming()
+		})
+
+		It("merges the log streams of all applications and tags each message with its application name", func() {
+			receivedMessages := map[string]string{}
+			for len(receivedMessages) < 2 {
+				var message ApplicationLogMessage
+				Eventually(messages).Should(Receive(&message))
+				receivedMessages[message.AppName] = message.Message()
+			}
+			Expect(receivedMessages).To(Equal(map[string]string{
+				"app-1": "app-1-guid-message",
+				"app-2": "app-2-guid-message",
+			}))
+		})
+
+		It("closes both channels once streaming is stopped", func() {
+			stopStreaming()
+			Eventually(func() bool {
+				for {
+					select {
+					case _, ok := <-messages:
+						if !ok {
+							return true
+						}
+					default:
+						return false
+					}
+				}
+			}).Should(BeTrue())
+			Eventually(logErrs).Should(BeClosed())
+		})
+	})
+
+	Describe("GetStreamingLogsForTask", func() {
+		var (
+			messages      <-chan sharedaction.LogMessage
+			stopStreaming context.CancelFunc
+			createdAt     time.Time
+		)
+
+		BeforeEach(func() {
+			createdAt = time.Now().Add(-time.Minute).Truncate(time.Second)
+			fakeLogCacheClient.ReadStub = func(
+				ctx context.Context,
+				sourceID string,
+				start time.Time,
+				opts ...logcache.ReadOption,
+			) ([]*loggregator_v2.Envelope, error) {
+				if start.Before(createdAt) {
+					return []*loggregator_v2.Envelope{
+						{
+							Timestamp:  createdAt.UnixNano(),
+							SourceId:   sourceID,
+							InstanceId: "0",
+							Tags:       map[string]string{"source_type": "APP/PROC/WEB"},
+							Message: &loggregator_v2.Envelope_Log{
+								Log: &loggregator_v2.Log{Payload: []byte("web-message"), Type: loggregator_v2.Log_OUT},
+							},
+						},
+						{
+							Timestamp:  createdAt.Add(time.Second).UnixNano(),
+							SourceId:   sourceID,
+							InstanceId: "0",
+							Tags:       map[string]string{"source_type": "APP/TASK/migrate"},
+							Message: &loggregator_v2.Envelope_Log{
+								Log: &loggregator_v2.Log{Payload: []byte("task-message"), Type: loggregator_v2.Log_OUT},
+							},
+						},
+					}, ctx.Err()
+				}
+				return nil, ctx.Err()
+			}
+
+			messages, _, stopStreaming = actor.GetStreamingLogsForTask(
+				"some-app-guid",
+				resources.Task{Name: "migrate", CreatedAt: createdAt.Format(time.RFC3339)},
+				fakeLogCacheClient,
+			)
+		})
+
+		AfterEach(func() {
+			stopStreaming()
+		})
+
+		It("streams only the task's logs, starting from when the task was created", func() {
+			var message sharedaction.LogMessage
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.Message()).To(Equal("task-message"))
+			Expect(message.SourceType()).To(Equal("APP/TASK/migrate"))
+
+			_, sourceID, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+			Expect(sourceID).To(Equal("some-app-guid"))
+			Expect(start).To(Equal(createdAt.Add(-time.Second)))
+		})
+	})
 })
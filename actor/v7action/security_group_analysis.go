@@ -0,0 +1,583 @@
+package v7action
+
+import (
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/util/batcher"
+	"code.cloudfoundry.org/cli/v9/util/lookuptable"
+)
+
+const (
+	// SecurityGroupAllDestinations is a rule that allows traffic to every
+	// IPv4 or IPv6 address.
+	SecurityGroupAllDestinations = "all-destinations"
+	// SecurityGroupAllPorts is a rule that allows traffic to every port.
+	SecurityGroupAllPorts = "all-ports"
+	// SecurityGroupShadowedRule is a rule that allows nothing beyond what a
+	// rule of another group bound to the same space already allows.
+	SecurityGroupShadowedRule = "shadowed"
+	// SecurityGroupUnbound is a group that is not bound to any space and not
+	// globally enabled.
+	SecurityGroupUnbound = "unbound"
+
+	allSecurityGroupSpaces = "<all>"
+)
+
+// SecurityGroupFinding is a problem found in a security group. Lifecycles
+// are the lifecycles the group is bound in. Shadowed rules name the group
+// that shadows them and the spaces where both groups are bound.
+type SecurityGroupFinding struct {
+	Type              string
+	SecurityGroupName string
+	Rule              resources.Rule
+	Lifecycles        []string
+	ShadowedBy        string
+	Spaces            []SecurityGroupSpace
+}
+
+// SecurityGroupEffectiveRule is a rule in effect for a space, with the
+// groups that contain it.
+type SecurityGroupEffectiveRule struct {
+	Rule               resources.Rule
+	SecurityGroupNames []string
+}
+
+// SpaceSecurityGroupRules is the merged egress rules of the groups in effect
+// for a space in a lifecycle. The space named <all> stands for every space
+// without groups of its own.
+type SpaceSecurityGroupRules struct {
+	SecurityGroupSpace
+	Rules []SecurityGroupEffectiveRule
+}
+
+type SecurityGroupAnalysis struct {
+	Findings   []SecurityGroupFinding
+	SpaceRules []SpaceSecurityGroupRules
+}
+
+// AnalyzeSecurityGroups reports rules that allow all destinations or all
+// ports, rules shadowed by another group bound to the same space and groups
+// bound nowhere, and merges the egress rules in effect for each space.
+func (actor Actor) AnalyzeSecurityGroups() (SecurityGroupAnalysis, Warnings, error) {
+	var allWarnings Warnings
+
+	securityGroups, warnings, err := actor.CloudControllerClient.GetSecurityGroups()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SecurityGroupAnalysis{}, allWarnings, err
+	}
+	sort.Slice(securityGroups, func(i, j int) bool { return securityGroups[i].Name < securityGroups[j].Name })
+
+	groups := make([]analyzedSecurityGroup, 0, len(securityGroups))
+	var spaceGUIDs []string
+	seenSpaceGUIDs := map[string]bool{}
+	for _, securityGroup := range securityGroups {
+		group := analyzedSecurityGroup{SecurityGroup: securityGroup}
+		for _, rule := range securityGroup.Rules {
+			group.rules = append(group.rules, parseSecurityGroupRule(rule))
+		}
+		groups = append(groups, group)
+
+		for _, spaceGUID := range append(append([]string{}, securityGroup.RunningSpaceGUIDs...), securityGroup.StagingSpaceGUIDs...) {
+			if !seenSpaceGUIDs[spaceGUID] {
+				seenSpaceGUIDs[spaceGUID] = true
+				spaceGUIDs = append(spaceGUIDs, spaceGUID)
+			}
+		}
+	}
+
+	spacesByGUID, spaceWarnings, err := actor.securityGroupSpacesByGUID(spaceGUIDs)
+	allWarnings = append(allWarnings, spaceWarnings...)
+	if err != nil {
+		return SecurityGroupAnalysis{}, allWarnings, err
+	}
+
+	var analysis SecurityGroupAnalysis
+	for _, group := range groups {
+		lifecycles := group.lifecycles()
+		if len(lifecycles) == 0 {
+			analysis.Findings = append(analysis.Findings, SecurityGroupFinding{
+				Type:              SecurityGroupUnbound,
+				SecurityGroupName: group.Name,
+			})
+		}
+
+		for _, rule := range group.rules {
+			if rule.allDestinations() {
+				analysis.Findings = append(analysis.Findings, SecurityGroupFinding{
+					Type:              SecurityGroupAllDestinations,
+					SecurityGroupName: group.Name,
+					Rule:              rule.Rule,
+					Lifecycles:        lifecycles,
+				})
+			}
+			if rule.allPorts() {
+				analysis.Findings = append(analysis.Findings, SecurityGroupFinding{
+					Type:              SecurityGroupAllPorts,
+					SecurityGroupName: group.Name,
+					Rule:              rule.Rule,
+					Lifecycles:        lifecycles,
+				})
+			}
+		}
+	}
+
+	shadowed := map[shadowKey]int{}
+	for _, lifecycle := range []constant.SecurityGroupLifecycle{constant.SecurityGroupLifecycleRunning, constant.SecurityGroupLifecycleStaging} {
+		for _, context := range securityGroupContexts(groups, lifecycle, spacesByGUID) {
+			analysis.Findings = addShadowedRules(analysis.Findings, shadowed, context)
+			if len(context.groups) > 0 {
+				analysis.SpaceRules = append(analysis.SpaceRules, SpaceSecurityGroupRules{
+					SecurityGroupSpace: context.space,
+					Rules:              mergeSecurityGroupRules(context.groups),
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(analysis.Findings, func(i, j int) bool {
+		return analysis.Findings[i].SecurityGroupName < analysis.Findings[j].SecurityGroupName
+	})
+
+	return analysis, allWarnings, nil
+}
+
+func (actor Actor) securityGroupSpacesByGUID(spaceGUIDs []string) (map[string]SecurityGroupSpace, Warnings, error) {
+	spacesByGUID := map[string]SecurityGroupSpace{}
+	var spaces []resources.Space
+	var included ccv3.IncludedResources
+
+	warnings, err := batcher.RequestByGUID(spaceGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		batch, batchIncluded, warnings, err := actor.CloudControllerClient.GetSpaces(
+			ccv3.Query{Key: ccv3.GUIDFilter, Values: guids},
+			ccv3.Query{Key: ccv3.Include, Values: []string{"organization"}},
+		)
+		spaces = append(spaces, batch...)
+		included.Organizations = append(included.Organizations, batchIncluded.Organizations...)
+		return warnings, err
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	orgNamesByGUID := lookuptable.NameFromGUID(included.Organizations)
+	for _, space := range spaces {
+		spacesByGUID[space.GUID] = SecurityGroupSpace{
+			SpaceName: space.Name,
+			OrgName:   orgNamesByGUID[space.Relationships[constant.RelationshipTypeOrganization].GUID],
+		}
+	}
+	return spacesByGUID, Warnings(warnings), nil
+}
+
+type analyzedSecurityGroup struct {
+	resources.SecurityGroup
+	rules []parsedSecurityGroupRule
+}
+
+func (group analyzedSecurityGroup) globallyEnabled(lifecycle constant.SecurityGroupLifecycle) bool {
+	enabled := group.RunningGloballyEnabled
+	if lifecycle == constant.SecurityGroupLifecycleStaging {
+		enabled = group.StagingGloballyEnabled
+	}
+	return enabled != nil && *enabled
+}
+
+func (group analyzedSecurityGroup) spaceGUIDs(lifecycle constant.SecurityGroupLifecycle) []string {
+	if lifecycle == constant.SecurityGroupLifecycleStaging {
+		return group.StagingSpaceGUIDs
+	}
+	return group.RunningSpaceGUIDs
+}
+
+func (group analyzedSecurityGroup) lifecycles() []string {
+	var lifecycles []string
+	for _, lifecycle := range []constant.SecurityGroupLifecycle{constant.SecurityGroupLifecycleRunning, constant.SecurityGroupLifecycleStaging} {
+		if group.globallyEnabled(lifecycle) || len(group.spaceGUIDs(lifecycle)) > 0 {
+			lifecycles = append(lifecycles, string(lifecycle))
+		}
+	}
+	return lifecycles
+}
+
+// securityGroupContext is the set of groups in effect for a space in a
+// lifecycle. globals is the number of leading groups that are globally
+// enabled.
+type securityGroupContext struct {
+	space   SecurityGroupSpace
+	groups  []analyzedSecurityGroup
+	globals int
+}
+
+// securityGroupContexts returns the groups in effect for every space in the
+// lifecycle, starting with the globally enabled groups that apply to all
+// spaces.
+func securityGroupContexts(groups []analyzedSecurityGroup, lifecycle constant.SecurityGroupLifecycle, spacesByGUID map[string]SecurityGroupSpace) []securityGroupContext {
+	var globals []analyzedSecurityGroup
+	groupsBySpaceGUID := map[string][]analyzedSecurityGroup{}
+	for _, group := range groups {
+		if group.globallyEnabled(lifecycle) {
+			globals = append(globals, group)
+			continue
+		}
+		for _, spaceGUID := range group.spaceGUIDs(lifecycle) {
+			groupsBySpaceGUID[spaceGUID] = append(groupsBySpaceGUID[spaceGUID], group)
+		}
+	}
+
+	contexts := []securityGroupContext{{
+		space:   SecurityGroupSpace{OrgName: allSecurityGroupSpaces, SpaceName: allSecurityGroupSpaces, Lifecycle: string(lifecycle)},
+		groups:  globals,
+		globals: len(globals),
+	}}
+
+	var spaceContexts []securityGroupContext
+	for spaceGUID, spaceGroups := range groupsBySpaceGUID {
+		space := spacesByGUID[spaceGUID]
+		if space.SpaceName == "" {
+			space.SpaceName = spaceGUID
+		}
+		space.Lifecycle = string(lifecycle)
+
+		spaceContexts = append(spaceContexts, securityGroupContext{
+			space:   space,
+			groups:  append(append([]analyzedSecurityGroup{}, globals...), spaceGroups...),
+			globals: len(globals),
+		})
+	}
+	sort.Slice(spaceContexts, func(i, j int) bool {
+		if spaceContexts[i].space.OrgName != spaceContexts[j].space.OrgName {
+			return spaceContexts[i].space.OrgName < spaceContexts[j].space.OrgName
+		}
+		return spaceContexts[i].space.SpaceName < spaceContexts[j].space.SpaceName
+	})
+
+	return append(contexts, spaceContexts...)
+}
+
+type shadowKey struct {
+	group      string
+	rule       int
+	shadowedBy string
+	lifecycle  string
+}
+
+// addShadowedRules adds a finding for every rule of a group in the context
+// that a rule of another group in the context covers. When two rules cover
+// each other, only the rule of the group that sorts last is reported. Pairs
+// of globally enabled groups are only compared in the context for all
+// spaces.
+func addShadowedRules(findings []SecurityGroupFinding, shadowed map[shadowKey]int, context securityGroupContext) []SecurityGroupFinding {
+	for i, group := range context.groups {
+		for ruleIndex, rule := range group.rules {
+			for j, other := range context.groups {
+				if i == j || (i < context.globals && j < context.globals && context.space.SpaceName != allSecurityGroupSpaces) {
+					continue
+				}
+
+				if !other.shadows(rule, group.Name) {
+					continue
+				}
+
+				key := shadowKey{group: group.Name, rule: ruleIndex, shadowedBy: other.Name, lifecycle: context.space.Lifecycle}
+				position, ok := shadowed[key]
+				if !ok {
+					position = len(findings)
+					shadowed[key] = position
+					findings = append(findings, SecurityGroupFinding{
+						Type:              SecurityGroupShadowedRule,
+						SecurityGroupName: group.Name,
+						Rule:              rule.Rule,
+						Lifecycles:        []string{context.space.Lifecycle},
+						ShadowedBy:        other.Name,
+					})
+				}
+				findings[position].Spaces = append(findings[position].Spaces, context.space)
+			}
+		}
+	}
+	return findings
+}
+
+func (group analyzedSecurityGroup) shadows(rule parsedSecurityGroupRule, ruleGroupName string) bool {
+	for _, otherRule := range group.rules {
+		if !otherRule.covers(rule) {
+			continue
+		}
+		if rule.covers(otherRule) && ruleGroupName < group.Name {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// mergeSecurityGroupRules returns the rules of the groups without duplicates
+// and without rules that another rule covers.
+func mergeSecurityGroupRules(groups []analyzedSecurityGroup) []SecurityGroupEffectiveRule {
+	type mergedRule struct {
+		rule  parsedSecurityGroupRule
+		names []string
+	}
+
+	var merged []*mergedRule
+	byKey := map[string]*mergedRule{}
+	for _, group := range groups {
+		for _, rule := range group.rules {
+			key := rule.key()
+			if existing, ok := byKey[key]; ok {
+				if existing.names[len(existing.names)-1] != group.Name {
+					existing.names = append(existing.names, group.Name)
+				}
+				continue
+			}
+			entry := &mergedRule{rule: rule, names: []string{group.Name}}
+			byKey[key] = entry
+			merged = append(merged, entry)
+		}
+	}
+
+	var effective []SecurityGroupEffectiveRule
+	for i, entry := range merged {
+		covered := false
+		for j, other := range merged {
+			if i == j || !other.rule.covers(entry.rule) {
+				continue
+			}
+			if entry.rule.covers(other.rule) && i < j {
+				continue
+			}
+			covered = true
+			break
+		}
+		if !covered {
+			effective = append(effective, SecurityGroupEffectiveRule{Rule: entry.rule.Rule, SecurityGroupNames: entry.names})
+		}
+	}
+	return effective
+}
+
+type addressRange struct {
+	first netip.Addr
+	last  netip.Addr
+}
+
+type portRange struct {
+	first int
+	last  int
+}
+
+// parsedSecurityGroupRule is a rule with its destinations and ports parsed
+// into ranges. Rules that cannot be parsed are never compared.
+type parsedSecurityGroupRule struct {
+	resources.Rule
+	protocol     string
+	destinations []addressRange
+	ports        []portRange
+	valid        bool
+}
+
+func parseSecurityGroupRule(rule resources.Rule) parsedSecurityGroupRule {
+	parsed := parsedSecurityGroupRule{Rule: rule, protocol: strings.ToLower(rule.Protocol)}
+
+	for _, destination := range strings.Split(rule.Destination, ",") {
+		addresses, ok := parseAddressRange(strings.TrimSpace(destination))
+		if !ok {
+			return parsed
+		}
+		parsed.destinations = append(parsed.destinations, addresses)
+	}
+
+	if parsed.protocol == "tcp" || parsed.protocol == "udp" {
+		if rule.Ports == nil {
+			return parsed
+		}
+		for _, ports := range strings.Split(*rule.Ports, ",") {
+			portRange, ok := parsePortRange(strings.TrimSpace(ports))
+			if !ok {
+				return parsed
+			}
+			parsed.ports = append(parsed.ports, portRange)
+		}
+	}
+
+	parsed.valid = true
+	return parsed
+}
+
+func parseAddressRange(destination string) (addressRange, bool) {
+	if strings.Contains(destination, "/") {
+		prefix, err := netip.ParsePrefix(destination)
+		if err != nil {
+			return addressRange{}, false
+		}
+		prefix = prefix.Masked()
+		return addressRange{first: prefix.Addr(), last: lastAddress(prefix)}, true
+	}
+
+	if first, last, found := strings.Cut(destination, "-"); found {
+		firstAddr, err := netip.ParseAddr(strings.TrimSpace(first))
+		if err != nil {
+			return addressRange{}, false
+		}
+		lastAddr, err := netip.ParseAddr(strings.TrimSpace(last))
+		if err != nil || firstAddr.BitLen() != lastAddr.BitLen() || lastAddr.Less(firstAddr) {
+			return addressRange{}, false
+		}
+		return addressRange{first: firstAddr, last: lastAddr}, true
+	}
+
+	addr, err := netip.ParseAddr(destination)
+	if err != nil {
+		return addressRange{}, false
+	}
+	return addressRange{first: addr, last: addr}, true
+}
+
+func lastAddress(prefix netip.Prefix) netip.Addr {
+	bytes := prefix.Addr().AsSlice()
+	for bit := prefix.Bits(); bit < len(bytes)*8; bit++ {
+		bytes[bit/8] |= 0x80 >> (bit % 8)
+	}
+	addr, _ := netip.AddrFromSlice(bytes)
+	return addr
+}
+
+func parsePortRange(ports string) (portRange, bool) {
+	first, last, found := strings.Cut(ports, "-")
+	if !found {
+		last = first
+	}
+
+	firstPort, err := strconv.Atoi(strings.TrimSpace(first))
+	if err != nil {
+		return portRange{}, false
+	}
+	lastPort, err := strconv.Atoi(strings.TrimSpace(last))
+	if err != nil || lastPort < firstPort {
+		return portRange{}, false
+	}
+	return portRange{first: firstPort, last: lastPort}, true
+}
+
+func (rule parsedSecurityGroupRule) key() string {
+	ports := ""
+	if rule.Ports != nil {
+		ports = *rule.Ports
+	}
+	return strings.Join([]string{rule.protocol, rule.Destination, ports, intString(rule.Type), intString(rule.Code)}, "|")
+}
+
+func (rule parsedSecurityGroupRule) allDestinations() bool {
+	if !rule.valid {
+		return false
+	}
+	for _, everything := range []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0"), netip.MustParsePrefix("::/0")} {
+		all := addressRange{first: everything.Addr(), last: lastAddress(everything)}
+		if addressRangesCover(rule.destinations, all) {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule parsedSecurityGroupRule) allPorts() bool {
+	if !rule.valid {
+		return false
+	}
+	switch rule.protocol {
+	case "all":
+		return true
+	case "tcp", "udp":
+		return portRangesCover(rule.ports, portRange{first: 1, last: 65535})
+	default:
+		return false
+	}
+}
+
+// covers reports whether the rule allows all traffic that the other rule
+// allows.
+func (rule parsedSecurityGroupRule) covers(other parsedSecurityGroupRule) bool {
+	if !rule.valid || !other.valid {
+		return false
+	}
+
+	if rule.protocol != "all" && rule.protocol != other.protocol {
+		return false
+	}
+
+	for _, destination := range other.destinations {
+		if !addressRangesCover(rule.destinations, destination) {
+			return false
+		}
+	}
+
+	switch rule.protocol {
+	case "tcp", "udp":
+		for _, ports := range other.ports {
+			if !portRangesCover(rule.ports, ports) {
+				return false
+			}
+		}
+	case "icmp", "icmpv6":
+		return icmpValueCovers(rule.Type, other.Type) && icmpValueCovers(rule.Code, other.Code)
+	}
+
+	return true
+}
+
+func icmpValueCovers(value *int, other *int) bool {
+	if value == nil || *value == -1 {
+		return true
+	}
+	return other != nil && *other == *value
+}
+
+// addressRangesCover reports whether the union of the ranges contains the
+// target range.
+func addressRangesCover(ranges []addressRange, target addressRange) bool {
+	sorted := append([]addressRange{}, ranges...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].first.Less(sorted[j].first) })
+
+	next := target.first
+	for _, r := range sorted {
+		if r.first.BitLen() != next.BitLen() || next.Less(r.first) || r.last.Less(next) {
+			continue
+		}
+		if !r.last.Less(target.last) {
+			return true
+		}
+		next = r.last.Next()
+	}
+	return false
+}
+
+func portRangesCover(ranges []portRange, target portRange) bool {
+	sorted := append([]portRange{}, ranges...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].first < sorted[j].first })
+
+	next := target.first
+	for _, r := range sorted {
+		if next < r.first || r.last < next {
+			continue
+		}
+		if r.last >= target.last {
+			return true
+		}
+		next = r.last + 1
+	}
+	return false
+}
+
+func intString(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
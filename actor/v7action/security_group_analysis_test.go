@@ -0,0 +1,240 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/v9/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Analysis Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+
+		securityGroups []resources.SecurityGroup
+
+		analysis   SecurityGroupAnalysis
+		warnings   Warnings
+		executeErr error
+	)
+
+	enabled := func(value bool) *bool { return &value }
+	ports := func(value string) *string { return &value }
+	rule := func(protocol, destination, portRange string) resources.Rule {
+		r := resources.Rule{Protocol: protocol, Destination: destination}
+		if portRange != "" {
+			r.Ports = ports(portRange)
+		}
+		return r
+	}
+	findingTypes := func(findings []SecurityGroupFinding, groupName string) []string {
+		var types []string
+		for _, finding := range findings {
+			if finding.SecurityGroupName == groupName {
+				types = append(types, finding.Type)
+			}
+		}
+		return types
+	}
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+
+		securityGroups = nil
+
+		fakeCloudControllerClient.GetSpacesReturns(
+			[]resources.Space{
+				{GUID: "web-guid", Name: "web", Relationships: resources.Relationships{constant.RelationshipTypeOrganization: {GUID: "shop-guid"}}},
+				{GUID: "api-guid", Name: "api", Relationships: resources.Relationships{constant.RelationshipTypeOrganization: {GUID: "shop-guid"}}},
+			},
+			ccv3.IncludedResources{Organizations: []resources.Organization{{GUID: "shop-guid", Name: "shop"}}},
+			ccv3.Warnings{"get-spaces-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		fakeCloudControllerClient.GetSecurityGroupsReturns(securityGroups, ccv3.Warnings{"get-groups-warning"}, nil)
+		analysis, warnings, executeErr = actor.AnalyzeSecurityGroups()
+	})
+
+	When("a group allows all destinations or all ports", func() {
+		BeforeEach(func() {
+			securityGroups = []resources.SecurityGroup{
+				{
+					Name:                   "public",
+					RunningGloballyEnabled: enabled(true),
+					StagingGloballyEnabled: enabled(false),
+					Rules: []resources.Rule{
+						rule("tcp", "0.0.0.0/0", "443"),
+						rule("all", "10.0.0.0/8", ""),
+						rule("udp", "0.0.0.0-127.255.255.255,128.0.0.0/1", "1-1024,1025-65535"),
+						rule("tcp", "10.0.0.1", "80"),
+					},
+				},
+			}
+		})
+
+		It("reports the rules with the lifecycles the group is bound in", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-groups-warning"))
+
+			Expect(analysis.Findings).To(Equal([]SecurityGroupFinding{
+				{Type: SecurityGroupAllDestinations, SecurityGroupName: "public", Rule: rule("tcp", "0.0.0.0/0", "443"), Lifecycles: []string{"running"}},
+				{Type: SecurityGroupAllPorts, SecurityGroupName: "public", Rule: rule("all", "10.0.0.0/8", ""), Lifecycles: []string{"running"}},
+				{Type: SecurityGroupAllDestinations, SecurityGroupName: "public", Rule: rule("udp", "0.0.0.0-127.255.255.255,128.0.0.0/1", "1-1024,1025-65535"), Lifecycles: []string{"running"}},
+				{Type: SecurityGroupAllPorts, SecurityGroupName: "public", Rule: rule("udp", "0.0.0.0-127.255.255.255,128.0.0.0/1", "1-1024,1025-65535"), Lifecycles: []string{"running"}},
+			}))
+		})
+	})
+
+	When("a group is bound nowhere", func() {
+		BeforeEach(func() {
+			securityGroups = []resources.SecurityGroup{
+				{Name: "orphan", Rules: []resources.Rule{rule("tcp", "10.0.0.1", "80")}},
+				{Name: "bound", RunningSpaceGUIDs: []string{"web-guid"}, Rules: []resources.Rule{rule("tcp", "10.0.0.2", "80")}},
+			}
+		})
+
+		It("reports it", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(analysis.Findings).To(ConsistOf(SecurityGroupFinding{Type: SecurityGroupUnbound, SecurityGroupName: "orphan"}))
+		})
+
+		It("looks up the bound spaces with their orgs", func() {
+			Expect(warnings).To(ConsistOf("get-groups-warning", "get-spaces-warning"))
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"web-guid"}},
+				ccv3.Query{Key: ccv3.Include, Values: []string{"organization"}},
+			))
+		})
+	})
+
+	When("a rule is covered by a group bound to the same space", func() {
+		BeforeEach(func() {
+			securityGroups = []resources.SecurityGroup{
+				{
+					Name:              "internal",
+					RunningSpaceGUIDs: []string{"web-guid", "api-guid"},
+					Rules:             []resources.Rule{rule("tcp", "10.0.0.0/8", "1-9000")},
+				},
+				{
+					Name:              "web-db",
+					RunningSpaceGUIDs: []string{"web-guid"},
+					StagingSpaceGUIDs: []string{"web-guid"},
+					Rules: []resources.Rule{
+						rule("tcp", "10.1.0.0-10.1.0.255", "5432,6379"),
+						rule("tcp", "192.168.0.1", "5432"),
+					},
+				},
+			}
+		})
+
+		It("reports the shadowed rule in the spaces where both groups are bound", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(findingTypes(analysis.Findings, "internal")).To(BeEmpty())
+			Expect(analysis.Findings).To(ConsistOf(SecurityGroupFinding{
+				Type:              SecurityGroupShadowedRule,
+				SecurityGroupName: "web-db",
+				Rule:              rule("tcp", "10.1.0.0-10.1.0.255", "5432,6379"),
+				Lifecycles:        []string{"running"},
+				ShadowedBy:        "internal",
+				Spaces:            []SecurityGroupSpace{{OrgName: "shop", SpaceName: "web", Lifecycle: "running"}},
+			}))
+		})
+
+		It("merges the egress rules in effect per space and lifecycle", func() {
+			Expect(analysis.SpaceRules).To(Equal([]SpaceSecurityGroupRules{
+				{
+					SecurityGroupSpace: SecurityGroupSpace{OrgName: "shop", SpaceName: "api", Lifecycle: "running"},
+					Rules: []SecurityGroupEffectiveRule{
+						{Rule: rule("tcp", "10.0.0.0/8", "1-9000"), SecurityGroupNames: []string{"internal"}},
+					},
+				},
+				{
+					SecurityGroupSpace: SecurityGroupSpace{OrgName: "shop", SpaceName: "web", Lifecycle: "running"},
+					Rules: []SecurityGroupEffectiveRule{
+						{Rule: rule("tcp", "10.0.0.0/8", "1-9000"), SecurityGroupNames: []string{"internal"}},
+						{Rule: rule("tcp", "192.168.0.1", "5432"), SecurityGroupNames: []string{"web-db"}},
+					},
+				},
+				{
+					SecurityGroupSpace: SecurityGroupSpace{OrgName: "shop", SpaceName: "web", Lifecycle: "staging"},
+					Rules: []SecurityGroupEffectiveRule{
+						{Rule: rule("tcp", "10.1.0.0-10.1.0.255", "5432,6379"), SecurityGroupNames: []string{"web-db"}},
+						{Rule: rule("tcp", "192.168.0.1", "5432"), SecurityGroupNames: []string{"web-db"}},
+					},
+				},
+			}))
+		})
+	})
+
+	When("two globally enabled groups contain the same rule", func() {
+		BeforeEach(func() {
+			securityGroups = []resources.SecurityGroup{
+				{Name: "dns", RunningGloballyEnabled: enabled(true), Rules: []resources.Rule{rule("udp", "8.8.8.8", "53")}},
+				{Name: "dns-copy", RunningGloballyEnabled: enabled(true), Rules: []resources.Rule{rule("udp", "8.8.8.8", "53")}},
+				{Name: "web", RunningSpaceGUIDs: []string{"web-guid"}, Rules: []resources.Rule{rule("icmp", "10.0.0.1", "")}},
+			}
+		})
+
+		It("reports only the rule of the group that sorts last, for all spaces", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(analysis.Findings).To(ConsistOf(SecurityGroupFinding{
+				Type:              SecurityGroupShadowedRule,
+				SecurityGroupName: "dns-copy",
+				Rule:              rule("udp", "8.8.8.8", "53"),
+				Lifecycles:        []string{"running"},
+				ShadowedBy:        "dns",
+				Spaces:            []SecurityGroupSpace{{OrgName: "<all>", SpaceName: "<all>", Lifecycle: "running"}},
+			}))
+		})
+
+		It("lists the merged rule once with both groups", func() {
+			Expect(analysis.SpaceRules[0]).To(Equal(SpaceSecurityGroupRules{
+				SecurityGroupSpace: SecurityGroupSpace{OrgName: "<all>", SpaceName: "<all>", Lifecycle: "running"},
+				Rules: []SecurityGroupEffectiveRule{
+					{Rule: rule("udp", "8.8.8.8", "53"), SecurityGroupNames: []string{"dns", "dns-copy"}},
+				},
+			}))
+			Expect(analysis.SpaceRules[1].SpaceName).To(Equal("web"))
+			Expect(analysis.SpaceRules[1].Rules).To(HaveLen(2))
+		})
+	})
+
+	When("a rule cannot be parsed", func() {
+		BeforeEach(func() {
+			securityGroups = []resources.SecurityGroup{
+				{
+					Name:                   "broken",
+					RunningGloballyEnabled: enabled(true),
+					Rules:                  []resources.Rule{rule("tcp", "not-an-ip", "80"), rule("tcp", "0.0.0.0/0", "80")},
+				},
+			}
+		})
+
+		It("does not compare it", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(findingTypes(analysis.Findings, "broken")).To(ConsistOf(SecurityGroupAllDestinations))
+		})
+	})
+
+	When("getting the security groups fails", func() {
+		JustBeforeEach(func() {
+			fakeCloudControllerClient.GetSecurityGroupsReturns(nil, ccv3.Warnings{"get-groups-warning"}, errors.New("get-groups-error"))
+			analysis, warnings, executeErr = actor.AnalyzeSecurityGroups()
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("get-groups-error"))
+			Expect(warnings).To(ConsistOf("get-groups-warning"))
+		})
+	})
+})
@@ -0,0 +1,220 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	. "code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sidecar Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		warnings                  Warnings
+		executeErr                error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+
+		fakeCloudControllerClient.GetApplicationsReturns(
+			[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+			ccv3.Warnings{"get-app-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetApplicationSidecarsReturns(
+			[]resources.Sidecar{
+				{GUID: "apm-guid", Name: "apm"},
+				{GUID: "envoy-guid", Name: "envoy"},
+			},
+			ccv3.Warnings{"get-sidecars-warning"},
+			nil,
+		)
+	})
+
+	Describe("GetApplicationSidecars", func() {
+		var sidecars []resources.Sidecar
+
+		JustBeforeEach(func() {
+			sidecars, warnings, executeErr = actor.GetApplicationSidecars("some-app", "some-space-guid")
+		})
+
+		It("returns the sidecars of the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning"))
+			Expect(sidecars).To(Equal([]resources.Sidecar{
+				{GUID: "apm-guid", Name: "apm"},
+				{GUID: "envoy-guid", Name: "envoy"},
+			}))
+
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-app"}},
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+			))
+			appGUID, _ := fakeCloudControllerClient.GetApplicationSidecarsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationSidecarsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the sidecars fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationSidecarsReturns(nil, ccv3.Warnings{"get-sidecars-warning"}, errors.New("sidecars-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("sidecars-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning"))
+			})
+		})
+	})
+
+	Describe("CreateApplicationSidecar", func() {
+		var (
+			sidecar        resources.Sidecar
+			createdSidecar resources.Sidecar
+		)
+
+		BeforeEach(func() {
+			sidecar = resources.Sidecar{
+				Name:         "apm",
+				Command:      types.FilteredString{IsSet: true, Value: "start-apm"},
+				ProcessTypes: []string{"web"},
+			}
+			fakeCloudControllerClient.CreateApplicationSidecarReturns(
+				resources.Sidecar{GUID: "apm-guid", Name: "apm"},
+				ccv3.Warnings{"create-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			createdSidecar, warnings, executeErr = actor.CreateApplicationSidecar("some-app", "some-space-guid", sidecar)
+		})
+
+		It("creates the sidecar for the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "create-warning"))
+			Expect(createdSidecar).To(Equal(resources.Sidecar{GUID: "apm-guid", Name: "apm"}))
+
+			appGUID, sidecarArg := fakeCloudControllerClient.CreateApplicationSidecarArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(sidecarArg).To(Equal(sidecar))
+		})
+
+		When("creating the sidecar fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateApplicationSidecarReturns(resources.Sidecar{}, ccv3.Warnings{"create-warning"}, errors.New("create-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("create-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "create-warning"))
+			})
+		})
+	})
+
+	Describe("UpdateApplicationSidecar", func() {
+		var (
+			sidecarName    string
+			updatedSidecar resources.Sidecar
+		)
+
+		BeforeEach(func() {
+			sidecarName = "envoy"
+			fakeCloudControllerClient.UpdateSidecarReturns(
+				resources.Sidecar{GUID: "envoy-guid", Name: "envoy", MemoryInMB: types.NullUint64{IsSet: true, Value: 64}},
+				ccv3.Warnings{"update-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			updatedSidecar, warnings, executeErr = actor.UpdateApplicationSidecar("some-app", "some-space-guid", sidecarName, resources.Sidecar{
+				MemoryInMB: types.NullUint64{IsSet: true, Value: 64},
+			})
+		})
+
+		It("updates the sidecar with the given name", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning", "update-warning"))
+			Expect(updatedSidecar.MemoryInMB).To(Equal(types.NullUint64{IsSet: true, Value: 64}))
+
+			Expect(fakeCloudControllerClient.UpdateSidecarArgsForCall(0)).To(Equal(resources.Sidecar{
+				GUID:       "envoy-guid",
+				MemoryInMB: types.NullUint64{IsSet: true, Value: 64},
+			}))
+		})
+
+		When("the app has no sidecar with the name", func() {
+			BeforeEach(func() {
+				sidecarName = "missing"
+			})
+
+			It("returns a SidecarNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SidecarNotFoundError{Name: "missing", AppName: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning"))
+				Expect(fakeCloudControllerClient.UpdateSidecarCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DeleteApplicationSidecar", func() {
+		var sidecarName string
+
+		BeforeEach(func() {
+			sidecarName = "apm"
+			fakeCloudControllerClient.DeleteSidecarReturns(ccv3.Warnings{"delete-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteApplicationSidecar("some-app", "some-space-guid", sidecarName)
+		})
+
+		It("deletes the sidecar with the given name", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning", "delete-warning"))
+			Expect(fakeCloudControllerClient.DeleteSidecarArgsForCall(0)).To(Equal("apm-guid"))
+		})
+
+		When("the app has no sidecar with the name", func() {
+			BeforeEach(func() {
+				sidecarName = "missing"
+			})
+
+			It("returns a SidecarNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SidecarNotFoundError{Name: "missing", AppName: "some-app"}))
+				Expect(fakeCloudControllerClient.DeleteSidecarCallCount()).To(Equal(0))
+			})
+		})
+
+		When("deleting the sidecar fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteSidecarReturns(ccv3.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning", "delete-warning"))
+			})
+		})
+	})
+})
@@ -14,6 +14,13 @@ type SSHAuthentication struct {
 	Username           string
 }
 
+// InstanceSSHAuthentication is the SSH authentication information for a
+// single instance of a process.
+type InstanceSSHAuthentication struct {
+	SSHAuthentication
+	InstanceIndex uint
+}
+
 func (actor Actor) GetSSHPasscode() (string, error) {
 	return actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
 }
@@ -70,24 +77,107 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 	}, allWarnings, err
 }
 
+// GetSecureShellConfigurationsForProcessInstances returns the SSH
+// authentication information for each of the given instances of the
+// application's process. When no instance indexes are provided, every running
+// instance of the process is returned. The passcodes are left empty: a
+// passcode can only be used for a single session and expires soon after it is
+// issued, so each session should get its own with GetSSHPasscode right before
+// it connects.
+func (actor Actor) GetSecureShellConfigurationsForProcessInstances(
+	appName string, spaceGUID string, processType string, processIndexes []uint,
+) ([]InstanceSSHAuthentication, Warnings, error) {
+	var allWarnings Warnings
+
+	rootInfo, warnings, err := actor.CloudControllerClient.GetRoot()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	endpoint := rootInfo.AppSSHEndpoint()
+	if endpoint == "" {
+		return nil, nil, actionerror.SSHEndpointNotSetError{}
+	}
+
+	fingerprint := rootInfo.AppSSHHostKeyFingerprint()
+	if fingerprint == "" {
+		return nil, nil, actionerror.SSHHostKeyFingerprintNotSetError{}
+	}
+
+	application, appWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, appWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if !application.Started() {
+		return nil, allWarnings, actionerror.ApplicationNotStartedError{Name: appName}
+	}
+
+	processSummary, processWarnings, err := actor.getProcessSummaryByType(application, processType)
+	allWarnings = append(allWarnings, processWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if processIndexes == nil {
+		for _, instance := range processSummary.InstanceDetails {
+			if instance.Running() {
+				processIndexes = append(processIndexes, uint(instance.Index))
+			}
+		}
+		if len(processIndexes) == 0 {
+			return nil, allWarnings, actionerror.NoRunningProcessInstancesError{ProcessType: processType}
+		}
+	}
+
+	var authentications []InstanceSSHAuthentication
+	for _, processIndex := range processIndexes {
+		username, err := usernameForInstance(processSummary, processIndex)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		authentications = append(authentications, InstanceSSHAuthentication{
+			SSHAuthentication: SSHAuthentication{
+				Endpoint:           endpoint,
+				HostKeyFingerprint: fingerprint,
+				Username:           username,
+			},
+			InstanceIndex: processIndex,
+		})
+	}
+
+	return authentications, allWarnings, nil
+}
+
 func (actor Actor) getUsername(application resources.Application, processType string, processIndex uint) (string, Warnings, error) {
-	processSummaries, processWarnings, err := actor.getProcessSummariesForApp(application.GUID, false)
+	processSummary, processWarnings, err := actor.getProcessSummaryByType(application, processType)
 	if err != nil {
 		return "", processWarnings, err
 	}
 
-	var processSummary ProcessSummary
+	username, err := usernameForInstance(processSummary, processIndex)
+	return username, processWarnings, err
+}
+
+func (actor Actor) getProcessSummaryByType(application resources.Application, processType string) (ProcessSummary, Warnings, error) {
+	processSummaries, processWarnings, err := actor.getProcessSummariesForApp(application.GUID, false)
+	if err != nil {
+		return ProcessSummary{}, processWarnings, err
+	}
+
 	for _, appProcessSummary := range processSummaries {
 		if appProcessSummary.Type == processType {
-			processSummary = appProcessSummary
-			break
+			return appProcessSummary, processWarnings, nil
 		}
 	}
 
-	if processSummary.GUID == "" {
-		return "", processWarnings, actionerror.ProcessNotFoundError{ProcessType: processType}
-	}
+	return ProcessSummary{}, processWarnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+}
 
+func usernameForInstance(processSummary ProcessSummary, processIndex uint) (string, error) {
 	var processInstance ProcessInstance
 	for _, instance := range processSummary.InstanceDetails {
 		if uint(instance.Index) == processIndex {
@@ -97,12 +187,12 @@ func (actor Actor) getUsername(application resources.Application, processType st
 	}
 
 	if processInstance == (ProcessInstance{}) {
-		return "", processWarnings, actionerror.ProcessInstanceNotFoundError{ProcessType: processType, InstanceIndex: processIndex}
+		return "", actionerror.ProcessInstanceNotFoundError{ProcessType: processSummary.Type, InstanceIndex: processIndex}
 	}
 
 	if !processInstance.Running() {
-		return "", processWarnings, actionerror.ProcessInstanceNotRunningError{ProcessType: processType, InstanceIndex: processIndex}
+		return "", actionerror.ProcessInstanceNotRunningError{ProcessType: processSummary.Type, InstanceIndex: processIndex}
 	}
 
-	return fmt.Sprintf("cf:%s/%d", processSummary.GUID, processIndex), processWarnings, nil
+	return fmt.Sprintf("cf:%s/%d", processSummary.GUID, processIndex), nil
 }
@@ -267,4 +267,143 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSecureShellConfigurationsForProcessInstances", func() {
+		var (
+			processIndexes  []uint
+			authentications []InstanceSSHAuthentication
+		)
+
+		BeforeEach(func() {
+			processIndexes = nil
+
+			fakeCloudControllerClient.GetRootReturns(ccv3.Root{
+				Links: ccv3.RootLinks{
+					AppSSH: resources.APILink{
+						HREF: "some-app-ssh-endpoint",
+						Meta: resources.APILinkMeta{HostKeyFingerprint: "some-app-ssh-fingerprint"},
+					},
+				},
+			}, nil, nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{{Name: "some-app", State: constant.ApplicationStarted}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]resources.Process{{Type: "some-process-type", GUID: "some-process-guid"}}, ccv3.Warnings{"some-process-warnings"}, nil)
+			fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+				{State: constant.ProcessInstanceRunning, Index: 0},
+				{State: constant.ProcessInstanceDown, Index: 1},
+				{State: constant.ProcessInstanceRunning, Index: 2},
+			}, ccv3.Warnings{"some-instance-warnings"}, nil)
+
+		})
+
+		JustBeforeEach(func() {
+			authentications, warnings, executeErr = actor.GetSecureShellConfigurationsForProcessInstances("some-app", "some-space-guid", "some-process-type", processIndexes)
+		})
+
+		When("no instance indexes are given", func() {
+			It("returns a configuration without a passcode for every running instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+
+				Expect(authentications).To(Equal([]InstanceSSHAuthentication{
+					{
+						SSHAuthentication: SSHAuthentication{
+							Endpoint:           "some-app-ssh-endpoint",
+							HostKeyFingerprint: "some-app-ssh-fingerprint",
+							Username:           "cf:some-process-guid/0",
+						},
+						InstanceIndex: 0,
+					},
+					{
+						SSHAuthentication: SSHAuthentication{
+							Endpoint:           "some-app-ssh-endpoint",
+							HostKeyFingerprint: "some-app-ssh-fingerprint",
+							Username:           "cf:some-process-guid/2",
+						},
+						InstanceIndex: 2,
+					},
+				}))
+
+				Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+			})
+
+			When("no instances are running", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+						{State: constant.ProcessInstanceDown, Index: 0},
+					}, nil, nil)
+				})
+
+				It("returns a NoRunningProcessInstancesError", func() {
+					Expect(executeErr).To(MatchError(actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"}))
+				})
+			})
+		})
+
+		When("instance indexes are given", func() {
+			BeforeEach(func() {
+				processIndexes = []uint{2}
+			})
+
+			It("returns a configuration for only those instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(authentications).To(HaveLen(1))
+				Expect(authentications[0].InstanceIndex).To(Equal(uint(2)))
+				Expect(authentications[0].Username).To(Equal("cf:some-process-guid/2"))
+			})
+
+			When("one of the instances is not running", func() {
+				BeforeEach(func() {
+					processIndexes = []uint{0, 1}
+				})
+
+				It("returns a ProcessInstanceNotRunningError", func() {
+					Expect(executeErr).To(MatchError(actionerror.ProcessInstanceNotRunningError{ProcessType: "some-process-type", InstanceIndex: 1}))
+					Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+				})
+			})
+
+			When("one of the instances does not exist", func() {
+				BeforeEach(func() {
+					processIndexes = []uint{0, 7}
+				})
+
+				It("returns a ProcessInstanceNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 7}))
+				})
+			})
+		})
+
+		When("the app ssh endpoint is empty", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRootReturns(ccv3.Root{}, nil, nil)
+			})
+
+			It("returns a SSHEndpointNotSetError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SSHEndpointNotSetError{}))
+			})
+		})
+
+		When("the application is stopped", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{{Name: "some-app", State: constant.ApplicationStopped}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			})
+
+			It("returns a ApplicationNotStartedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotStartedError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+			})
+		})
+
+		When("the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns([]resources.Process{}, ccv3.Warnings{"some-process-warnings"}, nil)
+			})
+
+			It("returns a ProcessNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "some-process-type"}))
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings"))
+			})
+		})
+
+	})
 })
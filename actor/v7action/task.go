@@ -73,9 +73,26 @@ func (actor Actor) TerminateTask(taskGUID string) (resources.Task, Warnings, err
 }
 
 func (actor Actor) PollTask(task resources.Task) (resources.Task, Warnings, error) {
+	return actor.PollTaskWithTimeout(task, 0)
+}
+
+// PollTaskWithTimeout polls the task until it succeeds or fails. When the task
+// is still running after the timeout, it is cancelled and a TaskTimeoutError
+// is returned. A zero timeout waits for as long as the task runs.
+func (actor Actor) PollTaskWithTimeout(task resources.Task, timeout time.Duration) (resources.Task, Warnings, error) {
 	var allWarnings Warnings
+	deadline := actor.Clock.Now().Add(timeout)
 
 	for task.State != constant.TaskSucceeded && task.State != constant.TaskFailed {
+		if timeout > 0 && !actor.Clock.Now().Before(deadline) {
+			_, warnings, err := actor.CloudControllerClient.UpdateTaskCancel(task.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return resources.Task{}, allWarnings, err
+			}
+
+			return task, allWarnings, actionerror.TaskTimeoutError{TaskName: task.Name, Timeout: timeout}
+		}
 
 		time.Sleep(actor.Config.PollingInterval())
 
@@ -95,7 +112,11 @@ func (actor Actor) PollTask(task resources.Task) (resources.Task, Warnings, erro
 	}
 
 	if task.State == constant.TaskFailed {
-		return task, allWarnings, actionerror.TaskFailedError{}
+		failedErr := actionerror.TaskFailedError{TaskName: task.Name}
+		if task.Result != nil {
+			failedErr.FailureReason = task.Result.FailureReason
+		}
+		return task, allWarnings, failedErr
 	}
 
 	return task, allWarnings, nil
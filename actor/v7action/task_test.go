@@ -2,6 +2,7 @@ package v7action_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/v9/actor/actionerror"
 	. "code.cloudfoundry.org/cli/v9/actor/v7action"
@@ -10,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/clock/fakeclock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -19,12 +21,14 @@ var _ = Describe("Task Actions", func() {
 		actor                     *Actor
 		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
 		fakeConfig                *v7actionfakes.FakeConfig
+		fakeClock                 *fakeclock.FakeClock
 	)
 
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
 		fakeConfig = new(v7actionfakes.FakeConfig)
-		actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil, nil, nil)
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil, nil, fakeClock)
 	})
 
 	Describe("RunTask", func() {
@@ -365,5 +369,75 @@ var _ = Describe("Task Actions", func() {
 
 			Expect(err).To(MatchError("Task failed to complete successfully"))
 		})
+
+		It("returns the failure reason if the task failed with one", func() {
+			firstTaskResponse := resources.Task{
+				Name:   "some-task",
+				State:  constant.TaskFailed,
+				Result: &resources.TaskResult{FailureReason: "Exited with status 1"},
+			}
+
+			fakeCloudControllerClient.GetTaskReturnsOnCall(0, firstTaskResponse, nil, nil)
+
+			_, _, err := actor.PollTask(resources.Task{})
+
+			Expect(err).To(MatchError(actionerror.TaskFailedError{TaskName: "some-task", FailureReason: "Exited with status 1"}))
+		})
+	})
+
+	Describe("PollTaskWithTimeout", func() {
+		var (
+			task     resources.Task
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			task = resources.Task{GUID: "some-task-guid", Name: "some-task", State: constant.TaskRunning}
+		})
+
+		When("the task finishes before the timeout", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTaskReturns(resources.Task{State: constant.TaskSucceeded}, ccv3.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns the task without cancelling it", func() {
+				task, warnings, err = actor.PollTaskWithTimeout(task, time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(constant.TaskSucceeded))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.UpdateTaskCancelCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the task is still running after the timeout", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTaskStub = func(string) (resources.Task, ccv3.Warnings, error) {
+					fakeClock.Increment(40 * time.Second)
+					return resources.Task{GUID: "some-task-guid", Name: "some-task", State: constant.TaskRunning}, ccv3.Warnings{"get-warning"}, nil
+				}
+				fakeCloudControllerClient.UpdateTaskCancelReturns(resources.Task{}, ccv3.Warnings{"cancel-warning"}, nil)
+			})
+
+			It("cancels the task and returns a timeout error", func() {
+				task, warnings, err = actor.PollTaskWithTimeout(task, time.Minute)
+				Expect(err).To(MatchError(actionerror.TaskTimeoutError{TaskName: "some-task", Timeout: time.Minute}))
+				Expect(warnings).To(ConsistOf("get-warning", "get-warning", "cancel-warning"))
+				Expect(fakeCloudControllerClient.GetTaskCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.UpdateTaskCancelCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateTaskCancelArgsForCall(0)).To(Equal("some-task-guid"))
+			})
+
+			When("cancelling the task fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateTaskCancelReturns(resources.Task{}, nil, errors.New("cancel-error"))
+				})
+
+				It("returns the error", func() {
+					_, _, err = actor.PollTaskWithTimeout(task, time.Minute)
+					Expect(err).To(MatchError("cancel-error"))
+				})
+			})
+		})
 	})
 })
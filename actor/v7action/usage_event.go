@@ -0,0 +1,240 @@
+package v7action
+
+import (
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/v9/resources"
+)
+
+// UsageEventFilter selects usage events. Events are created at or after Start
+// and before End; a zero Start or End leaves that side of the window open.
+// When States is not empty, only events in one of those states are returned.
+type UsageEventFilter struct {
+	Start  time.Time
+	End    time.Time
+	States []string
+}
+
+// AppInstanceHours is the number of instance-hours an app's processes and
+// tasks ran for within a time window.
+type AppInstanceHours struct {
+	OrganizationGUID string
+	SpaceGUID        string
+	SpaceName        string
+	AppGUID          string
+	AppName          string
+	InstanceHours    float64
+}
+
+// GetAppUsageEvents returns the app usage events matching the filter, oldest
+// first. Events are paged through with the GUID of the last event seen, as
+// pages are not stable while the Cloud Controller purges old events.
+func (actor Actor) GetAppUsageEvents(filter UsageEventFilter) ([]resources.AppUsageEvent, Warnings, error) {
+	var (
+		allEvents   []resources.AppUsageEvent
+		allWarnings Warnings
+		afterGUID   string
+	)
+
+	for {
+		events, warnings, err := actor.CloudControllerClient.GetAppUsageEvents(usageEventQuery(filter, afterGUID)...)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		if len(events) == 0 {
+			return allEvents, allWarnings, nil
+		}
+
+		for _, event := range events {
+			if filter.matchesState(event.State) {
+				allEvents = append(allEvents, event)
+			}
+		}
+		afterGUID = events[len(events)-1].GUID
+	}
+}
+
+// GetServiceUsageEvents returns the service usage events matching the
+// filter, oldest first.
+func (actor Actor) GetServiceUsageEvents(filter UsageEventFilter) ([]resources.ServiceUsageEvent, Warnings, error) {
+	var (
+		allEvents   []resources.ServiceUsageEvent
+		allWarnings Warnings
+		afterGUID   string
+	)
+
+	for {
+		events, warnings, err := actor.CloudControllerClient.GetServiceUsageEvents(usageEventQuery(filter, afterGUID)...)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		if len(events) == 0 {
+			return allEvents, allWarnings, nil
+		}
+
+		for _, event := range events {
+			if filter.matchesState(event.State) {
+				allEvents = append(allEvents, event)
+			}
+		}
+		afterGUID = events[len(events)-1].GUID
+	}
+}
+
+// GetAppInstanceHours returns the instance-hours of every app that ran
+// between start and end, sorted by organization, space and app. A zero end
+// means now. All retained events before end are read, so that processes
+// started before the window are counted from its start.
+func (actor Actor) GetAppInstanceHours(start time.Time, end time.Time) ([]AppInstanceHours, Warnings, error) {
+	if end.IsZero() {
+		end = actor.Clock.Now()
+	}
+
+	events, warnings, err := actor.GetAppUsageEvents(UsageEventFilter{End: end})
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return aggregateInstanceHours(events, start, end), warnings, nil
+}
+
+func usageEventQuery(filter UsageEventFilter, afterGUID string) []ccv3.Query {
+	query := []ccv3.Query{
+		{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+		{Key: ccv3.Page, Values: []string{"1"}},
+	}
+
+	if afterGUID != "" {
+		query = append(query, ccv3.Query{Key: ccv3.AfterGUIDFilter, Values: []string{afterGUID}})
+	}
+	if !filter.Start.IsZero() {
+		query = append(query, ccv3.Query{Key: ccv3.CreatedAtsGTEFilter, Values: []string{filter.Start.UTC().Format(time.RFC3339)}})
+	}
+	if !filter.End.IsZero() {
+		query = append(query, ccv3.Query{Key: ccv3.CreatedAtsLTFilter, Values: []string{filter.End.UTC().Format(time.RFC3339)}})
+	}
+
+	return query
+}
+
+func (filter UsageEventFilter) matchesState(state string) bool {
+	if len(filter.States) == 0 {
+		return true
+	}
+
+	for _, s := range filter.States {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// runningUnit is a process or task, tracked while replaying usage events.
+type runningUnit struct {
+	app       *AppInstanceHours
+	running   bool
+	instances int
+	since     time.Time
+}
+
+func aggregateInstanceHours(events []resources.AppUsageEvent, start time.Time, end time.Time) []AppInstanceHours {
+	apps := map[string]*AppInstanceHours{}
+	units := map[string]*runningUnit{}
+
+	// addHours counts the instances of a running unit from the time it was
+	// last updated until the given time, clipped to the window.
+	addHours := func(unit *runningUnit, until time.Time) {
+		if !unit.running {
+			return
+		}
+
+		from := unit.since
+		if from.Before(start) {
+			from = start
+		}
+		if until.After(end) {
+			until = end
+		}
+		if until.After(from) {
+			unit.app.InstanceHours += float64(unit.instances) * until.Sub(from).Hours()
+		}
+	}
+
+	for _, event := range events {
+		var key string
+		var running bool
+		switch event.State {
+		case "STARTED":
+			key, running = event.ProcessGUID, true
+		case "STOPPED":
+			key, running = event.ProcessGUID, false
+		case "TASK_STARTED":
+			key, running = event.TaskGUID, true
+		case "TASK_STOPPED":
+			key, running = event.TaskGUID, false
+		default:
+			continue
+		}
+
+		app, ok := apps[event.AppGUID]
+		if !ok {
+			app = &AppInstanceHours{
+				OrganizationGUID: event.OrganizationGUID,
+				SpaceGUID:        event.SpaceGUID,
+				SpaceName:        event.SpaceName,
+				AppGUID:          event.AppGUID,
+				AppName:          event.AppName,
+			}
+			apps[event.AppGUID] = app
+		}
+
+		unit, ok := units[key]
+		if !ok {
+			unit = &runningUnit{app: app}
+			// the unit's first retained event tells how it ran before; without
+			// a start of the window there is nothing to count from
+			if event.PreviousState == "STARTED" && !start.IsZero() {
+				unit.running = true
+				unit.instances = event.PreviousInstanceCount
+				unit.since = start
+			}
+			units[key] = unit
+		}
+
+		addHours(unit, event.CreatedAt)
+		unit.running = running
+		unit.instances = event.InstanceCount
+		unit.since = event.CreatedAt
+	}
+
+	for _, unit := range units {
+		addHours(unit, end)
+	}
+
+	var report []AppInstanceHours
+	for _, app := range apps {
+		if app.InstanceHours > 0 {
+			report = append(report, *app)
+		}
+	}
+
+	sort.Slice(report, func(i, j int) bool {
+		a, b := report[i], report[j]
+		if a.OrganizationGUID != b.OrganizationGUID {
+			return a.OrganizationGUID < b.OrganizationGUID
+		}
+		if a.SpaceName != b.SpaceName {
+			return a.SpaceName < b.SpaceName
+		}
+		return a.AppName < b.AppName
+	})
+
+	return report
+}
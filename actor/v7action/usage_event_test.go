@@ -0,0 +1,302 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Usage Event Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeClock                 *fakeclock.FakeClock
+		start                     time.Time
+		end                       time.Time
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, fakeClock = NewTestActor()
+		start = time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+		end = time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC)
+	})
+
+	Describe("GetAppUsageEvents", func() {
+		var (
+			filter     UsageEventFilter
+			events     []resources.AppUsageEvent
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			filter = UsageEventFilter{Start: start, End: end}
+		})
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = actor.GetAppUsageEvents(filter)
+		})
+
+		When("there are several pages of events", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetAppUsageEventsReturnsOnCall(0,
+					[]resources.AppUsageEvent{{GUID: "event-1", State: "STARTED"}, {GUID: "event-2", State: "BUILDPACK_SET"}},
+					ccv3.Warnings{"warning-1"}, nil)
+				fakeCloudControllerClient.GetAppUsageEventsReturnsOnCall(1,
+					[]resources.AppUsageEvent{{GUID: "event-3", State: "STOPPED"}},
+					ccv3.Warnings{"warning-2"}, nil)
+				fakeCloudControllerClient.GetAppUsageEventsReturnsOnCall(2,
+					nil, ccv3.Warnings{"warning-3"}, nil)
+			})
+
+			It("pages through them with the GUID of the last event", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2", "warning-3"))
+				Expect(events).To(Equal([]resources.AppUsageEvent{
+					{GUID: "event-1", State: "STARTED"},
+					{GUID: "event-2", State: "BUILDPACK_SET"},
+					{GUID: "event-3", State: "STOPPED"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetAppUsageEventsCallCount()).To(Equal(3))
+				Expect(fakeCloudControllerClient.GetAppUsageEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+					ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+					ccv3.Query{Key: ccv3.CreatedAtsGTEFilter, Values: []string{"2024-02-01T00:00:00Z"}},
+					ccv3.Query{Key: ccv3.CreatedAtsLTFilter, Values: []string{"2024-02-02T00:00:00Z"}},
+				))
+				Expect(fakeCloudControllerClient.GetAppUsageEventsArgsForCall(1)).To(ContainElement(
+					ccv3.Query{Key: ccv3.AfterGUIDFilter, Values: []string{"event-2"}},
+				))
+				Expect(fakeCloudControllerClient.GetAppUsageEventsArgsForCall(2)).To(ContainElement(
+					ccv3.Query{Key: ccv3.AfterGUIDFilter, Values: []string{"event-3"}},
+				))
+			})
+
+			When("states are given", func() {
+				BeforeEach(func() {
+					filter.States = []string{"STARTED", "STOPPED"}
+				})
+
+				It("only returns events in those states but pages through all of them", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(events).To(Equal([]resources.AppUsageEvent{
+						{GUID: "event-1", State: "STARTED"},
+						{GUID: "event-3", State: "STOPPED"},
+					}))
+					Expect(fakeCloudControllerClient.GetAppUsageEventsArgsForCall(1)).To(ContainElement(
+						ccv3.Query{Key: ccv3.AfterGUIDFilter, Values: []string{"event-2"}},
+					))
+				})
+			})
+		})
+
+		When("the window is open", func() {
+			BeforeEach(func() {
+				filter = UsageEventFilter{}
+			})
+
+			It("does not filter on the creation time", func() {
+				Expect(fakeCloudControllerClient.GetAppUsageEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+					ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+				))
+			})
+		})
+
+		When("getting a page fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetAppUsageEventsReturnsOnCall(0,
+					[]resources.AppUsageEvent{{GUID: "event-1"}}, ccv3.Warnings{"warning-1"}, nil)
+				fakeCloudControllerClient.GetAppUsageEventsReturnsOnCall(1,
+					nil, ccv3.Warnings{"warning-2"}, errors.New("page-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("page-error"))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(events).To(BeNil())
+			})
+		})
+	})
+
+	Describe("GetServiceUsageEvents", func() {
+		var (
+			events     []resources.ServiceUsageEvent
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = actor.GetServiceUsageEvents(UsageEventFilter{Start: start, States: []string{"CREATED"}})
+		})
+
+		When("the events are returned", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceUsageEventsReturnsOnCall(0,
+					[]resources.ServiceUsageEvent{{GUID: "event-1", State: "CREATED"}, {GUID: "event-2", State: "DELETED"}},
+					ccv3.Warnings{"warning-1"}, nil)
+			})
+
+			It("pages through them and filters on the states", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(events).To(Equal([]resources.ServiceUsageEvent{{GUID: "event-1", State: "CREATED"}}))
+
+				Expect(fakeCloudControllerClient.GetServiceUsageEventsCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetServiceUsageEventsArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.CreatedAtsGTEFilter, Values: []string{"2024-02-01T00:00:00Z"}},
+				))
+				Expect(fakeCloudControllerClient.GetServiceUsageEventsArgsForCall(1)).To(ContainElement(
+					ccv3.Query{Key: ccv3.AfterGUIDFilter, Values: []string{"event-2"}},
+				))
+			})
+		})
+
+		When("getting the events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceUsageEventsReturns(nil, ccv3.Warnings{"warning-1"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetAppInstanceHours", func() {
+		var (
+			reportEnd  time.Time
+			report     []AppInstanceHours
+			warnings   Warnings
+			executeErr error
+		)
+
+		at := func(hour int) time.Time {
+			return time.Date(2024, 2, 1, hour, 0, 0, 0, time.UTC)
+		}
+
+		appEvent := func(guid string, hour int, state string, previousState string, instances int, previousInstances int) resources.AppUsageEvent {
+			return resources.AppUsageEvent{
+				GUID:                  guid,
+				CreatedAt:             at(hour),
+				State:                 state,
+				PreviousState:         previousState,
+				AppGUID:               "app-guid",
+				AppName:               "app",
+				ProcessGUID:           "web-guid",
+				SpaceGUID:             "space-guid",
+				SpaceName:             "space",
+				OrganizationGUID:      "org-guid",
+				InstanceCount:         instances,
+				PreviousInstanceCount: previousInstances,
+			}
+		}
+
+		BeforeEach(func() {
+			reportEnd = end
+		})
+
+		JustBeforeEach(func() {
+			report, warnings, executeErr = actor.GetAppInstanceHours(start, reportEnd)
+		})
+
+		When("apps start, scale, stop and run tasks", func() {
+			BeforeEach(func() {
+				task := resources.AppUsageEvent{
+					AppGUID: "other-app-guid", AppName: "other-app", SpaceGUID: "space-guid", SpaceName: "space", OrganizationGUID: "org-guid",
+					TaskGUID: "task-guid", InstanceCount: 1,
+				}
+				taskStarted, taskStopped := task, task
+				taskStarted.GUID, taskStarted.State, taskStarted.CreatedAt = "event-4", "TASK_STARTED", at(10)
+				taskStopped.GUID, taskStopped.State, taskStopped.CreatedAt = "event-5", "TASK_STOPPED", at(13)
+
+				fakeCloudControllerClient.GetAppUsageEventsReturnsOnCall(0, []resources.AppUsageEvent{
+					// ran with 2 instances since before the window
+					appEvent("event-1", 2, "STARTED", "STARTED", 4, 2),
+					appEvent("event-2", 4, "BUILDPACK_SET", "STARTED", 4, 4),
+					taskStarted,
+					taskStopped,
+					appEvent("event-3", 6, "STOPPED", "STARTED", 4, 4),
+				}, ccv3.Warnings{"warning-1"}, nil)
+			})
+
+			It("sums the instance-hours of each app within the window", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.GetAppUsageEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+					ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+					ccv3.Query{Key: ccv3.CreatedAtsLTFilter, Values: []string{"2024-02-02T00:00:00Z"}},
+				))
+
+				Expect(report).To(Equal([]AppInstanceHours{
+					{OrganizationGUID: "org-guid", SpaceGUID: "space-guid", SpaceName: "space", AppGUID: "app-guid", AppName: "app", InstanceHours: 2*2 + 4*4},
+					{OrganizationGUID: "org-guid", SpaceGUID: "space-guid", SpaceName: "space", AppGUID: "other-app-guid", AppName: "other-app", InstanceHours: 3},
+				}))
+			})
+		})
+
+		When("a process is still running at the end of the window", func() {
+			BeforeEach(func() {
+				reportEnd = at(12)
+				fakeCloudControllerClient.GetAppUsageEventsReturnsOnCall(0, []resources.AppUsageEvent{
+					appEvent("event-1", 10, "STARTED", "STOPPED", 3, 0),
+				}, nil, nil)
+			})
+
+			It("counts it until the end of the window", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(report).To(HaveLen(1))
+				Expect(report[0].InstanceHours).To(Equal(float64(3 * 2)))
+			})
+		})
+
+		When("an app only ran before the window", func() {
+			BeforeEach(func() {
+				start = at(8)
+				fakeCloudControllerClient.GetAppUsageEventsReturnsOnCall(0, []resources.AppUsageEvent{
+					appEvent("event-1", 2, "STARTED", "STOPPED", 1, 0),
+					appEvent("event-2", 4, "STOPPED", "STARTED", 1, 1),
+				}, nil, nil)
+			})
+
+			It("leaves it out of the report", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(report).To(BeEmpty())
+			})
+		})
+
+		When("no end is given", func() {
+			BeforeEach(func() {
+				reportEnd = time.Time{}
+			})
+
+			It("reports until now", func() {
+				Expect(fakeCloudControllerClient.GetAppUsageEventsArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.CreatedAtsLTFilter, Values: []string{fakeClock.Now().UTC().Format(time.RFC3339)}},
+				))
+			})
+		})
+
+		When("getting the events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetAppUsageEventsReturns(nil, ccv3.Warnings{"warning-1"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
@@ -152,6 +152,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateApplicationSidecarStub        func(string, resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)
+	createApplicationSidecarMutex       sync.RWMutex
+	createApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 resources.Sidecar
+	}
+	createApplicationSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationTaskStub        func(string, resources.Task) (resources.Task, ccv3.Warnings, error)
 	createApplicationTaskMutex       sync.RWMutex
 	createApplicationTaskArgsForCall []struct {
@@ -720,6 +736,19 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeleteSidecarStub        func(string) (ccv3.Warnings, error)
+	deleteSidecarMutex       sync.RWMutex
+	deleteSidecarArgsForCall []struct {
+		arg1 string
+	}
+	deleteSidecarReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	deleteSidecarReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	DeleteSpaceStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
 	deleteSpaceMutex       sync.RWMutex
 	deleteSpaceArgsForCall []struct {
@@ -765,20 +794,18 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
-	DownloadDropletStub        func(string) ([]byte, ccv3.Warnings, error)
-	downloadDropletMutex       sync.RWMutex
-	downloadDropletArgsForCall []struct {
+	DropletDownloadURLStub        func(string) (string, error)
+	dropletDownloadURLMutex       sync.RWMutex
+	dropletDownloadURLArgsForCall []struct {
 		arg1 string
 	}
-	downloadDropletReturns struct {
-		result1 []byte
-		result2 ccv3.Warnings
-		result3 error
+	dropletDownloadURLReturns struct {
+		result1 string
+		result2 error
 	}
-	downloadDropletReturnsOnCall map[int]struct {
-		result1 []byte
-		result2 ccv3.Warnings
-		result3 error
+	dropletDownloadURLReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
 	}
 	EntitleIsolationSegmentToOrganizationsStub        func(string, []string) (resources.RelationshipList, ccv3.Warnings, error)
 	entitleIsolationSegmentToOrganizationsMutex       sync.RWMutex
@@ -812,6 +839,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetAppUsageEventsStub        func(...ccv3.Query) ([]resources.AppUsageEvent, ccv3.Warnings, error)
+	getAppUsageEventsMutex       sync.RWMutex
+	getAppUsageEventsArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getAppUsageEventsReturns struct {
+		result1 []resources.AppUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getAppUsageEventsReturnsOnCall map[int]struct {
+		result1 []resources.AppUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationByNameAndSpaceStub        func(string, string) (resources.Application, ccv3.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -950,6 +992,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationSidecarsStub        func(string, ...ccv3.Query) ([]resources.Sidecar, ccv3.Warnings, error)
+	getApplicationSidecarsMutex       sync.RWMutex
+	getApplicationSidecarsArgsForCall []struct {
+		arg1 string
+		arg2 []ccv3.Query
+	}
+	getApplicationSidecarsReturns struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationSidecarsReturnsOnCall map[int]struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationTasksStub        func(string, ...ccv3.Query) ([]resources.Task, ccv3.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -1865,6 +1923,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetServiceUsageEventsStub        func(...ccv3.Query) ([]resources.ServiceUsageEvent, ccv3.Warnings, error)
+	getServiceUsageEventsMutex       sync.RWMutex
+	getServiceUsageEventsArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getServiceUsageEventsReturns struct {
+		result1 []resources.ServiceUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServiceUsageEventsReturnsOnCall map[int]struct {
+		result1 []resources.ServiceUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetSpaceFeatureStub        func(string, string) (bool, ccv3.Warnings, error)
 	getSpaceFeatureMutex       sync.RWMutex
 	getSpaceFeatureArgsForCall []struct {
@@ -2695,6 +2768,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateSidecarStub        func(resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)
+	updateSidecarMutex       sync.RWMutex
+	updateSidecarArgsForCall []struct {
+		arg1 resources.Sidecar
+	}
+	updateSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateSpaceStub        func(resources.Space) (resources.Space, ccv3.Warnings, error)
 	updateSpaceMutex       sync.RWMutex
 	updateSpaceArgsForCall []struct {
@@ -3496,6 +3584,74 @@ func (fake *FakeCloudControllerClient) CreateApplicationProcessScaleReturnsOnCal
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateApplicationSidecar(arg1 string, arg2 resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error) {
+	fake.createApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.createApplicationSidecarReturnsOnCall[len(fake.createApplicationSidecarArgsForCall)]
+	fake.createApplicationSidecarArgsForCall = append(fake.createApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 resources.Sidecar
+	}{arg1, arg2})
+	stub := fake.CreateApplicationSidecarStub
+	fakeReturns := fake.createApplicationSidecarReturns
+	fake.recordInvocation("CreateApplicationSidecar", []interface{}{arg1, arg2})
+	fake.createApplicationSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarCallCount() int {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	return len(fake.createApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarCalls(stub func(string, resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarArgsForCall(i int) (string, resources.Sidecar) {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.createApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarReturns(result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	fake.createApplicationSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	if fake.createApplicationSidecarReturnsOnCall == nil {
+		fake.createApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplicationTask(arg1 string, arg2 resources.Task) (resources.Task, ccv3.Warnings, error) {
 	fake.createApplicationTaskMutex.Lock()
 	ret, specificReturn := fake.createApplicationTaskReturnsOnCall[len(fake.createApplicationTaskArgsForCall)]
@@ -6036,6 +6192,70 @@ func (fake *FakeCloudControllerClient) DeleteServicePlanVisibilityReturnsOnCall(
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteSidecar(arg1 string) (ccv3.Warnings, error) {
+	fake.deleteSidecarMutex.Lock()
+	ret, specificReturn := fake.deleteSidecarReturnsOnCall[len(fake.deleteSidecarArgsForCall)]
+	fake.deleteSidecarArgsForCall = append(fake.deleteSidecarArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteSidecarStub
+	fakeReturns := fake.deleteSidecarReturns
+	fake.recordInvocation("DeleteSidecar", []interface{}{arg1})
+	fake.deleteSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarCallCount() int {
+	fake.deleteSidecarMutex.RLock()
+	defer fake.deleteSidecarMutex.RUnlock()
+	return len(fake.deleteSidecarArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarCalls(stub func(string) (ccv3.Warnings, error)) {
+	fake.deleteSidecarMutex.Lock()
+	defer fake.deleteSidecarMutex.Unlock()
+	fake.DeleteSidecarStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarArgsForCall(i int) string {
+	fake.deleteSidecarMutex.RLock()
+	defer fake.deleteSidecarMutex.RUnlock()
+	argsForCall := fake.deleteSidecarArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarReturns(result1 ccv3.Warnings, result2 error) {
+	fake.deleteSidecarMutex.Lock()
+	defer fake.deleteSidecarMutex.Unlock()
+	fake.DeleteSidecarStub = nil
+	fake.deleteSidecarReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteSidecarReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.deleteSidecarMutex.Lock()
+	defer fake.deleteSidecarMutex.Unlock()
+	fake.DeleteSidecarStub = nil
+	if fake.deleteSidecarReturnsOnCall == nil {
+		fake.deleteSidecarReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.deleteSidecarReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteSpace(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
 	fake.deleteSpaceMutex.Lock()
 	ret, specificReturn := fake.deleteSpaceReturnsOnCall[len(fake.deleteSpaceArgsForCall)]
@@ -6237,71 +6457,68 @@ func (fake *FakeCloudControllerClient) DeleteUserReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) DownloadDroplet(arg1 string) ([]byte, ccv3.Warnings, error) {
-	fake.downloadDropletMutex.Lock()
-	ret, specificReturn := fake.downloadDropletReturnsOnCall[len(fake.downloadDropletArgsForCall)]
-	fake.downloadDropletArgsForCall = append(fake.downloadDropletArgsForCall, struct {
+func (fake *FakeCloudControllerClient) DropletDownloadURL(arg1 string) (string, error) {
+	fake.dropletDownloadURLMutex.Lock()
+	ret, specificReturn := fake.dropletDownloadURLReturnsOnCall[len(fake.dropletDownloadURLArgsForCall)]
+	fake.dropletDownloadURLArgsForCall = append(fake.dropletDownloadURLArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	stub := fake.DownloadDropletStub
-	fakeReturns := fake.downloadDropletReturns
-	fake.recordInvocation("DownloadDroplet", []interface{}{arg1})
-	fake.downloadDropletMutex.Unlock()
+	stub := fake.DropletDownloadURLStub
+	fakeReturns := fake.dropletDownloadURLReturns
+	fake.recordInvocation("DropletDownloadURL", []interface{}{arg1})
+	fake.dropletDownloadURLMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeCloudControllerClient) DownloadDropletCallCount() int {
-	fake.downloadDropletMutex.RLock()
-	defer fake.downloadDropletMutex.RUnlock()
-	return len(fake.downloadDropletArgsForCall)
+func (fake *FakeCloudControllerClient) DropletDownloadURLCallCount() int {
+	fake.dropletDownloadURLMutex.RLock()
+	defer fake.dropletDownloadURLMutex.RUnlock()
+	return len(fake.dropletDownloadURLArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) DownloadDropletCalls(stub func(string) ([]byte, ccv3.Warnings, error)) {
-	fake.downloadDropletMutex.Lock()
-	defer fake.downloadDropletMutex.Unlock()
-	fake.DownloadDropletStub = stub
+func (fake *FakeCloudControllerClient) DropletDownloadURLCalls(stub func(string) (string, error)) {
+	fake.dropletDownloadURLMutex.Lock()
+	defer fake.dropletDownloadURLMutex.Unlock()
+	fake.DropletDownloadURLStub = stub
 }
 
-func (fake *FakeCloudControllerClient) DownloadDropletArgsForCall(i int) string {
-	fake.downloadDropletMutex.RLock()
-	defer fake.downloadDropletMutex.RUnlock()
-	argsForCall := fake.downloadDropletArgsForCall[i]
+func (fake *FakeCloudControllerClient) DropletDownloadURLArgsForCall(i int) string {
+	fake.dropletDownloadURLMutex.RLock()
+	defer fake.dropletDownloadURLMutex.RUnlock()
+	argsForCall := fake.dropletDownloadURLArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeCloudControllerClient) DownloadDropletReturns(result1 []byte, result2 ccv3.Warnings, result3 error) {
-	fake.downloadDropletMutex.Lock()
-	defer fake.downloadDropletMutex.Unlock()
-	fake.DownloadDropletStub = nil
-	fake.downloadDropletReturns = struct {
-		result1 []byte
-		result2 ccv3.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeCloudControllerClient) DropletDownloadURLReturns(result1 string, result2 error) {
+	fake.dropletDownloadURLMutex.Lock()
+	defer fake.dropletDownloadURLMutex.Unlock()
+	fake.DropletDownloadURLStub = nil
+	fake.dropletDownloadURLReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeCloudControllerClient) DownloadDropletReturnsOnCall(i int, result1 []byte, result2 ccv3.Warnings, result3 error) {
-	fake.downloadDropletMutex.Lock()
-	defer fake.downloadDropletMutex.Unlock()
-	fake.DownloadDropletStub = nil
-	if fake.downloadDropletReturnsOnCall == nil {
-		fake.downloadDropletReturnsOnCall = make(map[int]struct {
-			result1 []byte
-			result2 ccv3.Warnings
-			result3 error
+func (fake *FakeCloudControllerClient) DropletDownloadURLReturnsOnCall(i int, result1 string, result2 error) {
+	fake.dropletDownloadURLMutex.Lock()
+	defer fake.dropletDownloadURLMutex.Unlock()
+	fake.DropletDownloadURLStub = nil
+	if fake.dropletDownloadURLReturnsOnCall == nil {
+		fake.dropletDownloadURLReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
 		})
 	}
-	fake.downloadDropletReturnsOnCall[i] = struct {
-		result1 []byte
-		result2 ccv3.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.dropletDownloadURLReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeCloudControllerClient) EntitleIsolationSegmentToOrganizations(arg1 string, arg2 []string) (resources.RelationshipList, ccv3.Warnings, error) {
@@ -6445,6 +6662,73 @@ func (fake *FakeCloudControllerClient) GetAppFeatureReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetAppUsageEvents(arg1 ...ccv3.Query) ([]resources.AppUsageEvent, ccv3.Warnings, error) {
+	fake.getAppUsageEventsMutex.Lock()
+	ret, specificReturn := fake.getAppUsageEventsReturnsOnCall[len(fake.getAppUsageEventsArgsForCall)]
+	fake.getAppUsageEventsArgsForCall = append(fake.getAppUsageEventsArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	stub := fake.GetAppUsageEventsStub
+	fakeReturns := fake.getAppUsageEventsReturns
+	fake.recordInvocation("GetAppUsageEvents", []interface{}{arg1})
+	fake.getAppUsageEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsCallCount() int {
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
+	return len(fake.getAppUsageEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsCalls(stub func(...ccv3.Query) ([]resources.AppUsageEvent, ccv3.Warnings, error)) {
+	fake.getAppUsageEventsMutex.Lock()
+	defer fake.getAppUsageEventsMutex.Unlock()
+	fake.GetAppUsageEventsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsArgsForCall(i int) []ccv3.Query {
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
+	argsForCall := fake.getAppUsageEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsReturns(result1 []resources.AppUsageEvent, result2 ccv3.Warnings, result3 error) {
+	fake.getAppUsageEventsMutex.Lock()
+	defer fake.getAppUsageEventsMutex.Unlock()
+	fake.GetAppUsageEventsStub = nil
+	fake.getAppUsageEventsReturns = struct {
+		result1 []resources.AppUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsReturnsOnCall(i int, result1 []resources.AppUsageEvent, result2 ccv3.Warnings, result3 error) {
+	fake.getAppUsageEventsMutex.Lock()
+	defer fake.getAppUsageEventsMutex.Unlock()
+	fake.GetAppUsageEventsStub = nil
+	if fake.getAppUsageEventsReturnsOnCall == nil {
+		fake.getAppUsageEventsReturnsOnCall = make(map[int]struct {
+			result1 []resources.AppUsageEvent
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getAppUsageEventsReturnsOnCall[i] = struct {
+		result1 []resources.AppUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationByNameAndSpace(arg1 string, arg2 string) (resources.Application, ccv3.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -7051,6 +7335,74 @@ func (fake *FakeCloudControllerClient) GetApplicationRoutesReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationSidecars(arg1 string, arg2 ...ccv3.Query) ([]resources.Sidecar, ccv3.Warnings, error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	ret, specificReturn := fake.getApplicationSidecarsReturnsOnCall[len(fake.getApplicationSidecarsArgsForCall)]
+	fake.getApplicationSidecarsArgsForCall = append(fake.getApplicationSidecarsArgsForCall, struct {
+		arg1 string
+		arg2 []ccv3.Query
+	}{arg1, arg2})
+	stub := fake.GetApplicationSidecarsStub
+	fakeReturns := fake.getApplicationSidecarsReturns
+	fake.recordInvocation("GetApplicationSidecars", []interface{}{arg1, arg2})
+	fake.getApplicationSidecarsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsCallCount() int {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	return len(fake.getApplicationSidecarsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsCalls(stub func(string, ...ccv3.Query) ([]resources.Sidecar, ccv3.Warnings, error)) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsArgsForCall(i int) (string, []ccv3.Query) {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	argsForCall := fake.getApplicationSidecarsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsReturns(result1 []resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	fake.getApplicationSidecarsReturns = struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsReturnsOnCall(i int, result1 []resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	if fake.getApplicationSidecarsReturnsOnCall == nil {
+		fake.getApplicationSidecarsReturnsOnCall = make(map[int]struct {
+			result1 []resources.Sidecar
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSidecarsReturnsOnCall[i] = struct {
+		result1 []resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationTasks(arg1 string, arg2 ...ccv3.Query) ([]resources.Task, ccv3.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
@@ -11070,6 +11422,73 @@ func (fake *FakeCloudControllerClient) GetServicePlansWithSpaceAndOrganizationRe
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceUsageEvents(arg1 ...ccv3.Query) ([]resources.ServiceUsageEvent, ccv3.Warnings, error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	ret, specificReturn := fake.getServiceUsageEventsReturnsOnCall[len(fake.getServiceUsageEventsArgsForCall)]
+	fake.getServiceUsageEventsArgsForCall = append(fake.getServiceUsageEventsArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	stub := fake.GetServiceUsageEventsStub
+	fakeReturns := fake.getServiceUsageEventsReturns
+	fake.recordInvocation("GetServiceUsageEvents", []interface{}{arg1})
+	fake.getServiceUsageEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceUsageEventsCallCount() int {
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
+	return len(fake.getServiceUsageEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceUsageEventsCalls(stub func(...ccv3.Query) ([]resources.ServiceUsageEvent, ccv3.Warnings, error)) {
+	fake.getServiceUsageEventsMutex.Lock()
+	defer fake.getServiceUsageEventsMutex.Unlock()
+	fake.GetServiceUsageEventsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceUsageEventsArgsForCall(i int) []ccv3.Query {
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
+	argsForCall := fake.getServiceUsageEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceUsageEventsReturns(result1 []resources.ServiceUsageEvent, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	defer fake.getServiceUsageEventsMutex.Unlock()
+	fake.GetServiceUsageEventsStub = nil
+	fake.getServiceUsageEventsReturns = struct {
+		result1 []resources.ServiceUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceUsageEventsReturnsOnCall(i int, result1 []resources.ServiceUsageEvent, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	defer fake.getServiceUsageEventsMutex.Unlock()
+	fake.GetServiceUsageEventsStub = nil
+	if fake.getServiceUsageEventsReturnsOnCall == nil {
+		fake.getServiceUsageEventsReturnsOnCall = make(map[int]struct {
+			result1 []resources.ServiceUsageEvent
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceUsageEventsReturnsOnCall[i] = struct {
+		result1 []resources.ServiceUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaceFeature(arg1 string, arg2 string) (bool, ccv3.Warnings, error) {
 	fake.getSpaceFeatureMutex.Lock()
 	ret, specificReturn := fake.getSpaceFeatureReturnsOnCall[len(fake.getSpaceFeatureArgsForCall)]
@@ -14796,6 +15215,73 @@ func (fake *FakeCloudControllerClient) UpdateServicePlanVisibilityReturnsOnCall(
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateSidecar(arg1 resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error) {
+	fake.updateSidecarMutex.Lock()
+	ret, specificReturn := fake.updateSidecarReturnsOnCall[len(fake.updateSidecarArgsForCall)]
+	fake.updateSidecarArgsForCall = append(fake.updateSidecarArgsForCall, struct {
+		arg1 resources.Sidecar
+	}{arg1})
+	stub := fake.UpdateSidecarStub
+	fakeReturns := fake.updateSidecarReturns
+	fake.recordInvocation("UpdateSidecar", []interface{}{arg1})
+	fake.updateSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarCallCount() int {
+	fake.updateSidecarMutex.RLock()
+	defer fake.updateSidecarMutex.RUnlock()
+	return len(fake.updateSidecarArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarCalls(stub func(resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)) {
+	fake.updateSidecarMutex.Lock()
+	defer fake.updateSidecarMutex.Unlock()
+	fake.UpdateSidecarStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarArgsForCall(i int) resources.Sidecar {
+	fake.updateSidecarMutex.RLock()
+	defer fake.updateSidecarMutex.RUnlock()
+	argsForCall := fake.updateSidecarArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarReturns(result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.updateSidecarMutex.Lock()
+	defer fake.updateSidecarMutex.Unlock()
+	fake.UpdateSidecarStub = nil
+	fake.updateSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.updateSidecarMutex.Lock()
+	defer fake.updateSidecarMutex.Unlock()
+	fake.UpdateSidecarStub = nil
+	if fake.updateSidecarReturnsOnCall == nil {
+		fake.updateSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateSpace(arg1 resources.Space) (resources.Space, ccv3.Warnings, error) {
 	fake.updateSpaceMutex.Lock()
 	ret, specificReturn := fake.updateSpaceReturnsOnCall[len(fake.updateSpaceArgsForCall)]
@@ -0,0 +1,119 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7actionfakes
+
+import (
+	"net/http"
+	"sync"
+
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/util/download"
+)
+
+type FakeDropletDownloader struct {
+	DownloadToFileStub        func(string, http.Header, string, download.Checksum, download.ProgressBar) error
+	downloadToFileMutex       sync.RWMutex
+	downloadToFileArgsForCall []struct {
+		arg1 string
+		arg2 http.Header
+		arg3 string
+		arg4 download.Checksum
+		arg5 download.ProgressBar
+	}
+	downloadToFileReturns struct {
+		result1 error
+	}
+	downloadToFileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDropletDownloader) DownloadToFile(arg1 string, arg2 http.Header, arg3 string, arg4 download.Checksum, arg5 download.ProgressBar) error {
+	fake.downloadToFileMutex.Lock()
+	ret, specificReturn := fake.downloadToFileReturnsOnCall[len(fake.downloadToFileArgsForCall)]
+	fake.downloadToFileArgsForCall = append(fake.downloadToFileArgsForCall, struct {
+		arg1 string
+		arg2 http.Header
+		arg3 string
+		arg4 download.Checksum
+		arg5 download.ProgressBar
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.DownloadToFileStub
+	fakeReturns := fake.downloadToFileReturns
+	fake.recordInvocation("DownloadToFile", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.downloadToFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDropletDownloader) DownloadToFileCallCount() int {
+	fake.downloadToFileMutex.RLock()
+	defer fake.downloadToFileMutex.RUnlock()
+	return len(fake.downloadToFileArgsForCall)
+}
+
+func (fake *FakeDropletDownloader) DownloadToFileCalls(stub func(string, http.Header, string, download.Checksum, download.ProgressBar) error) {
+	fake.downloadToFileMutex.Lock()
+	defer fake.downloadToFileMutex.Unlock()
+	fake.DownloadToFileStub = stub
+}
+
+func (fake *FakeDropletDownloader) DownloadToFileArgsForCall(i int) (string, http.Header, string, download.Checksum, download.ProgressBar) {
+	fake.downloadToFileMutex.RLock()
+	defer fake.downloadToFileMutex.RUnlock()
+	argsForCall := fake.downloadToFileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeDropletDownloader) DownloadToFileReturns(result1 error) {
+	fake.downloadToFileMutex.Lock()
+	defer fake.downloadToFileMutex.Unlock()
+	fake.DownloadToFileStub = nil
+	fake.downloadToFileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDropletDownloader) DownloadToFileReturnsOnCall(i int, result1 error) {
+	fake.downloadToFileMutex.Lock()
+	defer fake.downloadToFileMutex.Unlock()
+	fake.DownloadToFileStub = nil
+	if fake.downloadToFileReturnsOnCall == nil {
+		fake.downloadToFileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.downloadToFileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDropletDownloader) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDropletDownloader) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7action.DropletDownloader = new(FakeDropletDownloader)
@@ -0,0 +1,65 @@
+package v7pushaction
+
+import (
+	"code.cloudfoundry.org/cli/v9/actor/sharedaction"
+)
+
+// UploadPreviewFile is a file that pushing a plan would include in the app's
+// package.
+type UploadPreviewFile struct {
+	Path        string
+	SizeInBytes int64
+	// Matched is true when resource matching found the file on the Cloud
+	// Controller, so it would not be uploaded.
+	Matched bool
+}
+
+// PreviewUpload returns the files of the push plan's bits in the order they
+// were gathered, along with whether resource matching would skip uploading
+// them. Directories are left out. Nothing is created on the Cloud Controller.
+func (actor Actor) PreviewUpload(pushPlan PushPlan) ([]UploadPreviewFile, Warnings, error) {
+	var (
+		matched  []sharedaction.V3Resource
+		warnings Warnings
+		err      error
+	)
+
+	// mirror CreateAndUploadApplicationBits, which only matches resources
+	// when some of the files have content
+	for _, resource := range pushPlan.AllResources {
+		if resource.SizeInBytes != 0 {
+			matched, _, warnings, err = actor.MatchResources(pushPlan.AllResources)
+			if err != nil {
+				return nil, warnings, err
+			}
+			break
+		}
+	}
+
+	matchedChecksums := map[string]bool{}
+	for _, resource := range matched {
+		matchedChecksums[resource.Checksum.Value] = true
+	}
+
+	var files []UploadPreviewFile
+	for _, resource := range pushPlan.AllResources {
+		if isDirectoryResource(resource) {
+			continue
+		}
+
+		files = append(files, UploadPreviewFile{
+			Path:        resource.FilePath,
+			SizeInBytes: resource.SizeInBytes,
+			Matched:     resource.Checksum.Value != "" && matchedChecksums[resource.Checksum.Value],
+		})
+	}
+
+	return files, warnings, nil
+}
+
+// isDirectoryResource reports whether the resource was gathered from a
+// directory. Only directories have neither a checksum nor a mode of their own;
+// symlinks keep the mode of the link.
+func isDirectoryResource(resource sharedaction.V3Resource) bool {
+	return resource.Checksum.Value == "" && resource.Mode == sharedaction.DefaultFolderPermissions
+}
@@ -0,0 +1,99 @@
+package v7pushaction_test
+
+import (
+	"errors"
+	"os"
+
+	"code.cloudfoundry.org/cli/v9/actor/sharedaction"
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	. "code.cloudfoundry.org/cli/v9/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/v9/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PreviewUpload", func() {
+	var (
+		actor       *Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+
+		pushPlan   PushPlan
+		files      []UploadPreviewFile
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		actor, fakeV7Actor, _ = getTestPushActor()
+
+		pushPlan = PushPlan{
+			AllResources: []sharedaction.V3Resource{
+				{FilePath: "some-dir", Mode: sharedaction.DefaultFolderPermissions},
+				{FilePath: "some-dir/file-1", Mode: 0644, Checksum: ccv3.Checksum{Value: "sha-1"}, SizeInBytes: 10},
+				{FilePath: "file-2", Mode: 0644, Checksum: ccv3.Checksum{Value: "sha-2"}, SizeInBytes: 20},
+				{FilePath: "some-link", Mode: os.ModeSymlink | 0777},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		files, warnings, executeErr = actor.PreviewUpload(pushPlan)
+	})
+
+	When("resource matching succeeds", func() {
+		BeforeEach(func() {
+			fakeV7Actor.ResourceMatchReturns(
+				[]sharedaction.V3Resource{
+					{FilePath: "file-2", Mode: 0644, Checksum: ccv3.Checksum{Value: "sha-2"}, SizeInBytes: 20},
+				},
+				v7action.Warnings{"match-warning"},
+				nil,
+			)
+		})
+
+		It("returns the files in order and marks the matched ones", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("match-warning"))
+
+			Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(1))
+			Expect(fakeV7Actor.ResourceMatchArgsForCall(0)).To(Equal(pushPlan.AllResources))
+
+			Expect(files).To(Equal([]UploadPreviewFile{
+				{Path: "some-dir/file-1", SizeInBytes: 10},
+				{Path: "file-2", SizeInBytes: 20, Matched: true},
+				{Path: "some-link"},
+			}))
+		})
+
+		It("does not create anything on the Cloud Controller", func() {
+			Expect(fakeV7Actor.CreateBitsPackageByApplicationCallCount()).To(Equal(0))
+			Expect(fakeV7Actor.UploadBitsPackageCallCount()).To(Equal(0))
+		})
+	})
+
+	When("all the files are empty", func() {
+		BeforeEach(func() {
+			pushPlan.AllResources = []sharedaction.V3Resource{
+				{FilePath: "empty-file", Mode: 0644, Checksum: ccv3.Checksum{Value: "sha-empty"}},
+			}
+		})
+
+		It("does not match resources", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(0))
+			Expect(files).To(Equal([]UploadPreviewFile{{Path: "empty-file"}}))
+		})
+	})
+
+	When("resource matching fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.ResourceMatchReturns(nil, v7action.Warnings{"match-warning"}, errors.New("match-error"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("match-error"))
+			Expect(warnings).To(ConsistOf("match-warning"))
+		})
+	})
+})
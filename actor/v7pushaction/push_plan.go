@@ -65,6 +65,7 @@ type FlagOverrides struct {
 	Strategy            constant.DeploymentStrategy
 	ManifestPath        string
 	PathsToVarsFiles    []string
+	PathsToOpsFiles     []string
 	Vars                []template.VarKV
 	NoManifest          bool
 	Task                bool
@@ -65,6 +65,20 @@ var _ = Describe("SetupAllResourcesForPushPlan", func() {
 		})
 	})
 
+	When("the plan has a docker image for an app that does not exist yet", func() {
+		BeforeEach(func() {
+			pushPlan.DockerImageCredentials.Path = "some-image"
+		})
+
+		It("skips settings the resources", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(pushPlan.AllResources).To(BeEmpty())
+
+			Expect(fakeSharedActor.GatherArchiveResourcesCallCount()).To(Equal(0))
+			Expect(fakeSharedActor.GatherDirectoryResourcesCallCount()).To(Equal(0))
+		})
+	})
+
 	When("the application is a buildpack app", func() {
 		When("push plan's bits path is not set", func() {
 			It("returns an error", func() {
@@ -153,6 +153,10 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
 			})
 
+			It("should persist the refreshed tokens", func() {
+				Expect(inMemoryCache.PersistedRefreshToken()).To(Equal("bananananananana"))
+			})
+
 			Context("when the reseting the request body fails", func() {
 				BeforeEach(func() {
 					fakeConnection.MakeReturnsOnCall(0, networkerror.InvalidAuthTokenError{})
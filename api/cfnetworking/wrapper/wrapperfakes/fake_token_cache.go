@@ -18,6 +18,16 @@ type FakeTokenCache struct {
 	accessTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PersistTokensStub        func() error
+	persistTokensMutex       sync.RWMutex
+	persistTokensArgsForCall []struct {
+	}
+	persistTokensReturns struct {
+		result1 error
+	}
+	persistTokensReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct {
@@ -95,6 +105,59 @@ func (fake *FakeTokenCache) AccessTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeTokenCache) PersistTokens() error {
+	fake.persistTokensMutex.Lock()
+	ret, specificReturn := fake.persistTokensReturnsOnCall[len(fake.persistTokensArgsForCall)]
+	fake.persistTokensArgsForCall = append(fake.persistTokensArgsForCall, struct {
+	}{})
+	stub := fake.PersistTokensStub
+	fakeReturns := fake.persistTokensReturns
+	fake.recordInvocation("PersistTokens", []interface{}{})
+	fake.persistTokensMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTokenCache) PersistTokensCallCount() int {
+	fake.persistTokensMutex.RLock()
+	defer fake.persistTokensMutex.RUnlock()
+	return len(fake.persistTokensArgsForCall)
+}
+
+func (fake *FakeTokenCache) PersistTokensCalls(stub func() error) {
+	fake.persistTokensMutex.Lock()
+	defer fake.persistTokensMutex.Unlock()
+	fake.PersistTokensStub = stub
+}
+
+func (fake *FakeTokenCache) PersistTokensReturns(result1 error) {
+	fake.persistTokensMutex.Lock()
+	defer fake.persistTokensMutex.Unlock()
+	fake.PersistTokensStub = nil
+	fake.persistTokensReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTokenCache) PersistTokensReturnsOnCall(i int, result1 error) {
+	fake.persistTokensMutex.Lock()
+	defer fake.persistTokensMutex.Unlock()
+	fake.PersistTokensStub = nil
+	if fake.persistTokensReturnsOnCall == nil {
+		fake.persistTokensReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.persistTokensReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTokenCache) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -9,3 +9,7 @@ type RequestError struct {
 func (e RequestError) Error() string {
 	return e.Err.Error()
 }
+
+func (e RequestError) Unwrap() error {
+	return e.Err
+}
@@ -126,6 +126,19 @@ type FakeRequester struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	MakeStreamingRequestStub        func(*http.Request) (*http.Response, error)
+	makeStreamingRequestMutex       sync.RWMutex
+	makeStreamingRequestArgsForCall []struct {
+		arg1 *http.Request
+	}
+	makeStreamingRequestReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	makeStreamingRequestReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
 	WrapConnectionStub        func(ccv3.ConnectionWrapper)
 	wrapConnectionMutex       sync.RWMutex
 	wrapConnectionArgsForCall []struct {
@@ -626,6 +639,70 @@ func (fake *FakeRequester) MakeRequestUploadAsyncReturnsOnCall(i int, result1 st
 	}{result1, result2, result3}
 }
 
+func (fake *FakeRequester) MakeStreamingRequest(arg1 *http.Request) (*http.Response, error) {
+	fake.makeStreamingRequestMutex.Lock()
+	ret, specificReturn := fake.makeStreamingRequestReturnsOnCall[len(fake.makeStreamingRequestArgsForCall)]
+	fake.makeStreamingRequestArgsForCall = append(fake.makeStreamingRequestArgsForCall, struct {
+		arg1 *http.Request
+	}{arg1})
+	stub := fake.MakeStreamingRequestStub
+	fakeReturns := fake.makeStreamingRequestReturns
+	fake.recordInvocation("MakeStreamingRequest", []interface{}{arg1})
+	fake.makeStreamingRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRequester) MakeStreamingRequestCallCount() int {
+	fake.makeStreamingRequestMutex.RLock()
+	defer fake.makeStreamingRequestMutex.RUnlock()
+	return len(fake.makeStreamingRequestArgsForCall)
+}
+
+func (fake *FakeRequester) MakeStreamingRequestCalls(stub func(*http.Request) (*http.Response, error)) {
+	fake.makeStreamingRequestMutex.Lock()
+	defer fake.makeStreamingRequestMutex.Unlock()
+	fake.MakeStreamingRequestStub = stub
+}
+
+func (fake *FakeRequester) MakeStreamingRequestArgsForCall(i int) *http.Request {
+	fake.makeStreamingRequestMutex.RLock()
+	defer fake.makeStreamingRequestMutex.RUnlock()
+	argsForCall := fake.makeStreamingRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRequester) MakeStreamingRequestReturns(result1 *http.Response, result2 error) {
+	fake.makeStreamingRequestMutex.Lock()
+	defer fake.makeStreamingRequestMutex.Unlock()
+	fake.MakeStreamingRequestStub = nil
+	fake.makeStreamingRequestReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRequester) MakeStreamingRequestReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.makeStreamingRequestMutex.Lock()
+	defer fake.makeStreamingRequestMutex.Unlock()
+	fake.MakeStreamingRequestStub = nil
+	if fake.makeStreamingRequestReturnsOnCall == nil {
+		fake.makeStreamingRequestReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.makeStreamingRequestReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRequester) WrapConnection(arg1 ccv3.ConnectionWrapper) {
 	fake.wrapConnectionMutex.Lock()
 	fake.wrapConnectionArgsForCall = append(fake.wrapConnectionArgsForCall, struct {
@@ -111,6 +111,11 @@ type Config struct {
 	// JobPollingInterval is the wait time between job polls.
 	JobPollingInterval time.Duration
 
+	// PaginationConcurrency is the maximum number of pages of a list request
+	// fetched at once after the first page. Pages are fetched one after
+	// another when it is 1 or less.
+	PaginationConcurrency int
+
 	// Wrappers that apply to the client connection.
 	Wrappers []ConnectionWrapper
 }
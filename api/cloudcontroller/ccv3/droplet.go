@@ -118,11 +118,17 @@ func (client *Client) UploadDropletBits(dropletGUID string, dropletPath string,
 	return JobURL(responseLocation), warnings, err
 }
 
-func (client *Client) DownloadDroplet(dropletGUID string) ([]byte, Warnings, error) {
-	bytes, warnings, err := client.MakeRequestReceiveRaw(
+// DropletDownloadURL returns the URL of the bits of the droplet with the given
+// GUID, for callers that stream the bits instead of holding them in memory.
+func (client *Client) DropletDownloadURL(dropletGUID string) (string, error) {
+	request, err := internal.NewRouter(internal.APIRoutes, client.CloudControllerURL).CreateRequest(
 		internal.GetDropletBitsRequest,
 		internal.Params{"droplet_guid": dropletGUID},
-		"application/json",
+		nil,
 	)
-	return bytes, warnings, err
+	if err != nil {
+		return "", err
+	}
+
+	return request.URL.String(), nil
 }
@@ -421,35 +421,15 @@ var _ = Describe("Droplet", func() {
 		})
 	})
 
-	Describe("DownloadDroplet", func() {
-		var (
-			dropletBytes []byte
-			warnings     Warnings
-			executeErr   error
-		)
-
-		JustBeforeEach(func() {
-			dropletBytes, warnings, executeErr = client.DownloadDroplet("some-droplet-guid")
-		})
-
+	Describe("DropletDownloadURL", func() {
 		BeforeEach(func() {
-			requester.MakeRequestReceiveRawCalls(func(string, internal.Params, string) ([]byte, Warnings, error) {
-				return []byte{'d', 'r', 'o', 'p'}, Warnings{"some-warning"}, errors.New("some-error")
-			})
+			client.CloudControllerURL = "https://api.example.com"
 		})
 
-		It("makes the correct request", func() {
-			Expect(requester.MakeRequestReceiveRawCallCount()).To(Equal(1))
-			requestType, requestParams, responseType := requester.MakeRequestReceiveRawArgsForCall(0)
-			Expect(requestType).To(Equal(internal.GetDropletBitsRequest))
-			Expect(requestParams).To(Equal(internal.Params{"droplet_guid": "some-droplet-guid"}))
-			Expect(responseType).To(Equal("application/json"))
-		})
-
-		It("returns the given droplet and all warnings", func() {
-			Expect(dropletBytes).To(Equal([]byte{'d', 'r', 'o', 'p'}))
-			Expect(warnings).To(ConsistOf("some-warning"))
-			Expect(executeErr).To(MatchError("some-error"))
+		It("returns the URL of the droplet bits", func() {
+			url, err := client.DropletDownloadURL("some-droplet-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url).To(Equal("https://api.example.com/v3/droplets/some-droplet-guid/download"))
 		})
 	})
 })
@@ -35,6 +35,7 @@ const (
 	DeleteSpaceQuotaRequest                                     = "DeleteSpaceQuota"
 	DeleteSpaceRequest                                          = "DeleteSpace"
 	DeleteSpaceQuotaFromSpaceRequest                            = "DeleteSpaceQuotaFromSpace"
+	DeleteSidecarRequest                                        = "DeleteSidecar"
 	DeleteUserRequest                                           = "DeleteUser"
 	GetRoutePolicyRequest                                       = "GetRoutePolicyRequest"
 	GetRoutePoliciesRequest                                     = "GetRoutePoliciesRequest"
@@ -47,8 +48,10 @@ const (
 	GetApplicationRevisionsRequest                              = "GetApplicationRevisions"
 	GetApplicationRevisionsDeployedRequest                      = "GetApplicationRevisionsDeployed"
 	GetApplicationRoutesRequest                                 = "GetApplicationRoutes"
+	GetApplicationSidecarsRequest                               = "GetApplicationSidecars"
 	GetApplicationTasksRequest                                  = "GetApplicationTasks"
 	GetApplicationsRequest                                      = "GetApplications"
+	GetAppUsageEventsRequest                                    = "GetAppUsageEvents"
 	GetBuildRequest                                             = "GetBuild"
 	GetBuildpacksRequest                                        = "GetBuildpacks"
 	GetDefaultDomainRequest                                     = "GetDefaultDomain"
@@ -95,6 +98,7 @@ const (
 	GetServiceInstanceSharedSpacesUsageSummaryRequest           = "GetServiceInstanceSharedSpacesUsageSummaryRequest"
 	GetServiceOfferingRequest                                   = "GetServiceOffering"
 	GetServiceOfferingsRequest                                  = "GetServiceOfferings"
+	GetServiceUsageEventsRequest                                = "GetServiceUsageEvents"
 	GetServicePlanRequest                                       = "GetServicePlan"
 	GetServicePlansRequest                                      = "GetServicePlans"
 	GetServicePlanVisibilityRequest                             = "GetServicePlanVisibility"
@@ -131,6 +135,7 @@ const (
 	PatchServiceInstanceRequest                                 = "PatchServiceInstance"
 	PatchServiceOfferingRequest                                 = "PatchServiceOfferingRequest"
 	PatchServicePlanRequest                                     = "PatchServicePlanRequest"
+	PatchSidecarRequest                                         = "PatchSidecar"
 	PatchSpaceRelationshipIsolationSegmentRequest               = "PatchSpaceRelationshipIsolationSegment"
 	PatchSpaceRequest                                           = "PatchSpace"
 	PatchSpaceFeaturesRequest                                   = "PatchSpaceFeatures"
@@ -148,6 +153,7 @@ const (
 	PostApplicationDeploymentRequest                            = "PostApplicationDeployment"
 	PostApplicationProcessActionScaleRequest                    = "PostApplicationProcessActionScale"
 	PostApplicationRequest                                      = "PostApplication"
+	PostApplicationSidecarsRequest                              = "PostApplicationSidecars"
 	PostApplicationTasksRequest                                 = "PostApplicationTasks"
 	PostBuildRequest                                            = "PostBuild"
 	PostBuildpackBitsRequest                                    = "PostBuildpackBits"
@@ -218,6 +224,8 @@ var APIRoutes = map[string]Route{
 	GetApplicationRevisionsRequest:                              {Path: "/v3/apps/:app_guid/revisions", Method: http.MethodGet},
 	GetApplicationRevisionsDeployedRequest:                      {Path: "/v3/apps/:app_guid/revisions/deployed", Method: http.MethodGet},
 	GetApplicationRoutesRequest:                                 {Path: "/v3/apps/:app_guid/routes", Method: http.MethodGet},
+	GetApplicationSidecarsRequest:                               {Path: "/v3/apps/:app_guid/sidecars", Method: http.MethodGet},
+	PostApplicationSidecarsRequest:                              {Path: "/v3/apps/:app_guid/sidecars", Method: http.MethodPost},
 	GetSSHEnabled:                                               {Path: "/v3/apps/:app_guid/ssh_enabled", Method: http.MethodGet},
 	GetApplicationTasksRequest:                                  {Path: "/v3/apps/:app_guid/tasks", Method: http.MethodGet},
 	PostApplicationTasksRequest:                                 {Path: "/v3/apps/:app_guid/tasks", Method: http.MethodPost},
@@ -249,6 +257,8 @@ var APIRoutes = map[string]Route{
 	GetEnvironmentVariableGroupRequest:                          {Path: "/v3/environment_variable_groups/:group_name", Method: http.MethodGet},
 	PatchEnvironmentVariableGroupRequest:                        {Path: "/v3/environment_variable_groups/:group_name", Method: http.MethodPatch},
 	GetEventsRequest:                                            {Path: "/v3/audit_events", Method: http.MethodGet},
+	GetAppUsageEventsRequest:                                    {Path: "/v3/app_usage_events", Method: http.MethodGet},
+	GetServiceUsageEventsRequest:                                {Path: "/v3/service_usage_events", Method: http.MethodGet},
 	GetFeatureFlagsRequest:                                      {Path: "/v3/feature_flags", Method: http.MethodGet},
 	GetFeatureFlagRequest:                                       {Path: "/v3/feature_flags/:name", Method: http.MethodGet},
 	PatchFeatureFlagRequest:                                     {Path: "/v3/feature_flags/:name", Method: http.MethodPatch},
@@ -339,6 +349,8 @@ var APIRoutes = map[string]Route{
 	PostRouteBindingRequest:                                     {Path: "/v3/service_route_bindings", Method: http.MethodPost},
 	GetRouteBindingsRequest:                                     {Path: "/v3/service_route_bindings", Method: http.MethodGet},
 	DeleteRouteBindingRequest:                                   {Path: "/v3/service_route_bindings/:route_binding_guid", Method: http.MethodDelete},
+	DeleteSidecarRequest:                                        {Path: "/v3/sidecars/:sidecar_guid", Method: http.MethodDelete},
+	PatchSidecarRequest:                                         {Path: "/v3/sidecars/:sidecar_guid", Method: http.MethodPatch},
 	GetSpacesRequest:                                            {Path: "/v3/spaces", Method: http.MethodGet},
 	PostSpaceRequest:                                            {Path: "/v3/spaces", Method: http.MethodPost},
 	DeleteSpaceRequest:                                          {Path: "/v3/spaces/:space_guid", Method: http.MethodDelete},
@@ -2,6 +2,10 @@ package ccv3
 
 import (
 	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
 
 	"code.cloudfoundry.org/cli/v9/api/cloudcontroller"
 )
@@ -23,6 +27,17 @@ func (requester RealRequester) paginate(request *cloudcontroller.Request, obj in
 			break
 		}
 
+		if pageURLs, ok := requester.remainingPageURLs(wrapper); ok {
+			remainingIncludes, warnings, err := requester.paginateConcurrently(pageURLs, obj, appendToExternalList)
+			fullWarningsList = append(fullWarningsList, warnings...)
+			if err != nil {
+				return IncludedResources{}, fullWarningsList, err
+			}
+
+			includes.Merge(remainingIncludes)
+			break
+		}
+
 		request, err = requester.newHTTPRequest(requestOptions{
 			URL:    wrapper.NextPage(),
 			Method: http.MethodGet,
@@ -35,20 +50,122 @@ func (requester RealRequester) paginate(request *cloudcontroller.Request, obj in
 	return includes, fullWarningsList, nil
 }
 
-func (requester RealRequester) wrapFirstPage(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (*PaginatedResources, Warnings, error) {
-	warnings := Warnings{}
-	wrapper := NewPaginatedResources(obj)
-	response := cloudcontroller.Response{
-		DecodeJSONResponseInto: &wrapper,
+type pageResult struct {
+	wrapper   *PaginatedResources
+	resources []interface{}
+	warnings  Warnings
+	err       error
+}
+
+// paginateConcurrently fetches the given pages with up to
+// paginationConcurrency requests in flight. Resources, included resources and
+// warnings are merged in page order, and pages after the first failing page
+// are discarded, so the outcome is the same as fetching the pages one after
+// another.
+func (requester RealRequester) paginateConcurrently(pageURLs []string, obj interface{}, appendToExternalList func(interface{}) error) (IncludedResources, Warnings, error) {
+	results := make([]pageResult, len(pageURLs))
+	pageIndexes := make(chan int)
+	var failed int32
+
+	workers := requester.paginationConcurrency
+	if workers > len(pageURLs) {
+		workers = len(pageURLs)
 	}
 
-	err := requester.connection.Make(request, &response)
-	warnings = append(warnings, response.Warnings...)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range pageIndexes {
+				results[index] = requester.fetchPageURL(pageURLs[index], obj)
+				if results[index].err != nil {
+					atomic.StoreInt32(&failed, 1)
+				}
+			}
+		}()
+	}
+
+	for index := range pageURLs {
+		if atomic.LoadInt32(&failed) == 1 {
+			break
+		}
+		pageIndexes <- index
+	}
+	close(pageIndexes)
+	wg.Wait()
+
+	fullWarningsList := Warnings{}
+	var includes IncludedResources
+
+	for _, result := range results {
+		fullWarningsList = append(fullWarningsList, result.warnings...)
+		if result.err != nil {
+			return IncludedResources{}, fullWarningsList, result.err
+		}
+
+		for _, item := range result.resources {
+			err := appendToExternalList(item)
+			if err != nil {
+				return IncludedResources{}, fullWarningsList, err
+			}
+		}
+
+		includes.Merge(result.wrapper.IncludedResources)
+	}
+
+	return includes, fullWarningsList, nil
+}
+
+func (requester RealRequester) fetchPageURL(pageURL string, obj interface{}) pageResult {
+	request, err := requester.newHTTPRequest(requestOptions{
+		URL:    pageURL,
+		Method: http.MethodGet,
+	})
 	if err != nil {
-		return nil, warnings, err
+		return pageResult{err: err}
 	}
 
-	list, err := wrapper.Resources()
+	wrapper, resources, warnings, err := requester.fetchPage(request, obj)
+	return pageResult{
+		wrapper:   wrapper,
+		resources: resources,
+		warnings:  warnings,
+		err:       err,
+	}
+}
+
+// remainingPageURLs returns the URLs of the pages after the given page, built
+// from its next page link and the total number of pages. It returns false if
+// pages are fetched sequentially or the URLs cannot be determined.
+func (requester RealRequester) remainingPageURLs(wrapper *PaginatedResources) ([]string, bool) {
+	if requester.paginationConcurrency <= 1 {
+		return nil, false
+	}
+
+	nextURL, err := url.Parse(wrapper.NextPage())
+	if err != nil {
+		return nil, false
+	}
+
+	query := nextURL.Query()
+	nextPage, err := strconv.Atoi(query.Get(string(Page)))
+	if err != nil || nextPage > wrapper.TotalPages() {
+		return nil, false
+	}
+
+	var pageURLs []string
+	for page := nextPage; page <= wrapper.TotalPages(); page++ {
+		query.Set(string(Page), strconv.Itoa(page))
+		nextURL.RawQuery = query.Encode()
+		pageURLs = append(pageURLs, nextURL.String())
+	}
+
+	return pageURLs, true
+}
+
+func (requester RealRequester) wrapFirstPage(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (*PaginatedResources, Warnings, error) {
+	wrapper, list, warnings, err := requester.fetchPage(request, obj)
 	if err != nil {
 		return nil, warnings, err
 	}
@@ -62,3 +179,24 @@ func (requester RealRequester) wrapFirstPage(request *cloudcontroller.Request, o
 
 	return wrapper, warnings, nil
 }
+
+func (requester RealRequester) fetchPage(request *cloudcontroller.Request, obj interface{}) (*PaginatedResources, []interface{}, Warnings, error) {
+	warnings := Warnings{}
+	wrapper := NewPaginatedResources(obj)
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &wrapper,
+	}
+
+	err := requester.connection.Make(request, &response)
+	warnings = append(warnings, response.Warnings...)
+	if err != nil {
+		return nil, nil, warnings, err
+	}
+
+	list, err := wrapper.Resources()
+	if err != nil {
+		return nil, nil, warnings, err
+	}
+
+	return wrapper, list, warnings, nil
+}
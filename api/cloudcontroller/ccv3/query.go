@@ -11,8 +11,20 @@ type QueryKey string
 const (
 	// AppGUIDFilter is a query parameter for listing objects by app GUID.
 	AppGUIDFilter QueryKey = "app_guids"
+	// AfterGUIDFilter is a query parameter for listing usage events recorded
+	// after the event with the given GUID.
+	AfterGUIDFilter QueryKey = "after_guid"
+	// AuditEventTypesFilter is a query parameter for listing audit events by
+	// event type.
+	AuditEventTypesFilter QueryKey = "types"
 	// AvailableFilter is a query parameter for listing available resources
 	AvailableFilter QueryKey = "available"
+	// CreatedAtsGTEFilter is a query parameter for listing objects created at
+	// or after the given timestamp.
+	CreatedAtsGTEFilter QueryKey = "created_ats[gte]"
+	// CreatedAtsLTFilter is a query parameter for listing objects created
+	// before the given timestamp.
+	CreatedAtsLTFilter QueryKey = "created_ats[lt]"
 	// GUIDFilter is a query parameter for listing objects by GUID.
 	GUIDFilter QueryKey = "guids"
 	// LabelSelectorFilter is a query parameter for listing objects by label
@@ -122,6 +134,10 @@ const (
 	// used in conjunction with the OrderBy QueryKey.
 	PositionOrder = "position"
 
+	// CreatedAtAscendingOrder is a query value for ordering by created_at
+	// timestamp, in ascending order.
+	CreatedAtAscendingOrder = "created_at"
+
 	// CreatedAtDescendingOrder is a query value for ordering by created_at timestamp,
 	// in descending order.
 	CreatedAtDescendingOrder = "-created_at"
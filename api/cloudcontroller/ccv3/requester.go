@@ -54,6 +54,8 @@ type Requester interface {
 		requestBody []byte,
 	) ([]byte, *http.Response, error)
 
+	MakeStreamingRequest(request *http.Request) (*http.Response, error)
+
 	MakeRequestUploadAsync(
 		requestName string,
 		uriParams internal.Params,
@@ -68,10 +70,11 @@ type Requester interface {
 }
 
 type RealRequester struct {
-	connection cloudcontroller.Connection
-	router     *internal.Router
-	userAgent  string
-	wrappers   []ConnectionWrapper
+	connection            cloudcontroller.Connection
+	paginationConcurrency int
+	router                *internal.Router
+	userAgent             string
+	wrappers              []ConnectionWrapper
 }
 
 func (requester *RealRequester) InitializeConnection(settings TargetSettings) {
@@ -194,6 +197,26 @@ func (requester *RealRequester) MakeRequestSendRaw(
 	return response.ResourceLocationURL, response.Warnings, err
 }
 
+// MakeStreamingRequest sends the request through the connection and its
+// wrappers and returns the response whatever its status code. The body of a
+// successful response is left for the caller to read and close.
+func (requester *RealRequester) MakeStreamingRequest(request *http.Request) (*http.Response, error) {
+	if request.Header.Get("User-Agent") == "" {
+		request.Header.Set("User-Agent", requester.userAgent)
+	}
+
+	response := cloudcontroller.Response{StreamBody: true}
+	err := requester.connection.Make(cloudcontroller.NewRequest(request, nil), &response)
+	if response.HTTPResponse == nil {
+		return nil, err
+	}
+
+	if err != nil {
+		response.HTTPResponse.Body = io.NopCloser(bytes.NewReader(response.RawResponse))
+	}
+	return response.HTTPResponse, nil
+}
+
 func (requester *RealRequester) MakeRequestUploadAsync(
 	requestName string,
 	uriParams internal.Params,
@@ -229,8 +252,9 @@ func NewRequester(config Config) *RealRequester {
 	)
 
 	return &RealRequester{
-		userAgent: userAgent,
-		wrappers:  append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
+		paginationConcurrency: config.PaginationConcurrency,
+		userAgent:             userAgent,
+		wrappers:              append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
 	}
 }
 
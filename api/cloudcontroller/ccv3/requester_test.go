@@ -1034,6 +1034,84 @@ var _ = Describe("shared request helpers", func() {
 				})
 			})
 		})
+		Context("with pagination concurrency", func() {
+			var (
+				resourceList []Role
+				failingPage  string
+			)
+
+			BeforeEach(func() {
+				client, _ = NewTestClient(Config{AppName: "CF CLI API V3 Test", AppVersion: "Unknown", PaginationConcurrency: 3})
+
+				resourceList = []Role{}
+				failingPage = ""
+				requestParams = RequestParams{
+					RequestName:  internal.GetRolesRequest,
+					Query:        []Query{{Key: Include, Values: []string{"users"}}},
+					ResponseBody: Role{},
+					AppendToList: func(item interface{}) error {
+						resourceList = append(resourceList, item.(Role))
+						return nil
+					},
+				}
+
+				server.RouteToHandler(http.MethodGet, "/v3/roles", func(w http.ResponseWriter, req *http.Request) {
+					page := req.URL.Query().Get("page")
+					if page == "" {
+						page = "1"
+					}
+					Expect(req.URL.Query().Get("include")).To(Equal("users"))
+
+					w.Header().Set("X-Cf-Warnings", "warning-"+page)
+					if page == failingPage {
+						w.WriteHeader(http.StatusNotFound)
+						_, err := w.Write([]byte(`{"errors": [{"code": 10010, "detail": "page failed", "title": "CF-ResourceNotFound"}]}`))
+						Expect(err).ToNot(HaveOccurred())
+						return
+					}
+
+					next := "null"
+					if page != "4" {
+						next = fmt.Sprintf(`{"href": "%s/v3/roles?include=users&page=2&per_page=1"}`, server.URL())
+					}
+					_, err := fmt.Fprintf(w, `{
+						"pagination": {"total_pages": 4, "next": %s},
+						"resources": [{"guid": "role-guid-%[2]s", "type": "organization_user"}],
+						"included": {"users": [{"guid": "user-guid-%[2]s"}]}
+					}`, next, page)
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+
+			It("fetches the remaining pages and merges them in page order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(server.ReceivedRequests()).To(HaveLen(4))
+				Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3", "warning-4"}))
+				Expect(resourceList).To(Equal([]Role{
+					{GUID: "role-guid-1", Type: constant.OrgUserRole},
+					{GUID: "role-guid-2", Type: constant.OrgUserRole},
+					{GUID: "role-guid-3", Type: constant.OrgUserRole},
+					{GUID: "role-guid-4", Type: constant.OrgUserRole},
+				}))
+				Expect(includedResources.Users).To(Equal([]User{
+					{GUID: "user-guid-1"},
+					{GUID: "user-guid-2"},
+					{GUID: "user-guid-3"},
+					{GUID: "user-guid-4"},
+				}))
+			})
+
+			When("a page fails", func() {
+				BeforeEach(func() {
+					failingPage = "3"
+				})
+
+				It("returns the error and the warnings up to the failing page", func() {
+					Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "page failed"}))
+					Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3"}))
+				})
+			})
+		})
 	})
 
 	Describe("MakeRequestReceiveRaw", func() {
@@ -84,14 +84,24 @@ var _ = Describe("Sidecar", func() {
 				Expect(warnings).To(ConsistOf("this is a warning"))
 				Expect(len(processSidecars)).To(Equal(2))
 				Expect(processSidecars[0]).To(MatchAllFields(Fields{
-					"GUID":    Equal("process-1-guid"),
-					"Name":    Equal("auth-sidecar"),
-					"Command": Equal(types.FilteredString{IsSet: true, Value: "bundle exec rackup"}),
+					"GUID":         Equal("process-1-guid"),
+					"Name":         Equal("auth-sidecar"),
+					"Command":      Equal(types.FilteredString{IsSet: true, Value: "bundle exec rackup"}),
+					"ProcessTypes": Equal([]string{"web", "worker"}),
+					"MemoryInMB":   Equal(types.NullUint64{IsSet: true, Value: 300}),
+					"UnsetMemory":  BeFalse(),
+					"Origin":       BeEmpty(),
+					"AppGUID":      Equal("process-1-guid"),
 				}))
 				Expect(processSidecars[1]).To(MatchAllFields(Fields{
-					"GUID":    Equal("process-2-guid"),
-					"Name":    Equal("echo-sidecar"),
-					"Command": Equal(types.FilteredString{IsSet: true, Value: "start-echo-server"}),
+					"GUID":         Equal("process-2-guid"),
+					"Name":         Equal("echo-sidecar"),
+					"Command":      Equal(types.FilteredString{IsSet: true, Value: "start-echo-server"}),
+					"ProcessTypes": Equal([]string{"web"}),
+					"MemoryInMB":   Equal(types.NullUint64{IsSet: true, Value: 300}),
+					"UnsetMemory":  BeFalse(),
+					"Origin":       BeEmpty(),
+					"AppGUID":      Equal("process-2-guid"),
 				}))
 			})
 		})
@@ -185,4 +195,287 @@ var _ = Describe("Sidecar", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationSidecars", func() {
+		var (
+			sidecars []resources.Sidecar
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			sidecars, warnings, err = client.GetApplicationSidecars("some-app-guid")
+		})
+
+		When("the app has sidecars", func() {
+			BeforeEach(func() {
+				response := `{
+					"pagination": {"next": null},
+					"resources": [
+						{
+							"guid": "sidecar-1-guid",
+							"name": "apm",
+							"command": "start-apm",
+							"process_types": ["web"],
+							"memory_in_mb": 128,
+							"origin": "user",
+							"relationships": {"app": {"data": {"guid": "some-app-guid"}}}
+						},
+						{
+							"guid": "sidecar-2-guid",
+							"name": "envoy",
+							"command": "start-envoy",
+							"process_types": ["web", "worker"],
+							"memory_in_mb": null,
+							"origin": "buildpack",
+							"relationships": {"app": {"data": {"guid": "some-app-guid"}}}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/sidecars"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the sidecars and all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(sidecars).To(Equal([]resources.Sidecar{
+					{
+						GUID:         "sidecar-1-guid",
+						Name:         "apm",
+						Command:      types.FilteredString{IsSet: true, Value: "start-apm"},
+						ProcessTypes: []string{"web"},
+						MemoryInMB:   types.NullUint64{IsSet: true, Value: 128},
+						Origin:       "user",
+						AppGUID:      "some-app-guid",
+					},
+					{
+						GUID:         "sidecar-2-guid",
+						Name:         "envoy",
+						Command:      types.FilteredString{IsSet: true, Value: "start-envoy"},
+						ProcessTypes: []string{"web", "worker"},
+						Origin:       "buildpack",
+						AppGUID:      "some-app-guid",
+					},
+				}))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound",
+							"code": 10010
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/sidecars"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an error and warnings", func() {
+				Expect(err).To(MatchError(ccerror.ApplicationNotFoundError{}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("CreateApplicationSidecar", func() {
+		var (
+			sidecar  resources.Sidecar
+			created  resources.Sidecar
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			sidecar = resources.Sidecar{
+				Name:         "apm",
+				Command:      types.FilteredString{IsSet: true, Value: "start-apm"},
+				ProcessTypes: []string{"web", "worker"},
+				MemoryInMB:   types.NullUint64{IsSet: true, Value: 128},
+			}
+		})
+
+		JustBeforeEach(func() {
+			created, warnings, err = client.CreateApplicationSidecar("some-app-guid", sidecar)
+		})
+
+		When("the sidecar is created", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "sidecar-guid",
+					"name": "apm",
+					"command": "start-apm",
+					"process_types": ["web", "worker"],
+					"memory_in_mb": 128,
+					"origin": "user",
+					"relationships": {"app": {"data": {"guid": "some-app-guid"}}}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/sidecars"),
+						VerifyJSON(`{
+							"name": "apm",
+							"command": "start-apm",
+							"process_types": ["web", "worker"],
+							"memory_in_mb": 128
+						}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created sidecar and all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(created.GUID).To(Equal("sidecar-guid"))
+				Expect(created.Origin).To(Equal("user"))
+				Expect(created.AppGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		When("the memory is not set", func() {
+			BeforeEach(func() {
+				sidecar.MemoryInMB = types.NullUint64{}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/sidecars"),
+						VerifyJSON(`{
+							"name": "apm",
+							"command": "start-apm",
+							"process_types": ["web", "worker"]
+						}`),
+						RespondWith(http.StatusCreated, `{"guid": "sidecar-guid"}`),
+					),
+				)
+			})
+
+			It("does not send the memory", func() {
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Sidecar with name 'apm' already exists for given app",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/sidecars"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{Message: "Sidecar with name 'apm' already exists for given app"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateSidecar", func() {
+		var (
+			sidecar  resources.Sidecar
+			updated  resources.Sidecar
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			sidecar = resources.Sidecar{
+				GUID:       "sidecar-guid",
+				MemoryInMB: types.NullUint64{IsSet: true, Value: 256},
+			}
+		})
+
+		JustBeforeEach(func() {
+			updated, warnings, err = client.UpdateSidecar(sidecar)
+		})
+
+		When("the sidecar is updated", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/sidecars/sidecar-guid"),
+						VerifyJSON(`{"memory_in_mb": 256}`),
+						RespondWith(http.StatusOK, `{"guid": "sidecar-guid", "name": "apm", "memory_in_mb": 256}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("sends only the set fields and returns the updated sidecar", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(updated.Name).To(Equal("apm"))
+				Expect(updated.MemoryInMB).To(Equal(types.NullUint64{IsSet: true, Value: 256}))
+			})
+		})
+
+		When("the memory is unset", func() {
+			BeforeEach(func() {
+				sidecar = resources.Sidecar{GUID: "sidecar-guid", UnsetMemory: true}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/sidecars/sidecar-guid"),
+						VerifyJSON(`{"memory_in_mb": null}`),
+						RespondWith(http.StatusOK, `{"guid": "sidecar-guid", "name": "apm", "memory_in_mb": null}`),
+					),
+				)
+			})
+
+			It("sends an explicit null for the memory", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updated.MemoryInMB).To(Equal(types.NullUint64{}))
+			})
+		})
+	})
+
+	Describe("DeleteSidecar", func() {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			warnings, err = client.DeleteSidecar("sidecar-guid")
+		})
+
+		When("the sidecar is deleted", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/sidecars/sidecar-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
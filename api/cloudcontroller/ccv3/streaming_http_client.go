@@ -0,0 +1,32 @@
+package ccv3
+
+import "net/http"
+
+// StreamingHTTPClient sends requests for files served by the Cloud Controller
+// through the client's connection, so that they are logged, traced and retried
+// like any other request. It leaves the response body for the caller to read.
+type StreamingHTTPClient struct {
+	requester Requester
+}
+
+// StreamingHTTPClient returns a StreamingHTTPClient that uses the client's
+// connection.
+func (client *Client) StreamingHTTPClient() StreamingHTTPClient {
+	return StreamingHTTPClient{requester: client.Requester}
+}
+
+// Do sends the request and returns the response, including responses with an
+// error status code.
+func (client StreamingHTTPClient) Do(request *http.Request) (*http.Response, error) {
+	return client.requester.MakeStreamingRequest(request)
+}
+
+// Get sends a GET request for url.
+func (client StreamingHTTPClient) Get(url string) (*http.Response, error) {
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(request)
+}
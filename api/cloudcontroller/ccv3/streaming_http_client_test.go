@@ -0,0 +1,77 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+
+	. "code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("StreamingHTTPClient", func() {
+	var (
+		client   *Client
+		response *http.Response
+		body     string
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v3/droplets/some-guid/download", server.URL()), nil)
+		Expect(err).ToNot(HaveOccurred())
+		request.Header.Set("Range", "bytes=5-")
+
+		response, executeErr = client.StreamingHTTPClient().Do(request)
+		if response != nil {
+			defer response.Body.Close()
+			rawBody, err := io.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			body = string(rawBody)
+		}
+	})
+
+	When("the request succeeds", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/droplets/some-guid/download"),
+					VerifyHeaderKV("Range", "bytes=5-"),
+					VerifyHeaderKV("User-Agent", fmt.Sprintf("CF CLI API V3 Test/Unknown (%s; %s %s)", runtime.Version(), runtime.GOARCH, runtime.GOOS)),
+					RespondWith(http.StatusPartialContent, "some-bits"),
+				),
+			)
+		})
+
+		It("returns the response with its body", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusPartialContent))
+			Expect(body).To(Equal("some-bits"))
+		})
+	})
+
+	When("the server responds with an error status code", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/droplets/some-guid/download"),
+					RespondWith(http.StatusRequestedRangeNotSatisfiable, "some-error"),
+				),
+			)
+		})
+
+		It("returns the response instead of an error", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusRequestedRangeNotSatisfiable))
+			Expect(body).To(Equal("some-error"))
+		})
+	})
+})
@@ -0,0 +1,42 @@
+package ccv3
+
+import (
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/v9/resources"
+)
+
+// GetAppUsageEvents uses the /v3/app_usage_events endpoint to retrieve a list
+// of app usage events.
+func (client *Client) GetAppUsageEvents(query ...Query) ([]resources.AppUsageEvent, Warnings, error) {
+	var events []resources.AppUsageEvent
+
+	_, warnings, err := client.MakeListRequest(RequestParams{
+		RequestName:  internal.GetAppUsageEventsRequest,
+		Query:        query,
+		ResponseBody: resources.AppUsageEvent{},
+		AppendToList: func(item interface{}) error {
+			events = append(events, item.(resources.AppUsageEvent))
+			return nil
+		},
+	})
+
+	return events, warnings, err
+}
+
+// GetServiceUsageEvents uses the /v3/service_usage_events endpoint to
+// retrieve a list of service usage events.
+func (client *Client) GetServiceUsageEvents(query ...Query) ([]resources.ServiceUsageEvent, Warnings, error) {
+	var events []resources.ServiceUsageEvent
+
+	_, warnings, err := client.MakeListRequest(RequestParams{
+		RequestName:  internal.GetServiceUsageEventsRequest,
+		Query:        query,
+		ResponseBody: resources.ServiceUsageEvent{},
+		AppendToList: func(item interface{}) error {
+			events = append(events, item.(resources.ServiceUsageEvent))
+			return nil
+		},
+	})
+
+	return events, warnings, err
+}
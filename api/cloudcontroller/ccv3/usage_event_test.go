@@ -0,0 +1,212 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/v9/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Usage Events", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetAppUsageEvents", func() {
+		var (
+			events     []resources.AppUsageEvent
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = client.GetAppUsageEvents(
+				Query{Key: AfterGUIDFilter, Values: []string{"some-cursor-guid"}},
+				Query{Key: CreatedAtsLTFilter, Values: []string{"2024-03-01T00:00:00Z"}},
+				Query{Key: PerPage, Values: []string{"2"}},
+				Query{Key: Page, Values: []string{"1"}},
+			)
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				response := fmt.Sprintf(`{
+  "pagination": {
+    "next": {
+      "href": "%s/v3/app_usage_events?after_guid=some-cursor-guid&page=2&per_page=2"
+    }
+  },
+  "resources": [
+    {
+      "guid": "event-guid-1",
+      "created_at": "2024-02-01T10:00:00Z",
+      "state": { "current": "STARTED", "previous": "STOPPED" },
+      "app": { "guid": "app-guid", "name": "some-app" },
+      "process": { "guid": "process-guid", "type": "web" },
+      "space": { "guid": "space-guid", "name": "some-space" },
+      "organization": { "guid": "org-guid" },
+      "buildpack": { "guid": "buildpack-guid", "name": "ruby_buildpack" },
+      "task": { "guid": null, "name": null },
+      "memory_in_mb_per_instance": { "current": 512, "previous": 256 },
+      "instance_count": { "current": 3, "previous": 1 }
+    },
+    {
+      "guid": "event-guid-2",
+      "created_at": "2024-02-01T11:00:00Z",
+      "state": { "current": "TASK_STARTED", "previous": null },
+      "app": { "guid": "app-guid", "name": "some-app" },
+      "process": { "guid": null, "type": null },
+      "space": { "guid": "space-guid", "name": "some-space" },
+      "organization": { "guid": "org-guid" },
+      "buildpack": { "guid": null, "name": null },
+      "task": { "guid": "task-guid", "name": "migrate" },
+      "memory_in_mb_per_instance": { "current": 128, "previous": null },
+      "instance_count": { "current": 1, "previous": null }
+    }
+  ]
+}`, server.URL())
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/app_usage_events", "after_guid=some-cursor-guid&created_ats%5Blt%5D=2024-03-01T00:00:00Z&per_page=2&page=1"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the events of the requested page only", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(events).To(Equal([]resources.AppUsageEvent{
+					{
+						GUID:                          "event-guid-1",
+						CreatedAt:                     time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC),
+						State:                         "STARTED",
+						PreviousState:                 "STOPPED",
+						AppGUID:                       "app-guid",
+						AppName:                       "some-app",
+						ProcessGUID:                   "process-guid",
+						ProcessType:                   "web",
+						SpaceGUID:                     "space-guid",
+						SpaceName:                     "some-space",
+						OrganizationGUID:              "org-guid",
+						BuildpackName:                 "ruby_buildpack",
+						InstanceCount:                 3,
+						PreviousInstanceCount:         1,
+						MemoryInMBPerInstance:         512,
+						PreviousMemoryInMBPerInstance: 256,
+					},
+					{
+						GUID:                  "event-guid-2",
+						CreatedAt:             time.Date(2024, 2, 1, 11, 0, 0, 0, time.UTC),
+						State:                 "TASK_STARTED",
+						AppGUID:               "app-guid",
+						AppName:               "some-app",
+						TaskGUID:              "task-guid",
+						TaskName:              "migrate",
+						SpaceGUID:             "space-guid",
+						SpaceName:             "some-space",
+						OrganizationGUID:      "org-guid",
+						InstanceCount:         1,
+						MemoryInMBPerInstance: 128,
+					},
+				}))
+			})
+		})
+
+		When("the request fails", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10003,
+      "detail": "You are not authorized to perform the requested action",
+      "title": "CF-NotAuthorized"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/app_usage_events"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ForbiddenError{Message: "You are not authorized to perform the requested action"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetServiceUsageEvents", func() {
+		var (
+			events     []resources.ServiceUsageEvent
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = client.GetServiceUsageEvents(
+				Query{Key: CreatedAtsGTEFilter, Values: []string{"2024-02-01T00:00:00Z"}},
+				Query{Key: Page, Values: []string{"1"}},
+			)
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+  "pagination": { "next": null },
+  "resources": [
+    {
+      "guid": "event-guid",
+      "created_at": "2024-02-01T10:00:00Z",
+      "state": "CREATED",
+      "space": { "guid": "space-guid", "name": "some-space" },
+      "organization": { "guid": "org-guid" },
+      "service_instance": { "guid": "instance-guid", "name": "some-db", "type": "managed_service_instance" },
+      "service_plan": { "guid": "plan-guid", "name": "small" },
+      "service_offering": { "guid": "offering-guid", "name": "postgres" },
+      "service_broker": { "guid": "broker-guid", "name": "some-broker" }
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_usage_events", "created_ats%5Bgte%5D=2024-02-01T00:00:00Z&page=1"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the events", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(events).To(Equal([]resources.ServiceUsageEvent{
+					{
+						GUID:                "event-guid",
+						CreatedAt:           time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC),
+						State:               "CREATED",
+						SpaceGUID:           "space-guid",
+						SpaceName:           "some-space",
+						OrganizationGUID:    "org-guid",
+						ServiceInstanceGUID: "instance-guid",
+						ServiceInstanceName: "some-db",
+						ServiceInstanceType: "managed_service_instance",
+						ServicePlanName:     "small",
+						ServiceOfferingName: "postgres",
+						ServiceBrokerName:   "some-broker",
+					},
+				}))
+			})
+		})
+	})
+})
@@ -55,13 +55,22 @@ func (connection *CloudControllerConnection) Make(request *Request, passedRespon
 	if err != nil {
 		return connection.processRequestErrors(request.Request, err)
 	}
-	defer response.Body.Close()
 
-	return connection.populateResponse(response, passedResponse)
+	err = connection.populateResponse(response, passedResponse)
+	if err != nil || !isStreamed(response, passedResponse) {
+		response.Body.Close()
+	}
+	return err
+}
+
+func isStreamed(response *http.Response, passedResponse *Response) bool {
+	return passedResponse.StreamBody && response.StatusCode < http.StatusBadRequest
 }
 
 func (*CloudControllerConnection) handleStatusCodes(response *http.Response, passedResponse *Response) error {
-	if response.StatusCode == http.StatusNoContent {
+	if isStreamed(response, passedResponse) {
+		return nil
+	} else if response.StatusCode == http.StatusNoContent {
 		passedResponse.RawResponse = []byte("{}")
 	} else {
 		rawBytes, err := io.ReadAll(response.Body)
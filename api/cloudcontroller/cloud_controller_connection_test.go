@@ -2,6 +2,7 @@ package cloudcontroller_test
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"runtime"
 	"strings"
@@ -111,6 +112,62 @@ var _ = Describe("Cloud Controller Connection", func() {
 			})
 		})
 
+		Describe("Streamed Body", func() {
+			var request *Request
+
+			BeforeEach(func() {
+				req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+				Expect(err).ToNot(HaveOccurred())
+				request = &Request{Request: req}
+			})
+
+			When("the request succeeds", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/foo"),
+							RespondWith(http.StatusOK, "some-bits"),
+						),
+					)
+				})
+
+				It("leaves the body for the caller to read", func() {
+					response := Response{StreamBody: true}
+
+					err := connection.Make(request, &response)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.RawResponse).To(BeEmpty())
+
+					defer response.HTTPResponse.Body.Close()
+					body, err := io.ReadAll(response.HTTPResponse.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal("some-bits"))
+				})
+			})
+
+			When("the request fails", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/foo"),
+							RespondWith(http.StatusForbidden, "some-error"),
+						),
+					)
+				})
+
+				It("reads the body into the RawHTTPStatusError", func() {
+					response := Response{StreamBody: true}
+
+					err := connection.Make(request, &response)
+					Expect(err).To(MatchError(ccerror.RawHTTPStatusError{
+						StatusCode:  http.StatusForbidden,
+						RawResponse: []byte("some-error"),
+					}))
+					Expect(response.RawResponse).To(Equal([]byte("some-error")))
+				})
+			})
+		})
+
 		Describe("Response Headers", func() {
 			Describe("Location", func() {
 				BeforeEach(func() {
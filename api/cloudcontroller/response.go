@@ -20,6 +20,11 @@ type Response struct {
 
 	// ResourceLocationURL represents the Location header value
 	ResourceLocationURL string
+
+	// StreamBody leaves the body of a successful response unread in
+	// HTTPResponse, for the caller to read and close, instead of reading it
+	// into RawResponse.
+	StreamBody bool
 }
 
 func (r *Response) reset() {
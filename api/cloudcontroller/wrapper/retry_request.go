@@ -2,28 +2,44 @@ package wrapper
 
 import (
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/cli/v9/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/v9/api/shared"
 )
 
 // RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// or 429 status code, or if no response was received. Retries back off
+// exponentially and honor the rate limit headers sent by the server.
 type RetryRequest struct {
-	maxRetries int
-	connection cloudcontroller.Connection
+	maxRetries   int
+	maxRetryTime time.Duration
+	clock        shared.Clock
+	connection   cloudcontroller.Connection
 }
 
-// NewRetryRequest returns a pointer to a RetryRequest wrapper.
-func NewRetryRequest(maxRetries int) *RetryRequest {
+// NewRetryRequest returns a pointer to a RetryRequest wrapper. A request is
+// retried at most maxRetries times, and not after maxRetryTime has passed.
+func NewRetryRequest(maxRetries int, maxRetryTime time.Duration) *RetryRequest {
+	return NewRetryRequestWithClock(maxRetries, maxRetryTime, shared.RealClock{})
+}
+
+// NewRetryRequestWithClock returns a pointer to a RetryRequest wrapper that
+// uses the given clock to wait between retries.
+func NewRetryRequestWithClock(maxRetries int, maxRetryTime time.Duration, clock shared.Clock) *RetryRequest {
 	return &RetryRequest{
-		maxRetries: maxRetries,
+		maxRetries:   maxRetries,
+		maxRetryTime: maxRetryTime,
+		clock:        clock,
 	}
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// Make retries the request if it comes back with a 5XX or 429 status code or
+// no response is received.
 func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
 	var err error
+	backoff := shared.NewRetryBackoff(retry.clock, retry.maxRetryTime)
 
 	for i := 0; i < retry.maxRetries+1; i++ {
 		err = retry.connection.Make(request, passedResponse)
@@ -31,7 +47,7 @@ func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse
 			return nil
 		}
 
-		if retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
+		if i == retry.maxRetries || retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
 			break
 		}
 
@@ -43,6 +59,10 @@ func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse
 			}
 			return resetErr
 		}
+
+		if !backoff.Wait(passedResponse.HTTPResponse) {
+			break
+		}
 	}
 	return err
 }
@@ -55,10 +75,18 @@ func (retry *RetryRequest) Wrap(innerconnection cloudcontroller.Connection) clou
 
 // skipRetry will skip retry if the request method is POST or contains a status
 // code that is not one of following http status codes: 500, 502, 503, 504.
+// A 429 status code is only retried for idempotent requests.
 func (*RetryRequest) skipRetry(httpMethod string, response *http.Response) bool {
+	if response == nil {
+		return httpMethod == http.MethodPost
+	}
+
+	if response.StatusCode == http.StatusTooManyRequests {
+		return !shared.IsIdempotentMethod(httpMethod)
+	}
+
 	return httpMethod == http.MethodPost ||
-		response != nil &&
-			response.StatusCode != http.StatusInternalServerError &&
+		response.StatusCode != http.StatusInternalServerError &&
 			response.StatusCode != http.StatusBadGateway &&
 			response.StatusCode != http.StatusServiceUnavailable &&
 			response.StatusCode != http.StatusGatewayTimeout
@@ -4,18 +4,29 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
+	"syscall"
+	"time"
 
 	"code.cloudfoundry.org/cli/v9/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/cloudcontrollerfakes"
 	. "code.cloudfoundry.org/cli/v9/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/v9/api/shared/sharedfakes"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("Retry Request", func() {
+	var fakeClock *sharedfakes.FakeClock
+
+	BeforeEach(func() {
+		fakeClock = new(sharedfakes.FakeClock)
+		fakeClock.NowReturns(time.Unix(1700000000, 0))
+	})
+
 	DescribeTable("number of retries",
 		func(requestMethod string, responseStatusCode int, expectedNumberOfRetries int) {
 			rawRequestBody := "banana pants"
@@ -43,7 +54,7 @@ var _ = Describe("Retry Request", func() {
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper := NewRetryRequestWithClock(2, time.Minute, fakeClock).Wrap(fakeConnection)
 			err = wrapper.Make(request, response)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
@@ -59,6 +70,11 @@ var _ = Describe("Retry Request", func() {
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
 
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
+		Entry("maxRetries for Put (429) Too Many Requests", http.MethodPut, http.StatusTooManyRequests, 3),
+		Entry("1 for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 1),
+		Entry("1 for Patch (429) Too Many Requests", http.MethodPatch, http.StatusTooManyRequests, 1),
+
 		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
 
@@ -73,13 +89,100 @@ var _ = Describe("Retry Request", func() {
 		}
 
 		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
-		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+		wrapper := NewRetryRequestWithClock(2, time.Minute, fakeClock).Wrap(fakeConnection)
 
 		err = wrapper.Make(request, response)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 	})
 
+	Describe("waiting between retries", func() {
+		var (
+			request        *cloudcontroller.Request
+			response       *cloudcontroller.Response
+			fakeConnection *cloudcontrollerfakes.FakeConnection
+		)
+
+		BeforeEach(func() {
+			req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			request = cloudcontroller.NewRequest(req, nil)
+			response = &cloudcontroller.Response{
+				HTTPResponse: &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Header:     http.Header{},
+				},
+			}
+
+			fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+			fakeConnection.MakeReturns(ccerror.RawHTTPStatusError{StatusCode: http.StatusServiceUnavailable})
+		})
+
+		It("backs off exponentially with jitter", func() {
+			wrapper := NewRetryRequestWithClock(3, time.Minute, fakeClock).Wrap(fakeConnection)
+			Expect(wrapper.Make(request, response)).To(HaveOccurred())
+
+			Expect(fakeClock.SleepCallCount()).To(Equal(3))
+			Expect(fakeClock.SleepArgsForCall(0)).To(BeNumerically("~", 375*time.Millisecond, 125*time.Millisecond))
+			Expect(fakeClock.SleepArgsForCall(1)).To(BeNumerically("~", 750*time.Millisecond, 250*time.Millisecond))
+			Expect(fakeClock.SleepArgsForCall(2)).To(BeNumerically("~", 1500*time.Millisecond, 500*time.Millisecond))
+		})
+
+		When("the response has a Retry-After header", func() {
+			BeforeEach(func() {
+				response.HTTPResponse.StatusCode = http.StatusTooManyRequests
+				response.HTTPResponse.Header.Set("Retry-After", "7")
+				fakeConnection.MakeReturnsOnCall(1, nil)
+			})
+
+			It("waits as long as the server asks", func() {
+				wrapper := NewRetryRequestWithClock(3, time.Minute, fakeClock).Wrap(fakeConnection)
+				Expect(wrapper.Make(request, response)).To(Succeed())
+
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				Expect(fakeClock.SleepCallCount()).To(Equal(1))
+				Expect(fakeClock.SleepArgsForCall(0)).To(Equal(7 * time.Second))
+			})
+		})
+
+		When("retrying would exceed the max retry time", func() {
+			BeforeEach(func() {
+				response.HTTPResponse.StatusCode = http.StatusTooManyRequests
+				response.HTTPResponse.Header.Set("X-RateLimit-Reset", "1700000120")
+			})
+
+			It("gives up without waiting", func() {
+				wrapper := NewRetryRequestWithClock(3, time.Minute, fakeClock).Wrap(fakeConnection)
+				Expect(wrapper.Make(request, response)).To(HaveOccurred())
+
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				Expect(fakeClock.SleepCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	DescribeTable("requests that fail without a response",
+		func(requestMethod string, requestErr error, expectedNumberOfRetries int) {
+			req, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			request := cloudcontroller.NewRequest(req, nil)
+			response := &cloudcontroller.Response{}
+
+			fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+			fakeConnection.MakeReturns(requestErr)
+
+			wrapper := NewRetryRequestWithClock(2, time.Minute, fakeClock).Wrap(fakeConnection)
+			Expect(wrapper.Make(request, response)).To(MatchError(requestErr))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
+		},
+
+		Entry("connection reset, GET", http.MethodGet, ccerror.RequestError{Err: &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: syscall.ECONNRESET}}, 3),
+		Entry("timeout, GET", http.MethodGet, ccerror.RequestError{Err: &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: errors.New("i/o timeout")}}, 3),
+		Entry("timeout, PATCH", http.MethodPatch, ccerror.RequestError{Err: &url.Error{Op: "Patch", URL: "https://foo.bar.com/banana", Err: errors.New("i/o timeout")}}, 3),
+		Entry("connection reset, POST", http.MethodPost, ccerror.RequestError{Err: &url.Error{Op: "Post", URL: "https://foo.bar.com/banana", Err: syscall.ECONNRESET}}, 1),
+		Entry("timeout, POST", http.MethodPost, ccerror.RequestError{Err: &url.Error{Op: "Post", URL: "https://foo.bar.com/banana", Err: errors.New("i/o timeout")}}, 1),
+	)
+
 	When("a PipeSeekError is returned from ResetBody", func() {
 		var (
 			expectedErr error
@@ -105,7 +208,7 @@ var _ = Describe("Retry Request", func() {
 			expectedErr = errors.New("oh noes")
 			fakeConnection.MakeReturns(expectedErr)
 
-			wrapper = NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper = NewRetryRequestWithClock(2, time.Minute, fakeClock).Wrap(fakeConnection)
 		})
 
 		It("sets the err on PipeSeekError", func() {
@@ -2,6 +2,7 @@ package wrapper
 
 import (
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/SermoDigital/jose/jws"
@@ -27,6 +28,7 @@ type TokenCache interface {
 	RefreshToken() string
 	SetAccessToken(token string)
 	SetRefreshToken(token string)
+	PersistTokens() error
 }
 
 // UAAAuthentication wraps connections and adds authentication headers to all
@@ -35,6 +37,10 @@ type UAAAuthentication struct {
 	connection cloudcontroller.Connection
 	client     UAAClient
 	cache      TokenCache
+
+	// tokenMutex serializes access to the token cache, so that concurrent
+	// requests wait for a single token refresh.
+	tokenMutex sync.Mutex
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -50,14 +56,15 @@ func NewUAAAuthentication(client UAAClient, cache TokenCache) *UAAAuthentication
 // wrapped connection's Make. If the client is not set on the wrapper, it will
 // not add any header or handle any authentication errors.
 func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
-	if request.Header.Get("Authorization") == "" && (t.cache.AccessToken() != "" || t.cache.RefreshToken() != "") {
-		// assert a valid access token for authenticated requests
-		err := t.refreshTokenIfNecessary(t.cache.AccessToken())
+	if request.Header.Get("Authorization") == "" {
+		accessToken, err := t.validAccessToken()
 		if nil != err {
 			return err
 		}
 
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		if accessToken != "" {
+			request.Header.Set("Authorization", accessToken)
+		}
 	}
 
 	err := t.connection.Make(request, passedResponse)
@@ -75,6 +82,26 @@ func (t *UAAAuthentication) Wrap(innerconnection cloudcontroller.Connection) clo
 	return t
 }
 
+// validAccessToken returns the cached access token after refreshing it if
+// necessary, or an empty string if no user is logged in. Concurrent callers
+// wait for the refresh of the first one instead of refreshing the token again
+// with a refresh token that may no longer be valid.
+func (t *UAAAuthentication) validAccessToken() (string, error) {
+	t.tokenMutex.Lock()
+	defer t.tokenMutex.Unlock()
+
+	if t.cache.AccessToken() == "" && t.cache.RefreshToken() == "" {
+		return "", nil
+	}
+
+	err := t.refreshTokenIfNecessary(t.cache.AccessToken())
+	if err != nil {
+		return "", err
+	}
+
+	return t.cache.AccessToken(), nil
+}
+
 // refreshToken refreshes the JWT access token if it is expired or about to expire.
 // If the access token is not yet expired, no action is performed.
 func (t *UAAAuthentication) refreshTokenIfNecessary(accessToken string) error {
@@ -97,6 +124,10 @@ func (t *UAAAuthentication) refreshTokenIfNecessary(accessToken string) error {
 		}
 		t.cache.SetAccessToken(tokens.AuthorizationToken())
 		t.cache.SetRefreshToken(tokens.RefreshToken)
+		err = t.cache.PersistTokens()
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
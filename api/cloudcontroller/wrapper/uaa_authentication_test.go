@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/cli/v9/api/uaa"
@@ -210,6 +211,27 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(inMemoryCache.AccessToken()).To(ContainSubstring(newAccessToken))
 			})
 
+			It("should persist the refreshed tokens", func() {
+				Expect(inMemoryCache.PersistedRefreshToken()).To(Equal(newRefreshToken))
+			})
+
+			When("the refreshed tokens cannot be persisted", func() {
+				var fakeCache *wrapperfakes.FakeTokenCache
+
+				BeforeEach(func() {
+					fakeCache = new(wrapperfakes.FakeTokenCache)
+					fakeCache.AccessTokenReturns(invalidAccessToken)
+					fakeCache.PersistTokensReturns(errors.New("vault is sealed"))
+					wrapper = NewUAAAuthentication(fakeClient, fakeCache).Wrap(fakeConnection)
+				})
+
+				It("returns the error without making the request", func() {
+					Expect(executeErr).To(MatchError("vault is sealed"))
+					Expect(fakeCache.PersistTokensCallCount()).To(Equal(1))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+				})
+			})
+
 			When("token cannot be refreshed", func() {
 				JustBeforeEach(func() {
 					fakeConnection.MakeReturns(ccerror.InvalidAuthTokenError{})
@@ -221,6 +243,52 @@ var _ = Describe("UAA Authentication", func() {
 			})
 
 		})
+
+		When("the access token is expired and requests are made concurrently", func() {
+			var (
+				requestCount   int
+				newAccessToken string
+			)
+
+			BeforeEach(func() {
+				requestCount = 10
+
+				invalidAccessToken, err := buildTokenString(time.Time{})
+				Expect(err).ToNot(HaveOccurred())
+				newAccessToken, err = buildTokenString(time.Now().AddDate(0, 1, 1))
+				Expect(err).ToNot(HaveOccurred())
+
+				inMemoryCache.SetAccessToken(invalidAccessToken)
+				inMemoryCache.SetRefreshToken("some-refresh-token")
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshedTokens{
+						AccessToken:  newAccessToken,
+						RefreshToken: "newRefreshToken",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			It("refreshes the token only once", func() {
+				var wg sync.WaitGroup
+				for i := 0; i < requestCount; i++ {
+					wg.Add(1)
+					go func() {
+						defer GinkgoRecover()
+						defer wg.Done()
+
+						concurrentRequest := cloudcontroller.NewRequest(&http.Request{Header: http.Header{}}, nil)
+						Expect(wrapper.Make(concurrentRequest, nil)).To(Succeed())
+						Expect(concurrentRequest.Header.Get("Authorization")).To(ContainSubstring(newAccessToken))
+					}()
+				}
+				wg.Wait()
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(requestCount))
+			})
+		})
 	})
 })
 
@@ -3,6 +3,8 @@ package util
 type InMemoryCache struct {
 	accessToken  string
 	refreshToken string
+
+	persistedRefreshToken string
 }
 
 func (c InMemoryCache) AccessToken() string {
@@ -21,6 +23,15 @@ func (c *InMemoryCache) SetRefreshToken(token string) {
 	c.refreshToken = token
 }
 
+func (c *InMemoryCache) PersistTokens() error {
+	c.persistedRefreshToken = c.refreshToken
+	return nil
+}
+
+func (c InMemoryCache) PersistedRefreshToken() string {
+	return c.persistedRefreshToken
+}
+
 func NewInMemoryTokenCache() *InMemoryCache {
 	return new(InMemoryCache)
 }
@@ -15,6 +15,9 @@ type PluginBinary struct {
 	Platform string `json:"platform"`
 	URL      string `json:"url"`
 	Checksum string `json:"checksum"`
+	// Signature is an optional detached ed25519 signature of the binary, as
+	// accepted by install-plugin --signature.
+	Signature string `json:"signature,omitempty"`
 }
 
 type Plugin struct {
@@ -21,6 +21,7 @@ type TokenCache interface {
 	RefreshToken() string
 	SetAccessToken(token string)
 	SetRefreshToken(token string)
+	PersistTokens() error
 }
 
 // UAAAuthentication wraps connections and adds authentication headers to all
@@ -59,6 +60,10 @@ func (t *UAAAuthentication) Make(request *router.Request, passedResponse *router
 
 		t.cache.SetAccessToken(tokens.AuthorizationToken())
 		t.cache.SetRefreshToken(tokens.RefreshToken)
+		err = t.cache.PersistTokens()
+		if err != nil {
+			return err
+		}
 
 		if request.Body != nil {
 			err = request.ResetBody()
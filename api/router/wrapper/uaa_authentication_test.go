@@ -168,6 +168,10 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
 			})
+
+			It("should persist the refreshed tokens", func() {
+				Expect(inMemoryCache.PersistedRefreshToken()).To(Equal("bananananananana"))
+			})
 		})
 	})
 })
@@ -0,0 +1,142 @@
+package shared
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// RetryBaseDelay is the delay before the first retry of a failed request.
+	// It doubles with every further retry.
+	RetryBaseDelay = 500 * time.Millisecond
+
+	// RetryMaxDelay is the longest delay between two retries, unless the
+	// server asks for a longer one.
+	RetryMaxDelay = 30 * time.Second
+
+	RateLimitResetHeader = "X-RateLimit-Reset"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Clock
+
+// Clock tells the time and waits between retries of a request.
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+}
+
+// RealClock is the Clock used outside of tests.
+type RealClock struct{}
+
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
+
+func (RealClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+// RetryBackoff waits between the retries of a single request. The delay grows
+// exponentially with jitter, unless the server says when to retry with a
+// Retry-After or X-RateLimit-Reset header.
+type RetryBackoff struct {
+	clock        Clock
+	maxRetryTime time.Duration
+	deadline     time.Time
+	attempt      int
+}
+
+// NewRetryBackoff returns a RetryBackoff that gives up once retrying would
+// take longer than maxRetryTime in total. A maxRetryTime of 0 does not limit
+// the retry time.
+func NewRetryBackoff(clock Clock, maxRetryTime time.Duration) *RetryBackoff {
+	backoff := &RetryBackoff{
+		clock:        clock,
+		maxRetryTime: maxRetryTime,
+	}
+	if maxRetryTime > 0 {
+		backoff.deadline = clock.Now().Add(maxRetryTime)
+	}
+	return backoff
+}
+
+// Wait sleeps until the request that got the given response should be retried.
+// It returns false without sleeping if the retry would happen after the max
+// retry time.
+func (backoff *RetryBackoff) Wait(response *http.Response) bool {
+	now := backoff.clock.Now()
+
+	delay, ok := RetryAfter(response, now)
+	if !ok {
+		delay = backoff.exponentialDelay()
+	}
+	backoff.attempt++
+
+	if backoff.maxRetryTime > 0 && now.Add(delay).After(backoff.deadline) {
+		return false
+	}
+
+	backoff.clock.Sleep(delay)
+	return true
+}
+
+// exponentialDelay returns a random delay between half and all of the current
+// backoff, so that clients that failed together do not retry together.
+func (backoff *RetryBackoff) exponentialDelay() time.Duration {
+	delay := RetryMaxDelay
+	if backoff.attempt < 16 {
+		delay = RetryBaseDelay << uint(backoff.attempt)
+		if delay > RetryMaxDelay {
+			delay = RetryMaxDelay
+		}
+	}
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// RetryAfter returns how long to wait before retrying, based on the
+// Retry-After header, either in seconds or as an HTTP date, or the
+// X-RateLimit-Reset header as a unix timestamp. It returns false if the
+// response has neither header.
+func RetryAfter(response *http.Response, now time.Time) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+
+	if value := response.Header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return nonNegative(time.Duration(seconds) * time.Second), true
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return nonNegative(date.Sub(now)), true
+		}
+	}
+
+	if value := response.Header.Get(RateLimitResetHeader); value != "" {
+		if timestamp, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return nonNegative(time.Unix(timestamp, 0).Sub(now)), true
+		}
+	}
+
+	return 0, false
+}
+
+// IsIdempotentMethod returns true if sending a request with the given method
+// twice has the same effect as sending it once.
+func IsIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
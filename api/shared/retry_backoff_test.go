@@ -0,0 +1,66 @@
+package shared_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/v9/api/shared"
+	"code.cloudfoundry.org/cli/v9/api/shared/sharedfakes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RetryBackoff", func() {
+	var (
+		now       time.Time
+		fakeClock *sharedfakes.FakeClock
+		response  *http.Response
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+		fakeClock = new(sharedfakes.FakeClock)
+		fakeClock.NowReturns(now)
+		response = &http.Response{Header: http.Header{}}
+	})
+
+	DescribeTable("RetryAfter",
+		func(header string, value string, expectedDelay time.Duration, expectedOK bool) {
+			if header != "" {
+				response.Header.Set(header, value)
+			}
+
+			delay, ok := RetryAfter(response, now)
+			Expect(ok).To(Equal(expectedOK))
+			Expect(delay).To(Equal(expectedDelay))
+		},
+
+		Entry("no header", "", "", time.Duration(0), false),
+		Entry("Retry-After in seconds", "Retry-After", "12", 12*time.Second, true),
+		Entry("Retry-After as an HTTP date", "Retry-After", "Fri, 01 Mar 2024 12:00:30 GMT", 30*time.Second, true),
+		Entry("Retry-After in the past", "Retry-After", "Fri, 01 Mar 2024 11:00:00 GMT", time.Duration(0), true),
+		Entry("invalid Retry-After", "Retry-After", "soon", time.Duration(0), false),
+		Entry("X-RateLimit-Reset", "X-RateLimit-Reset", "1709294445", 45*time.Second, true),
+	)
+
+	It("doubles the delay up to the max delay", func() {
+		backoff := NewRetryBackoff(fakeClock, 0)
+		for i := 0; i < 10; i++ {
+			Expect(backoff.Wait(response)).To(BeTrue())
+		}
+
+		Expect(fakeClock.SleepArgsForCall(0)).To(BeNumerically(">=", RetryBaseDelay/2))
+		Expect(fakeClock.SleepArgsForCall(0)).To(BeNumerically("<=", RetryBaseDelay))
+		Expect(fakeClock.SleepArgsForCall(9)).To(BeNumerically(">=", RetryMaxDelay/2))
+		Expect(fakeClock.SleepArgsForCall(9)).To(BeNumerically("<=", RetryMaxDelay))
+	})
+
+	It("does not wait past the max retry time", func() {
+		backoff := NewRetryBackoff(fakeClock, 10*time.Second)
+		response.Header.Set("Retry-After", "11")
+
+		Expect(backoff.Wait(response)).To(BeFalse())
+		Expect(fakeClock.SleepCallCount()).To(Equal(0))
+	})
+})
@@ -52,6 +52,10 @@ func (e RequestError) Error() string {
 	return e.Err.Error()
 }
 
+func (e RequestError) Unwrap() error {
+	return e.Err
+}
+
 // UnauthorizedError is returned when the authentication information is invalid.
 type UnauthorizedError struct {
 	Message string
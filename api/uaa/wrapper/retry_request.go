@@ -4,25 +4,40 @@ import (
 	"bytes"
 	"io"
 	"net/http"
+	"time"
 
+	"code.cloudfoundry.org/cli/v9/api/shared"
 	"code.cloudfoundry.org/cli/v9/api/uaa"
 )
 
 // RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// or 429 status code, or if no response was received. Retries back off
+// exponentially and honor the rate limit headers sent by the server.
 type RetryRequest struct {
-	maxRetries int
-	connection uaa.Connection
+	maxRetries   int
+	maxRetryTime time.Duration
+	clock        shared.Clock
+	connection   uaa.Connection
 }
 
-// NewRetryRequest returns a pointer to a RetryRequest wrapper.
-func NewRetryRequest(maxRetries int) *RetryRequest {
+// NewRetryRequest returns a pointer to a RetryRequest wrapper. A request is
+// retried at most maxRetries times, and not after maxRetryTime has passed.
+func NewRetryRequest(maxRetries int, maxRetryTime time.Duration) *RetryRequest {
+	return NewRetryRequestWithClock(maxRetries, maxRetryTime, shared.RealClock{})
+}
+
+// NewRetryRequestWithClock returns a pointer to a RetryRequest wrapper that
+// uses the given clock to wait between retries.
+func NewRetryRequestWithClock(maxRetries int, maxRetryTime time.Duration, clock shared.Clock) *RetryRequest {
 	return &RetryRequest{
-		maxRetries: maxRetries,
+		maxRetries:   maxRetries,
+		maxRetryTime: maxRetryTime,
+		clock:        clock,
 	}
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// Make retries the request if it comes back with a 5XX or 429 status code or
+// no response is received.
 func (retry *RetryRequest) Make(request *http.Request, passedResponse *uaa.Response) error {
 	var err error
 	var rawRequestBody []byte
@@ -35,6 +50,8 @@ func (retry *RetryRequest) Make(request *http.Request, passedResponse *uaa.Respo
 		}
 	}
 
+	backoff := shared.NewRetryBackoff(retry.clock, retry.maxRetryTime)
+
 	for i := 0; i < retry.maxRetries+1; i++ {
 		if rawRequestBody != nil {
 			request.Body = io.NopCloser(bytes.NewBuffer(rawRequestBody))
@@ -44,7 +61,11 @@ func (retry *RetryRequest) Make(request *http.Request, passedResponse *uaa.Respo
 			return nil
 		}
 
-		if retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
+		if i == retry.maxRetries || retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
+			break
+		}
+
+		if !backoff.Wait(passedResponse.HTTPResponse) {
 			break
 		}
 	}
@@ -59,10 +80,18 @@ func (retry *RetryRequest) Wrap(innerconnection uaa.Connection) uaa.Connection {
 
 // skipRetry will skip retry if the request method is POST or contains a status
 // code that is not one of following http status codes: 500, 502, 503, 504.
+// A 429 status code is only retried for idempotent requests.
 func (*RetryRequest) skipRetry(httpMethod string, response *http.Response) bool {
+	if response == nil {
+		return httpMethod == http.MethodPost
+	}
+
+	if response.StatusCode == http.StatusTooManyRequests {
+		return !shared.IsIdempotentMethod(httpMethod)
+	}
+
 	return httpMethod == http.MethodPost ||
-		response != nil &&
-			response.StatusCode != http.StatusInternalServerError &&
+		response.StatusCode != http.StatusInternalServerError &&
 			response.StatusCode != http.StatusBadGateway &&
 			response.StatusCode != http.StatusServiceUnavailable &&
 			response.StatusCode != http.StatusGatewayTimeout
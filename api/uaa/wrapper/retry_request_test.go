@@ -1,10 +1,15 @@
 package wrapper_test
 
 import (
+	"errors"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
+	"syscall"
+	"time"
 
+	"code.cloudfoundry.org/cli/v9/api/shared/sharedfakes"
 	"code.cloudfoundry.org/cli/v9/api/uaa"
 	"code.cloudfoundry.org/cli/v9/api/uaa/uaafakes"
 	. "code.cloudfoundry.org/cli/v9/api/uaa/wrapper"
@@ -14,6 +19,13 @@ import (
 )
 
 var _ = Describe("Retry Request", func() {
+	var fakeClock *sharedfakes.FakeClock
+
+	BeforeEach(func() {
+		fakeClock = new(sharedfakes.FakeClock)
+		fakeClock.NowReturns(time.Unix(1700000000, 0))
+	})
+
 	DescribeTable("number of retries",
 		func(requestMethod string, responseStatusCode int, expectedNumberOfRetries int) {
 			request, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", nil)
@@ -40,7 +52,7 @@ var _ = Describe("Retry Request", func() {
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper := NewRetryRequestWithClock(2, time.Minute, fakeClock).Wrap(fakeConnection)
 			err = wrapper.Make(request, response)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
@@ -56,6 +68,11 @@ var _ = Describe("Retry Request", func() {
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
 
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
+		Entry("maxRetries for Put (429) Too Many Requests", http.MethodPut, http.StatusTooManyRequests, 3),
+		Entry("1 for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 1),
+		Entry("1 for Patch (429) Too Many Requests", http.MethodPatch, http.StatusTooManyRequests, 1),
+
 		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
 
@@ -69,10 +86,52 @@ var _ = Describe("Retry Request", func() {
 		}
 
 		fakeConnection := new(uaafakes.FakeConnection)
-		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+		wrapper := NewRetryRequestWithClock(2, time.Minute, fakeClock).Wrap(fakeConnection)
 
 		err = wrapper.Make(request, response)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 	})
+
+	It("waits as long as a rate limited server asks", func() {
+		request, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+		response := &uaa.Response{
+			HTTPResponse: &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{"Retry-After": {"3"}},
+			},
+		}
+
+		fakeConnection := new(uaafakes.FakeConnection)
+		fakeConnection.MakeReturnsOnCall(0, uaa.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests})
+		wrapper := NewRetryRequestWithClock(2, time.Minute, fakeClock).Wrap(fakeConnection)
+
+		err = wrapper.Make(request, response)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		Expect(fakeClock.SleepCallCount()).To(Equal(1))
+		Expect(fakeClock.SleepArgsForCall(0)).To(Equal(3 * time.Second))
+	})
+
+	DescribeTable("requests that fail without a response",
+		func(requestMethod string, requestErr error, expectedNumberOfRetries int) {
+			request, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			response := &uaa.Response{}
+
+			fakeConnection := new(uaafakes.FakeConnection)
+			fakeConnection.MakeReturns(requestErr)
+			wrapper := NewRetryRequestWithClock(2, time.Minute, fakeClock).Wrap(fakeConnection)
+
+			err = wrapper.Make(request, response)
+			Expect(err).To(MatchError(requestErr))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
+		},
+
+		Entry("connection reset, GET", http.MethodGet, uaa.RequestError{Err: &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: syscall.ECONNRESET}}, 3),
+		Entry("timeout, GET", http.MethodGet, uaa.RequestError{Err: &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: errors.New("i/o timeout")}}, 3),
+		Entry("timeout, PATCH", http.MethodPatch, uaa.RequestError{Err: &url.Error{Op: "Patch", URL: "https://foo.bar.com/banana", Err: errors.New("i/o timeout")}}, 3),
+		Entry("timeout, POST", http.MethodPost, uaa.RequestError{Err: &url.Error{Op: "Post", URL: "https://foo.bar.com/banana", Err: errors.New("i/o timeout")}}, 1),
+	)
 })
@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/v9/api/uaa"
 )
@@ -24,6 +25,7 @@ type TokenCache interface {
 	RefreshToken() string
 	SetAccessToken(token string)
 	SetRefreshToken(token string)
+	PersistTokens() error
 }
 
 // UAAAuthentication wraps connections and adds authentication headers to all
@@ -32,6 +34,10 @@ type UAAAuthentication struct {
 	connection uaa.Connection
 	client     UAAClient
 	cache      TokenCache
+
+	// tokenMutex serializes access to the token cache, so that concurrent
+	// requests rejected with the same token wait for a single token refresh.
+	tokenMutex sync.Mutex
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -67,28 +73,60 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Resp
 		}
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	accessToken := t.accessToken()
+	request.Header.Set("Authorization", accessToken)
 
 	err = t.connection.Make(request, passedResponse)
 	if _, ok := err.(uaa.InvalidAuthTokenError); ok {
-		tokens, refreshErr := t.client.RefreshAccessToken(t.cache.RefreshToken())
-		if refreshErr != nil {
-			return refreshErr
+		accessToken, err = t.refreshAccessToken(accessToken)
+		if err != nil {
+			return err
 		}
 
-		t.cache.SetAccessToken(tokens.AuthorizationToken())
-		t.cache.SetRefreshToken(tokens.RefreshToken)
-
 		if rawRequestBody != nil {
 			request.Body = io.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", accessToken)
 		return t.connection.Make(request, passedResponse)
 	}
 
 	return err
 }
 
+func (t *UAAAuthentication) accessToken() string {
+	t.tokenMutex.Lock()
+	defer t.tokenMutex.Unlock()
+
+	return t.cache.AccessToken()
+}
+
+// refreshAccessToken returns a new access token in place of the rejected one.
+// When another request already refreshed the rejected token, its result is
+// used instead of refreshing again with a refresh token that may no longer be
+// valid.
+func (t *UAAAuthentication) refreshAccessToken(rejectedToken string) (string, error) {
+	t.tokenMutex.Lock()
+	defer t.tokenMutex.Unlock()
+
+	if accessToken := t.cache.AccessToken(); accessToken != rejectedToken {
+		return accessToken, nil
+	}
+
+	tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return "", err
+	}
+
+	t.cache.SetAccessToken(tokens.AuthorizationToken())
+	t.cache.SetRefreshToken(tokens.RefreshToken)
+	err = t.cache.PersistTokens()
+	if err != nil {
+		return "", err
+	}
+
+	return t.cache.AccessToken(), nil
+}
+
 // SetClient sets the UAA client that the wrapper will use.
 func (t *UAAAuthentication) SetClient(client UAAClient) {
 	t.client = client
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/v9/api/uaa"
 	"code.cloudfoundry.org/cli/v9/api/uaa/uaafakes"
@@ -160,6 +161,55 @@ var _ = Describe("UAA Authentication", func() {
 			It("should save the refresh token", func() {
 				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
 			})
+
+			It("should persist the refreshed tokens", func() {
+				Expect(inMemoryCache.PersistedRefreshToken()).To(Equal("bananananananana"))
+			})
+		})
+
+		When("requests rejected with the same token are made concurrently", func() {
+			var requestCount int
+
+			BeforeEach(func() {
+				requestCount = 10
+				inMemoryCache.SetAccessToken("expired-token")
+				inMemoryCache.SetRefreshToken("some-refresh-token")
+
+				fakeConnection.MakeStub = func(request *http.Request, response *uaa.Response) error {
+					if request.Header.Get("Authorization") == "expired-token" {
+						return uaa.InvalidAuthTokenError{}
+					}
+					return nil
+				}
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshedTokens{
+						AccessToken:  "new-token",
+						RefreshToken: "new-refresh-token",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			It("refreshes the token only once", func() {
+				var wg sync.WaitGroup
+				for i := 0; i < requestCount; i++ {
+					wg.Add(1)
+					go func() {
+						defer GinkgoRecover()
+						defer wg.Done()
+
+						concurrentRequest := &http.Request{Header: http.Header{}}
+						Expect(wrapper.Make(concurrentRequest, nil)).To(Succeed())
+						Expect(concurrentRequest.Header.Get("Authorization")).To(Equal("bearer new-token"))
+					}()
+				}
+				wg.Wait()
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+				Expect(inMemoryCache.PersistedRefreshToken()).To(Equal("new-refresh-token"))
+			})
 		})
 
 		When("refreshing the token", func() {
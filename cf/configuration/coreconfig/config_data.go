@@ -28,6 +28,9 @@ type Data struct {
 	AuthorizationEndpoint    string
 	ColorEnabled             string
 	ConfigVersion            int
+	Contexts                 json.RawMessage `json:",omitempty"`
+	CredentialHelper         string          `json:",omitempty"`
+	CurrentContext           string          `json:",omitempty"`
 	DopplerEndPoint          string
 	Locale                   string
 	LogCacheEndPoint         string
@@ -60,7 +63,16 @@ func NewData() *Data {
 
 func (d *Data) JSONMarshalV3() ([]byte, error) {
 	d.ConfigVersion = configv3.CurrentConfigVersion
-	return json.MarshalIndent(d, "", "  ")
+	if d.CredentialHelper == "" {
+		return json.MarshalIndent(d, "", "  ")
+	}
+
+	// The secrets are kept by the credential helper instead.
+	contents := *d
+	contents.AccessToken = ""
+	contents.RefreshToken = ""
+	contents.UAAOAuthClientSecret = ""
+	return json.MarshalIndent(contents, "", "  ")
 }
 
 func (d *Data) JSONUnmarshalV3(input []byte) error {
@@ -76,3 +88,20 @@ func (d *Data) JSONUnmarshalV3(input []byte) error {
 
 	return nil
 }
+
+// credentials returns the secrets of the context that the top level target
+// information belongs to.
+func (d *Data) credentials() configv3.Credentials {
+	contextName := d.CurrentContext
+	if contextName == "" {
+		contextName = configv3.DefaultContextName
+	}
+
+	return configv3.Credentials{
+		Context:              contextName,
+		ServerURL:            d.Target,
+		AccessToken:          d.AccessToken,
+		RefreshToken:         d.RefreshToken,
+		UAAOAuthClientSecret: d.UAAOAuthClientSecret,
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/v9/cf/configuration"
 	"code.cloudfoundry.org/cli/v9/cf/models"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
 	"code.cloudfoundry.org/cli/v9/version"
 	"github.com/blang/semver/v4"
 )
@@ -17,6 +18,10 @@ type ConfigRepository struct {
 	initOnce     *sync.Once
 	persistor    configuration.Persistor
 	onError      func(error)
+
+	// storedCredentials are the credentials last read from or handed to the
+	// credential helper, if one is configured.
+	storedCredentials *configv3.Credentials
 }
 
 type CCInfo struct {
@@ -144,6 +149,9 @@ type Repository interface {
 func (c *ConfigRepository) init() {
 	c.initOnce.Do(func() {
 		err := c.persistor.Load(c.data)
+		if err == nil {
+			err = c.loadCredentials()
+		}
 		if err != nil {
 			c.onError(err)
 		}
@@ -165,12 +173,65 @@ func (c *ConfigRepository) write(cb func()) {
 
 	cb()
 
-	err := c.persistor.Save(c.data)
+	err := c.storeCredentials()
+	if err != nil {
+		c.onError(err)
+		return
+	}
+
+	err = c.persistor.Save(c.data)
 	if err != nil {
 		c.onError(err)
 	}
 }
 
+// loadCredentials gets the secrets from the credential helper. Secrets that
+// are still in config.json are left alone so that they are moved to the helper
+// on the next write.
+func (c *ConfigRepository) loadCredentials() error {
+	credentials := c.data.credentials()
+	if c.data.CredentialHelper == "" || credentials.HasSecrets() {
+		return nil
+	}
+
+	credentials, err := configv3.GetCredentials(c.data.CredentialHelper, credentials.Context, credentials.ServerURL)
+	if err != nil {
+		return err
+	}
+
+	c.data.AccessToken = credentials.AccessToken
+	c.data.RefreshToken = credentials.RefreshToken
+	c.data.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+	c.storedCredentials = &credentials
+	return nil
+}
+
+// storeCredentials hands changed secrets to the credential helper and erases
+// cleared ones.
+func (c *ConfigRepository) storeCredentials() error {
+	if c.data.CredentialHelper == "" {
+		return nil
+	}
+
+	credentials := c.data.credentials()
+
+	var err error
+	switch {
+	case c.storedCredentials != nil && *c.storedCredentials == credentials:
+	case c.storedCredentials == nil && !credentials.HasSecrets():
+	case !credentials.HasSecrets():
+		err = configv3.EraseCredentials(c.data.CredentialHelper, credentials)
+	default:
+		err = configv3.StoreCredentials(c.data.CredentialHelper, credentials)
+	}
+	if err != nil {
+		return err
+	}
+
+	c.storedCredentials = &credentials
+	return nil
+}
+
 // CLOSERS
 
 func (c *ConfigRepository) Close() {
@@ -0,0 +1,131 @@
+//go:build !windows
+// +build !windows
+
+package coreconfig_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/v9/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeHelperScript keeps credentials as files named after their context in
+// $CF_TEST_CREDENTIAL_STORE and records every invocation in its 'calls' file.
+const fakeHelperScript = `#!/bin/sh
+input=$(cat)
+context=$(printf '%s' "$input" | sed -n 's/.*"Context":"\([^"]*\)".*/\1/p')
+echo "$1 $context" >> "$CF_TEST_CREDENTIAL_STORE/calls"
+case "$1" in
+get) cat "$CF_TEST_CREDENTIAL_STORE/$context.json" 2>/dev/null || echo '{}' ;;
+store) printf '%s' "$input" > "$CF_TEST_CREDENTIAL_STORE/$context.json" ;;
+erase) rm -f "$CF_TEST_CREDENTIAL_STORE/$context.json" ;;
+esac
+`
+
+var _ = Describe("Configuration Repository with a credential helper", func() {
+	var (
+		tempDir    string
+		storeDir   string
+		configPath string
+		oldPath    string
+		config     coreconfig.Repository
+	)
+
+	calls := func() []string {
+		rawCalls, err := os.ReadFile(filepath.Join(storeDir, "calls"))
+		if os.IsNotExist(err) {
+			return nil
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return strings.Split(strings.TrimSpace(string(rawCalls)), "\n")
+	}
+
+	storedCredentials := func(contextName string) string {
+		rawCredentials, err := os.ReadFile(filepath.Join(storeDir, contextName+".json"))
+		if os.IsNotExist(err) {
+			return ""
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return string(rawCredentials)
+	}
+
+	configFile := func() string {
+		rawConfig, err := os.ReadFile(configPath)
+		Expect(err).ToNot(HaveOccurred())
+		return string(rawConfig)
+	}
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "cli-coreconfig-credential-helper")
+		Expect(err).ToNot(HaveOccurred())
+		storeDir = filepath.Join(tempDir, "store")
+		Expect(os.Mkdir(storeDir, 0700)).To(Succeed())
+
+		helperPath := filepath.Join(tempDir, configv3.CredentialHelperPrefix+"fake")
+		Expect(os.WriteFile(helperPath, []byte(fakeHelperScript), 0700)).To(Succeed())
+
+		oldPath = os.Getenv("PATH")
+		Expect(os.Setenv("PATH", tempDir+string(os.PathListSeparator)+oldPath)).To(Succeed())
+		Expect(os.Setenv("CF_TEST_CREDENTIAL_STORE", storeDir)).To(Succeed())
+
+		Expect(os.WriteFile(filepath.Join(storeDir, "prod.json"), []byte(`{"AccessToken":"stored-access-token","RefreshToken":"stored-refresh-token"}`), 0600)).To(Succeed())
+
+		configPath = filepath.Join(tempDir, "config.json")
+		Expect(os.WriteFile(configPath, []byte(`{
+			"ConfigVersion": 4,
+			"Target": "https://api.foo.com",
+			"AccessToken": "",
+			"RefreshToken": "",
+			"CredentialHelper": "fake",
+			"CurrentContext": "prod",
+			"Contexts": {"prod": {"Target": "https://api.foo.com"}}
+		}`), 0600)).To(Succeed())
+
+		config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) { panic(err) })
+	})
+
+	AfterEach(func() {
+		Expect(os.Setenv("PATH", oldPath)).To(Succeed())
+		Expect(os.Unsetenv("CF_TEST_CREDENTIAL_STORE")).To(Succeed())
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	It("gets the tokens of the current context from the helper", func() {
+		Expect(config.AccessToken()).To(Equal("stored-access-token"))
+		Expect(config.RefreshToken()).To(Equal("stored-refresh-token"))
+		Expect(calls()).To(Equal([]string{"get prod"}))
+	})
+
+	It("stores refreshed tokens with the helper instead of config.json", func() {
+		config.SetAccessToken("refreshed-access-token")
+
+		Expect(calls()).To(Equal([]string{"get prod", "store prod"}))
+		Expect(storedCredentials("prod")).To(ContainSubstring(`"AccessToken":"refreshed-access-token"`))
+		Expect(storedCredentials("prod")).To(ContainSubstring(`"RefreshToken":"stored-refresh-token"`))
+
+		Expect(configFile()).ToNot(ContainSubstring("refreshed-access-token"))
+		Expect(configFile()).ToNot(ContainSubstring("stored-refresh-token"))
+		Expect(configFile()).To(ContainSubstring(`"CredentialHelper": "fake"`))
+		Expect(configFile()).To(ContainSubstring(`"CurrentContext": "prod"`))
+		Expect(configFile()).To(MatchRegexp(`"Contexts": {\s*"prod"`))
+	})
+
+	It("does not store unchanged tokens again", func() {
+		config.SetAPIVersion("3.100.0")
+		Expect(calls()).To(Equal([]string{"get prod"}))
+	})
+
+	It("erases the tokens from the helper when the session is cleared", func() {
+		config.ClearSession()
+
+		Expect(calls()).To(Equal([]string{"get prod", "erase prod"}))
+		Expect(storedCredentials("prod")).To(BeEmpty())
+	})
+})
@@ -30,6 +30,11 @@ type FakeConfig struct {
 	accessTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	AddContextStub        func(string)
+	addContextMutex       sync.RWMutex
+	addContextArgsForCall []struct {
+		arg1 string
+	}
 	AddPluginStub        func(configv3.Plugin)
 	addPluginMutex       sync.RWMutex
 	addPluginArgsForCall []struct {
@@ -41,6 +46,12 @@ type FakeConfig struct {
 		arg1 string
 		arg2 string
 	}
+	AddTrustedPluginKeyStub        func(string, string)
+	addTrustedPluginKeyMutex       sync.RWMutex
+	addTrustedPluginKeyArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
 	AuthorizationEndpointStub        func() string
 	authorizationEndpointMutex       sync.RWMutex
 	authorizationEndpointArgsForCall []struct {
@@ -123,6 +134,26 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	ContextsStub        func() []configv3.TargetContext
+	contextsMutex       sync.RWMutex
+	contextsArgsForCall []struct {
+	}
+	contextsReturns struct {
+		result1 []configv3.TargetContext
+	}
+	contextsReturnsOnCall map[int]struct {
+		result1 []configv3.TargetContext
+	}
+	CurrentContextNameStub        func() string
+	currentContextNameMutex       sync.RWMutex
+	currentContextNameArgsForCall []struct {
+	}
+	currentContextNameReturns struct {
+		result1 string
+	}
+	currentContextNameReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct {
@@ -203,6 +234,17 @@ type FakeConfig struct {
 		result1 configv3.Plugin
 		result2 bool
 	}
+	HasContextStub        func(string) bool
+	hasContextMutex       sync.RWMutex
+	hasContextArgsForCall []struct {
+		arg1 string
+	}
+	hasContextReturns struct {
+		result1 bool
+	}
+	hasContextReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	HasTargetedOrganizationStub        func() bool
 	hasTargetedOrganizationMutex       sync.RWMutex
 	hasTargetedOrganizationArgsForCall []struct {
@@ -223,6 +265,26 @@ type FakeConfig struct {
 	hasTargetedSpaceReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	HashCacheFileStub        func() string
+	hashCacheFileMutex       sync.RWMutex
+	hashCacheFileArgsForCall []struct {
+	}
+	hashCacheFileReturns struct {
+		result1 string
+	}
+	hashCacheFileReturnsOnCall map[int]struct {
+		result1 string
+	}
+	HashConcurrencyStub        func() int
+	hashConcurrencyMutex       sync.RWMutex
+	hashConcurrencyArgsForCall []struct {
+	}
+	hashConcurrencyReturns struct {
+		result1 int
+	}
+	hashConcurrencyReturnsOnCall map[int]struct {
+		result1 int
+	}
 	IsCFOnK8sStub        func() bool
 	isCFOnK8sMutex       sync.RWMutex
 	isCFOnK8sArgsForCall []struct {
@@ -303,6 +365,26 @@ type FakeConfig struct {
 	overallPollingTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	PaginationConcurrencyStub        func() int
+	paginationConcurrencyMutex       sync.RWMutex
+	paginationConcurrencyArgsForCall []struct {
+	}
+	paginationConcurrencyReturns struct {
+		result1 int
+	}
+	paginationConcurrencyReturnsOnCall map[int]struct {
+		result1 int
+	}
+	PersistTokensStub        func() error
+	persistTokensMutex       sync.RWMutex
+	persistTokensArgsForCall []struct {
+	}
+	persistTokensReturns struct {
+		result1 error
+	}
+	persistTokensReturnsOnCall map[int]struct {
+		result1 error
+	}
 	PluginHomeStub        func() string
 	pluginHomeMutex       sync.RWMutex
 	pluginHomeArgsForCall []struct {
@@ -323,6 +405,16 @@ type FakeConfig struct {
 	pluginRepositoriesReturnsOnCall map[int]struct {
 		result1 []configv3.PluginRepository
 	}
+	PluginSignaturePolicyStub        func() string
+	pluginSignaturePolicyMutex       sync.RWMutex
+	pluginSignaturePolicyArgsForCall []struct {
+	}
+	pluginSignaturePolicyReturns struct {
+		result1 string
+	}
+	pluginSignaturePolicyReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PluginsStub        func() []configv3.Plugin
 	pluginsMutex       sync.RWMutex
 	pluginsArgsForCall []struct {
@@ -353,11 +445,45 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	RemoveContextStub        func(string) error
+	removeContextMutex       sync.RWMutex
+	removeContextArgsForCall []struct {
+		arg1 string
+	}
+	removeContextReturns struct {
+		result1 error
+	}
+	removeContextReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RemovePluginStub        func(string)
 	removePluginMutex       sync.RWMutex
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RemoveTrustedPluginKeyStub        func(string) bool
+	removeTrustedPluginKeyMutex       sync.RWMutex
+	removeTrustedPluginKeyArgsForCall []struct {
+		arg1 string
+	}
+	removeTrustedPluginKeyReturns struct {
+		result1 bool
+	}
+	removeTrustedPluginKeyReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	RenameContextStub        func(string, string) error
+	renameContextMutex       sync.RWMutex
+	renameContextArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	renameContextReturns struct {
+		result1 error
+	}
+	renameContextReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RequestRetryCountStub        func() int
 	requestRetryCountMutex       sync.RWMutex
 	requestRetryCountArgsForCall []struct {
@@ -368,6 +494,16 @@ type FakeConfig struct {
 	requestRetryCountReturnsOnCall map[int]struct {
 		result1 int
 	}
+	RetryTimeoutStub        func() time.Duration
+	retryTimeoutMutex       sync.RWMutex
+	retryTimeoutArgsForCall []struct {
+	}
+	retryTimeoutReturns struct {
+		result1 time.Duration
+	}
+	retryTimeoutReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
 	RoutingEndpointStub        func() string
 	routingEndpointMutex       sync.RWMutex
 	routingEndpointArgsForCall []struct {
@@ -403,6 +539,17 @@ type FakeConfig struct {
 	setColorEnabledArgsForCall []struct {
 		arg1 string
 	}
+	SetCredentialHelperStub        func(string) error
+	setCredentialHelperMutex       sync.RWMutex
+	setCredentialHelperArgsForCall []struct {
+		arg1 string
+	}
+	setCredentialHelperReturns struct {
+		result1 error
+	}
+	setCredentialHelperReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetKubernetesAuthInfoStub        func(string)
 	setKubernetesAuthInfoMutex       sync.RWMutex
 	setKubernetesAuthInfoArgsForCall []struct {
@@ -424,6 +571,11 @@ type FakeConfig struct {
 		arg1 string
 		arg2 string
 	}
+	SetPluginSignaturePolicyStub        func(string)
+	setPluginSignaturePolicyMutex       sync.RWMutex
+	setPluginSignaturePolicyArgsForCall []struct {
+		arg1 string
+	}
 	SetRefreshTokenStub        func(string)
 	setRefreshTokenMutex       sync.RWMutex
 	setRefreshTokenArgsForCall []struct {
@@ -499,6 +651,17 @@ type FakeConfig struct {
 	startupTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	SwitchContextStub        func(string) error
+	switchContextMutex       sync.RWMutex
+	switchContextArgsForCall []struct {
+		arg1 string
+	}
+	switchContextReturns struct {
+		result1 error
+	}
+	switchContextReturnsOnCall map[int]struct {
+		result1 error
+	}
 	TargetStub        func() string
 	targetMutex       sync.RWMutex
 	targetArgsForCall []struct {
@@ -549,6 +712,16 @@ type FakeConfig struct {
 	terminalWidthReturnsOnCall map[int]struct {
 		result1 int
 	}
+	TrustedPluginKeysStub        func() []configv3.TrustedPluginKey
+	trustedPluginKeysMutex       sync.RWMutex
+	trustedPluginKeysArgsForCall []struct {
+	}
+	trustedPluginKeysReturns struct {
+		result1 []configv3.TrustedPluginKey
+	}
+	trustedPluginKeysReturnsOnCall map[int]struct {
+		result1 []configv3.TrustedPluginKey
+	}
 	UAADisableKeepAlivesStub        func() bool
 	uAADisableKeepAlivesMutex       sync.RWMutex
 	uAADisableKeepAlivesArgsForCall []struct {
@@ -611,6 +784,16 @@ type FakeConfig struct {
 	unsetUserInformationMutex       sync.RWMutex
 	unsetUserInformationArgsForCall []struct {
 	}
+	UseGitIgnoreStub        func() bool
+	useGitIgnoreMutex       sync.RWMutex
+	useGitIgnoreArgsForCall []struct {
+	}
+	useGitIgnoreReturns struct {
+		result1 bool
+	}
+	useGitIgnoreReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	V7SetSpaceInformationStub        func(string, string)
 	v7SetSpaceInformationMutex       sync.RWMutex
 	v7SetSpaceInformationArgsForCall []struct {
@@ -759,6 +942,38 @@ func (fake *FakeConfig) AccessTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) AddContext(arg1 string) {
+	fake.addContextMutex.Lock()
+	fake.addContextArgsForCall = append(fake.addContextArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.AddContextStub
+	fake.recordInvocation("AddContext", []interface{}{arg1})
+	fake.addContextMutex.Unlock()
+	if stub != nil {
+		fake.AddContextStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) AddContextCallCount() int {
+	fake.addContextMutex.RLock()
+	defer fake.addContextMutex.RUnlock()
+	return len(fake.addContextArgsForCall)
+}
+
+func (fake *FakeConfig) AddContextCalls(stub func(string)) {
+	fake.addContextMutex.Lock()
+	defer fake.addContextMutex.Unlock()
+	fake.AddContextStub = stub
+}
+
+func (fake *FakeConfig) AddContextArgsForCall(i int) string {
+	fake.addContextMutex.RLock()
+	defer fake.addContextMutex.RUnlock()
+	argsForCall := fake.addContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) AddPlugin(arg1 configv3.Plugin) {
 	fake.addPluginMutex.Lock()
 	fake.addPluginArgsForCall = append(fake.addPluginArgsForCall, struct {
@@ -824,6 +1039,39 @@ func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeConfig) AddTrustedPluginKey(arg1 string, arg2 string) {
+	fake.addTrustedPluginKeyMutex.Lock()
+	fake.addTrustedPluginKeyArgsForCall = append(fake.addTrustedPluginKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AddTrustedPluginKeyStub
+	fake.recordInvocation("AddTrustedPluginKey", []interface{}{arg1, arg2})
+	fake.addTrustedPluginKeyMutex.Unlock()
+	if stub != nil {
+		fake.AddTrustedPluginKeyStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeConfig) AddTrustedPluginKeyCallCount() int {
+	fake.addTrustedPluginKeyMutex.RLock()
+	defer fake.addTrustedPluginKeyMutex.RUnlock()
+	return len(fake.addTrustedPluginKeyArgsForCall)
+}
+
+func (fake *FakeConfig) AddTrustedPluginKeyCalls(stub func(string, string)) {
+	fake.addTrustedPluginKeyMutex.Lock()
+	defer fake.addTrustedPluginKeyMutex.Unlock()
+	fake.AddTrustedPluginKeyStub = stub
+}
+
+func (fake *FakeConfig) AddTrustedPluginKeyArgsForCall(i int) (string, string) {
+	fake.addTrustedPluginKeyMutex.RLock()
+	defer fake.addTrustedPluginKeyMutex.RUnlock()
+	argsForCall := fake.addTrustedPluginKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeConfig) AuthorizationEndpoint() string {
 	fake.authorizationEndpointMutex.Lock()
 	ret, specificReturn := fake.authorizationEndpointReturnsOnCall[len(fake.authorizationEndpointArgsForCall)]
@@ -1251,6 +1499,112 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) Contexts() []configv3.TargetContext {
+	fake.contextsMutex.Lock()
+	ret, specificReturn := fake.contextsReturnsOnCall[len(fake.contextsArgsForCall)]
+	fake.contextsArgsForCall = append(fake.contextsArgsForCall, struct {
+	}{})
+	stub := fake.ContextsStub
+	fakeReturns := fake.contextsReturns
+	fake.recordInvocation("Contexts", []interface{}{})
+	fake.contextsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ContextsCallCount() int {
+	fake.contextsMutex.RLock()
+	defer fake.contextsMutex.RUnlock()
+	return len(fake.contextsArgsForCall)
+}
+
+func (fake *FakeConfig) ContextsCalls(stub func() []configv3.TargetContext) {
+	fake.contextsMutex.Lock()
+	defer fake.contextsMutex.Unlock()
+	fake.ContextsStub = stub
+}
+
+func (fake *FakeConfig) ContextsReturns(result1 []configv3.TargetContext) {
+	fake.contextsMutex.Lock()
+	defer fake.contextsMutex.Unlock()
+	fake.ContextsStub = nil
+	fake.contextsReturns = struct {
+		result1 []configv3.TargetContext
+	}{result1}
+}
+
+func (fake *FakeConfig) ContextsReturnsOnCall(i int, result1 []configv3.TargetContext) {
+	fake.contextsMutex.Lock()
+	defer fake.contextsMutex.Unlock()
+	fake.ContextsStub = nil
+	if fake.contextsReturnsOnCall == nil {
+		fake.contextsReturnsOnCall = make(map[int]struct {
+			result1 []configv3.TargetContext
+		})
+	}
+	fake.contextsReturnsOnCall[i] = struct {
+		result1 []configv3.TargetContext
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentContextName() string {
+	fake.currentContextNameMutex.Lock()
+	ret, specificReturn := fake.currentContextNameReturnsOnCall[len(fake.currentContextNameArgsForCall)]
+	fake.currentContextNameArgsForCall = append(fake.currentContextNameArgsForCall, struct {
+	}{})
+	stub := fake.CurrentContextNameStub
+	fakeReturns := fake.currentContextNameReturns
+	fake.recordInvocation("CurrentContextName", []interface{}{})
+	fake.currentContextNameMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CurrentContextNameCallCount() int {
+	fake.currentContextNameMutex.RLock()
+	defer fake.currentContextNameMutex.RUnlock()
+	return len(fake.currentContextNameArgsForCall)
+}
+
+func (fake *FakeConfig) CurrentContextNameCalls(stub func() string) {
+	fake.currentContextNameMutex.Lock()
+	defer fake.currentContextNameMutex.Unlock()
+	fake.CurrentContextNameStub = stub
+}
+
+func (fake *FakeConfig) CurrentContextNameReturns(result1 string) {
+	fake.currentContextNameMutex.Lock()
+	defer fake.currentContextNameMutex.Unlock()
+	fake.CurrentContextNameStub = nil
+	fake.currentContextNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentContextNameReturnsOnCall(i int, result1 string) {
+	fake.currentContextNameMutex.Lock()
+	defer fake.currentContextNameMutex.Unlock()
+	fake.CurrentContextNameStub = nil
+	if fake.currentContextNameReturnsOnCall == nil {
+		fake.currentContextNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.currentContextNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -1650,6 +2004,67 @@ func (fake *FakeConfig) GetPluginCaseInsensitiveReturnsOnCall(i int, result1 con
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) HasContext(arg1 string) bool {
+	fake.hasContextMutex.Lock()
+	ret, specificReturn := fake.hasContextReturnsOnCall[len(fake.hasContextArgsForCall)]
+	fake.hasContextArgsForCall = append(fake.hasContextArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.HasContextStub
+	fakeReturns := fake.hasContextReturns
+	fake.recordInvocation("HasContext", []interface{}{arg1})
+	fake.hasContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) HasContextCallCount() int {
+	fake.hasContextMutex.RLock()
+	defer fake.hasContextMutex.RUnlock()
+	return len(fake.hasContextArgsForCall)
+}
+
+func (fake *FakeConfig) HasContextCalls(stub func(string) bool) {
+	fake.hasContextMutex.Lock()
+	defer fake.hasContextMutex.Unlock()
+	fake.HasContextStub = stub
+}
+
+func (fake *FakeConfig) HasContextArgsForCall(i int) string {
+	fake.hasContextMutex.RLock()
+	defer fake.hasContextMutex.RUnlock()
+	argsForCall := fake.hasContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) HasContextReturns(result1 bool) {
+	fake.hasContextMutex.Lock()
+	defer fake.hasContextMutex.Unlock()
+	fake.HasContextStub = nil
+	fake.hasContextReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) HasContextReturnsOnCall(i int, result1 bool) {
+	fake.hasContextMutex.Lock()
+	defer fake.hasContextMutex.Unlock()
+	fake.HasContextStub = nil
+	if fake.hasContextReturnsOnCall == nil {
+		fake.hasContextReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.hasContextReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) HasTargetedOrganization() bool {
 	fake.hasTargetedOrganizationMutex.Lock()
 	ret, specificReturn := fake.hasTargetedOrganizationReturnsOnCall[len(fake.hasTargetedOrganizationArgsForCall)]
@@ -1756,6 +2171,112 @@ func (fake *FakeConfig) HasTargetedSpaceReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) HashCacheFile() string {
+	fake.hashCacheFileMutex.Lock()
+	ret, specificReturn := fake.hashCacheFileReturnsOnCall[len(fake.hashCacheFileArgsForCall)]
+	fake.hashCacheFileArgsForCall = append(fake.hashCacheFileArgsForCall, struct {
+	}{})
+	stub := fake.HashCacheFileStub
+	fakeReturns := fake.hashCacheFileReturns
+	fake.recordInvocation("HashCacheFile", []interface{}{})
+	fake.hashCacheFileMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) HashCacheFileCallCount() int {
+	fake.hashCacheFileMutex.RLock()
+	defer fake.hashCacheFileMutex.RUnlock()
+	return len(fake.hashCacheFileArgsForCall)
+}
+
+func (fake *FakeConfig) HashCacheFileCalls(stub func() string) {
+	fake.hashCacheFileMutex.Lock()
+	defer fake.hashCacheFileMutex.Unlock()
+	fake.HashCacheFileStub = stub
+}
+
+func (fake *FakeConfig) HashCacheFileReturns(result1 string) {
+	fake.hashCacheFileMutex.Lock()
+	defer fake.hashCacheFileMutex.Unlock()
+	fake.HashCacheFileStub = nil
+	fake.hashCacheFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) HashCacheFileReturnsOnCall(i int, result1 string) {
+	fake.hashCacheFileMutex.Lock()
+	defer fake.hashCacheFileMutex.Unlock()
+	fake.HashCacheFileStub = nil
+	if fake.hashCacheFileReturnsOnCall == nil {
+		fake.hashCacheFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.hashCacheFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) HashConcurrency() int {
+	fake.hashConcurrencyMutex.Lock()
+	ret, specificReturn := fake.hashConcurrencyReturnsOnCall[len(fake.hashConcurrencyArgsForCall)]
+	fake.hashConcurrencyArgsForCall = append(fake.hashConcurrencyArgsForCall, struct {
+	}{})
+	stub := fake.HashConcurrencyStub
+	fakeReturns := fake.hashConcurrencyReturns
+	fake.recordInvocation("HashConcurrency", []interface{}{})
+	fake.hashConcurrencyMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) HashConcurrencyCallCount() int {
+	fake.hashConcurrencyMutex.RLock()
+	defer fake.hashConcurrencyMutex.RUnlock()
+	return len(fake.hashConcurrencyArgsForCall)
+}
+
+func (fake *FakeConfig) HashConcurrencyCalls(stub func() int) {
+	fake.hashConcurrencyMutex.Lock()
+	defer fake.hashConcurrencyMutex.Unlock()
+	fake.HashConcurrencyStub = stub
+}
+
+func (fake *FakeConfig) HashConcurrencyReturns(result1 int) {
+	fake.hashConcurrencyMutex.Lock()
+	defer fake.hashConcurrencyMutex.Unlock()
+	fake.HashConcurrencyStub = nil
+	fake.hashConcurrencyReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) HashConcurrencyReturnsOnCall(i int, result1 int) {
+	fake.hashConcurrencyMutex.Lock()
+	defer fake.hashConcurrencyMutex.Unlock()
+	fake.HashConcurrencyStub = nil
+	if fake.hashConcurrencyReturnsOnCall == nil {
+		fake.hashConcurrencyReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.hashConcurrencyReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeConfig) IsCFOnK8s() bool {
 	fake.isCFOnK8sMutex.Lock()
 	ret, specificReturn := fake.isCFOnK8sReturnsOnCall[len(fake.isCFOnK8sArgsForCall)]
@@ -2180,15 +2701,15 @@ func (fake *FakeConfig) OverallPollingTimeoutReturnsOnCall(i int, result1 time.D
 	}{result1}
 }
 
-func (fake *FakeConfig) PluginHome() string {
-	fake.pluginHomeMutex.Lock()
-	ret, specificReturn := fake.pluginHomeReturnsOnCall[len(fake.pluginHomeArgsForCall)]
-	fake.pluginHomeArgsForCall = append(fake.pluginHomeArgsForCall, struct {
+func (fake *FakeConfig) PaginationConcurrency() int {
+	fake.paginationConcurrencyMutex.Lock()
+	ret, specificReturn := fake.paginationConcurrencyReturnsOnCall[len(fake.paginationConcurrencyArgsForCall)]
+	fake.paginationConcurrencyArgsForCall = append(fake.paginationConcurrencyArgsForCall, struct {
 	}{})
-	stub := fake.PluginHomeStub
-	fakeReturns := fake.pluginHomeReturns
-	fake.recordInvocation("PluginHome", []interface{}{})
-	fake.pluginHomeMutex.Unlock()
+	stub := fake.PaginationConcurrencyStub
+	fakeReturns := fake.paginationConcurrencyReturns
+	fake.recordInvocation("PaginationConcurrency", []interface{}{})
+	fake.paginationConcurrencyMutex.Unlock()
 	if stub != nil {
 		return stub()
 	}
@@ -2198,13 +2719,119 @@ func (fake *FakeConfig) PluginHome() string {
 	return fakeReturns.result1
 }
 
-func (fake *FakeConfig) PluginHomeCallCount() int {
-	fake.pluginHomeMutex.RLock()
-	defer fake.pluginHomeMutex.RUnlock()
-	return len(fake.pluginHomeArgsForCall)
+func (fake *FakeConfig) PaginationConcurrencyCallCount() int {
+	fake.paginationConcurrencyMutex.RLock()
+	defer fake.paginationConcurrencyMutex.RUnlock()
+	return len(fake.paginationConcurrencyArgsForCall)
 }
 
-func (fake *FakeConfig) PluginHomeCalls(stub func() string) {
+func (fake *FakeConfig) PaginationConcurrencyCalls(stub func() int) {
+	fake.paginationConcurrencyMutex.Lock()
+	defer fake.paginationConcurrencyMutex.Unlock()
+	fake.PaginationConcurrencyStub = stub
+}
+
+func (fake *FakeConfig) PaginationConcurrencyReturns(result1 int) {
+	fake.paginationConcurrencyMutex.Lock()
+	defer fake.paginationConcurrencyMutex.Unlock()
+	fake.PaginationConcurrencyStub = nil
+	fake.paginationConcurrencyReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) PaginationConcurrencyReturnsOnCall(i int, result1 int) {
+	fake.paginationConcurrencyMutex.Lock()
+	defer fake.paginationConcurrencyMutex.Unlock()
+	fake.PaginationConcurrencyStub = nil
+	if fake.paginationConcurrencyReturnsOnCall == nil {
+		fake.paginationConcurrencyReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.paginationConcurrencyReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) PersistTokens() error {
+	fake.persistTokensMutex.Lock()
+	ret, specificReturn := fake.persistTokensReturnsOnCall[len(fake.persistTokensArgsForCall)]
+	fake.persistTokensArgsForCall = append(fake.persistTokensArgsForCall, struct {
+	}{})
+	stub := fake.PersistTokensStub
+	fakeReturns := fake.persistTokensReturns
+	fake.recordInvocation("PersistTokens", []interface{}{})
+	fake.persistTokensMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PersistTokensCallCount() int {
+	fake.persistTokensMutex.RLock()
+	defer fake.persistTokensMutex.RUnlock()
+	return len(fake.persistTokensArgsForCall)
+}
+
+func (fake *FakeConfig) PersistTokensCalls(stub func() error) {
+	fake.persistTokensMutex.Lock()
+	defer fake.persistTokensMutex.Unlock()
+	fake.PersistTokensStub = stub
+}
+
+func (fake *FakeConfig) PersistTokensReturns(result1 error) {
+	fake.persistTokensMutex.Lock()
+	defer fake.persistTokensMutex.Unlock()
+	fake.PersistTokensStub = nil
+	fake.persistTokensReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) PersistTokensReturnsOnCall(i int, result1 error) {
+	fake.persistTokensMutex.Lock()
+	defer fake.persistTokensMutex.Unlock()
+	fake.PersistTokensStub = nil
+	if fake.persistTokensReturnsOnCall == nil {
+		fake.persistTokensReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.persistTokensReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginHome() string {
+	fake.pluginHomeMutex.Lock()
+	ret, specificReturn := fake.pluginHomeReturnsOnCall[len(fake.pluginHomeArgsForCall)]
+	fake.pluginHomeArgsForCall = append(fake.pluginHomeArgsForCall, struct {
+	}{})
+	stub := fake.PluginHomeStub
+	fakeReturns := fake.pluginHomeReturns
+	fake.recordInvocation("PluginHome", []interface{}{})
+	fake.pluginHomeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PluginHomeCallCount() int {
+	fake.pluginHomeMutex.RLock()
+	defer fake.pluginHomeMutex.RUnlock()
+	return len(fake.pluginHomeArgsForCall)
+}
+
+func (fake *FakeConfig) PluginHomeCalls(stub func() string) {
 	fake.pluginHomeMutex.Lock()
 	defer fake.pluginHomeMutex.Unlock()
 	fake.PluginHomeStub = stub
@@ -2286,6 +2913,59 @@ func (fake *FakeConfig) PluginRepositoriesReturnsOnCall(i int, result1 []configv
 	}{result1}
 }
 
+func (fake *FakeConfig) PluginSignaturePolicy() string {
+	fake.pluginSignaturePolicyMutex.Lock()
+	ret, specificReturn := fake.pluginSignaturePolicyReturnsOnCall[len(fake.pluginSignaturePolicyArgsForCall)]
+	fake.pluginSignaturePolicyArgsForCall = append(fake.pluginSignaturePolicyArgsForCall, struct {
+	}{})
+	stub := fake.PluginSignaturePolicyStub
+	fakeReturns := fake.pluginSignaturePolicyReturns
+	fake.recordInvocation("PluginSignaturePolicy", []interface{}{})
+	fake.pluginSignaturePolicyMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PluginSignaturePolicyCallCount() int {
+	fake.pluginSignaturePolicyMutex.RLock()
+	defer fake.pluginSignaturePolicyMutex.RUnlock()
+	return len(fake.pluginSignaturePolicyArgsForCall)
+}
+
+func (fake *FakeConfig) PluginSignaturePolicyCalls(stub func() string) {
+	fake.pluginSignaturePolicyMutex.Lock()
+	defer fake.pluginSignaturePolicyMutex.Unlock()
+	fake.PluginSignaturePolicyStub = stub
+}
+
+func (fake *FakeConfig) PluginSignaturePolicyReturns(result1 string) {
+	fake.pluginSignaturePolicyMutex.Lock()
+	defer fake.pluginSignaturePolicyMutex.Unlock()
+	fake.PluginSignaturePolicyStub = nil
+	fake.pluginSignaturePolicyReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginSignaturePolicyReturnsOnCall(i int, result1 string) {
+	fake.pluginSignaturePolicyMutex.Lock()
+	defer fake.pluginSignaturePolicyMutex.Unlock()
+	fake.PluginSignaturePolicyStub = nil
+	if fake.pluginSignaturePolicyReturnsOnCall == nil {
+		fake.pluginSignaturePolicyReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pluginSignaturePolicyReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) Plugins() []configv3.Plugin {
 	fake.pluginsMutex.Lock()
 	ret, specificReturn := fake.pluginsReturnsOnCall[len(fake.pluginsArgsForCall)]
@@ -2445,6 +3125,67 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RemoveContext(arg1 string) error {
+	fake.removeContextMutex.Lock()
+	ret, specificReturn := fake.removeContextReturnsOnCall[len(fake.removeContextArgsForCall)]
+	fake.removeContextArgsForCall = append(fake.removeContextArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RemoveContextStub
+	fakeReturns := fake.removeContextReturns
+	fake.recordInvocation("RemoveContext", []interface{}{arg1})
+	fake.removeContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RemoveContextCallCount() int {
+	fake.removeContextMutex.RLock()
+	defer fake.removeContextMutex.RUnlock()
+	return len(fake.removeContextArgsForCall)
+}
+
+func (fake *FakeConfig) RemoveContextCalls(stub func(string) error) {
+	fake.removeContextMutex.Lock()
+	defer fake.removeContextMutex.Unlock()
+	fake.RemoveContextStub = stub
+}
+
+func (fake *FakeConfig) RemoveContextArgsForCall(i int) string {
+	fake.removeContextMutex.RLock()
+	defer fake.removeContextMutex.RUnlock()
+	argsForCall := fake.removeContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) RemoveContextReturns(result1 error) {
+	fake.removeContextMutex.Lock()
+	defer fake.removeContextMutex.Unlock()
+	fake.RemoveContextStub = nil
+	fake.removeContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) RemoveContextReturnsOnCall(i int, result1 error) {
+	fake.removeContextMutex.Lock()
+	defer fake.removeContextMutex.Unlock()
+	fake.RemoveContextStub = nil
+	if fake.removeContextReturnsOnCall == nil {
+		fake.removeContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) RemovePlugin(arg1 string) {
 	fake.removePluginMutex.Lock()
 	fake.removePluginArgsForCall = append(fake.removePluginArgsForCall, struct {
@@ -2477,6 +3218,129 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) RemoveTrustedPluginKey(arg1 string) bool {
+	fake.removeTrustedPluginKeyMutex.Lock()
+	ret, specificReturn := fake.removeTrustedPluginKeyReturnsOnCall[len(fake.removeTrustedPluginKeyArgsForCall)]
+	fake.removeTrustedPluginKeyArgsForCall = append(fake.removeTrustedPluginKeyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RemoveTrustedPluginKeyStub
+	fakeReturns := fake.removeTrustedPluginKeyReturns
+	fake.recordInvocation("RemoveTrustedPluginKey", []interface{}{arg1})
+	fake.removeTrustedPluginKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RemoveTrustedPluginKeyCallCount() int {
+	fake.removeTrustedPluginKeyMutex.RLock()
+	defer fake.removeTrustedPluginKeyMutex.RUnlock()
+	return len(fake.removeTrustedPluginKeyArgsForCall)
+}
+
+func (fake *FakeConfig) RemoveTrustedPluginKeyCalls(stub func(string) bool) {
+	fake.removeTrustedPluginKeyMutex.Lock()
+	defer fake.removeTrustedPluginKeyMutex.Unlock()
+	fake.RemoveTrustedPluginKeyStub = stub
+}
+
+func (fake *FakeConfig) RemoveTrustedPluginKeyArgsForCall(i int) string {
+	fake.removeTrustedPluginKeyMutex.RLock()
+	defer fake.removeTrustedPluginKeyMutex.RUnlock()
+	argsForCall := fake.removeTrustedPluginKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) RemoveTrustedPluginKeyReturns(result1 bool) {
+	fake.removeTrustedPluginKeyMutex.Lock()
+	defer fake.removeTrustedPluginKeyMutex.Unlock()
+	fake.RemoveTrustedPluginKeyStub = nil
+	fake.removeTrustedPluginKeyReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) RemoveTrustedPluginKeyReturnsOnCall(i int, result1 bool) {
+	fake.removeTrustedPluginKeyMutex.Lock()
+	defer fake.removeTrustedPluginKeyMutex.Unlock()
+	fake.RemoveTrustedPluginKeyStub = nil
+	if fake.removeTrustedPluginKeyReturnsOnCall == nil {
+		fake.removeTrustedPluginKeyReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.removeTrustedPluginKeyReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) RenameContext(arg1 string, arg2 string) error {
+	fake.renameContextMutex.Lock()
+	ret, specificReturn := fake.renameContextReturnsOnCall[len(fake.renameContextArgsForCall)]
+	fake.renameContextArgsForCall = append(fake.renameContextArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RenameContextStub
+	fakeReturns := fake.renameContextReturns
+	fake.recordInvocation("RenameContext", []interface{}{arg1, arg2})
+	fake.renameContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RenameContextCallCount() int {
+	fake.renameContextMutex.RLock()
+	defer fake.renameContextMutex.RUnlock()
+	return len(fake.renameContextArgsForCall)
+}
+
+func (fake *FakeConfig) RenameContextCalls(stub func(string, string) error) {
+	fake.renameContextMutex.Lock()
+	defer fake.renameContextMutex.Unlock()
+	fake.RenameContextStub = stub
+}
+
+func (fake *FakeConfig) RenameContextArgsForCall(i int) (string, string) {
+	fake.renameContextMutex.RLock()
+	defer fake.renameContextMutex.RUnlock()
+	argsForCall := fake.renameContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeConfig) RenameContextReturns(result1 error) {
+	fake.renameContextMutex.Lock()
+	defer fake.renameContextMutex.Unlock()
+	fake.RenameContextStub = nil
+	fake.renameContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) RenameContextReturnsOnCall(i int, result1 error) {
+	fake.renameContextMutex.Lock()
+	defer fake.renameContextMutex.Unlock()
+	fake.RenameContextStub = nil
+	if fake.renameContextReturnsOnCall == nil {
+		fake.renameContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.renameContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) RequestRetryCount() int {
 	fake.requestRetryCountMutex.Lock()
 	ret, specificReturn := fake.requestRetryCountReturnsOnCall[len(fake.requestRetryCountArgsForCall)]
@@ -2530,6 +3394,59 @@ func (fake *FakeConfig) RequestRetryCountReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RetryTimeout() time.Duration {
+	fake.retryTimeoutMutex.Lock()
+	ret, specificReturn := fake.retryTimeoutReturnsOnCall[len(fake.retryTimeoutArgsForCall)]
+	fake.retryTimeoutArgsForCall = append(fake.retryTimeoutArgsForCall, struct {
+	}{})
+	stub := fake.RetryTimeoutStub
+	fakeReturns := fake.retryTimeoutReturns
+	fake.recordInvocation("RetryTimeout", []interface{}{})
+	fake.retryTimeoutMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RetryTimeoutCallCount() int {
+	fake.retryTimeoutMutex.RLock()
+	defer fake.retryTimeoutMutex.RUnlock()
+	return len(fake.retryTimeoutArgsForCall)
+}
+
+func (fake *FakeConfig) RetryTimeoutCalls(stub func() time.Duration) {
+	fake.retryTimeoutMutex.Lock()
+	defer fake.retryTimeoutMutex.Unlock()
+	fake.RetryTimeoutStub = stub
+}
+
+func (fake *FakeConfig) RetryTimeoutReturns(result1 time.Duration) {
+	fake.retryTimeoutMutex.Lock()
+	defer fake.retryTimeoutMutex.Unlock()
+	fake.RetryTimeoutStub = nil
+	fake.retryTimeoutReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) RetryTimeoutReturnsOnCall(i int, result1 time.Duration) {
+	fake.retryTimeoutMutex.Lock()
+	defer fake.retryTimeoutMutex.Unlock()
+	fake.RetryTimeoutStub = nil
+	if fake.retryTimeoutReturnsOnCall == nil {
+		fake.retryTimeoutReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.retryTimeoutReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
 func (fake *FakeConfig) RoutingEndpoint() string {
 	fake.routingEndpointMutex.Lock()
 	ret, specificReturn := fake.routingEndpointReturnsOnCall[len(fake.routingEndpointArgsForCall)]
@@ -2732,6 +3649,67 @@ func (fake *FakeConfig) SetColorEnabledArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) SetCredentialHelper(arg1 string) error {
+	fake.setCredentialHelperMutex.Lock()
+	ret, specificReturn := fake.setCredentialHelperReturnsOnCall[len(fake.setCredentialHelperArgsForCall)]
+	fake.setCredentialHelperArgsForCall = append(fake.setCredentialHelperArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SetCredentialHelperStub
+	fakeReturns := fake.setCredentialHelperReturns
+	fake.recordInvocation("SetCredentialHelper", []interface{}{arg1})
+	fake.setCredentialHelperMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) SetCredentialHelperCallCount() int {
+	fake.setCredentialHelperMutex.RLock()
+	defer fake.setCredentialHelperMutex.RUnlock()
+	return len(fake.setCredentialHelperArgsForCall)
+}
+
+func (fake *FakeConfig) SetCredentialHelperCalls(stub func(string) error) {
+	fake.setCredentialHelperMutex.Lock()
+	defer fake.setCredentialHelperMutex.Unlock()
+	fake.SetCredentialHelperStub = stub
+}
+
+func (fake *FakeConfig) SetCredentialHelperArgsForCall(i int) string {
+	fake.setCredentialHelperMutex.RLock()
+	defer fake.setCredentialHelperMutex.RUnlock()
+	argsForCall := fake.setCredentialHelperArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetCredentialHelperReturns(result1 error) {
+	fake.setCredentialHelperMutex.Lock()
+	defer fake.setCredentialHelperMutex.Unlock()
+	fake.SetCredentialHelperStub = nil
+	fake.setCredentialHelperReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SetCredentialHelperReturnsOnCall(i int, result1 error) {
+	fake.setCredentialHelperMutex.Lock()
+	defer fake.setCredentialHelperMutex.Unlock()
+	fake.SetCredentialHelperStub = nil
+	if fake.setCredentialHelperReturnsOnCall == nil {
+		fake.setCredentialHelperReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setCredentialHelperReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) SetKubernetesAuthInfo(arg1 string) {
 	fake.setKubernetesAuthInfoMutex.Lock()
 	fake.setKubernetesAuthInfoArgsForCall = append(fake.setKubernetesAuthInfoArgsForCall, struct {
@@ -2861,6 +3839,38 @@ func (fake *FakeConfig) SetOrganizationInformationArgsForCall(i int) (string, st
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeConfig) SetPluginSignaturePolicy(arg1 string) {
+	fake.setPluginSignaturePolicyMutex.Lock()
+	fake.setPluginSignaturePolicyArgsForCall = append(fake.setPluginSignaturePolicyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SetPluginSignaturePolicyStub
+	fake.recordInvocation("SetPluginSignaturePolicy", []interface{}{arg1})
+	fake.setPluginSignaturePolicyMutex.Unlock()
+	if stub != nil {
+		fake.SetPluginSignaturePolicyStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetPluginSignaturePolicyCallCount() int {
+	fake.setPluginSignaturePolicyMutex.RLock()
+	defer fake.setPluginSignaturePolicyMutex.RUnlock()
+	return len(fake.setPluginSignaturePolicyArgsForCall)
+}
+
+func (fake *FakeConfig) SetPluginSignaturePolicyCalls(stub func(string)) {
+	fake.setPluginSignaturePolicyMutex.Lock()
+	defer fake.setPluginSignaturePolicyMutex.Unlock()
+	fake.SetPluginSignaturePolicyStub = stub
+}
+
+func (fake *FakeConfig) SetPluginSignaturePolicyArgsForCall(i int) string {
+	fake.setPluginSignaturePolicyMutex.RLock()
+	defer fake.setPluginSignaturePolicyMutex.RUnlock()
+	argsForCall := fake.setPluginSignaturePolicyArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetRefreshToken(arg1 string) {
 	fake.setRefreshTokenMutex.Lock()
 	fake.setRefreshTokenArgsForCall = append(fake.setRefreshTokenArgsForCall, struct {
@@ -3281,6 +4291,67 @@ func (fake *FakeConfig) StartupTimeoutReturnsOnCall(i int, result1 time.Duration
 	}{result1}
 }
 
+func (fake *FakeConfig) SwitchContext(arg1 string) error {
+	fake.switchContextMutex.Lock()
+	ret, specificReturn := fake.switchContextReturnsOnCall[len(fake.switchContextArgsForCall)]
+	fake.switchContextArgsForCall = append(fake.switchContextArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SwitchContextStub
+	fakeReturns := fake.switchContextReturns
+	fake.recordInvocation("SwitchContext", []interface{}{arg1})
+	fake.switchContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) SwitchContextCallCount() int {
+	fake.switchContextMutex.RLock()
+	defer fake.switchContextMutex.RUnlock()
+	return len(fake.switchContextArgsForCall)
+}
+
+func (fake *FakeConfig) SwitchContextCalls(stub func(string) error) {
+	fake.switchContextMutex.Lock()
+	defer fake.switchContextMutex.Unlock()
+	fake.SwitchContextStub = stub
+}
+
+func (fake *FakeConfig) SwitchContextArgsForCall(i int) string {
+	fake.switchContextMutex.RLock()
+	defer fake.switchContextMutex.RUnlock()
+	argsForCall := fake.switchContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SwitchContextReturns(result1 error) {
+	fake.switchContextMutex.Lock()
+	defer fake.switchContextMutex.Unlock()
+	fake.SwitchContextStub = nil
+	fake.switchContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SwitchContextReturnsOnCall(i int, result1 error) {
+	fake.switchContextMutex.Lock()
+	defer fake.switchContextMutex.Unlock()
+	fake.SwitchContextStub = nil
+	if fake.switchContextReturnsOnCall == nil {
+		fake.switchContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.switchContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) Target() string {
 	fake.targetMutex.Lock()
 	ret, specificReturn := fake.targetReturnsOnCall[len(fake.targetArgsForCall)]
@@ -3546,6 +4617,59 @@ func (fake *FakeConfig) TerminalWidthReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) TrustedPluginKeys() []configv3.TrustedPluginKey {
+	fake.trustedPluginKeysMutex.Lock()
+	ret, specificReturn := fake.trustedPluginKeysReturnsOnCall[len(fake.trustedPluginKeysArgsForCall)]
+	fake.trustedPluginKeysArgsForCall = append(fake.trustedPluginKeysArgsForCall, struct {
+	}{})
+	stub := fake.TrustedPluginKeysStub
+	fakeReturns := fake.trustedPluginKeysReturns
+	fake.recordInvocation("TrustedPluginKeys", []interface{}{})
+	fake.trustedPluginKeysMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) TrustedPluginKeysCallCount() int {
+	fake.trustedPluginKeysMutex.RLock()
+	defer fake.trustedPluginKeysMutex.RUnlock()
+	return len(fake.trustedPluginKeysArgsForCall)
+}
+
+func (fake *FakeConfig) TrustedPluginKeysCalls(stub func() []configv3.TrustedPluginKey) {
+	fake.trustedPluginKeysMutex.Lock()
+	defer fake.trustedPluginKeysMutex.Unlock()
+	fake.TrustedPluginKeysStub = stub
+}
+
+func (fake *FakeConfig) TrustedPluginKeysReturns(result1 []configv3.TrustedPluginKey) {
+	fake.trustedPluginKeysMutex.Lock()
+	defer fake.trustedPluginKeysMutex.Unlock()
+	fake.TrustedPluginKeysStub = nil
+	fake.trustedPluginKeysReturns = struct {
+		result1 []configv3.TrustedPluginKey
+	}{result1}
+}
+
+func (fake *FakeConfig) TrustedPluginKeysReturnsOnCall(i int, result1 []configv3.TrustedPluginKey) {
+	fake.trustedPluginKeysMutex.Lock()
+	defer fake.trustedPluginKeysMutex.Unlock()
+	fake.TrustedPluginKeysStub = nil
+	if fake.trustedPluginKeysReturnsOnCall == nil {
+		fake.trustedPluginKeysReturnsOnCall = make(map[int]struct {
+			result1 []configv3.TrustedPluginKey
+		})
+	}
+	fake.trustedPluginKeysReturnsOnCall[i] = struct {
+		result1 []configv3.TrustedPluginKey
+	}{result1}
+}
+
 func (fake *FakeConfig) UAADisableKeepAlives() bool {
 	fake.uAADisableKeepAlivesMutex.Lock()
 	ret, specificReturn := fake.uAADisableKeepAlivesReturnsOnCall[len(fake.uAADisableKeepAlivesArgsForCall)]
@@ -3883,6 +5007,59 @@ func (fake *FakeConfig) UnsetUserInformationCalls(stub func()) {
 	fake.UnsetUserInformationStub = stub
 }
 
+func (fake *FakeConfig) UseGitIgnore() bool {
+	fake.useGitIgnoreMutex.Lock()
+	ret, specificReturn := fake.useGitIgnoreReturnsOnCall[len(fake.useGitIgnoreArgsForCall)]
+	fake.useGitIgnoreArgsForCall = append(fake.useGitIgnoreArgsForCall, struct {
+	}{})
+	stub := fake.UseGitIgnoreStub
+	fakeReturns := fake.useGitIgnoreReturns
+	fake.recordInvocation("UseGitIgnore", []interface{}{})
+	fake.useGitIgnoreMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) UseGitIgnoreCallCount() int {
+	fake.useGitIgnoreMutex.RLock()
+	defer fake.useGitIgnoreMutex.RUnlock()
+	return len(fake.useGitIgnoreArgsForCall)
+}
+
+func (fake *FakeConfig) UseGitIgnoreCalls(stub func() bool) {
+	fake.useGitIgnoreMutex.Lock()
+	defer fake.useGitIgnoreMutex.Unlock()
+	fake.UseGitIgnoreStub = stub
+}
+
+func (fake *FakeConfig) UseGitIgnoreReturns(result1 bool) {
+	fake.useGitIgnoreMutex.Lock()
+	defer fake.useGitIgnoreMutex.Unlock()
+	fake.UseGitIgnoreStub = nil
+	fake.useGitIgnoreReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) UseGitIgnoreReturnsOnCall(i int, result1 bool) {
+	fake.useGitIgnoreMutex.Lock()
+	defer fake.useGitIgnoreMutex.Unlock()
+	fake.UseGitIgnoreStub = nil
+	if fake.useGitIgnoreReturnsOnCall == nil {
+		fake.useGitIgnoreReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.useGitIgnoreReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) V7SetSpaceInformation(arg1 string, arg2 string) {
 	fake.v7SetSpaceInformationMutex.Lock()
 	fake.v7SetSpaceInformationArgsForCall = append(fake.v7SetSpaceInformationArgsForCall, struct {
@@ -117,6 +117,25 @@ type FakeUI struct {
 		arg1 ui.LogMessage
 		arg2 bool
 	}
+	DisplayLogMessageForAppStub        func(string, ui.LogMessage, bool)
+	displayLogMessageForAppMutex       sync.RWMutex
+	displayLogMessageForAppArgsForCall []struct {
+		arg1 string
+		arg2 ui.LogMessage
+		arg3 bool
+	}
+	DisplayLogMessageStructuredStub        func(string, ui.LogMessage) error
+	displayLogMessageStructuredMutex       sync.RWMutex
+	displayLogMessageStructuredArgsForCall []struct {
+		arg1 string
+		arg2 ui.LogMessage
+	}
+	displayLogMessageStructuredReturns struct {
+		result1 error
+	}
+	displayLogMessageStructuredReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisplayNewlineStub        func()
 	displayNewlineMutex       sync.RWMutex
 	displayNewlineArgsForCall []struct {
@@ -161,6 +180,17 @@ type FakeUI struct {
 		result1 string
 		result2 error
 	}
+	DisplayStructuredStub        func(interface{}) error
+	displayStructuredMutex       sync.RWMutex
+	displayStructuredArgsForCall []struct {
+		arg1 interface{}
+	}
+	displayStructuredReturns struct {
+		result1 error
+	}
+	displayStructuredReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisplayTableWithHeaderStub        func(string, [][]string, int)
 	displayTableWithHeaderMutex       sync.RWMutex
 	displayTableWithHeaderArgsForCall []struct {
@@ -261,6 +291,16 @@ type FakeUI struct {
 	getOutReturnsOnCall map[int]struct {
 		result1 io.Writer
 	}
+	IsStructuredOutputStub        func() bool
+	isStructuredOutputMutex       sync.RWMutex
+	isStructuredOutputArgsForCall []struct {
+	}
+	isStructuredOutputReturns struct {
+		result1 bool
+	}
+	isStructuredOutputReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	RequestLoggerFileWriterStub        func([]string) *ui.RequestLoggerFileWriter
 	requestLoggerFileWriterMutex       sync.RWMutex
 	requestLoggerFileWriterArgsForCall []struct {
@@ -906,6 +946,102 @@ func (fake *FakeUI) DisplayLogMessageArgsForCall(i int) (ui.LogMessage, bool) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeUI) DisplayLogMessageForApp(arg1 string, arg2 ui.LogMessage, arg3 bool) {
+	fake.displayLogMessageForAppMutex.Lock()
+	fake.displayLogMessageForAppArgsForCall = append(fake.displayLogMessageForAppArgsForCall, struct {
+		arg1 string
+		arg2 ui.LogMessage
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.DisplayLogMessageForAppStub
+	fake.recordInvocation("DisplayLogMessageForApp", []interface{}{arg1, arg2, arg3})
+	fake.displayLogMessageForAppMutex.Unlock()
+	if stub != nil {
+		fake.DisplayLogMessageForAppStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeUI) DisplayLogMessageForAppCallCount() int {
+	fake.displayLogMessageForAppMutex.RLock()
+	defer fake.displayLogMessageForAppMutex.RUnlock()
+	return len(fake.displayLogMessageForAppArgsForCall)
+}
+
+func (fake *FakeUI) DisplayLogMessageForAppCalls(stub func(string, ui.LogMessage, bool)) {
+	fake.displayLogMessageForAppMutex.Lock()
+	defer fake.displayLogMessageForAppMutex.Unlock()
+	fake.DisplayLogMessageForAppStub = stub
+}
+
+func (fake *FakeUI) DisplayLogMessageForAppArgsForCall(i int) (string, ui.LogMessage, bool) {
+	fake.displayLogMessageForAppMutex.RLock()
+	defer fake.displayLogMessageForAppMutex.RUnlock()
+	argsForCall := fake.displayLogMessageForAppArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUI) DisplayLogMessageStructured(arg1 string, arg2 ui.LogMessage) error {
+	fake.displayLogMessageStructuredMutex.Lock()
+	ret, specificReturn := fake.displayLogMessageStructuredReturnsOnCall[len(fake.displayLogMessageStructuredArgsForCall)]
+	fake.displayLogMessageStructuredArgsForCall = append(fake.displayLogMessageStructuredArgsForCall, struct {
+		arg1 string
+		arg2 ui.LogMessage
+	}{arg1, arg2})
+	stub := fake.DisplayLogMessageStructuredStub
+	fakeReturns := fake.displayLogMessageStructuredReturns
+	fake.recordInvocation("DisplayLogMessageStructured", []interface{}{arg1, arg2})
+	fake.displayLogMessageStructuredMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) DisplayLogMessageStructuredCallCount() int {
+	fake.displayLogMessageStructuredMutex.RLock()
+	defer fake.displayLogMessageStructuredMutex.RUnlock()
+	return len(fake.displayLogMessageStructuredArgsForCall)
+}
+
+func (fake *FakeUI) DisplayLogMessageStructuredCalls(stub func(string, ui.LogMessage) error) {
+	fake.displayLogMessageStructuredMutex.Lock()
+	defer fake.displayLogMessageStructuredMutex.Unlock()
+	fake.DisplayLogMessageStructuredStub = stub
+}
+
+func (fake *FakeUI) DisplayLogMessageStructuredArgsForCall(i int) (string, ui.LogMessage) {
+	fake.displayLogMessageStructuredMutex.RLock()
+	defer fake.displayLogMessageStructuredMutex.RUnlock()
+	argsForCall := fake.displayLogMessageStructuredArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUI) DisplayLogMessageStructuredReturns(result1 error) {
+	fake.displayLogMessageStructuredMutex.Lock()
+	defer fake.displayLogMessageStructuredMutex.Unlock()
+	fake.DisplayLogMessageStructuredStub = nil
+	fake.displayLogMessageStructuredReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUI) DisplayLogMessageStructuredReturnsOnCall(i int, result1 error) {
+	fake.displayLogMessageStructuredMutex.Lock()
+	defer fake.displayLogMessageStructuredMutex.Unlock()
+	fake.DisplayLogMessageStructuredStub = nil
+	if fake.displayLogMessageStructuredReturnsOnCall == nil {
+		fake.displayLogMessageStructuredReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayLogMessageStructuredReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUI) DisplayNewline() {
 	fake.displayNewlineMutex.Lock()
 	fake.displayNewlineArgsForCall = append(fake.displayNewlineArgsForCall, struct {
@@ -1124,6 +1260,67 @@ func (fake *FakeUI) DisplayPasswordPromptReturnsOnCall(i int, result1 string, re
 	}{result1, result2}
 }
 
+func (fake *FakeUI) DisplayStructured(arg1 interface{}) error {
+	fake.displayStructuredMutex.Lock()
+	ret, specificReturn := fake.displayStructuredReturnsOnCall[len(fake.displayStructuredArgsForCall)]
+	fake.displayStructuredArgsForCall = append(fake.displayStructuredArgsForCall, struct {
+		arg1 interface{}
+	}{arg1})
+	stub := fake.DisplayStructuredStub
+	fakeReturns := fake.displayStructuredReturns
+	fake.recordInvocation("DisplayStructured", []interface{}{arg1})
+	fake.displayStructuredMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) DisplayStructuredCallCount() int {
+	fake.displayStructuredMutex.RLock()
+	defer fake.displayStructuredMutex.RUnlock()
+	return len(fake.displayStructuredArgsForCall)
+}
+
+func (fake *FakeUI) DisplayStructuredCalls(stub func(interface{}) error) {
+	fake.displayStructuredMutex.Lock()
+	defer fake.displayStructuredMutex.Unlock()
+	fake.DisplayStructuredStub = stub
+}
+
+func (fake *FakeUI) DisplayStructuredArgsForCall(i int) interface{} {
+	fake.displayStructuredMutex.RLock()
+	defer fake.displayStructuredMutex.RUnlock()
+	argsForCall := fake.displayStructuredArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUI) DisplayStructuredReturns(result1 error) {
+	fake.displayStructuredMutex.Lock()
+	defer fake.displayStructuredMutex.Unlock()
+	fake.DisplayStructuredStub = nil
+	fake.displayStructuredReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUI) DisplayStructuredReturnsOnCall(i int, result1 error) {
+	fake.displayStructuredMutex.Lock()
+	defer fake.displayStructuredMutex.Unlock()
+	fake.DisplayStructuredStub = nil
+	if fake.displayStructuredReturnsOnCall == nil {
+		fake.displayStructuredReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayStructuredReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUI) DisplayTableWithHeader(arg1 string, arg2 [][]string, arg3 int) {
 	var arg2Copy [][]string
 	if arg2 != nil {
@@ -1659,6 +1856,59 @@ func (fake *FakeUI) GetOutReturnsOnCall(i int, result1 io.Writer) {
 	}{result1}
 }
 
+func (fake *FakeUI) IsStructuredOutput() bool {
+	fake.isStructuredOutputMutex.Lock()
+	ret, specificReturn := fake.isStructuredOutputReturnsOnCall[len(fake.isStructuredOutputArgsForCall)]
+	fake.isStructuredOutputArgsForCall = append(fake.isStructuredOutputArgsForCall, struct {
+	}{})
+	stub := fake.IsStructuredOutputStub
+	fakeReturns := fake.isStructuredOutputReturns
+	fake.recordInvocation("IsStructuredOutput", []interface{}{})
+	fake.isStructuredOutputMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) IsStructuredOutputCallCount() int {
+	fake.isStructuredOutputMutex.RLock()
+	defer fake.isStructuredOutputMutex.RUnlock()
+	return len(fake.isStructuredOutputArgsForCall)
+}
+
+func (fake *FakeUI) IsStructuredOutputCalls(stub func() bool) {
+	fake.isStructuredOutputMutex.Lock()
+	defer fake.isStructuredOutputMutex.Unlock()
+	fake.IsStructuredOutputStub = stub
+}
+
+func (fake *FakeUI) IsStructuredOutputReturns(result1 bool) {
+	fake.isStructuredOutputMutex.Lock()
+	defer fake.isStructuredOutputMutex.Unlock()
+	fake.IsStructuredOutputStub = nil
+	fake.isStructuredOutputReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUI) IsStructuredOutputReturnsOnCall(i int, result1 bool) {
+	fake.isStructuredOutputMutex.Lock()
+	defer fake.isStructuredOutputMutex.Unlock()
+	fake.IsStructuredOutputStub = nil
+	if fake.isStructuredOutputReturnsOnCall == nil {
+		fake.isStructuredOutputReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isStructuredOutputReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeUI) RequestLoggerFileWriter(arg1 []string) *ui.RequestLoggerFileWriter {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -3,6 +3,7 @@ package common
 import (
 	"reflect"
 
+	"code.cloudfoundry.org/cli/v9/command/flag"
 	"code.cloudfoundry.org/cli/v9/command/plugin"
 	v7 "code.cloudfoundry.org/cli/v9/command/v7"
 )
@@ -11,7 +12,9 @@ var Commands commandList
 var ShouldFallbackToLegacy = false
 
 type commandList struct {
-	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	VerboseOrVersion bool              `short:"v" long:"version" description:"verbose and version flag"`
+	OutputFormat     flag.OutputFormat `long:"output-format" description:"Render command output as a json or yaml document"`
+	Context          string            `long:"context" description:"Use the named context for this command only"`
 
 	V3Push v7.PushCommand `command:"v3-push" description:"Push a new app or sync changes to an existing app" hidden:"true"`
 
@@ -19,11 +22,15 @@ type commandList struct {
 	AddRoutePolicy                     v7.AddRoutePolicyCommand                     `command:"add-route-policy" description:"Add a route policy to allow specific apps, spaces, or orgs to access a route"`
 	API                                v7.APICommand                                `command:"api" description:"Set or view target api url"`
 	AddNetworkPolicy                   v7.AddNetworkPolicyCommand                   `command:"add-network-policy" description:"Create policy to allow direct network traffic from one app to another"`
+	AddPluginKey                       plugin.AddPluginKeyCommand                   `command:"add-plugin-key" description:"Trust plugins signed with a public key"`
 	AddPluginRepo                      plugin.AddPluginRepoCommand                  `command:"add-plugin-repo" description:"Add a new plugin repository"`
 	AllowSpaceSSH                      v7.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
+	AnalyzeSecurityGroups              v7.AnalyzeSecurityGroupsCommand              `command:"analyze-security-groups" description:"Report overly broad, shadowed and unbound security group rules"`
 	App                                v7.AppCommand                                `command:"app" description:"Display health and status for an app"`
+	AppUsageEvents                     v7.AppUsageEventsCommand                     `command:"app-usage-events" description:"List app usage events"`
 	ApplyManifest                      v7.ApplyManifestCommand                      `command:"apply-manifest" description:"Apply manifest properties to a space"`
 	Apps                               v7.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
+	AuditEvents                        v7.AuditEventsCommand                        `command:"audit-events" description:"List audit events in a space, an org or across all orgs"`
 	Auth                               v7.AuthCommand                               `command:"auth" description:"Authenticate non-interactively"`
 	BindRouteService                   v7.BindRouteServiceCommand                   `command:"bind-route-service" alias:"brs" description:"Bind a service instance to an HTTP route"`
 	BindRunningSecurityGroup           v7.BindRunningSecurityGroupCommand           `command:"bind-running-security-group" description:"Bind a security group to the list of security groups to be used for running applications"`
@@ -35,11 +42,13 @@ type commandList struct {
 	CheckRoute                         v7.CheckRouteCommand                         `command:"check-route" description:"Perform a check to determine whether a route currently exists or not"`
 	CleanupOutdatedServiceBindings     v7.CleanupOutdatedServiceBindingsCommand     `command:"cleanup-outdated-service-bindings" description:"Cleans up old service bindings for an app, keeping only the most recent binding for each service instance"`
 	Config                             v7.ConfigCommand                             `command:"config" description:"Write default values to the config"`
+	Contexts                           v7.ContextsCommand                           `command:"contexts" description:"List all contexts"`
 	ContinueDeployment                 v7.ContinueDeploymentCommand                 `command:"continue-deployment" description:"Continue the most recent deployment for an app."`
 	CopySource                         v7.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application and restages that application"`
 	CreateApp                          v7.CreateAppCommand                          `command:"create-app" description:"Create an Application in the target space"`
 	CreateAppManifest                  v7.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
 	CreateBuildpack                    v7.CreateBuildpackCommand                    `command:"create-buildpack" description:"Create a buildpack"`
+	CreateContext                      v7.CreateContextCommand                      `command:"create-context" description:"Create a context to hold the target of another foundation"`
 	CreatePackage                      v7.CreatePackageCommand                      `command:"create-package" description:"Uploads a Package"`
 	CreateIsolationSegment             v7.CreateIsolationSegmentCommand             `command:"create-isolation-segment" description:"Create an isolation segment"`
 	CreateOrg                          v7.CreateOrgCommand                          `command:"create-org" alias:"co" description:"Create an org"`
@@ -52,6 +61,7 @@ type commandList struct {
 	CreateServiceBroker                v7.CreateServiceBrokerCommand                `command:"create-service-broker" alias:"csb" description:"Create a service broker"`
 	CreateServiceKey                   v7.CreateServiceKeyCommand                   `command:"create-service-key" alias:"csk" description:"Create key for a service instance"`
 	CreateSharedDomain                 v7.CreateSharedDomainCommand                 `command:"create-shared-domain" description:"Create a domain that can be used by all orgs (admin-only)"`
+	CreateSidecar                      v7.CreateSidecarCommand                      `command:"create-sidecar" description:"Create a sidecar for an app"`
 	CreateSpace                        v7.CreateSpaceCommand                        `command:"create-space" alias:"csp" description:"Create a space"`
 	CreateSpaceQuota                   v7.CreateSpaceQuotaCommand                   `command:"create-space-quota" description:"Define a new quota for a space"`
 	CreateUser                         v7.CreateUserCommand                         `command:"create-user" description:"Create a new user"`
@@ -59,6 +69,7 @@ type commandList struct {
 	Curl                               v7.CurlCommand                               `command:"curl" description:"Executes a request to the targeted API endpoint"`
 	Delete                             v7.DeleteCommand                             `command:"delete" alias:"d" description:"Delete an app"`
 	DeleteBuildpack                    v7.DeleteBuildpackCommand                    `command:"delete-buildpack" description:"Delete a buildpack"`
+	DeleteContext                      v7.DeleteContextCommand                      `command:"delete-context" description:"Delete a context"`
 	DeleteIsolationSegment             v7.DeleteIsolationSegmentCommand             `command:"delete-isolation-segment" description:"Delete an isolation segment"`
 	DeleteOrg                          v7.DeleteOrgCommand                          `command:"delete-org" description:"Delete an org"`
 	DeleteOrgQuota                     v7.DeleteOrgQuotaCommand                     `command:"delete-org-quota" alias:"delete-quota" description:"Delete an organization quota"`
@@ -70,9 +81,11 @@ type commandList struct {
 	DeleteServiceBroker                v7.DeleteServiceBrokerCommand                `command:"delete-service-broker" description:"Delete a service broker"`
 	DeleteServiceKey                   v7.DeleteServiceKeyCommand                   `command:"delete-service-key" alias:"dsk" description:"Delete a service key"`
 	DeleteSharedDomain                 v7.DeleteSharedDomainCommand                 `command:"delete-shared-domain" description:"Delete a shared domain"`
+	DeleteSidecar                      v7.DeleteSidecarCommand                      `command:"delete-sidecar" description:"Delete a sidecar of an app"`
 	DeleteSpace                        v7.DeleteSpaceCommand                        `command:"delete-space" description:"Delete a space"`
 	DeleteSpaceQuota                   v7.DeleteSpaceQuotaCommand                   `command:"delete-space-quota" description:"Delete a space quota"`
 	DeleteUser                         v7.DeleteUserCommand                         `command:"delete-user" description:"Delete a user"`
+	DiffManifest                       v7.DiffManifestCommand                       `command:"diff-manifest" description:"Show how the apps in a space differ from a manifest"`
 	DisableFeatureFlag                 v7.DisableFeatureFlagCommand                 `command:"disable-feature-flag" description:"Prevent use of a feature"`
 	DisableOrgIsolation                v7.DisableOrgIsolationCommand                `command:"disable-org-isolation" description:"Revoke an organization's entitlement to an isolation segment"`
 	DisableSSH                         v7.DisableSSHCommand                         `command:"disable-ssh" description:"Disable ssh for the application"`
@@ -87,6 +100,7 @@ type commandList struct {
 	EnableServiceAccess                v7.EnableServiceAccessCommand                `command:"enable-service-access" description:"Enable access to a service offering or service plan for one or all orgs"`
 	Env                                v7.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
 	Events                             v7.EventsCommand                             `command:"events" description:"Show recent app events"`
+	ExportNetworkPolicies              v7.ExportNetworkPoliciesCommand              `command:"export-network-policies" description:"Export the network policies of the targeted space as a network policy file"`
 	FeatureFlag                        v7.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
 	FeatureFlags                       v7.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status"`
 	GetHealthCheck                     v7.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
@@ -102,6 +116,7 @@ type commandList struct {
 	MapRoute                           v7.MapRouteCommand                           `command:"map-route" description:"Map a route to an app"`
 	Marketplace                        v7.MarketplaceCommand                        `command:"marketplace" alias:"m" description:"List available offerings in the marketplace"`
 	NetworkPolicies                    v7.NetworkPoliciesCommand                    `command:"network-policies" description:"List direct network traffic policies"`
+	NetworkPolicyGraph                 v7.NetworkPolicyGraphCommand                 `command:"network-policy-graph" description:"Print the graph of network policies as Graphviz DOT or Mermaid"`
 	OauthToken                         v7.OauthTokenCommand                         `command:"oauth-token" description:"Display the OAuth token for the current session and refresh the token if necessary"`
 	Org                                v7.OrgCommand                                `command:"org" description:"Show org info"`
 	OrgQuotas                          v7.OrgQuotasCommand                          `command:"org-quotas" alias:"quotas" description:"List available organization quotas"`
@@ -110,14 +125,17 @@ type commandList struct {
 	Orgs                               v7.OrgsCommand                               `command:"orgs" alias:"o" description:"List all orgs"`
 	Packages                           v7.PackagesCommand                           `command:"packages" description:"List packages of an app"`
 	Passwd                             v7.PasswdCommand                             `command:"passwd" alias:"pw" description:"Change user password"`
+	PluginKeys                         plugin.PluginKeysCommand                     `command:"plugin-keys" description:"List the public keys trusted to sign plugins"`
 	Plugins                            plugin.PluginsCommand                        `command:"plugins" description:"List commands of installed plugins"`
 	PurgeServiceInstance               v7.PurgeServiceInstanceCommand               `command:"purge-service-instance" description:"Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"`
 	PurgeServiceOffering               v7.PurgeServiceOfferingCommand               `command:"purge-service-offering" description:"Recursively remove a service offering and child objects from Cloud Foundry database without making requests to a service broker"`
 	Push                               v7.PushCommand                               `command:"push" alias:"p" description:"Push a new app or sync changes to an existing app"`
 	RemoveNetworkPolicy                v7.RemoveNetworkPolicyCommand                `command:"remove-network-policy" description:"Remove network traffic policy of an app"`
 	RemoveRoutePolicy                  v7.RemoveRoutePolicyCommand                  `command:"remove-route-policy" description:"Remove a route policy from a route"`
+	RemovePluginKey                    plugin.RemovePluginKeyCommand                `command:"remove-plugin-key" description:"Stop trusting plugins signed with a public key"`
 	RemovePluginRepo                   plugin.RemovePluginRepoCommand               `command:"remove-plugin-repo" description:"Remove a plugin repository"`
 	Rename                             v7.RenameCommand                             `command:"rename" description:"Rename an app"`
+	RenameContext                      v7.RenameContextCommand                      `command:"rename-context" description:"Rename a context"`
 	RenameOrg                          v7.RenameOrgCommand                          `command:"rename-org" description:"Rename an org"`
 	RenameService                      v7.RenameServiceCommand                      `command:"rename-service" description:"Rename a service instance"`
 	RenameServiceBroker                v7.RenameServiceBrokerCommand                `command:"rename-service-broker" description:"Rename a service broker"`
@@ -138,6 +156,7 @@ type commandList struct {
 	RunTask                            v7.RunTaskCommand                            `command:"run-task" alias:"rt" description:"Run a one-off task on an app"`
 	RunningEnvironmentVariableGroup    v7.RunningEnvironmentVariableGroupCommand    `command:"running-environment-variable-group" alias:"revg" description:"Retrieve the contents of the running environment variable group"`
 	RunningSecurityGroups              v7.RunningSecurityGroupsCommand              `command:"running-security-groups" description:"List security groups globally configured for running applications"`
+	SCP                                v7.SCPCommand                                `command:"scp" description:"Copy files and directories to or from an application container instance"`
 	SSH                                v7.SSHCommand                                `command:"ssh" description:"SSH to an application container instance"`
 	SSHCode                            v7.SSHCodeCommand                            `command:"ssh-code" description:"Get a one time password for ssh clients"`
 	SSHEnabled                         v7.SSHEnabledCommand                         `command:"ssh-enabled" description:"Reports whether SSH is enabled on an application container instance"`
@@ -149,6 +168,7 @@ type commandList struct {
 	ServiceBrokers                     v7.ServiceBrokersCommand                     `command:"service-brokers" description:"List service brokers"`
 	ServiceKey                         v7.ServiceKeyCommand                         `command:"service-key" description:"Show service key info"`
 	ServiceKeys                        v7.ServiceKeysCommand                        `command:"service-keys" alias:"sk" description:"List keys for a service instance"`
+	ServiceUsageEvents                 v7.ServiceUsageEventsCommand                 `command:"service-usage-events" description:"List service usage events"`
 	Services                           v7.ServicesCommand                           `command:"services" alias:"s" description:"List all service instances in the target space"`
 	SetDroplet                         v7.SetDropletCommand                         `command:"set-droplet" description:"Set the droplet used to run an app"`
 	SetEnv                             v7.SetEnvCommand                             `command:"set-env" alias:"se" description:"Set an env variable for an app"`
@@ -165,6 +185,7 @@ type commandList struct {
 	SharePrivateDomain                 v7.SharePrivateDomainCommand                 `command:"share-private-domain" description:"Share a private domain with a specific org"`
 	ShareService                       v7.ShareServiceCommand                       `command:"share-service" description:"Share a service instance with another space"`
 	ShareRoute                         v7.ShareRouteCommand                         `command:"share-route" description:"Share a route in between spaces"`
+	Sidecars                           v7.SidecarsCommand                           `command:"sidecars" description:"List sidecars of an app"`
 	Space                              v7.SpaceCommand                              `command:"space" description:"Show space info"`
 	SpaceQuota                         v7.SpaceQuotaCommand                         `command:"space-quota" description:"Show space quota info"`
 	SpaceQuotas                        v7.SpaceQuotasCommand                        `command:"space-quotas" description:"List available space quotas"`
@@ -177,6 +198,8 @@ type commandList struct {
 	StagingSecurityGroups              v7.StagingSecurityGroupsCommand              `command:"staging-security-groups" description:"List security groups globally configured for staging applications"`
 	Start                              v7.StartCommand                              `command:"start" alias:"st" description:"Start an app"`
 	Stop                               v7.StopCommand                               `command:"stop" alias:"sp" description:"Stop an app"`
+	SwitchContext                      v7.SwitchContextCommand                      `command:"switch-context" description:"Switch the current context"`
+	SyncNetworkPolicies                v7.SyncNetworkPoliciesCommand                `command:"sync-network-policies" description:"Make network policies match a network policy file"`
 	Target                             v7.TargetCommand                             `command:"target" alias:"t" description:"Set or view the targeted org or space"`
 	Task                               v7.TaskCommand                               `command:"task" description:"Display a task of an app"`
 	Tasks                              v7.TasksCommand                              `command:"tasks" description:"List tasks of an app"`
@@ -202,11 +225,15 @@ type commandList struct {
 	UpdateOrgQuota                     v7.UpdateOrgQuotaCommand                     `command:"update-org-quota" alias:"update-quota" description:"Update an existing organization quota"`
 	UpdateSecurityGroup                v7.UpdateSecurityGroupCommand                `command:"update-security-group" description:"Update a security group"`
 	UpdateService                      v7.UpdateServiceCommand                      `command:"update-service" description:"Update a service instance"`
+	UpgradePlugins                     UpgradePluginsCommand                        `command:"upgrade-plugins" description:"Upgrade installed CLI plugins from the plugin repositories"`
 	UpgradeService                     v7.UpgradeServiceCommand                     `command:"upgrade-service" description:"Upgrade a service instance to the latest available version of its current service plan"`
 	UpdateServiceBroker                v7.UpdateServiceBrokerCommand                `command:"update-service-broker" description:"Update a service broker"`
+	UpdateSidecar                      v7.UpdateSidecarCommand                      `command:"update-sidecar" description:"Update a sidecar of an app"`
 	UpdateSpaceQuota                   v7.UpdateSpaceQuotaCommand                   `command:"update-space-quota" description:"Update an existing space quota"`
 	UpdateStack                        v7.UpdateStackCommand                        `command:"update-stack" description:"Transition a stack between the defined states"`
 	UpdateUserProvidedService          v7.UpdateUserProvidedServiceCommand          `command:"update-user-provided-service" alias:"uups" description:"Update user-provided service instance"`
+	UsageReport                        v7.UsageReportCommand                        `command:"usage-report" description:"Report instance-hours used by each app over a time window"`
+	ValidateManifest                   v7.ValidateManifestCommand                   `command:"validate-manifest" description:"Check an app manifest for errors without pushing"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
 }
 
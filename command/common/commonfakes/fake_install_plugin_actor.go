@@ -108,6 +108,20 @@ type FakeInstallPluginActor struct {
 		result1 configv3.PluginRepository
 		result2 error
 	}
+	GetPluginSignatureStub        func(string, string) (string, error)
+	getPluginSignatureMutex       sync.RWMutex
+	getPluginSignatureArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getPluginSignatureReturns struct {
+		result1 string
+		result2 error
+	}
+	getPluginSignatureReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	InstallPluginFromPathStub        func(string, configv3.Plugin) error
 	installPluginFromPathMutex       sync.RWMutex
 	installPluginFromPathArgsForCall []struct {
@@ -144,6 +158,20 @@ type FakeInstallPluginActor struct {
 	validateFileChecksumReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	VerifyPluginSignatureStub        func(string, string) (string, error)
+	verifyPluginSignatureMutex       sync.RWMutex
+	verifyPluginSignatureArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	verifyPluginSignatureReturns struct {
+		result1 string
+		result2 error
+	}
+	verifyPluginSignatureReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -606,6 +634,71 @@ func (fake *FakeInstallPluginActor) GetPluginRepositoryReturnsOnCall(i int, resu
 	}{result1, result2}
 }
 
+func (fake *FakeInstallPluginActor) GetPluginSignature(arg1 string, arg2 string) (string, error) {
+	fake.getPluginSignatureMutex.Lock()
+	ret, specificReturn := fake.getPluginSignatureReturnsOnCall[len(fake.getPluginSignatureArgsForCall)]
+	fake.getPluginSignatureArgsForCall = append(fake.getPluginSignatureArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetPluginSignatureStub
+	fakeReturns := fake.getPluginSignatureReturns
+	fake.recordInvocation("GetPluginSignature", []interface{}{arg1, arg2})
+	fake.getPluginSignatureMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInstallPluginActor) GetPluginSignatureCallCount() int {
+	fake.getPluginSignatureMutex.RLock()
+	defer fake.getPluginSignatureMutex.RUnlock()
+	return len(fake.getPluginSignatureArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) GetPluginSignatureCalls(stub func(string, string) (string, error)) {
+	fake.getPluginSignatureMutex.Lock()
+	defer fake.getPluginSignatureMutex.Unlock()
+	fake.GetPluginSignatureStub = stub
+}
+
+func (fake *FakeInstallPluginActor) GetPluginSignatureArgsForCall(i int) (string, string) {
+	fake.getPluginSignatureMutex.RLock()
+	defer fake.getPluginSignatureMutex.RUnlock()
+	argsForCall := fake.getPluginSignatureArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInstallPluginActor) GetPluginSignatureReturns(result1 string, result2 error) {
+	fake.getPluginSignatureMutex.Lock()
+	defer fake.getPluginSignatureMutex.Unlock()
+	fake.GetPluginSignatureStub = nil
+	fake.getPluginSignatureReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallPluginActor) GetPluginSignatureReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getPluginSignatureMutex.Lock()
+	defer fake.getPluginSignatureMutex.Unlock()
+	fake.GetPluginSignatureStub = nil
+	if fake.getPluginSignatureReturnsOnCall == nil {
+		fake.getPluginSignatureReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getPluginSignatureReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInstallPluginActor) InstallPluginFromPath(arg1 string, arg2 configv3.Plugin) error {
 	fake.installPluginFromPathMutex.Lock()
 	ret, specificReturn := fake.installPluginFromPathReturnsOnCall[len(fake.installPluginFromPathArgsForCall)]
@@ -792,6 +885,71 @@ func (fake *FakeInstallPluginActor) ValidateFileChecksumReturnsOnCall(i int, res
 	}{result1}
 }
 
+func (fake *FakeInstallPluginActor) VerifyPluginSignature(arg1 string, arg2 string) (string, error) {
+	fake.verifyPluginSignatureMutex.Lock()
+	ret, specificReturn := fake.verifyPluginSignatureReturnsOnCall[len(fake.verifyPluginSignatureArgsForCall)]
+	fake.verifyPluginSignatureArgsForCall = append(fake.verifyPluginSignatureArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.VerifyPluginSignatureStub
+	fakeReturns := fake.verifyPluginSignatureReturns
+	fake.recordInvocation("VerifyPluginSignature", []interface{}{arg1, arg2})
+	fake.verifyPluginSignatureMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureCallCount() int {
+	fake.verifyPluginSignatureMutex.RLock()
+	defer fake.verifyPluginSignatureMutex.RUnlock()
+	return len(fake.verifyPluginSignatureArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureCalls(stub func(string, string) (string, error)) {
+	fake.verifyPluginSignatureMutex.Lock()
+	defer fake.verifyPluginSignatureMutex.Unlock()
+	fake.VerifyPluginSignatureStub = stub
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureArgsForCall(i int) (string, string) {
+	fake.verifyPluginSignatureMutex.RLock()
+	defer fake.verifyPluginSignatureMutex.RUnlock()
+	argsForCall := fake.verifyPluginSignatureArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureReturns(result1 string, result2 error) {
+	fake.verifyPluginSignatureMutex.Lock()
+	defer fake.verifyPluginSignatureMutex.Unlock()
+	fake.VerifyPluginSignatureStub = nil
+	fake.verifyPluginSignatureReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureReturnsOnCall(i int, result1 string, result2 error) {
+	fake.verifyPluginSignatureMutex.Lock()
+	defer fake.verifyPluginSignatureMutex.Unlock()
+	fake.VerifyPluginSignatureStub = nil
+	if fake.verifyPluginSignatureReturnsOnCall == nil {
+		fake.verifyPluginSignatureReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.verifyPluginSignatureReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -0,0 +1,1215 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package commonfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/v9/actor/pluginaction"
+	"code.cloudfoundry.org/cli/v9/api/plugin"
+	"code.cloudfoundry.org/cli/v9/command/common"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+)
+
+type FakeUpgradePluginsActor struct {
+	CreateExecutableCopyStub        func(string, string) (string, error)
+	createExecutableCopyMutex       sync.RWMutex
+	createExecutableCopyArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	createExecutableCopyReturns struct {
+		result1 string
+		result2 error
+	}
+	createExecutableCopyReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	DownloadExecutableBinaryFromURLStub        func(string, string, plugin.ProxyReader) (string, error)
+	downloadExecutableBinaryFromURLMutex       sync.RWMutex
+	downloadExecutableBinaryFromURLArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 plugin.ProxyReader
+	}
+	downloadExecutableBinaryFromURLReturns struct {
+		result1 string
+		result2 error
+	}
+	downloadExecutableBinaryFromURLReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetAndValidatePluginStub        func(pluginaction.PluginMetadata, pluginaction.CommandList, string) (configv3.Plugin, error)
+	getAndValidatePluginMutex       sync.RWMutex
+	getAndValidatePluginArgsForCall []struct {
+		arg1 pluginaction.PluginMetadata
+		arg2 pluginaction.CommandList
+		arg3 string
+	}
+	getAndValidatePluginReturns struct {
+		result1 configv3.Plugin
+		result2 error
+	}
+	getAndValidatePluginReturnsOnCall map[int]struct {
+		result1 configv3.Plugin
+		result2 error
+	}
+	GetLockedPluginUpgradesStub        func(pluginaction.PluginLockFile, string) ([]pluginaction.PluginUpgrade, []configv3.Plugin, error)
+	getLockedPluginUpgradesMutex       sync.RWMutex
+	getLockedPluginUpgradesArgsForCall []struct {
+		arg1 pluginaction.PluginLockFile
+		arg2 string
+	}
+	getLockedPluginUpgradesReturns struct {
+		result1 []pluginaction.PluginUpgrade
+		result2 []configv3.Plugin
+		result3 error
+	}
+	getLockedPluginUpgradesReturnsOnCall map[int]struct {
+		result1 []pluginaction.PluginUpgrade
+		result2 []configv3.Plugin
+		result3 error
+	}
+	GetPlatformStringStub        func(string, string) string
+	getPlatformStringMutex       sync.RWMutex
+	getPlatformStringArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getPlatformStringReturns struct {
+		result1 string
+	}
+	getPlatformStringReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetPluginLockFileStub        func([]configv3.PluginRepository, string) (pluginaction.PluginLockFile, []configv3.Plugin, error)
+	getPluginLockFileMutex       sync.RWMutex
+	getPluginLockFileArgsForCall []struct {
+		arg1 []configv3.PluginRepository
+		arg2 string
+	}
+	getPluginLockFileReturns struct {
+		result1 pluginaction.PluginLockFile
+		result2 []configv3.Plugin
+		result3 error
+	}
+	getPluginLockFileReturnsOnCall map[int]struct {
+		result1 pluginaction.PluginLockFile
+		result2 []configv3.Plugin
+		result3 error
+	}
+	GetPluginRepositoryStub        func(string) (configv3.PluginRepository, error)
+	getPluginRepositoryMutex       sync.RWMutex
+	getPluginRepositoryArgsForCall []struct {
+		arg1 string
+	}
+	getPluginRepositoryReturns struct {
+		result1 configv3.PluginRepository
+		result2 error
+	}
+	getPluginRepositoryReturnsOnCall map[int]struct {
+		result1 configv3.PluginRepository
+		result2 error
+	}
+	GetPluginUpgradesStub        func(map[string]string, []configv3.PluginRepository, string) ([]pluginaction.PluginUpgrade, error)
+	getPluginUpgradesMutex       sync.RWMutex
+	getPluginUpgradesArgsForCall []struct {
+		arg1 map[string]string
+		arg2 []configv3.PluginRepository
+		arg3 string
+	}
+	getPluginUpgradesReturns struct {
+		result1 []pluginaction.PluginUpgrade
+		result2 error
+	}
+	getPluginUpgradesReturnsOnCall map[int]struct {
+		result1 []pluginaction.PluginUpgrade
+		result2 error
+	}
+	InstallPluginFromPathStub        func(string, configv3.Plugin) error
+	installPluginFromPathMutex       sync.RWMutex
+	installPluginFromPathArgsForCall []struct {
+		arg1 string
+		arg2 configv3.Plugin
+	}
+	installPluginFromPathReturns struct {
+		result1 error
+	}
+	installPluginFromPathReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PinPluginVersionsStub        func(map[string]string) error
+	pinPluginVersionsMutex       sync.RWMutex
+	pinPluginVersionsArgsForCall []struct {
+		arg1 map[string]string
+	}
+	pinPluginVersionsReturns struct {
+		result1 error
+	}
+	pinPluginVersionsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReadPluginLockFileStub        func(string) (pluginaction.PluginLockFile, error)
+	readPluginLockFileMutex       sync.RWMutex
+	readPluginLockFileArgsForCall []struct {
+		arg1 string
+	}
+	readPluginLockFileReturns struct {
+		result1 pluginaction.PluginLockFile
+		result2 error
+	}
+	readPluginLockFileReturnsOnCall map[int]struct {
+		result1 pluginaction.PluginLockFile
+		result2 error
+	}
+	UninstallPluginStub        func(pluginaction.PluginUninstaller, string) error
+	uninstallPluginMutex       sync.RWMutex
+	uninstallPluginArgsForCall []struct {
+		arg1 pluginaction.PluginUninstaller
+		arg2 string
+	}
+	uninstallPluginReturns struct {
+		result1 error
+	}
+	uninstallPluginReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateFileChecksumStub        func(string, string) bool
+	validateFileChecksumMutex       sync.RWMutex
+	validateFileChecksumArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	validateFileChecksumReturns struct {
+		result1 bool
+	}
+	validateFileChecksumReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	VerifyPluginSignatureStub        func(string, string) (string, error)
+	verifyPluginSignatureMutex       sync.RWMutex
+	verifyPluginSignatureArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	verifyPluginSignatureReturns struct {
+		result1 string
+		result2 error
+	}
+	verifyPluginSignatureReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	WritePluginLockFileStub        func(string, pluginaction.PluginLockFile) error
+	writePluginLockFileMutex       sync.RWMutex
+	writePluginLockFileArgsForCall []struct {
+		arg1 string
+		arg2 pluginaction.PluginLockFile
+	}
+	writePluginLockFileReturns struct {
+		result1 error
+	}
+	writePluginLockFileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUpgradePluginsActor) CreateExecutableCopy(arg1 string, arg2 string) (string, error) {
+	fake.createExecutableCopyMutex.Lock()
+	ret, specificReturn := fake.createExecutableCopyReturnsOnCall[len(fake.createExecutableCopyArgsForCall)]
+	fake.createExecutableCopyArgsForCall = append(fake.createExecutableCopyArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CreateExecutableCopyStub
+	fakeReturns := fake.createExecutableCopyReturns
+	fake.recordInvocation("CreateExecutableCopy", []interface{}{arg1, arg2})
+	fake.createExecutableCopyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePluginsActor) CreateExecutableCopyCallCount() int {
+	fake.createExecutableCopyMutex.RLock()
+	defer fake.createExecutableCopyMutex.RUnlock()
+	return len(fake.createExecutableCopyArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) CreateExecutableCopyCalls(stub func(string, string) (string, error)) {
+	fake.createExecutableCopyMutex.Lock()
+	defer fake.createExecutableCopyMutex.Unlock()
+	fake.CreateExecutableCopyStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) CreateExecutableCopyArgsForCall(i int) (string, string) {
+	fake.createExecutableCopyMutex.RLock()
+	defer fake.createExecutableCopyMutex.RUnlock()
+	argsForCall := fake.createExecutableCopyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpgradePluginsActor) CreateExecutableCopyReturns(result1 string, result2 error) {
+	fake.createExecutableCopyMutex.Lock()
+	defer fake.createExecutableCopyMutex.Unlock()
+	fake.CreateExecutableCopyStub = nil
+	fake.createExecutableCopyReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) CreateExecutableCopyReturnsOnCall(i int, result1 string, result2 error) {
+	fake.createExecutableCopyMutex.Lock()
+	defer fake.createExecutableCopyMutex.Unlock()
+	fake.CreateExecutableCopyStub = nil
+	if fake.createExecutableCopyReturnsOnCall == nil {
+		fake.createExecutableCopyReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.createExecutableCopyReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) DownloadExecutableBinaryFromURL(arg1 string, arg2 string, arg3 plugin.ProxyReader) (string, error) {
+	fake.downloadExecutableBinaryFromURLMutex.Lock()
+	ret, specificReturn := fake.downloadExecutableBinaryFromURLReturnsOnCall[len(fake.downloadExecutableBinaryFromURLArgsForCall)]
+	fake.downloadExecutableBinaryFromURLArgsForCall = append(fake.downloadExecutableBinaryFromURLArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 plugin.ProxyReader
+	}{arg1, arg2, arg3})
+	stub := fake.DownloadExecutableBinaryFromURLStub
+	fakeReturns := fake.downloadExecutableBinaryFromURLReturns
+	fake.recordInvocation("DownloadExecutableBinaryFromURL", []interface{}{arg1, arg2, arg3})
+	fake.downloadExecutableBinaryFromURLMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePluginsActor) DownloadExecutableBinaryFromURLCallCount() int {
+	fake.downloadExecutableBinaryFromURLMutex.RLock()
+	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
+	return len(fake.downloadExecutableBinaryFromURLArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) DownloadExecutableBinaryFromURLCalls(stub func(string, string, plugin.ProxyReader) (string, error)) {
+	fake.downloadExecutableBinaryFromURLMutex.Lock()
+	defer fake.downloadExecutableBinaryFromURLMutex.Unlock()
+	fake.DownloadExecutableBinaryFromURLStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) DownloadExecutableBinaryFromURLArgsForCall(i int) (string, string, plugin.ProxyReader) {
+	fake.downloadExecutableBinaryFromURLMutex.RLock()
+	defer fake.downloadExecutableBinaryFromURLMutex.RUnlock()
+	argsForCall := fake.downloadExecutableBinaryFromURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUpgradePluginsActor) DownloadExecutableBinaryFromURLReturns(result1 string, result2 error) {
+	fake.downloadExecutableBinaryFromURLMutex.Lock()
+	defer fake.downloadExecutableBinaryFromURLMutex.Unlock()
+	fake.DownloadExecutableBinaryFromURLStub = nil
+	fake.downloadExecutableBinaryFromURLReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) DownloadExecutableBinaryFromURLReturnsOnCall(i int, result1 string, result2 error) {
+	fake.downloadExecutableBinaryFromURLMutex.Lock()
+	defer fake.downloadExecutableBinaryFromURLMutex.Unlock()
+	fake.DownloadExecutableBinaryFromURLStub = nil
+	if fake.downloadExecutableBinaryFromURLReturnsOnCall == nil {
+		fake.downloadExecutableBinaryFromURLReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.downloadExecutableBinaryFromURLReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) GetAndValidatePlugin(arg1 pluginaction.PluginMetadata, arg2 pluginaction.CommandList, arg3 string) (configv3.Plugin, error) {
+	fake.getAndValidatePluginMutex.Lock()
+	ret, specificReturn := fake.getAndValidatePluginReturnsOnCall[len(fake.getAndValidatePluginArgsForCall)]
+	fake.getAndValidatePluginArgsForCall = append(fake.getAndValidatePluginArgsForCall, struct {
+		arg1 pluginaction.PluginMetadata
+		arg2 pluginaction.CommandList
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetAndValidatePluginStub
+	fakeReturns := fake.getAndValidatePluginReturns
+	fake.recordInvocation("GetAndValidatePlugin", []interface{}{arg1, arg2, arg3})
+	fake.getAndValidatePluginMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePluginsActor) GetAndValidatePluginCallCount() int {
+	fake.getAndValidatePluginMutex.RLock()
+	defer fake.getAndValidatePluginMutex.RUnlock()
+	return len(fake.getAndValidatePluginArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) GetAndValidatePluginCalls(stub func(pluginaction.PluginMetadata, pluginaction.CommandList, string) (configv3.Plugin, error)) {
+	fake.getAndValidatePluginMutex.Lock()
+	defer fake.getAndValidatePluginMutex.Unlock()
+	fake.GetAndValidatePluginStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) GetAndValidatePluginArgsForCall(i int) (pluginaction.PluginMetadata, pluginaction.CommandList, string) {
+	fake.getAndValidatePluginMutex.RLock()
+	defer fake.getAndValidatePluginMutex.RUnlock()
+	argsForCall := fake.getAndValidatePluginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUpgradePluginsActor) GetAndValidatePluginReturns(result1 configv3.Plugin, result2 error) {
+	fake.getAndValidatePluginMutex.Lock()
+	defer fake.getAndValidatePluginMutex.Unlock()
+	fake.GetAndValidatePluginStub = nil
+	fake.getAndValidatePluginReturns = struct {
+		result1 configv3.Plugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) GetAndValidatePluginReturnsOnCall(i int, result1 configv3.Plugin, result2 error) {
+	fake.getAndValidatePluginMutex.Lock()
+	defer fake.getAndValidatePluginMutex.Unlock()
+	fake.GetAndValidatePluginStub = nil
+	if fake.getAndValidatePluginReturnsOnCall == nil {
+		fake.getAndValidatePluginReturnsOnCall = make(map[int]struct {
+			result1 configv3.Plugin
+			result2 error
+		})
+	}
+	fake.getAndValidatePluginReturnsOnCall[i] = struct {
+		result1 configv3.Plugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) GetLockedPluginUpgrades(arg1 pluginaction.PluginLockFile, arg2 string) ([]pluginaction.PluginUpgrade, []configv3.Plugin, error) {
+	fake.getLockedPluginUpgradesMutex.Lock()
+	ret, specificReturn := fake.getLockedPluginUpgradesReturnsOnCall[len(fake.getLockedPluginUpgradesArgsForCall)]
+	fake.getLockedPluginUpgradesArgsForCall = append(fake.getLockedPluginUpgradesArgsForCall, struct {
+		arg1 pluginaction.PluginLockFile
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetLockedPluginUpgradesStub
+	fakeReturns := fake.getLockedPluginUpgradesReturns
+	fake.recordInvocation("GetLockedPluginUpgrades", []interface{}{arg1, arg2})
+	fake.getLockedPluginUpgradesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUpgradePluginsActor) GetLockedPluginUpgradesCallCount() int {
+	fake.getLockedPluginUpgradesMutex.RLock()
+	defer fake.getLockedPluginUpgradesMutex.RUnlock()
+	return len(fake.getLockedPluginUpgradesArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) GetLockedPluginUpgradesCalls(stub func(pluginaction.PluginLockFile, string) ([]pluginaction.PluginUpgrade, []configv3.Plugin, error)) {
+	fake.getLockedPluginUpgradesMutex.Lock()
+	defer fake.getLockedPluginUpgradesMutex.Unlock()
+	fake.GetLockedPluginUpgradesStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) GetLockedPluginUpgradesArgsForCall(i int) (pluginaction.PluginLockFile, string) {
+	fake.getLockedPluginUpgradesMutex.RLock()
+	defer fake.getLockedPluginUpgradesMutex.RUnlock()
+	argsForCall := fake.getLockedPluginUpgradesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpgradePluginsActor) GetLockedPluginUpgradesReturns(result1 []pluginaction.PluginUpgrade, result2 []configv3.Plugin, result3 error) {
+	fake.getLockedPluginUpgradesMutex.Lock()
+	defer fake.getLockedPluginUpgradesMutex.Unlock()
+	fake.GetLockedPluginUpgradesStub = nil
+	fake.getLockedPluginUpgradesReturns = struct {
+		result1 []pluginaction.PluginUpgrade
+		result2 []configv3.Plugin
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpgradePluginsActor) GetLockedPluginUpgradesReturnsOnCall(i int, result1 []pluginaction.PluginUpgrade, result2 []configv3.Plugin, result3 error) {
+	fake.getLockedPluginUpgradesMutex.Lock()
+	defer fake.getLockedPluginUpgradesMutex.Unlock()
+	fake.GetLockedPluginUpgradesStub = nil
+	if fake.getLockedPluginUpgradesReturnsOnCall == nil {
+		fake.getLockedPluginUpgradesReturnsOnCall = make(map[int]struct {
+			result1 []pluginaction.PluginUpgrade
+			result2 []configv3.Plugin
+			result3 error
+		})
+	}
+	fake.getLockedPluginUpgradesReturnsOnCall[i] = struct {
+		result1 []pluginaction.PluginUpgrade
+		result2 []configv3.Plugin
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpgradePluginsActor) GetPlatformString(arg1 string, arg2 string) string {
+	fake.getPlatformStringMutex.Lock()
+	ret, specificReturn := fake.getPlatformStringReturnsOnCall[len(fake.getPlatformStringArgsForCall)]
+	fake.getPlatformStringArgsForCall = append(fake.getPlatformStringArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetPlatformStringStub
+	fakeReturns := fake.getPlatformStringReturns
+	fake.recordInvocation("GetPlatformString", []interface{}{arg1, arg2})
+	fake.getPlatformStringMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpgradePluginsActor) GetPlatformStringCallCount() int {
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	return len(fake.getPlatformStringArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) GetPlatformStringCalls(stub func(string, string) string) {
+	fake.getPlatformStringMutex.Lock()
+	defer fake.getPlatformStringMutex.Unlock()
+	fake.GetPlatformStringStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) GetPlatformStringArgsForCall(i int) (string, string) {
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	argsForCall := fake.getPlatformStringArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpgradePluginsActor) GetPlatformStringReturns(result1 string) {
+	fake.getPlatformStringMutex.Lock()
+	defer fake.getPlatformStringMutex.Unlock()
+	fake.GetPlatformStringStub = nil
+	fake.getPlatformStringReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) GetPlatformStringReturnsOnCall(i int, result1 string) {
+	fake.getPlatformStringMutex.Lock()
+	defer fake.getPlatformStringMutex.Unlock()
+	fake.GetPlatformStringStub = nil
+	if fake.getPlatformStringReturnsOnCall == nil {
+		fake.getPlatformStringReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getPlatformStringReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginLockFile(arg1 []configv3.PluginRepository, arg2 string) (pluginaction.PluginLockFile, []configv3.Plugin, error) {
+	var arg1Copy []configv3.PluginRepository
+	if arg1 != nil {
+		arg1Copy = make([]configv3.PluginRepository, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getPluginLockFileMutex.Lock()
+	ret, specificReturn := fake.getPluginLockFileReturnsOnCall[len(fake.getPluginLockFileArgsForCall)]
+	fake.getPluginLockFileArgsForCall = append(fake.getPluginLockFileArgsForCall, struct {
+		arg1 []configv3.PluginRepository
+		arg2 string
+	}{arg1Copy, arg2})
+	stub := fake.GetPluginLockFileStub
+	fakeReturns := fake.getPluginLockFileReturns
+	fake.recordInvocation("GetPluginLockFile", []interface{}{arg1Copy, arg2})
+	fake.getPluginLockFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginLockFileCallCount() int {
+	fake.getPluginLockFileMutex.RLock()
+	defer fake.getPluginLockFileMutex.RUnlock()
+	return len(fake.getPluginLockFileArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginLockFileCalls(stub func([]configv3.PluginRepository, string) (pluginaction.PluginLockFile, []configv3.Plugin, error)) {
+	fake.getPluginLockFileMutex.Lock()
+	defer fake.getPluginLockFileMutex.Unlock()
+	fake.GetPluginLockFileStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginLockFileArgsForCall(i int) ([]configv3.PluginRepository, string) {
+	fake.getPluginLockFileMutex.RLock()
+	defer fake.getPluginLockFileMutex.RUnlock()
+	argsForCall := fake.getPluginLockFileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginLockFileReturns(result1 pluginaction.PluginLockFile, result2 []configv3.Plugin, result3 error) {
+	fake.getPluginLockFileMutex.Lock()
+	defer fake.getPluginLockFileMutex.Unlock()
+	fake.GetPluginLockFileStub = nil
+	fake.getPluginLockFileReturns = struct {
+		result1 pluginaction.PluginLockFile
+		result2 []configv3.Plugin
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginLockFileReturnsOnCall(i int, result1 pluginaction.PluginLockFile, result2 []configv3.Plugin, result3 error) {
+	fake.getPluginLockFileMutex.Lock()
+	defer fake.getPluginLockFileMutex.Unlock()
+	fake.GetPluginLockFileStub = nil
+	if fake.getPluginLockFileReturnsOnCall == nil {
+		fake.getPluginLockFileReturnsOnCall = make(map[int]struct {
+			result1 pluginaction.PluginLockFile
+			result2 []configv3.Plugin
+			result3 error
+		})
+	}
+	fake.getPluginLockFileReturnsOnCall[i] = struct {
+		result1 pluginaction.PluginLockFile
+		result2 []configv3.Plugin
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginRepository(arg1 string) (configv3.PluginRepository, error) {
+	fake.getPluginRepositoryMutex.Lock()
+	ret, specificReturn := fake.getPluginRepositoryReturnsOnCall[len(fake.getPluginRepositoryArgsForCall)]
+	fake.getPluginRepositoryArgsForCall = append(fake.getPluginRepositoryArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetPluginRepositoryStub
+	fakeReturns := fake.getPluginRepositoryReturns
+	fake.recordInvocation("GetPluginRepository", []interface{}{arg1})
+	fake.getPluginRepositoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginRepositoryCallCount() int {
+	fake.getPluginRepositoryMutex.RLock()
+	defer fake.getPluginRepositoryMutex.RUnlock()
+	return len(fake.getPluginRepositoryArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginRepositoryCalls(stub func(string) (configv3.PluginRepository, error)) {
+	fake.getPluginRepositoryMutex.Lock()
+	defer fake.getPluginRepositoryMutex.Unlock()
+	fake.GetPluginRepositoryStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginRepositoryArgsForCall(i int) string {
+	fake.getPluginRepositoryMutex.RLock()
+	defer fake.getPluginRepositoryMutex.RUnlock()
+	argsForCall := fake.getPluginRepositoryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginRepositoryReturns(result1 configv3.PluginRepository, result2 error) {
+	fake.getPluginRepositoryMutex.Lock()
+	defer fake.getPluginRepositoryMutex.Unlock()
+	fake.GetPluginRepositoryStub = nil
+	fake.getPluginRepositoryReturns = struct {
+		result1 configv3.PluginRepository
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginRepositoryReturnsOnCall(i int, result1 configv3.PluginRepository, result2 error) {
+	fake.getPluginRepositoryMutex.Lock()
+	defer fake.getPluginRepositoryMutex.Unlock()
+	fake.GetPluginRepositoryStub = nil
+	if fake.getPluginRepositoryReturnsOnCall == nil {
+		fake.getPluginRepositoryReturnsOnCall = make(map[int]struct {
+			result1 configv3.PluginRepository
+			result2 error
+		})
+	}
+	fake.getPluginRepositoryReturnsOnCall[i] = struct {
+		result1 configv3.PluginRepository
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginUpgrades(arg1 map[string]string, arg2 []configv3.PluginRepository, arg3 string) ([]pluginaction.PluginUpgrade, error) {
+	var arg2Copy []configv3.PluginRepository
+	if arg2 != nil {
+		arg2Copy = make([]configv3.PluginRepository, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.getPluginUpgradesMutex.Lock()
+	ret, specificReturn := fake.getPluginUpgradesReturnsOnCall[len(fake.getPluginUpgradesArgsForCall)]
+	fake.getPluginUpgradesArgsForCall = append(fake.getPluginUpgradesArgsForCall, struct {
+		arg1 map[string]string
+		arg2 []configv3.PluginRepository
+		arg3 string
+	}{arg1, arg2Copy, arg3})
+	stub := fake.GetPluginUpgradesStub
+	fakeReturns := fake.getPluginUpgradesReturns
+	fake.recordInvocation("GetPluginUpgrades", []interface{}{arg1, arg2Copy, arg3})
+	fake.getPluginUpgradesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginUpgradesCallCount() int {
+	fake.getPluginUpgradesMutex.RLock()
+	defer fake.getPluginUpgradesMutex.RUnlock()
+	return len(fake.getPluginUpgradesArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginUpgradesCalls(stub func(map[string]string, []configv3.PluginRepository, string) ([]pluginaction.PluginUpgrade, error)) {
+	fake.getPluginUpgradesMutex.Lock()
+	defer fake.getPluginUpgradesMutex.Unlock()
+	fake.GetPluginUpgradesStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginUpgradesArgsForCall(i int) (map[string]string, []configv3.PluginRepository, string) {
+	fake.getPluginUpgradesMutex.RLock()
+	defer fake.getPluginUpgradesMutex.RUnlock()
+	argsForCall := fake.getPluginUpgradesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginUpgradesReturns(result1 []pluginaction.PluginUpgrade, result2 error) {
+	fake.getPluginUpgradesMutex.Lock()
+	defer fake.getPluginUpgradesMutex.Unlock()
+	fake.GetPluginUpgradesStub = nil
+	fake.getPluginUpgradesReturns = struct {
+		result1 []pluginaction.PluginUpgrade
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) GetPluginUpgradesReturnsOnCall(i int, result1 []pluginaction.PluginUpgrade, result2 error) {
+	fake.getPluginUpgradesMutex.Lock()
+	defer fake.getPluginUpgradesMutex.Unlock()
+	fake.GetPluginUpgradesStub = nil
+	if fake.getPluginUpgradesReturnsOnCall == nil {
+		fake.getPluginUpgradesReturnsOnCall = make(map[int]struct {
+			result1 []pluginaction.PluginUpgrade
+			result2 error
+		})
+	}
+	fake.getPluginUpgradesReturnsOnCall[i] = struct {
+		result1 []pluginaction.PluginUpgrade
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) InstallPluginFromPath(arg1 string, arg2 configv3.Plugin) error {
+	fake.installPluginFromPathMutex.Lock()
+	ret, specificReturn := fake.installPluginFromPathReturnsOnCall[len(fake.installPluginFromPathArgsForCall)]
+	fake.installPluginFromPathArgsForCall = append(fake.installPluginFromPathArgsForCall, struct {
+		arg1 string
+		arg2 configv3.Plugin
+	}{arg1, arg2})
+	stub := fake.InstallPluginFromPathStub
+	fakeReturns := fake.installPluginFromPathReturns
+	fake.recordInvocation("InstallPluginFromPath", []interface{}{arg1, arg2})
+	fake.installPluginFromPathMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpgradePluginsActor) InstallPluginFromPathCallCount() int {
+	fake.installPluginFromPathMutex.RLock()
+	defer fake.installPluginFromPathMutex.RUnlock()
+	return len(fake.installPluginFromPathArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) InstallPluginFromPathCalls(stub func(string, configv3.Plugin) error) {
+	fake.installPluginFromPathMutex.Lock()
+	defer fake.installPluginFromPathMutex.Unlock()
+	fake.InstallPluginFromPathStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) InstallPluginFromPathArgsForCall(i int) (string, configv3.Plugin) {
+	fake.installPluginFromPathMutex.RLock()
+	defer fake.installPluginFromPathMutex.RUnlock()
+	argsForCall := fake.installPluginFromPathArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpgradePluginsActor) InstallPluginFromPathReturns(result1 error) {
+	fake.installPluginFromPathMutex.Lock()
+	defer fake.installPluginFromPathMutex.Unlock()
+	fake.InstallPluginFromPathStub = nil
+	fake.installPluginFromPathReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) InstallPluginFromPathReturnsOnCall(i int, result1 error) {
+	fake.installPluginFromPathMutex.Lock()
+	defer fake.installPluginFromPathMutex.Unlock()
+	fake.InstallPluginFromPathStub = nil
+	if fake.installPluginFromPathReturnsOnCall == nil {
+		fake.installPluginFromPathReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.installPluginFromPathReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) PinPluginVersions(arg1 map[string]string) error {
+	fake.pinPluginVersionsMutex.Lock()
+	ret, specificReturn := fake.pinPluginVersionsReturnsOnCall[len(fake.pinPluginVersionsArgsForCall)]
+	fake.pinPluginVersionsArgsForCall = append(fake.pinPluginVersionsArgsForCall, struct {
+		arg1 map[string]string
+	}{arg1})
+	stub := fake.PinPluginVersionsStub
+	fakeReturns := fake.pinPluginVersionsReturns
+	fake.recordInvocation("PinPluginVersions", []interface{}{arg1})
+	fake.pinPluginVersionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpgradePluginsActor) PinPluginVersionsCallCount() int {
+	fake.pinPluginVersionsMutex.RLock()
+	defer fake.pinPluginVersionsMutex.RUnlock()
+	return len(fake.pinPluginVersionsArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) PinPluginVersionsCalls(stub func(map[string]string) error) {
+	fake.pinPluginVersionsMutex.Lock()
+	defer fake.pinPluginVersionsMutex.Unlock()
+	fake.PinPluginVersionsStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) PinPluginVersionsArgsForCall(i int) map[string]string {
+	fake.pinPluginVersionsMutex.RLock()
+	defer fake.pinPluginVersionsMutex.RUnlock()
+	argsForCall := fake.pinPluginVersionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUpgradePluginsActor) PinPluginVersionsReturns(result1 error) {
+	fake.pinPluginVersionsMutex.Lock()
+	defer fake.pinPluginVersionsMutex.Unlock()
+	fake.PinPluginVersionsStub = nil
+	fake.pinPluginVersionsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) PinPluginVersionsReturnsOnCall(i int, result1 error) {
+	fake.pinPluginVersionsMutex.Lock()
+	defer fake.pinPluginVersionsMutex.Unlock()
+	fake.PinPluginVersionsStub = nil
+	if fake.pinPluginVersionsReturnsOnCall == nil {
+		fake.pinPluginVersionsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pinPluginVersionsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) ReadPluginLockFile(arg1 string) (pluginaction.PluginLockFile, error) {
+	fake.readPluginLockFileMutex.Lock()
+	ret, specificReturn := fake.readPluginLockFileReturnsOnCall[len(fake.readPluginLockFileArgsForCall)]
+	fake.readPluginLockFileArgsForCall = append(fake.readPluginLockFileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadPluginLockFileStub
+	fakeReturns := fake.readPluginLockFileReturns
+	fake.recordInvocation("ReadPluginLockFile", []interface{}{arg1})
+	fake.readPluginLockFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePluginsActor) ReadPluginLockFileCallCount() int {
+	fake.readPluginLockFileMutex.RLock()
+	defer fake.readPluginLockFileMutex.RUnlock()
+	return len(fake.readPluginLockFileArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) ReadPluginLockFileCalls(stub func(string) (pluginaction.PluginLockFile, error)) {
+	fake.readPluginLockFileMutex.Lock()
+	defer fake.readPluginLockFileMutex.Unlock()
+	fake.ReadPluginLockFileStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) ReadPluginLockFileArgsForCall(i int) string {
+	fake.readPluginLockFileMutex.RLock()
+	defer fake.readPluginLockFileMutex.RUnlock()
+	argsForCall := fake.readPluginLockFileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUpgradePluginsActor) ReadPluginLockFileReturns(result1 pluginaction.PluginLockFile, result2 error) {
+	fake.readPluginLockFileMutex.Lock()
+	defer fake.readPluginLockFileMutex.Unlock()
+	fake.ReadPluginLockFileStub = nil
+	fake.readPluginLockFileReturns = struct {
+		result1 pluginaction.PluginLockFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) ReadPluginLockFileReturnsOnCall(i int, result1 pluginaction.PluginLockFile, result2 error) {
+	fake.readPluginLockFileMutex.Lock()
+	defer fake.readPluginLockFileMutex.Unlock()
+	fake.ReadPluginLockFileStub = nil
+	if fake.readPluginLockFileReturnsOnCall == nil {
+		fake.readPluginLockFileReturnsOnCall = make(map[int]struct {
+			result1 pluginaction.PluginLockFile
+			result2 error
+		})
+	}
+	fake.readPluginLockFileReturnsOnCall[i] = struct {
+		result1 pluginaction.PluginLockFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) UninstallPlugin(arg1 pluginaction.PluginUninstaller, arg2 string) error {
+	fake.uninstallPluginMutex.Lock()
+	ret, specificReturn := fake.uninstallPluginReturnsOnCall[len(fake.uninstallPluginArgsForCall)]
+	fake.uninstallPluginArgsForCall = append(fake.uninstallPluginArgsForCall, struct {
+		arg1 pluginaction.PluginUninstaller
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.UninstallPluginStub
+	fakeReturns := fake.uninstallPluginReturns
+	fake.recordInvocation("UninstallPlugin", []interface{}{arg1, arg2})
+	fake.uninstallPluginMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpgradePluginsActor) UninstallPluginCallCount() int {
+	fake.uninstallPluginMutex.RLock()
+	defer fake.uninstallPluginMutex.RUnlock()
+	return len(fake.uninstallPluginArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) UninstallPluginCalls(stub func(pluginaction.PluginUninstaller, string) error) {
+	fake.uninstallPluginMutex.Lock()
+	defer fake.uninstallPluginMutex.Unlock()
+	fake.UninstallPluginStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) UninstallPluginArgsForCall(i int) (pluginaction.PluginUninstaller, string) {
+	fake.uninstallPluginMutex.RLock()
+	defer fake.uninstallPluginMutex.RUnlock()
+	argsForCall := fake.uninstallPluginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpgradePluginsActor) UninstallPluginReturns(result1 error) {
+	fake.uninstallPluginMutex.Lock()
+	defer fake.uninstallPluginMutex.Unlock()
+	fake.UninstallPluginStub = nil
+	fake.uninstallPluginReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) UninstallPluginReturnsOnCall(i int, result1 error) {
+	fake.uninstallPluginMutex.Lock()
+	defer fake.uninstallPluginMutex.Unlock()
+	fake.UninstallPluginStub = nil
+	if fake.uninstallPluginReturnsOnCall == nil {
+		fake.uninstallPluginReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uninstallPluginReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileChecksum(arg1 string, arg2 string) bool {
+	fake.validateFileChecksumMutex.Lock()
+	ret, specificReturn := fake.validateFileChecksumReturnsOnCall[len(fake.validateFileChecksumArgsForCall)]
+	fake.validateFileChecksumArgsForCall = append(fake.validateFileChecksumArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ValidateFileChecksumStub
+	fakeReturns := fake.validateFileChecksumReturns
+	fake.recordInvocation("ValidateFileChecksum", []interface{}{arg1, arg2})
+	fake.validateFileChecksumMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileChecksumCallCount() int {
+	fake.validateFileChecksumMutex.RLock()
+	defer fake.validateFileChecksumMutex.RUnlock()
+	return len(fake.validateFileChecksumArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileChecksumCalls(stub func(string, string) bool) {
+	fake.validateFileChecksumMutex.Lock()
+	defer fake.validateFileChecksumMutex.Unlock()
+	fake.ValidateFileChecksumStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileChecksumArgsForCall(i int) (string, string) {
+	fake.validateFileChecksumMutex.RLock()
+	defer fake.validateFileChecksumMutex.RUnlock()
+	argsForCall := fake.validateFileChecksumArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileChecksumReturns(result1 bool) {
+	fake.validateFileChecksumMutex.Lock()
+	defer fake.validateFileChecksumMutex.Unlock()
+	fake.ValidateFileChecksumStub = nil
+	fake.validateFileChecksumReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) ValidateFileChecksumReturnsOnCall(i int, result1 bool) {
+	fake.validateFileChecksumMutex.Lock()
+	defer fake.validateFileChecksumMutex.Unlock()
+	fake.ValidateFileChecksumStub = nil
+	if fake.validateFileChecksumReturnsOnCall == nil {
+		fake.validateFileChecksumReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.validateFileChecksumReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) VerifyPluginSignature(arg1 string, arg2 string) (string, error) {
+	fake.verifyPluginSignatureMutex.Lock()
+	ret, specificReturn := fake.verifyPluginSignatureReturnsOnCall[len(fake.verifyPluginSignatureArgsForCall)]
+	fake.verifyPluginSignatureArgsForCall = append(fake.verifyPluginSignatureArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.VerifyPluginSignatureStub
+	fakeReturns := fake.verifyPluginSignatureReturns
+	fake.recordInvocation("VerifyPluginSignature", []interface{}{arg1, arg2})
+	fake.verifyPluginSignatureMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUpgradePluginsActor) VerifyPluginSignatureCallCount() int {
+	fake.verifyPluginSignatureMutex.RLock()
+	defer fake.verifyPluginSignatureMutex.RUnlock()
+	return len(fake.verifyPluginSignatureArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) VerifyPluginSignatureCalls(stub func(string, string) (string, error)) {
+	fake.verifyPluginSignatureMutex.Lock()
+	defer fake.verifyPluginSignatureMutex.Unlock()
+	fake.VerifyPluginSignatureStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) VerifyPluginSignatureArgsForCall(i int) (string, string) {
+	fake.verifyPluginSignatureMutex.RLock()
+	defer fake.verifyPluginSignatureMutex.RUnlock()
+	argsForCall := fake.verifyPluginSignatureArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpgradePluginsActor) VerifyPluginSignatureReturns(result1 string, result2 error) {
+	fake.verifyPluginSignatureMutex.Lock()
+	defer fake.verifyPluginSignatureMutex.Unlock()
+	fake.VerifyPluginSignatureStub = nil
+	fake.verifyPluginSignatureReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) VerifyPluginSignatureReturnsOnCall(i int, result1 string, result2 error) {
+	fake.verifyPluginSignatureMutex.Lock()
+	defer fake.verifyPluginSignatureMutex.Unlock()
+	fake.VerifyPluginSignatureStub = nil
+	if fake.verifyPluginSignatureReturnsOnCall == nil {
+		fake.verifyPluginSignatureReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.verifyPluginSignatureReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUpgradePluginsActor) WritePluginLockFile(arg1 string, arg2 pluginaction.PluginLockFile) error {
+	fake.writePluginLockFileMutex.Lock()
+	ret, specificReturn := fake.writePluginLockFileReturnsOnCall[len(fake.writePluginLockFileArgsForCall)]
+	fake.writePluginLockFileArgsForCall = append(fake.writePluginLockFileArgsForCall, struct {
+		arg1 string
+		arg2 pluginaction.PluginLockFile
+	}{arg1, arg2})
+	stub := fake.WritePluginLockFileStub
+	fakeReturns := fake.writePluginLockFileReturns
+	fake.recordInvocation("WritePluginLockFile", []interface{}{arg1, arg2})
+	fake.writePluginLockFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUpgradePluginsActor) WritePluginLockFileCallCount() int {
+	fake.writePluginLockFileMutex.RLock()
+	defer fake.writePluginLockFileMutex.RUnlock()
+	return len(fake.writePluginLockFileArgsForCall)
+}
+
+func (fake *FakeUpgradePluginsActor) WritePluginLockFileCalls(stub func(string, pluginaction.PluginLockFile) error) {
+	fake.writePluginLockFileMutex.Lock()
+	defer fake.writePluginLockFileMutex.Unlock()
+	fake.WritePluginLockFileStub = stub
+}
+
+func (fake *FakeUpgradePluginsActor) WritePluginLockFileArgsForCall(i int) (string, pluginaction.PluginLockFile) {
+	fake.writePluginLockFileMutex.RLock()
+	defer fake.writePluginLockFileMutex.RUnlock()
+	argsForCall := fake.writePluginLockFileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUpgradePluginsActor) WritePluginLockFileReturns(result1 error) {
+	fake.writePluginLockFileMutex.Lock()
+	defer fake.writePluginLockFileMutex.Unlock()
+	fake.WritePluginLockFileStub = nil
+	fake.writePluginLockFileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) WritePluginLockFileReturnsOnCall(i int, result1 error) {
+	fake.writePluginLockFileMutex.Lock()
+	defer fake.writePluginLockFileMutex.Unlock()
+	fake.WritePluginLockFileStub = nil
+	if fake.writePluginLockFileReturnsOnCall == nil {
+		fake.writePluginLockFileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writePluginLockFileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUpgradePluginsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeUpgradePluginsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ common.UpgradePluginsActor = new(FakeUpgradePluginsActor)
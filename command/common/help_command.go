@@ -317,10 +317,16 @@ func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 	return [][]string{
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
 		{"CF_DIAL_TIMEOUT=6", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
+		{"CF_HASH_CACHE=true", cmd.UI.TranslateText("Cache the hashes of pushed files between pushes")},
+		{"CF_HASH_CONCURRENCY=8", cmd.UI.TranslateText("Max number of files hashed at once during push")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
+		{"CF_OUTPUT_FORMAT=json", cmd.UI.TranslateText("Render the output of commands that support it as a json or yaml document")},
+		{"CF_PAGINATION_CONCURRENCY=4", cmd.UI.TranslateText("Max number of pages of a list fetched at once")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_RETRY_TIMEOUT=60", cmd.UI.TranslateText("Max time spent retrying a failed request, in seconds")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
+		{"CF_USE_GITIGNORE=true", cmd.UI.TranslateText("Apply .gitignore files in pushed directories that have no .cfignore file")},
 		{"all_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Specify a proxy server to enable proxying for all requests")},
 		{"https_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Enable proxying for HTTP requests")},
 	}
@@ -330,6 +336,8 @@ func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
+		{"--output-format json|yaml", cmd.UI.TranslateText("Render the output of commands that support it as a json or yaml document")},
+		{"--context CONTEXT_NAME", cmd.UI.TranslateText("Use the named context for this command only")},
 	}
 }
 
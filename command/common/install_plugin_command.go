@@ -29,9 +29,11 @@ type InstallPluginActor interface {
 	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
 	GetPluginInfoFromRepositoriesForPlatform(pluginName string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error)
 	GetPluginRepository(repositoryName string) (configv3.PluginRepository, error)
+	GetPluginSignature(location string, tempPluginDir string) (string, error)
 	InstallPluginFromPath(path string, plugin configv3.Plugin) error
 	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
 	ValidateFileChecksum(path string, checksum string) bool
+	VerifyPluginSignature(path string, signature string) (string, error)
 }
 
 const installConfirmationPrompt = "Do you want to install the plugin {{.Path}}?"
@@ -56,8 +58,9 @@ type InstallPluginCommand struct {
 	SkipSSLValidation    bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
 	Force                bool                   `short:"f" description:"Force install of plugin without confirmation"`
 	RegisteredRepository string                 `short:"r" description:"Restrict search for plugin to this registered repository"`
-	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
-	relatedCommands      interface{}            `related_commands:"add-plugin-repo, list-plugin-repos, plugins"`
+	Signature            string                 `long:"signature" description:"Verify the plugin binary against the trusted plugin keys with this detached signature file or URL, instead of the signature from the repository"`
+	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f] [--signature SIGNATURE_PATH | URL]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f] [--signature SIGNATURE_PATH | URL]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo\n   CF_NAME install-plugin ~/Downloads/plugin-foobar --signature ~/Downloads/plugin-foobar.minisig"`
+	relatedCommands      interface{}            `related_commands:"add-plugin-key, add-plugin-repo, list-plugin-repos, plugins"`
 	UI                   command.UI
 	Config               command.Config
 	Actor                InstallPluginActor
@@ -112,7 +115,7 @@ func (cmd InstallPluginCommand) Execute([]string) (err error) {
 		return err
 	}
 
-	tempPluginPath, pluginSource, err := cmd.getPluginBinaryAndSource(tempPluginDir)
+	tempPluginPath, pluginSource, signature, err := cmd.getPluginBinaryAndSource(tempPluginDir)
 	if _, ok := err.(cancelInstall); ok {
 		cmd.UI.DisplayText("Plugin installation cancelled.")
 		return nil
@@ -121,6 +124,25 @@ func (cmd InstallPluginCommand) Execute([]string) (err error) {
 	}
 	log.WithFields(log.Fields{"tempPluginPath": tempPluginPath, "pluginSource": pluginSource}).Debug("getPluginBinaryAndSource")
 
+	if cmd.Signature != "" {
+		signature, err = cmd.Actor.GetPluginSignature(cmd.Signature, tempPluginDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	// verify the signature before the plugin binary is run to read its
+	// metadata
+	signedBy, err := cmd.Actor.VerifyPluginSignature(tempPluginPath, signature)
+	if err != nil {
+		return err
+	}
+	if signedBy != "" {
+		cmd.UI.DisplayText("Plugin binary signed by {{.KeyName}}.", map[string]interface{}{
+			"KeyName": signedBy,
+		})
+	}
+
 	// copy twice when downloading from a URL to keep Windows specific code
 	// isolated to CreateExecutableCopy
 	executablePath, err := cmd.Actor.CreateExecutableCopy(tempPluginPath, tempPluginDir)
@@ -140,6 +162,7 @@ func (cmd InstallPluginCommand) Execute([]string) (err error) {
 		return err
 	}
 	log.Info("validated plugin")
+	plugin.SignedBy = signedBy
 
 	if installedPlugin, installed := cmd.Config.GetPluginCaseInsensitive(plugin.Name); installed {
 		log.WithField("version", installedPlugin.Version).Debug("uninstall plugin")
@@ -201,7 +224,9 @@ func (cmd InstallPluginCommand) uninstallPlugin(plugin configv3.Plugin, rpcServi
 	return nil
 }
 
-func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (string, PluginSource, error) {
+// getPluginBinaryAndSource returns the path of the plugin binary, where it
+// came from and the signature provided for it by the plugin repository.
+func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (string, PluginSource, string, error) {
 	pluginNameOrLocation := cmd.OptionalArgs.PluginNameOrLocation.String()
 
 	switch {
@@ -209,14 +234,14 @@ func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (
 		log.WithField("RegisteredRepository", cmd.RegisteredRepository).Info("installing from specified repository")
 		pluginRepository, err := cmd.Actor.GetPluginRepository(cmd.RegisteredRepository)
 		if err != nil {
-			return "", 0, err
+			return "", 0, "", err
 		}
-		path, pluginSource, err := cmd.getPluginFromRepositories(pluginNameOrLocation, []configv3.PluginRepository{pluginRepository}, tempPluginDir)
+		path, pluginSource, signature, err := cmd.getPluginFromRepositories(pluginNameOrLocation, []configv3.PluginRepository{pluginRepository}, tempPluginDir)
 
 		if err != nil {
 			switch pluginErr := err.(type) {
 			case actionerror.PluginNotFoundInAnyRepositoryError:
-				return "", 0, translatableerror.PluginNotFoundInRepositoryError{
+				return "", 0, "", translatableerror.PluginNotFoundInRepositoryError{
 					BinaryName:     cmd.Config.BinaryName(),
 					PluginName:     pluginNameOrLocation,
 					RepositoryName: cmd.RegisteredRepository,
@@ -226,47 +251,49 @@ func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (
 				// The error wrapped inside pluginErr is handled differently in the case of
 				// a specified repo from that of searching through all repos.  pluginErr.Err
 				// is then processed by shared.HandleError by this function's caller.
-				return "", 0, pluginErr.Err
+				return "", 0, "", pluginErr.Err
 
 			default:
-				return "", 0, err
+				return "", 0, "", err
 			}
 		}
-		return path, pluginSource, nil
+		return path, pluginSource, signature, nil
 
 	case cmd.Actor.FileExists(pluginNameOrLocation):
 		log.WithField("pluginNameOrLocation", pluginNameOrLocation).Info("installing from specified file")
-		return cmd.getPluginFromLocalFile(pluginNameOrLocation)
+		path, pluginSource, err := cmd.getPluginFromLocalFile(pluginNameOrLocation)
+		return path, pluginSource, "", err
 
 	case util.IsHTTPScheme(pluginNameOrLocation):
 		log.WithField("pluginNameOrLocation", pluginNameOrLocation).Info("installing from specified URL")
-		return cmd.getPluginFromURL(pluginNameOrLocation, tempPluginDir)
+		path, pluginSource, err := cmd.getPluginFromURL(pluginNameOrLocation, tempPluginDir)
+		return path, pluginSource, "", err
 
 	case util.IsUnsupportedURLScheme(pluginNameOrLocation):
 		log.WithField("pluginNameOrLocation", pluginNameOrLocation).Error("Unsupported URL")
-		return "", 0, translatableerror.UnsupportedURLSchemeError{UnsupportedURL: pluginNameOrLocation}
+		return "", 0, "", translatableerror.UnsupportedURLSchemeError{UnsupportedURL: pluginNameOrLocation}
 
 	default:
 		log.Info("installing from first repository with plugin")
 		repos := cmd.Config.PluginRepositories()
 		if len(repos) == 0 {
-			return "", 0, translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: pluginNameOrLocation, BinaryName: cmd.Config.BinaryName()}
+			return "", 0, "", translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: pluginNameOrLocation, BinaryName: cmd.Config.BinaryName()}
 		}
 
-		path, pluginSource, err := cmd.getPluginFromRepositories(pluginNameOrLocation, repos, tempPluginDir)
+		path, pluginSource, signature, err := cmd.getPluginFromRepositories(pluginNameOrLocation, repos, tempPluginDir)
 		if err != nil {
 			switch pluginErr := err.(type) {
 			case actionerror.PluginNotFoundInAnyRepositoryError:
-				return "", 0, translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: pluginNameOrLocation, BinaryName: cmd.Config.BinaryName()}
+				return "", 0, "", translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: pluginNameOrLocation, BinaryName: cmd.Config.BinaryName()}
 
 			case actionerror.FetchingPluginInfoFromRepositoryError:
-				return "", 0, cmd.handleFetchingPluginInfoFromRepositoriesError(pluginErr)
+				return "", 0, "", cmd.handleFetchingPluginInfoFromRepositoriesError(pluginErr)
 
 			default:
-				return "", 0, err
+				return "", 0, "", err
 			}
 		}
-		return path, pluginSource, nil
+		return path, pluginSource, signature, nil
 	}
 }
 
@@ -326,7 +353,7 @@ func (cmd InstallPluginCommand) getPluginFromURL(pluginLocation string, tempPlug
 	return tempPath, PluginFromURL, err
 }
 
-func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, repos []configv3.PluginRepository, tempPluginDir string) (string, PluginSource, error) {
+func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, repos []configv3.PluginRepository, tempPluginDir string) (string, PluginSource, string, error) {
 	var repoNames []string
 	for _, repo := range repos {
 		repoNames = append(repoNames, repo.Name)
@@ -340,7 +367,7 @@ func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, rep
 	currentPlatform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
 	pluginInfo, repoList, err := cmd.Actor.GetPluginInfoFromRepositoriesForPlatform(pluginName, repos, currentPlatform)
 	if err != nil {
-		return "", 0, err
+		return "", 0, "", err
 	}
 
 	cmd.UI.DisplayText("Plugin {{.PluginName}} {{.PluginVersion}} found in: {{.RepositoryName}}", map[string]interface{}{
@@ -367,7 +394,7 @@ func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, rep
 	}
 
 	if err != nil {
-		return "", 0, err
+		return "", 0, "", err
 	}
 
 	cmd.UI.DisplayText("Starting download of plugin binary from repository {{.RepositoryName}}...", map[string]interface{}{
@@ -376,14 +403,14 @@ func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, rep
 
 	tempPath, err := cmd.Actor.DownloadExecutableBinaryFromURL(pluginInfo.URL, tempPluginDir, cmd.ProgressBar)
 	if err != nil {
-		return "", 0, err
+		return "", 0, "", err
 	}
 
 	if !cmd.Actor.ValidateFileChecksum(tempPath, pluginInfo.Checksum) {
-		return "", 0, translatableerror.InvalidChecksumError{}
+		return "", 0, "", translatableerror.InvalidChecksumError{}
 	}
 
-	return tempPath, PluginFromRepository, err
+	return tempPath, PluginFromRepository, pluginInfo.Signature, err
 }
 
 func (cmd InstallPluginCommand) installPluginPrompt(template string, templateValues ...map[string]interface{}) error {
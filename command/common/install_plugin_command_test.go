@@ -255,6 +255,66 @@ var _ = Describe("install-plugin command", func() {
 						Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
 					})
 
+					It("verifies the plugin binary without a signature before running it", func() {
+						Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(1))
+						path, signature := fakeActor.VerifyPluginSignatureArgsForCall(0)
+						Expect(path).To(Equal("some-path"))
+						Expect(signature).To(BeEmpty())
+						Expect(fakeActor.GetPluginSignatureCallCount()).To(Equal(0))
+						Expect(testUI.Out).NotTo(Say("signed by"))
+					})
+
+					When("a signature is given", func() {
+						BeforeEach(func() {
+							cmd.Signature = "some-path.minisig"
+							fakeActor.GetPluginSignatureReturns("some-signature", nil)
+							fakeActor.VerifyPluginSignatureReturns("release-key", nil)
+						})
+
+						It("installs the plugin with the name of the key that signed it", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							location, pluginDir := fakeActor.GetPluginSignatureArgsForCall(0)
+							Expect(location).To(Equal("some-path.minisig"))
+							Expect(pluginDir).To(ContainSubstring("some-pluginhome"))
+
+							path, signature := fakeActor.VerifyPluginSignatureArgsForCall(0)
+							Expect(path).To(Equal("some-path"))
+							Expect(signature).To(Equal("some-signature"))
+
+							Expect(testUI.Out).To(Say(`Plugin binary signed by release-key\.`))
+							Expect(testUI.Out).To(Say(`Installing plugin some-plugin\.\.\.`))
+
+							_, installedPlugin := fakeActor.InstallPluginFromPathArgsForCall(0)
+							Expect(installedPlugin.SignedBy).To(Equal("release-key"))
+						})
+
+						When("reading the signature fails", func() {
+							BeforeEach(func() {
+								expectedErr = errors.New("read signature error")
+								fakeActor.GetPluginSignatureReturns("", expectedErr)
+							})
+
+							It("returns the error", func() {
+								Expect(executeErr).To(MatchError(expectedErr))
+								Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(0))
+							})
+						})
+					})
+
+					When("the signature cannot be verified", func() {
+						BeforeEach(func() {
+							fakeActor.VerifyPluginSignatureReturns("", actionerror.PluginNotSignedError{})
+						})
+
+						It("returns the error without running the plugin", func() {
+							Expect(executeErr).To(MatchError(actionerror.PluginNotSignedError{}))
+							Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+							Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(0))
+							Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+						})
+					})
+
 					When("there is an error making an executable copy of the plugin binary", func() {
 						BeforeEach(func() {
 							expectedErr = errors.New("create executable copy error")
@@ -290,6 +290,34 @@ var _ = Describe("install-plugin command", func() {
 									fakeActor.ValidateFileChecksumReturns(true)
 								})
 
+								When("the repository provides a signature", func() {
+									BeforeEach(func() {
+										fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{Name: pluginName, Version: downloadedVersionString, URL: pluginURL, Checksum: checksum, Signature: "repo-signature"}, []string{repoName}, nil)
+										fakeActor.VerifyPluginSignatureReturns("", actionerror.PluginSignatureNotTrustedError{})
+									})
+
+									It("verifies the downloaded binary with it", func() {
+										Expect(executeErr).To(MatchError(actionerror.PluginSignatureNotTrustedError{}))
+
+										pathArg, signatureArg := fakeActor.VerifyPluginSignatureArgsForCall(0)
+										Expect(pathArg).To(Equal(execPath))
+										Expect(signatureArg).To(Equal("repo-signature"))
+										Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+									})
+
+									When("a signature is given", func() {
+										BeforeEach(func() {
+											cmd.Signature = "https://example.com/plugin.minisig"
+											fakeActor.GetPluginSignatureReturns("given-signature", nil)
+										})
+
+										It("verifies the binary with the given signature instead", func() {
+											_, signatureArg := fakeActor.VerifyPluginSignatureArgsForCall(0)
+											Expect(signatureArg).To(Equal("given-signature"))
+										})
+									})
+								})
+
 								When("creating an executable copy errors", func() {
 									BeforeEach(func() {
 										fakeActor.CreateExecutableCopyReturns("", errors.New("some-error"))
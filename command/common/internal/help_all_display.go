@@ -6,6 +6,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
 			{"api", "auth"},
+			{"contexts", "create-context", "switch-context", "rename-context", "delete-context"},
 		},
 	},
 	{
@@ -22,9 +23,10 @@ var HelpCategoryList = []HelpCategory{
 			{"events", "logs"},
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack", "update-stack"},
-			{"copy-source", "create-app-manifest"},
+			{"copy-source", "create-app-manifest", "validate-manifest", "diff-manifest"},
 			{"get-health-check", "set-health-check", "get-readiness-health-check"},
-			{"enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"enable-ssh", "disable-ssh", "ssh-enabled", "ssh", "scp"},
+			{"sidecars", "create-sidecar", "update-sidecar", "delete-sidecar"},
 		},
 	},
 	{
@@ -79,6 +81,7 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "NETWORK POLICIES:",
 		CommandList: [][]string{
 			{"network-policies", "add-network-policy", "remove-network-policy"},
+			{"sync-network-policies", "export-network-policies", "network-policy-graph"},
 		},
 	},
 	{
@@ -101,6 +104,8 @@ var HelpCategoryList = []HelpCategory{
 			{"org-quotas", "org-quota", "set-org-quota"},
 			{"create-org-quota", "delete-org-quota", "update-org-quota"},
 			{"share-private-domain", "unshare-private-domain"},
+			{"usage-report", "app-usage-events", "service-usage-events"},
+			{"audit-events"},
 		},
 	},
 	{
@@ -125,6 +130,7 @@ var HelpCategoryList = []HelpCategory{
 			{"security-group", "security-groups", "create-security-group", "update-security-group", "delete-security-group", "bind-security-group", "unbind-security-group"},
 			{"bind-staging-security-group", "staging-security-groups", "unbind-staging-security-group"},
 			{"bind-running-security-group", "running-security-groups", "unbind-running-security-group"},
+			{"analyze-security-groups"},
 		},
 	},
 	{
@@ -166,7 +172,8 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADD/REMOVE PLUGIN:",
 		CommandList: [][]string{
-			{"plugins", "install-plugin", "uninstall-plugin"},
+			{"plugins", "install-plugin", "upgrade-plugins", "uninstall-plugin"},
+			{"add-plugin-key", "remove-plugin-key", "plugin-keys"},
 		},
 	},
 }
@@ -0,0 +1,362 @@
+package common
+
+import (
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/v9/actor/pluginaction"
+	"code.cloudfoundry.org/cli/v9/api/plugin"
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/plugin/shared"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . UpgradePluginsActor
+
+type UpgradePluginsActor interface {
+	CreateExecutableCopy(path string, tempPluginDir string) (string, error)
+	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
+	GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
+	GetLockedPluginUpgrades(lockFile pluginaction.PluginLockFile, platform string) ([]pluginaction.PluginUpgrade, []configv3.Plugin, error)
+	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
+	GetPluginLockFile(repos []configv3.PluginRepository, platform string) (pluginaction.PluginLockFile, []configv3.Plugin, error)
+	GetPluginRepository(repositoryName string) (configv3.PluginRepository, error)
+	GetPluginUpgrades(pluginVersions map[string]string, repos []configv3.PluginRepository, platform string) ([]pluginaction.PluginUpgrade, error)
+	InstallPluginFromPath(path string, plugin configv3.Plugin) error
+	PinPluginVersions(pluginVersions map[string]string) error
+	ReadPluginLockFile(path string) (pluginaction.PluginLockFile, error)
+	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
+	ValidateFileChecksum(path string, checksum string) bool
+	VerifyPluginSignature(path string, signature string) (string, error)
+	WritePluginLockFile(path string, lockFile pluginaction.PluginLockFile) error
+}
+
+type UpgradePluginsCommand struct {
+	OptionalArgs         flag.UpgradePluginsArgs     `positional-args:"yes"`
+	SkipSSLValidation    bool                        `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	Force                bool                        `short:"f" description:"Force upgrade of plugins without confirmation"`
+	RegisteredRepository string                      `short:"r" description:"Restrict search for new versions to this registered repository"`
+	LockFile             flag.Path                   `long:"lock-file" description:"After upgrading, record the versions, repositories and checksums of the installed plugins in this lock file"`
+	FromLockFile         flag.PathWithExistenceCheck `long:"from-lock-file" description:"Install the plugin versions recorded in this lock file instead of the newest versions"`
+	usage                interface{}                 `usage:"CF_NAME upgrade-plugins [PLUGIN_NAME[@VERSION]...] [-r REPO_NAME] [-f] [--lock-file LOCK_FILE]\n   CF_NAME upgrade-plugins --from-lock-file LOCK_FILE [-f]\n\n   A plugin upgraded as PLUGIN_NAME@VERSION stays pinned to that version until it is upgraded by name without a version.\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME upgrade-plugins\n   CF_NAME upgrade-plugins -r CF-Community plugin-echo plugin-foobar@1.2.0 --lock-file cf-plugins.lock\n   CF_NAME upgrade-plugins --from-lock-file cf-plugins.lock -f"`
+	relatedCommands      interface{}                 `related_commands:"install-plugin, plugins, repo-plugins"`
+	UI                   command.UI
+	Config               command.Config
+	Actor                UpgradePluginsActor
+	ProgressBar          plugin.ProxyReader
+}
+
+func (cmd *UpgradePluginsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
+
+	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+
+	return nil
+}
+
+func (cmd UpgradePluginsCommand) Execute([]string) error {
+	if cmd.FromLockFile != "" && (len(cmd.OptionalArgs.PluginNames) > 0 || cmd.RegisteredRepository != "" || cmd.LockFile != "") {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--from-lock-file", "PLUGIN_NAME", "-r", "--lock-file"},
+		}
+	}
+
+	platform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
+
+	var (
+		upgrades []pluginaction.PluginUpgrade
+		err      error
+	)
+	if cmd.FromLockFile != "" {
+		upgrades, err = cmd.getLockedUpgrades(platform)
+	} else {
+		upgrades, err = cmd.getUpgrades(platform)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(upgrades) == 0 {
+		cmd.UI.DisplayText("All plugins are up to date.")
+	} else {
+		err = cmd.upgradePlugins(upgrades)
+		if _, ok := err.(cancelInstall); ok {
+			cmd.UI.DisplayText("Plugin upgrade cancelled.")
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+
+	if len(cmd.OptionalArgs.PluginNames) > 0 {
+		err = cmd.pinVersions(cmd.pluginVersions())
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.LockFile != "" {
+		return cmd.writeLockFile(platform)
+	}
+
+	return nil
+}
+
+func (cmd UpgradePluginsCommand) getUpgrades(platform string) ([]pluginaction.PluginUpgrade, error) {
+	var repos []configv3.PluginRepository
+	if cmd.RegisteredRepository != "" {
+		repo, err := cmd.Actor.GetPluginRepository(cmd.RegisteredRepository)
+		if err != nil {
+			return nil, err
+		}
+		repos = []configv3.PluginRepository{repo}
+	} else {
+		repos = cmd.Config.PluginRepositories()
+		if len(repos) == 0 {
+			return nil, translatableerror.NoPluginRepositoriesError{}
+		}
+	}
+
+	repoNames := make([]string, len(repos))
+	for i := range repos {
+		repoNames[i] = repos[i].Name
+	}
+	cmd.UI.DisplayTextWithFlavor("Searching {{.RepoNames}} for newer versions of installed plugins...", map[string]interface{}{
+		"RepoNames": strings.Join(repoNames, ", "),
+	})
+
+	pluginVersions := cmd.pluginVersions()
+	if len(pluginVersions) == 0 {
+		for _, installedPlugin := range cmd.Config.Plugins() {
+			if installedPlugin.PinnedVersion == "" {
+				continue
+			}
+			cmd.UI.DisplayText("Plugin {{.Name}} is pinned to version {{.Version}}. Upgrade it by name without a version to unpin it.", map[string]interface{}{
+				"Name":    installedPlugin.Name,
+				"Version": installedPlugin.PinnedVersion,
+			})
+		}
+	}
+
+	return cmd.Actor.GetPluginUpgrades(pluginVersions, repos, platform)
+}
+
+// pluginVersions maps the plugin names given as arguments to the versions
+// given with them, or to no version.
+func (cmd UpgradePluginsCommand) pluginVersions() map[string]string {
+	pluginVersions := map[string]string{}
+	for _, arg := range cmd.OptionalArgs.PluginNames {
+		pluginName, version := arg, ""
+		if i := strings.LastIndex(arg, "@"); i > 0 {
+			pluginName, version = arg[:i], arg[i+1:]
+		}
+		pluginVersions[pluginName] = version
+	}
+	return pluginVersions
+}
+
+func (cmd UpgradePluginsCommand) pinVersions(pluginVersions map[string]string) error {
+	err := cmd.Actor.PinPluginVersions(pluginVersions)
+	if err != nil {
+		return err
+	}
+
+	pluginNames := make([]string, 0, len(pluginVersions))
+	for pluginName := range pluginVersions {
+		pluginNames = append(pluginNames, pluginName)
+	}
+	sort.Strings(pluginNames)
+
+	for _, pluginName := range pluginNames {
+		if pluginVersions[pluginName] == "" {
+			continue
+		}
+		cmd.UI.DisplayText("Pinned plugin {{.Name}} to version {{.Version}}.", map[string]interface{}{
+			"Name":    pluginName,
+			"Version": pluginVersions[pluginName],
+		})
+	}
+
+	return nil
+}
+
+func (cmd UpgradePluginsCommand) getLockedUpgrades(platform string) ([]pluginaction.PluginUpgrade, error) {
+	cmd.UI.DisplayTextWithFlavor("Reading plugin versions from lock file {{.Path}}...", map[string]interface{}{
+		"Path": cmd.FromLockFile,
+	})
+
+	lockFile, err := cmd.Actor.ReadPluginLockFile(string(cmd.FromLockFile))
+	if err != nil {
+		return nil, err
+	}
+
+	upgrades, unlockedPlugins, err := cmd.Actor.GetLockedPluginUpgrades(lockFile, platform)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, unlockedPlugin := range unlockedPlugins {
+		cmd.UI.DisplayWarning("Plugin {{.Name}} {{.Version}} is installed but not in the lock file. Uninstall it with '{{.Command}}' to match the lock file.", map[string]interface{}{
+			"Name":    unlockedPlugin.Name,
+			"Version": unlockedPlugin.Version.String(),
+			"Command": cmd.Config.BinaryName() + " uninstall-plugin " + unlockedPlugin.Name,
+		})
+	}
+
+	return upgrades, nil
+}
+
+func (cmd UpgradePluginsCommand) upgradePlugins(upgrades []pluginaction.PluginUpgrade) error {
+	table := [][]string{{
+		cmd.UI.TranslateText("plugin"),
+		cmd.UI.TranslateText("version"),
+		cmd.UI.TranslateText("new version"),
+		cmd.UI.TranslateText("repository"),
+	}}
+	for _, upgrade := range upgrades {
+		currentVersion := upgrade.CurrentVersion
+		if currentVersion == "" {
+			currentVersion = cmd.UI.TranslateText("not installed")
+		}
+		table = append(table, []string{upgrade.Name, currentVersion, upgrade.Version, upgrade.RepositoryName})
+	}
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	err := cmd.upgradePrompt()
+	if err != nil {
+		return err
+	}
+
+	tempPluginDir, err := os.MkdirTemp(cmd.Config.PluginHome(), "temp")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempPluginDir)
+
+	rpcService, err := shared.NewRPCService(cmd.Config, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	for _, upgrade := range upgrades {
+		err = cmd.upgradePlugin(upgrade, tempPluginDir, rpcService)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd UpgradePluginsCommand) upgradePlugin(upgrade pluginaction.PluginUpgrade, tempPluginDir string, rpcService *shared.RPCService) error {
+	cmd.UI.DisplayTextWithFlavor("Installing plugin {{.Name}} {{.Version}} from repository {{.RepositoryName}}...", map[string]interface{}{
+		"Name":           upgrade.Name,
+		"Version":        upgrade.Version,
+		"RepositoryName": upgrade.RepositoryName,
+	})
+
+	tempPath, err := cmd.Actor.DownloadExecutableBinaryFromURL(upgrade.URL, tempPluginDir, cmd.ProgressBar)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Actor.ValidateFileChecksum(tempPath, upgrade.Checksum) {
+		return translatableerror.InvalidChecksumError{}
+	}
+
+	signedBy, err := cmd.Actor.VerifyPluginSignature(tempPath, upgrade.Signature)
+	if err != nil {
+		return err
+	}
+	if signedBy != "" {
+		cmd.UI.DisplayText("Plugin binary signed by {{.KeyName}}.", map[string]interface{}{
+			"KeyName": signedBy,
+		})
+	}
+
+	executablePath, err := cmd.Actor.CreateExecutableCopy(tempPath, tempPluginDir)
+	if err != nil {
+		return err
+	}
+	log.WithField("executablePath", executablePath).Debug("created executable copy")
+
+	plugin, err := cmd.Actor.GetAndValidatePlugin(rpcService, Commands, executablePath)
+	if err != nil {
+		return err
+	}
+	plugin.SignedBy = signedBy
+
+	if installedPlugin, installed := cmd.Config.GetPluginCaseInsensitive(plugin.Name); installed {
+		plugin.PinnedVersion = installedPlugin.PinnedVersion
+		log.WithField("version", installedPlugin.Version).Debug("uninstall plugin")
+		err = cmd.Actor.UninstallPlugin(rpcService, installedPlugin.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cmd.Actor.InstallPluginFromPath(executablePath, plugin)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd UpgradePluginsCommand) upgradePrompt() error {
+	cmd.UI.DisplayHeader("Attention: Plugins are binaries written by potentially untrusted authors.")
+	cmd.UI.DisplayHeader("Install and use plugins at your own risk.")
+
+	if cmd.Force {
+		return nil
+	}
+
+	really, err := cmd.UI.DisplayBoolPrompt(false, "Do you want to install these plugin versions?")
+	if err != nil {
+		return err
+	}
+
+	if !really {
+		log.Debug("plugin upgrade confirmation - 'no' inputted")
+		return cancelInstall{}
+	}
+
+	return nil
+}
+
+func (cmd UpgradePluginsCommand) writeLockFile(platform string) error {
+	cmd.UI.DisplayTextWithFlavor("Writing plugin lock file {{.Path}}...", map[string]interface{}{
+		"Path": cmd.LockFile,
+	})
+
+	lockFile, unlockedPlugins, err := cmd.Actor.GetPluginLockFile(cmd.Config.PluginRepositories(), platform)
+	if err != nil {
+		return err
+	}
+
+	for _, unlockedPlugin := range unlockedPlugins {
+		cmd.UI.DisplayWarning("Plugin {{.Name}} {{.Version}} was not installed from a registered plugin repository and is not in the lock file.", map[string]interface{}{
+			"Name":    unlockedPlugin.Name,
+			"Version": unlockedPlugin.Version.String(),
+		})
+	}
+
+	err = cmd.Actor.WritePluginLockFile(string(cmd.LockFile), lockFile)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,461 @@
+package common_test
+
+import (
+	"errors"
+	"os"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/pluginaction"
+	"code.cloudfoundry.org/cli/v9/api/plugin"
+	"code.cloudfoundry.org/cli/v9/api/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	. "code.cloudfoundry.org/cli/v9/command/common"
+	"code.cloudfoundry.org/cli/v9/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("upgrade-plugins command", func() {
+	var (
+		cmd             UpgradePluginsCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeActor       *commonfakes.FakeUpgradePluginsActor
+		fakeProgressBar *pluginfakes.FakeProxyReader
+		executeErr      error
+		pluginHome      string
+
+		upgrades []pluginaction.PluginUpgrade
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeUpgradePluginsActor)
+		fakeProgressBar = new(pluginfakes.FakeProxyReader)
+
+		cmd = UpgradePluginsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		var err error
+		pluginHome, err = os.MkdirTemp("", "some-pluginhome")
+		Expect(err).ToNot(HaveOccurred())
+		fakeConfig.PluginHomeReturns(pluginHome)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+			{Name: "repo-1", URL: "https://repo-1.example.com"},
+			{Name: "repo-2", URL: "https://repo-2.example.com"},
+		})
+
+		fakeActor.GetPlatformStringReturns("some-platform")
+
+		upgrades = []pluginaction.PluginUpgrade{
+			{
+				PluginInfo:     pluginaction.PluginInfo{Name: "plugin-1", Version: "2.0.0", URL: "https://example.com/plugin-1", Checksum: "checksum-1", Signature: "signature-1"},
+				CurrentVersion: "1.0.0",
+				RepositoryName: "repo-1",
+			},
+			{
+				PluginInfo:     pluginaction.PluginInfo{Name: "plugin-2", Version: "3.0.0", URL: "https://example.com/plugin-2", Checksum: "checksum-2"},
+				RepositoryName: "repo-2",
+			},
+		}
+		fakeActor.GetPluginUpgradesReturns(upgrades, nil)
+
+		fakeActor.DownloadExecutableBinaryFromURLStub = func(url string, _ string, _ plugin.ProxyReader) (string, error) {
+			return url + "-download", nil
+		}
+		fakeActor.ValidateFileChecksumReturns(true)
+		fakeActor.CreateExecutableCopyStub = func(path string, _ string) (string, error) {
+			return path + "-copy", nil
+		}
+		fakeActor.GetAndValidatePluginStub = func(_ pluginaction.PluginMetadata, _ pluginaction.CommandList, path string) (configv3.Plugin, error) {
+			if path == "https://example.com/plugin-1-download-copy" {
+				return configv3.Plugin{Name: "plugin-1", Version: configv3.PluginVersion{Major: 2}}, nil
+			}
+			return configv3.Plugin{Name: "plugin-2", Version: configv3.PluginVersion{Major: 3}}, nil
+		}
+		fakeConfig.GetPluginCaseInsensitiveStub = func(pluginName string) (configv3.Plugin, bool) {
+			if pluginName == "plugin-1" {
+				return configv3.Plugin{Name: "plugin-1", Version: configv3.PluginVersion{Major: 1}}, true
+			}
+			return configv3.Plugin{}, false
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(pluginHome)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the user confirms the upgrade", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("searches all registered repositories for upgrades of all installed plugins", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Searching repo-1, repo-2 for newer versions of installed plugins\.\.\.`))
+
+			Expect(fakeActor.GetPluginUpgradesCallCount()).To(Equal(1))
+			pluginVersions, repos, platform := fakeActor.GetPluginUpgradesArgsForCall(0)
+			Expect(pluginVersions).To(BeEmpty())
+			Expect(repos).To(HaveLen(2))
+			Expect(platform).To(Equal("some-platform"))
+
+			Expect(fakeActor.PinPluginVersionsCallCount()).To(Equal(0))
+		})
+
+		When("an installed plugin is pinned to a version", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginsReturns([]configv3.Plugin{
+					{Name: "plugin-1", Version: configv3.PluginVersion{Major: 1}, PinnedVersion: "1.0.0"},
+					{Name: "plugin-2", Version: configv3.PluginVersion{Major: 3}},
+				})
+				fakeConfig.GetPluginCaseInsensitiveStub = func(pluginName string) (configv3.Plugin, bool) {
+					if pluginName == "plugin-1" {
+						return configv3.Plugin{Name: "plugin-1", Version: configv3.PluginVersion{Major: 1}, PinnedVersion: "1.0.0"}, true
+					}
+					return configv3.Plugin{}, false
+				}
+			})
+
+			It("says which plugins are kept at their pinned version", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Plugin plugin-1 is pinned to version 1\.0\.0\. Upgrade it by name without a version to unpin it\.`))
+				Expect(testUI.Out).ToNot(Say(`Plugin plugin-2 is pinned`))
+			})
+
+			It("keeps the pin when the plugin is reinstalled", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, installedPlugin := fakeActor.InstallPluginFromPathArgsForCall(0)
+				Expect(installedPlugin.PinnedVersion).To(Equal("1.0.0"))
+			})
+		})
+
+		It("lists the upgrades and installs each new version", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`plugin\s+version\s+new version\s+repository`))
+			Expect(testUI.Out).To(Say(`plugin-1\s+1\.0\.0\s+2\.0\.0\s+repo-1`))
+			Expect(testUI.Out).To(Say(`plugin-2\s+not installed\s+3\.0\.0\s+repo-2`))
+			Expect(testUI.Out).To(Say(`Attention: Plugins are binaries written by potentially untrusted authors\.`))
+			Expect(testUI.Out).To(Say(`Do you want to install these plugin versions\?`))
+			Expect(testUI.Out).To(Say(`Installing plugin plugin-1 2\.0\.0 from repository repo-1\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`Installing plugin plugin-2 3\.0\.0 from repository repo-2\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(2))
+			url, tempDir, proxyReader := fakeActor.DownloadExecutableBinaryFromURLArgsForCall(0)
+			Expect(url).To(Equal("https://example.com/plugin-1"))
+			Expect(tempDir).To(ContainSubstring("some-pluginhome"))
+			Expect(proxyReader).To(Equal(fakeProgressBar))
+
+			path, checksum := fakeActor.ValidateFileChecksumArgsForCall(0)
+			Expect(path).To(Equal("https://example.com/plugin-1-download"))
+			Expect(checksum).To(Equal("checksum-1"))
+
+			path, signature := fakeActor.VerifyPluginSignatureArgsForCall(0)
+			Expect(path).To(Equal("https://example.com/plugin-1-download"))
+			Expect(signature).To(Equal("signature-1"))
+
+			Expect(fakeActor.UninstallPluginCallCount()).To(Equal(1))
+			_, pluginName := fakeActor.UninstallPluginArgsForCall(0)
+			Expect(pluginName).To(Equal("plugin-1"))
+
+			Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(2))
+			path, installedPlugin := fakeActor.InstallPluginFromPathArgsForCall(1)
+			Expect(path).To(Equal("https://example.com/plugin-2-download-copy"))
+			Expect(installedPlugin.Name).To(Equal("plugin-2"))
+		})
+
+		When("the binary is signed by a trusted key", func() {
+			BeforeEach(func() {
+				fakeActor.VerifyPluginSignatureReturns("release-key", nil)
+			})
+
+			It("records the key on the installed plugin", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Plugin binary signed by release-key\.`))
+
+				_, installedPlugin := fakeActor.InstallPluginFromPathArgsForCall(0)
+				Expect(installedPlugin.SignedBy).To(Equal("release-key"))
+			})
+		})
+
+		When("the checksum of a binary does not match", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateFileChecksumReturns(false)
+			})
+
+			It("returns an InvalidChecksumError without installing the plugin", func() {
+				Expect(executeErr).To(MatchError(translatableerror.InvalidChecksumError{}))
+				Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the signature of a binary cannot be verified", func() {
+			BeforeEach(func() {
+				fakeActor.VerifyPluginSignatureReturns("", actionerror.PluginSignatureNotTrustedError{})
+			})
+
+			It("returns the error before running the plugin", func() {
+				Expect(executeErr).To(MatchError(actionerror.PluginSignatureNotTrustedError{}))
+				Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+				Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(0))
+			})
+		})
+
+		When("installing a plugin fails", func() {
+			BeforeEach(func() {
+				fakeActor.InstallPluginFromPathReturns(errors.New("install-error"))
+			})
+
+			It("stops upgrading and returns the error", func() {
+				Expect(executeErr).To(MatchError("install-error"))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+			})
+		})
+
+		When("a lock file is requested", func() {
+			BeforeEach(func() {
+				cmd.LockFile = "some-lock-file"
+				fakeActor.GetPluginLockFileReturns(
+					pluginaction.PluginLockFile{Plugins: []pluginaction.LockedPlugin{{Name: "plugin-1", Version: "2.0.0"}}},
+					[]configv3.Plugin{{Name: "local-plugin", Version: configv3.PluginVersion{Major: 1, Minor: 2}}},
+					nil,
+				)
+			})
+
+			It("writes the installed plugins to the lock file after upgrading", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Writing plugin lock file some-lock-file\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say(`Plugin local-plugin 1\.2\.0 was not installed from a registered plugin repository and is not in the lock file\.`))
+
+				repos, platform := fakeActor.GetPluginLockFileArgsForCall(0)
+				Expect(repos).To(HaveLen(2))
+				Expect(platform).To(Equal("some-platform"))
+
+				Expect(fakeActor.WritePluginLockFileCallCount()).To(Equal(1))
+				path, lockFile := fakeActor.WritePluginLockFileArgsForCall(0)
+				Expect(path).To(Equal("some-lock-file"))
+				Expect(lockFile.Plugins).To(HaveLen(1))
+			})
+
+			When("all plugins are up to date", func() {
+				BeforeEach(func() {
+					fakeActor.GetPluginUpgradesReturns(nil, nil)
+				})
+
+				It("still writes the lock file", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`All plugins are up to date\.`))
+					Expect(fakeActor.WritePluginLockFileCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+
+	When("the user cancels the upgrade", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+			cmd.LockFile = "some-lock-file"
+		})
+
+		It("does not install anything or write the lock file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Plugin upgrade cancelled\.`))
+			Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+			Expect(fakeActor.WritePluginLockFileCallCount()).To(Equal(0))
+		})
+
+		When("plugins are named with versions", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.UpgradePluginsArgs{PluginNames: []string{"plugin-1@2.0.0"}}
+			})
+
+			It("does not pin them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.PinPluginVersionsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("-f is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("does not prompt", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say(`Do you want to install these plugin versions\?`))
+			Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(2))
+		})
+
+		When("plugins are named with versions", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs = flag.UpgradePluginsArgs{PluginNames: []string{"plugin-1@1.5.0", "plugin-2", "@scoped@2.0.0"}}
+			})
+
+			It("pins the versions", func() {
+				pluginVersions, _, _ := fakeActor.GetPluginUpgradesArgsForCall(0)
+				Expect(pluginVersions).To(Equal(map[string]string{
+					"plugin-1": "1.5.0",
+					"plugin-2": "",
+					"@scoped":  "2.0.0",
+				}))
+			})
+
+			It("persists the pins after upgrading", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Installing plugin plugin-2 3\.0\.0`))
+				Expect(testUI.Out).To(Say(`Pinned plugin @scoped to version 2\.0\.0\.`))
+				Expect(testUI.Out).To(Say(`Pinned plugin plugin-1 to version 1\.5\.0\.`))
+
+				Expect(fakeActor.PinPluginVersionsCallCount()).To(Equal(1))
+				Expect(fakeActor.PinPluginVersionsArgsForCall(0)).To(Equal(map[string]string{
+					"plugin-1": "1.5.0",
+					"plugin-2": "",
+					"@scoped":  "2.0.0",
+				}))
+			})
+
+			When("the plugins are already at the requested versions", func() {
+				BeforeEach(func() {
+					fakeActor.GetPluginUpgradesReturns(nil, nil)
+				})
+
+				It("still persists the pins", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`All plugins are up to date\.`))
+					Expect(fakeActor.PinPluginVersionsCallCount()).To(Equal(1))
+				})
+			})
+
+			When("persisting the pins fails", func() {
+				BeforeEach(func() {
+					fakeActor.PinPluginVersionsReturns(errors.New("pin-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("pin-error"))
+				})
+			})
+		})
+
+		When("a repository is specified", func() {
+			BeforeEach(func() {
+				cmd.RegisteredRepository = "repo-2"
+				fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{Name: "repo-2", URL: "https://repo-2.example.com"}, nil)
+			})
+
+			It("only searches that repository", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Searching repo-2 for newer versions of installed plugins\.\.\.`))
+				Expect(fakeActor.GetPluginRepositoryArgsForCall(0)).To(Equal("repo-2"))
+
+				_, repos, _ := fakeActor.GetPluginUpgradesArgsForCall(0)
+				Expect(repos).To(Equal([]configv3.PluginRepository{{Name: "repo-2", URL: "https://repo-2.example.com"}}))
+			})
+
+			When("the repository is not registered", func() {
+				BeforeEach(func() {
+					fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{}, actionerror.RepositoryNotRegisteredError{Name: "repo-2"})
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(actionerror.RepositoryNotRegisteredError{Name: "repo-2"}))
+				})
+			})
+		})
+
+		When("upgrading from a lock file", func() {
+			BeforeEach(func() {
+				cmd.FromLockFile = "some-lock-file"
+				fakeActor.ReadPluginLockFileReturns(pluginaction.PluginLockFile{Plugins: []pluginaction.LockedPlugin{{Name: "plugin-1"}}}, nil)
+				fakeActor.GetLockedPluginUpgradesReturns(upgrades[:1], []configv3.Plugin{{Name: "local-plugin", Version: configv3.PluginVersion{Major: 1, Minor: 2}}}, nil)
+			})
+
+			It("installs the locked versions", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Reading plugin versions from lock file some-lock-file\.\.\.`))
+				Expect(testUI.Out).To(Say(`Installing plugin plugin-1 2\.0\.0 from repository repo-1\.\.\.`))
+
+				Expect(fakeActor.ReadPluginLockFileArgsForCall(0)).To(Equal("some-lock-file"))
+				lockFile, platform := fakeActor.GetLockedPluginUpgradesArgsForCall(0)
+				Expect(lockFile.Plugins).To(HaveLen(1))
+				Expect(platform).To(Equal("some-platform"))
+
+				Expect(fakeActor.GetPluginUpgradesCallCount()).To(Equal(0))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+			})
+
+			It("warns about installed plugins that are not in the lock file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say(`Plugin local-plugin 1\.2\.0 is installed but not in the lock file\. Uninstall it with 'faceman uninstall-plugin local-plugin' to match the lock file\.`))
+			})
+
+			When("the lock file is invalid", func() {
+				BeforeEach(func() {
+					fakeActor.ReadPluginLockFileReturns(pluginaction.PluginLockFile{}, actionerror.InvalidPluginLockFileError{Path: "some-lock-file", Message: "some-message"})
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(actionerror.InvalidPluginLockFileError{Path: "some-lock-file", Message: "some-message"}))
+				})
+			})
+
+			When("plugin names are also provided", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs = flag.UpgradePluginsArgs{PluginNames: []string{"plugin-1"}}
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+						Args: []string{"--from-lock-file", "PLUGIN_NAME", "-r", "--lock-file"},
+					}))
+				})
+			})
+		})
+	})
+
+	When("no plugin repositories are registered", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginRepositoriesReturns(nil)
+		})
+
+		It("returns a NoPluginRepositoriesError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoPluginRepositoriesError{}))
+		})
+	})
+
+	When("getting the upgrades fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetPluginUpgradesReturns(nil, actionerror.PluginVersionNotFoundError{PluginName: "plugin-1", Version: "9.9.9"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.PluginVersionNotFoundError{PluginName: "plugin-1", Version: "9.9.9"}))
+			Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -11,8 +11,10 @@ import (
 // Config a way of getting basic CF configuration
 type Config interface {
 	AccessToken() string
+	AddContext(name string)
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(name string, url string)
+	AddTrustedPluginKey(name string, publicKey string)
 	AuthorizationEndpoint() string
 	APIVersion() string
 	B3TraceID() string
@@ -21,6 +23,8 @@ type Config interface {
 	CFPassword() string
 	CFUsername() string
 	ColorEnabled() configv3.ColorSetting
+	Contexts() []configv3.TargetContext
+	CurrentContextName() string
 	CurrentUser() (configv3.User, error)
 	CurrentUserName() (string, error)
 	DialTimeout() time.Duration
@@ -29,8 +33,11 @@ type Config interface {
 	Experimental() bool
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
+	HasContext(name string) bool
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	HashCacheFile() string
+	HashConcurrency() int
 	IsTTY() bool
 	Locale() string
 	LogCacheEndpoint() string
@@ -38,20 +45,29 @@ type Config interface {
 	NOAARequestRetryCount() int
 	NetworkPolicyV1Endpoint() string
 	OverallPollingTimeout() time.Duration
+	PaginationConcurrency() int
+	PersistTokens() error
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
+	PluginSignaturePolicy() string
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
 	RefreshToken() string
+	RemoveContext(name string) error
 	RemovePlugin(string)
+	RemoveTrustedPluginKey(name string) bool
+	RenameContext(oldName string, newName string) error
 	RequestRetryCount() int
+	RetryTimeout() time.Duration
 	RoutingEndpoint() string
 	SetAsyncTimeout(timeout int)
 	SetAccessToken(token string)
 	SetColorEnabled(enabled string)
+	SetCredentialHelper(name string) error
 	SetLocale(locale string)
 	SetMinCLIVersion(version string)
 	SetOrganizationInformation(guid string, name string)
+	SetPluginSignaturePolicy(policy string)
 	SetRefreshToken(token string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
 	V7SetSpaceInformation(guid string, name string)
@@ -63,6 +79,7 @@ type Config interface {
 	SetUAAGrantType(uaaGrantType string)
 	SkipSSLValidation() bool
 	SSHOAuthClient() string
+	SwitchContext(name string) error
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
 	// TODO: Rename to APITarget()
@@ -71,6 +88,7 @@ type Config interface {
 	TargetedOrganizationName() string
 	TargetedSpace() configv3.Space
 	TerminalWidth() int
+	TrustedPluginKeys() []configv3.TrustedPluginKey
 	UAADisableKeepAlives() bool
 	UAAEndpoint() string
 	UAAGrantType() string
@@ -79,6 +97,7 @@ type Config interface {
 	UnsetOrganizationAndSpaceInformation()
 	UnsetSpaceInformation()
 	UnsetUserInformation()
+	UseGitIgnore() bool
 	Verbose() (bool, []string)
 	WritePluginConfig() error
 	WriteConfig() error
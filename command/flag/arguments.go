@@ -8,11 +8,20 @@ type OptionalAppName struct {
 	AppName string `positional-arg-name:"APP_NAME" description:"The application name"`
 }
 
+type OptionalAppNames struct {
+	AppNames []string `positional-arg-name:"APP_NAME" description:"The application names"`
+}
+
 type AppDroplet struct {
 	AppName     string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	DropletGUID string `positional-arg-name:"DROPLET_GUID" required:"true" description:"The droplet guid"`
 }
 
+type SourceAndDestination struct {
+	Source      string `positional-arg-name:"SOURCE" required:"true" description:"The path to copy from"`
+	Destination string `positional-arg-name:"DESTINATION" required:"true" description:"The path to copy to"`
+}
+
 type BuildpackName struct {
 	Buildpack string `positional-arg-name:"BUILDPACK" required:"true" description:"The buildpack"`
 }
@@ -61,6 +70,15 @@ type PluginName struct {
 	PluginName string `positional-arg-name:"PLUGIN_NAME" required:"true" description:"The plugin name"`
 }
 
+type ContextName struct {
+	Name string `positional-arg-name:"CONTEXT_NAME" required:"true" description:"The context name"`
+}
+
+type RenameContextArgs struct {
+	OldName string `positional-arg-name:"CONTEXT_NAME" required:"true" description:"The current context name"`
+	NewName string `positional-arg-name:"NEW_CONTEXT_NAME" required:"true" description:"The new context name"`
+}
+
 type Quota struct {
 	Quota string `positional-arg-name:"QUOTA" required:"true" description:"The organization quota"`
 }
@@ -129,6 +147,11 @@ type AppDomain struct {
 	Domain string `positional-arg-name:"DOMAIN" required:"true" description:"The domain"`
 }
 
+type AppSidecar struct {
+	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	Sidecar string `positional-arg-name:"SIDECAR_NAME" required:"true" description:"The sidecar name"`
+}
+
 type HostDomain struct {
 	Host   string `positional-arg-name:"HOST" required:"true" description:"The hostname"`
 	Domain string `positional-arg-name:"DOMAIN" required:"true" description:"The domain"`
@@ -346,6 +369,19 @@ type SecurityGroupArgs struct {
 	PathToJSONRules PathWithExistenceCheck `positional-arg-name:"PATH_TO_JSON_RULES_FILE" required:"true" description:"Path to file of JSON describing security group rules"`
 }
 
+type AddPluginKeyArgs struct {
+	KeyName   string `positional-arg-name:"KEY_NAME" required:"true" description:"The plugin key name"`
+	PublicKey string `positional-arg-name:"PUBLIC_KEY" required:"true" description:"The base64 encoded ed25519 or minisign public key"`
+}
+
+type PluginKeyName struct {
+	KeyName string `positional-arg-name:"KEY_NAME" required:"true" description:"The plugin key name"`
+}
+
+type UpgradePluginsArgs struct {
+	PluginNames []string `positional-arg-name:"PLUGIN_NAME" description:"The plugins to upgrade, each optionally followed by @VERSION to pin the version"`
+}
+
 type AddPluginRepoArgs struct {
 	PluginRepoName string `positional-arg-name:"REPO_NAME" required:"true" description:"The plugin repo name"`
 	PluginRepoURL  string `positional-arg-name:"URL" required:"true" description:"The URL to the plugin repo"`
@@ -0,0 +1,28 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// GraphFormat is the format of a graph written for other programs to render.
+type GraphFormat string
+
+func (GraphFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"dot", "mermaid"}, prefix, false)
+}
+
+func (g *GraphFormat) UnmarshalFlag(val string) error {
+	switch strings.ToLower(val) {
+	case "dot", "mermaid":
+		*g = GraphFormat(strings.ToLower(val))
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `FORMAT must be "dot" or "mermaid"`,
+		}
+	}
+
+	return nil
+}
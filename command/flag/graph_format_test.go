@@ -0,0 +1,61 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/v9/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GraphFormat", func() {
+	var graphFormat GraphFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := graphFormat.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+
+			Entry("completes to 'dot' when passed 'd'", "d",
+				[]flags.Completion{{Item: "dot"}}),
+			Entry("completes to 'mermaid' when passed 'M'", "M",
+				[]flags.Completion{{Item: "mermaid"}}),
+			Entry("returns all formats when passed nothing", "",
+				[]flags.Completion{{Item: "dot"}, {Item: "mermaid"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			graphFormat = ""
+		})
+
+		DescribeTable("accepts the supported formats in any case",
+			func(val string, expected GraphFormat) {
+				err := graphFormat.UnmarshalFlag(val)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(graphFormat).To(Equal(expected))
+			},
+
+			Entry("dot", "DOT", GraphFormat("dot")),
+			Entry("mermaid", "Mermaid", GraphFormat("mermaid")),
+		)
+
+		DescribeTable("errors on anything else",
+			func(val string) {
+				err := graphFormat.UnmarshalFlag(val)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `FORMAT must be "dot" or "mermaid"`,
+				}))
+				Expect(graphFormat).To(BeEmpty())
+			},
+
+			Entry("svg", "svg"),
+			Entry("json, which is covered by --output-format", "json"),
+		)
+	})
+})
@@ -0,0 +1,56 @@
+package flag
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// InstanceRange is a comma separated list of instance indexes and inclusive
+// index ranges, such as "0-2,5".
+type InstanceRange struct {
+	Indexes []uint
+}
+
+func (r *InstanceRange) UnmarshalFlag(rawValue string) error {
+	seen := map[uint]bool{}
+	var indexes []uint
+
+	for _, part := range strings.Split(rawValue, ",") {
+		start, end, err := parseInstanceRangePart(strings.TrimSpace(part))
+		if err != nil {
+			return err
+		}
+
+		for index := start; index <= end; index++ {
+			if !seen[index] {
+				seen[index] = true
+				indexes = append(indexes, index)
+			}
+		}
+	}
+
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	r.Indexes = indexes
+	return nil
+}
+
+func parseInstanceRangePart(part string) (uint, uint, error) {
+	rawStart, rawEnd, isRange := strings.Cut(part, "-")
+	if !isRange {
+		rawEnd = rawStart
+	}
+
+	start, startErr := strconv.ParseUint(rawStart, 10, 32)
+	end, endErr := strconv.ParseUint(rawEnd, 10, 32)
+	if startErr != nil || endErr != nil || start > end {
+		return 0, 0, &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: "INSTANCES must be comma separated instance indexes or ranges, such as 0-2,5",
+		}
+	}
+
+	return uint(start), uint(end), nil
+}
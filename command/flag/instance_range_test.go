@@ -0,0 +1,46 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/v9/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InstanceRange", func() {
+	var instanceRange InstanceRange
+
+	BeforeEach(func() {
+		instanceRange = InstanceRange{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("valid values",
+			func(input string, expected []uint) {
+				Expect(instanceRange.UnmarshalFlag(input)).To(Succeed())
+				Expect(instanceRange.Indexes).To(Equal(expected))
+			},
+			Entry("a single index", "3", []uint{3}),
+			Entry("a list of indexes", "4,1,2", []uint{1, 2, 4}),
+			Entry("a range", "0-2", []uint{0, 1, 2}),
+			Entry("ranges and indexes", "0-2,5", []uint{0, 1, 2, 5}),
+			Entry("overlapping ranges", "1-3, 2-4,3", []uint{1, 2, 3, 4}),
+		)
+
+		DescribeTable("invalid values",
+			func(input string) {
+				err := instanceRange.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: "INSTANCES must be comma separated instance indexes or ranges, such as 0-2,5",
+				}))
+			},
+			Entry("an empty value", ""),
+			Entry("a non-numeric index", "a"),
+			Entry("a negative index", "-1"),
+			Entry("an empty entry", "1,,2"),
+			Entry("a descending range", "3-1"),
+			Entry("an open range", "1-"),
+		)
+	})
+})
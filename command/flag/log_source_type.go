@@ -0,0 +1,27 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LogSourceType string
+
+func (LogSourceType) Complete(prefix string) []flags.Completion {
+	return completions([]string{"APP", "RTR", "STG", "CELL", "API"}, prefix, false)
+}
+
+func (t *LogSourceType) UnmarshalFlag(val string) error {
+	switch strings.ToUpper(val) {
+	case "APP", "RTR", "STG", "CELL", "API":
+		*t = LogSourceType(strings.ToUpper(val))
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `SOURCE_TYPE must be "APP", "RTR", "STG", "CELL" or "API"`,
+		}
+	}
+
+	return nil
+}
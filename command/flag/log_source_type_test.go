@@ -0,0 +1,49 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/v9/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogSourceType", func() {
+	var sourceType LogSourceType
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := sourceType.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+
+			Entry("completes to 'RTR' when passed 'r'", "r",
+				[]flags.Completion{{Item: "RTR"}}),
+			Entry("completes to 'APP' and 'API' when passed 'AP'", "AP",
+				[]flags.Completion{{Item: "APP"}, {Item: "API"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			sourceType = ""
+		})
+
+		It("accepts a source type in any case", func() {
+			err := sourceType.UnmarshalFlag("rtr")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sourceType).To(Equal(LogSourceType("RTR")))
+		})
+
+		It("errors on anything else", func() {
+			err := sourceType.UnmarshalFlag("LGR")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `SOURCE_TYPE must be "APP", "RTR", "STG", "CELL" or "API"`,
+			}))
+			Expect(sourceType).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,19 @@
+package flag
+
+import "code.cloudfoundry.org/cli/v9/types"
+
+// MegabytesOrNull is a memory size like Megabytes, or 'null' to unset a
+// memory size that was set before.
+type MegabytesOrNull struct {
+	Megabytes
+	IsNull bool
+}
+
+func (m *MegabytesOrNull) UnmarshalFlag(val string) error {
+	if val == types.JsonNull {
+		m.IsNull = true
+		return nil
+	}
+
+	return m.Megabytes.UnmarshalFlag(val)
+}
@@ -0,0 +1,47 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MegabytesOrNull", func() {
+	var megabytes MegabytesOrNull
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			megabytes = MegabytesOrNull{}
+		})
+
+		When("the value is null", func() {
+			It("marks the value as null", func() {
+				err := megabytes.UnmarshalFlag("null")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(megabytes).To(Equal(MegabytesOrNull{IsNull: true}))
+			})
+		})
+
+		When("the value is a memory size", func() {
+			It("interprets the size like Megabytes", func() {
+				err := megabytes.UnmarshalFlag("2G")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(megabytes).To(Equal(MegabytesOrNull{
+					Megabytes: Megabytes{NullUint64: types.NullUint64{IsSet: true, Value: 2048}},
+				}))
+			})
+		})
+
+		When("the value is invalid", func() {
+			It("returns an error", func() {
+				err := megabytes.UnmarshalFlag("nil")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `Byte quantity must be an integer with a unit of measurement like M, MB, G, or GB`,
+				}))
+			})
+		})
+	})
+})
@@ -0,0 +1,27 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type OutputFormat string
+
+func (OutputFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"json", "yaml"}, prefix, false)
+}
+
+func (o *OutputFormat) UnmarshalFlag(val string) error {
+	switch strings.ToLower(val) {
+	case "json", "yaml":
+		*o = OutputFormat(strings.ToLower(val))
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `OUTPUT_FORMAT must be "json" or "yaml"`,
+		}
+	}
+
+	return nil
+}
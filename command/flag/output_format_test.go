@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/v9/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	var outputFormat OutputFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := outputFormat.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+
+			Entry("completes to 'json' when passed 'j'", "j",
+				[]flags.Completion{{Item: "json"}}),
+			Entry("completes to 'yaml' when passed 'Y'", "Y",
+				[]flags.Completion{{Item: "yaml"}}),
+			Entry("returns 'json' and 'yaml' when passed nothing", "",
+				[]flags.Completion{{Item: "json"}, {Item: "yaml"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			outputFormat = ""
+		})
+
+		It("accepts json", func() {
+			err := outputFormat.UnmarshalFlag("JSON")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(outputFormat).To(Equal(OutputFormat("json")))
+		})
+
+		It("accepts yaml", func() {
+			err := outputFormat.UnmarshalFlag("yaml")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(outputFormat).To(Equal(OutputFormat("yaml")))
+		})
+
+		It("errors on anything else", func() {
+			err := outputFormat.UnmarshalFlag("table")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `OUTPUT_FORMAT must be "json" or "yaml"`,
+			}))
+			Expect(outputFormat).To(BeEmpty())
+		})
+	})
+})
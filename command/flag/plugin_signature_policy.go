@@ -0,0 +1,28 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// PluginSignaturePolicy is whether plugins must be signed by a trusted key.
+type PluginSignaturePolicy string
+
+func (PluginSignaturePolicy) Complete(prefix string) []flags.Completion {
+	return completions([]string{"optional", "required"}, prefix, false)
+}
+
+func (p *PluginSignaturePolicy) UnmarshalFlag(val string) error {
+	switch strings.ToLower(val) {
+	case "optional", "required":
+		*p = PluginSignaturePolicy(strings.ToLower(val))
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `PLUGIN_SIGNATURES must be "required" or "optional"`,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/v9/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PluginSignaturePolicy", func() {
+	var policy PluginSignaturePolicy
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := policy.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+
+			Entry("completes to 'required' when passed 'r'", "r",
+				[]flags.Completion{{Item: "required"}}),
+			Entry("completes to 'optional' when passed 'O'", "O",
+				[]flags.Completion{{Item: "optional"}}),
+			Entry("returns all policies when passed nothing", "",
+				[]flags.Completion{{Item: "optional"}, {Item: "required"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			policy = ""
+		})
+
+		DescribeTable("accepts the policies in any case",
+			func(val string, expected PluginSignaturePolicy) {
+				err := policy.UnmarshalFlag(val)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(policy).To(Equal(expected))
+			},
+
+			Entry("required", "Required", PluginSignaturePolicy("required")),
+			Entry("optional", "optional", PluginSignaturePolicy("optional")),
+		)
+
+		It("errors on anything else", func() {
+			err := policy.UnmarshalFlag("always")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `PLUGIN_SIGNATURES must be "required" or "optional"`,
+			}))
+			Expect(policy).To(BeEmpty())
+		})
+	})
+})
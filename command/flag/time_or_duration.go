@@ -0,0 +1,45 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// TimeOrDuration is a point in time given either as an RFC 3339 timestamp or
+// as a duration before now, such as 1h30m.
+type TimeOrDuration struct {
+	Time     time.Time
+	Duration time.Duration
+	IsSet    bool
+}
+
+func (t *TimeOrDuration) UnmarshalFlag(val string) error {
+	if timestamp, err := time.Parse(time.RFC3339, val); err == nil {
+		*t = TimeOrDuration{Time: timestamp, IsSet: true}
+		return nil
+	}
+
+	if duration, err := time.ParseDuration(val); err == nil && duration >= 0 {
+		*t = TimeOrDuration{Duration: duration, IsSet: true}
+		return nil
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: `Time must be an RFC 3339 timestamp such as "2024-03-01T12:00:00Z" or a duration such as "1h30m"`,
+	}
+}
+
+// Resolve returns the point in time relative to now. It returns the zero time
+// if the flag is not set.
+func (t TimeOrDuration) Resolve(now time.Time) time.Time {
+	switch {
+	case !t.IsSet:
+		return time.Time{}
+	case t.Time.IsZero():
+		return now.Add(-t.Duration)
+	default:
+		return t.Time
+	}
+}
@@ -0,0 +1,53 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/v9/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TimeOrDuration", func() {
+	var (
+		timeOrDuration TimeOrDuration
+		now            time.Time
+	)
+
+	BeforeEach(func() {
+		timeOrDuration = TimeOrDuration{}
+		now = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	It("accepts an RFC 3339 timestamp", func() {
+		err := timeOrDuration.UnmarshalFlag("2024-02-29T08:30:00Z")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(timeOrDuration.Resolve(now)).To(Equal(time.Date(2024, time.February, 29, 8, 30, 0, 0, time.UTC)))
+	})
+
+	It("accepts a duration before now", func() {
+		err := timeOrDuration.UnmarshalFlag("1h30m")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(timeOrDuration.Resolve(now)).To(Equal(time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC)))
+	})
+
+	It("resolves to the zero time when not set", func() {
+		Expect(timeOrDuration.Resolve(now)).To(BeZero())
+	})
+
+	DescribeTable("errors on anything else",
+		func(val string) {
+			err := timeOrDuration.UnmarshalFlag(val)
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `Time must be an RFC 3339 timestamp such as "2024-03-01T12:00:00Z" or a duration such as "1h30m"`,
+			}))
+			Expect(timeOrDuration.IsSet).To(BeFalse())
+		},
+
+		Entry("a date without a time", "2024-03-01"),
+		Entry("a negative duration", "-5m"),
+		Entry("a word", "yesterday"),
+	)
+})
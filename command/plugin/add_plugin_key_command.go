@@ -0,0 +1,48 @@
+package plugin
+
+import (
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/pluginaction"
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . AddPluginKeyActor
+
+type AddPluginKeyActor interface {
+	AddTrustedPluginKey(keyName string, publicKey string) error
+}
+
+type AddPluginKeyCommand struct {
+	RequiredArgs    flag.AddPluginKeyArgs `positional-args:"yes"`
+	usage           interface{}           `usage:"CF_NAME add-plugin-key KEY_NAME PUBLIC_KEY\n\nEXAMPLES:\n   CF_NAME add-plugin-key release-key RWQf6LRCGA9i53mlYecO4IzT51TGPpvWucNSCh1CBM0QTaLn73Y7GFO3"`
+	relatedCommands interface{}           `related_commands:"config, install-plugin, plugin-keys, remove-plugin-key"`
+	UI              command.UI
+	Config          command.Config
+	Actor           AddPluginKeyActor
+}
+
+func (cmd *AddPluginKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, nil)
+	return nil
+}
+
+func (cmd AddPluginKeyCommand) Execute(args []string) error {
+	err := cmd.Actor.AddTrustedPluginKey(cmd.RequiredArgs.KeyName, cmd.RequiredArgs.PublicKey)
+	switch e := err.(type) {
+	case actionerror.PluginKeyAlreadyExistsError:
+		cmd.UI.DisplayTextWithFlavor("Plugin key {{.KeyName}} is already trusted.", map[string]interface{}{
+			"KeyName": e.Name,
+		})
+	case nil:
+		cmd.UI.DisplayTextWithFlavor("Plugins signed with {{.KeyName}} are now trusted.", map[string]interface{}{
+			"KeyName": cmd.RequiredArgs.KeyName,
+		})
+	default:
+		return err
+	}
+
+	return nil
+}
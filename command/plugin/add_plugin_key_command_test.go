@@ -0,0 +1,66 @@
+package plugin_test
+
+import (
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	. "code.cloudfoundry.org/cli/v9/command/plugin"
+	"code.cloudfoundry.org/cli/v9/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("add-plugin-key command", func() {
+	var (
+		cmd        AddPluginKeyCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakeAddPluginKeyActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakeAddPluginKeyActor)
+		cmd = AddPluginKeyCommand{UI: testUI, Config: fakeConfig, Actor: fakeActor}
+		cmd.RequiredArgs.KeyName = "some-key"
+		cmd.RequiredArgs.PublicKey = "some-public-key"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("trusts the key", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say("Plugins signed with some-key are now trusted."))
+
+		keyName, publicKey := fakeActor.AddTrustedPluginKeyArgsForCall(0)
+		Expect(keyName).To(Equal("some-key"))
+		Expect(publicKey).To(Equal("some-public-key"))
+	})
+
+	When("the key is already trusted", func() {
+		BeforeEach(func() {
+			fakeActor.AddTrustedPluginKeyReturns(actionerror.PluginKeyAlreadyExistsError{Name: "Some-Key"})
+		})
+
+		It("says so and does not return an error", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Plugin key Some-Key is already trusted."))
+		})
+	})
+
+	When("adding the key fails", func() {
+		BeforeEach(func() {
+			fakeActor.AddTrustedPluginKeyReturns(actionerror.PluginKeyNameTakenError{Name: "some-key"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.PluginKeyNameTakenError{Name: "some-key"}))
+			Expect(testUI.Out).NotTo(Say("trusted"))
+		})
+	})
+})
@@ -0,0 +1,39 @@
+package plugin
+
+import (
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+)
+
+type PluginKeysCommand struct {
+	usage           interface{} `usage:"CF_NAME plugin-keys"`
+	relatedCommands interface{} `related_commands:"add-plugin-key, config, remove-plugin-key"`
+	UI              command.UI
+	Config          command.Config
+}
+
+func (cmd *PluginKeysCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd PluginKeysCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Plugin signatures are {{.Policy}}.", map[string]interface{}{
+		"Policy": cmd.Config.PluginSignaturePolicy(),
+	})
+	cmd.UI.DisplayNewline()
+
+	keys := cmd.Config.TrustedPluginKeys()
+	if len(keys) == 0 {
+		cmd.UI.DisplayText("No trusted plugin keys found.")
+		return nil
+	}
+
+	table := [][]string{{cmd.UI.TranslateText("name"), cmd.UI.TranslateText("public key")}}
+	for _, key := range keys {
+		table = append(table, []string{key.Name, key.PublicKey})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
@@ -0,0 +1,57 @@
+package plugin_test
+
+import (
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	. "code.cloudfoundry.org/cli/v9/command/plugin"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("plugin-keys command", func() {
+	var (
+		cmd        PluginKeysCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = PluginKeysCommand{UI: testUI, Config: fakeConfig}
+		fakeConfig.PluginSignaturePolicyReturns(configv3.PluginSignaturesOptional)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("there are no trusted keys", func() {
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Plugin signatures are optional."))
+			Expect(testUI.Out).To(Say("No trusted plugin keys found."))
+		})
+	})
+
+	When("there are trusted keys", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginSignaturePolicyReturns(configv3.PluginSignaturesRequired)
+			fakeConfig.TrustedPluginKeysReturns([]configv3.TrustedPluginKey{
+				{Name: "key-1", PublicKey: "public-key-1"},
+				{Name: "key-2", PublicKey: "public-key-2"},
+			})
+		})
+
+		It("lists them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Plugin signatures are required."))
+			Expect(testUI.Out).To(Say(`name\s+public key`))
+			Expect(testUI.Out).To(Say(`key-1\s+public-key-1`))
+			Expect(testUI.Out).To(Say(`key-2\s+public-key-2`))
+		})
+	})
+})
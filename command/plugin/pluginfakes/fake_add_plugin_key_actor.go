@@ -0,0 +1,111 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package pluginfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/v9/command/plugin"
+)
+
+type FakeAddPluginKeyActor struct {
+	AddTrustedPluginKeyStub        func(string, string) error
+	addTrustedPluginKeyMutex       sync.RWMutex
+	addTrustedPluginKeyArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	addTrustedPluginKeyReturns struct {
+		result1 error
+	}
+	addTrustedPluginKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAddPluginKeyActor) AddTrustedPluginKey(arg1 string, arg2 string) error {
+	fake.addTrustedPluginKeyMutex.Lock()
+	ret, specificReturn := fake.addTrustedPluginKeyReturnsOnCall[len(fake.addTrustedPluginKeyArgsForCall)]
+	fake.addTrustedPluginKeyArgsForCall = append(fake.addTrustedPluginKeyArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AddTrustedPluginKeyStub
+	fakeReturns := fake.addTrustedPluginKeyReturns
+	fake.recordInvocation("AddTrustedPluginKey", []interface{}{arg1, arg2})
+	fake.addTrustedPluginKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeAddPluginKeyActor) AddTrustedPluginKeyCallCount() int {
+	fake.addTrustedPluginKeyMutex.RLock()
+	defer fake.addTrustedPluginKeyMutex.RUnlock()
+	return len(fake.addTrustedPluginKeyArgsForCall)
+}
+
+func (fake *FakeAddPluginKeyActor) AddTrustedPluginKeyCalls(stub func(string, string) error) {
+	fake.addTrustedPluginKeyMutex.Lock()
+	defer fake.addTrustedPluginKeyMutex.Unlock()
+	fake.AddTrustedPluginKeyStub = stub
+}
+
+func (fake *FakeAddPluginKeyActor) AddTrustedPluginKeyArgsForCall(i int) (string, string) {
+	fake.addTrustedPluginKeyMutex.RLock()
+	defer fake.addTrustedPluginKeyMutex.RUnlock()
+	argsForCall := fake.addTrustedPluginKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAddPluginKeyActor) AddTrustedPluginKeyReturns(result1 error) {
+	fake.addTrustedPluginKeyMutex.Lock()
+	defer fake.addTrustedPluginKeyMutex.Unlock()
+	fake.AddTrustedPluginKeyStub = nil
+	fake.addTrustedPluginKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAddPluginKeyActor) AddTrustedPluginKeyReturnsOnCall(i int, result1 error) {
+	fake.addTrustedPluginKeyMutex.Lock()
+	defer fake.addTrustedPluginKeyMutex.Unlock()
+	fake.AddTrustedPluginKeyStub = nil
+	if fake.addTrustedPluginKeyReturnsOnCall == nil {
+		fake.addTrustedPluginKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addTrustedPluginKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAddPluginKeyActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAddPluginKeyActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ plugin.AddPluginKeyActor = new(FakeAddPluginKeyActor)
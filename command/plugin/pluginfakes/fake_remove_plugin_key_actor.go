@@ -0,0 +1,109 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package pluginfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/v9/command/plugin"
+)
+
+type FakeRemovePluginKeyActor struct {
+	RemoveTrustedPluginKeyStub        func(string) error
+	removeTrustedPluginKeyMutex       sync.RWMutex
+	removeTrustedPluginKeyArgsForCall []struct {
+		arg1 string
+	}
+	removeTrustedPluginKeyReturns struct {
+		result1 error
+	}
+	removeTrustedPluginKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRemovePluginKeyActor) RemoveTrustedPluginKey(arg1 string) error {
+	fake.removeTrustedPluginKeyMutex.Lock()
+	ret, specificReturn := fake.removeTrustedPluginKeyReturnsOnCall[len(fake.removeTrustedPluginKeyArgsForCall)]
+	fake.removeTrustedPluginKeyArgsForCall = append(fake.removeTrustedPluginKeyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RemoveTrustedPluginKeyStub
+	fakeReturns := fake.removeTrustedPluginKeyReturns
+	fake.recordInvocation("RemoveTrustedPluginKey", []interface{}{arg1})
+	fake.removeTrustedPluginKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRemovePluginKeyActor) RemoveTrustedPluginKeyCallCount() int {
+	fake.removeTrustedPluginKeyMutex.RLock()
+	defer fake.removeTrustedPluginKeyMutex.RUnlock()
+	return len(fake.removeTrustedPluginKeyArgsForCall)
+}
+
+func (fake *FakeRemovePluginKeyActor) RemoveTrustedPluginKeyCalls(stub func(string) error) {
+	fake.removeTrustedPluginKeyMutex.Lock()
+	defer fake.removeTrustedPluginKeyMutex.Unlock()
+	fake.RemoveTrustedPluginKeyStub = stub
+}
+
+func (fake *FakeRemovePluginKeyActor) RemoveTrustedPluginKeyArgsForCall(i int) string {
+	fake.removeTrustedPluginKeyMutex.RLock()
+	defer fake.removeTrustedPluginKeyMutex.RUnlock()
+	argsForCall := fake.removeTrustedPluginKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRemovePluginKeyActor) RemoveTrustedPluginKeyReturns(result1 error) {
+	fake.removeTrustedPluginKeyMutex.Lock()
+	defer fake.removeTrustedPluginKeyMutex.Unlock()
+	fake.RemoveTrustedPluginKeyStub = nil
+	fake.removeTrustedPluginKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRemovePluginKeyActor) RemoveTrustedPluginKeyReturnsOnCall(i int, result1 error) {
+	fake.removeTrustedPluginKeyMutex.Lock()
+	defer fake.removeTrustedPluginKeyMutex.Unlock()
+	fake.RemoveTrustedPluginKeyStub = nil
+	if fake.removeTrustedPluginKeyReturnsOnCall == nil {
+		fake.removeTrustedPluginKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeTrustedPluginKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRemovePluginKeyActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRemovePluginKeyActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ plugin.RemovePluginKeyActor = new(FakeRemovePluginKeyActor)
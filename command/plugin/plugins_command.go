@@ -97,10 +97,10 @@ func (cmd PluginsCommand) displayOutdatedPlugins() error {
 
 func (cmd PluginsCommand) displayPluginCommands(plugins []configv3.Plugin) error {
 	cmd.UI.DisplayText("Listing installed plugins...")
-	table := [][]string{{"plugin", "version", "command name", "command help"}}
+	table := [][]string{{"plugin", "version", "signed by", "command name", "command help"}}
 	for _, plugin := range plugins {
 		for _, command := range plugin.PluginCommands() {
-			table = append(table, []string{plugin.Name, plugin.Version.String(), command.CommandName(), command.HelpText})
+			table = append(table, []string{plugin.Name, plugin.Version.String(), plugin.SignedBy, command.CommandName(), command.HelpText})
 		}
 	}
 	cmd.UI.DisplayNewline()
@@ -45,7 +45,7 @@ var _ = Describe("plugins Command", func() {
 
 			Expect(testUI.Out).To(Say("Listing installed plugins..."))
 			Expect(testUI.Out).To(Say(""))
-			Expect(testUI.Out).To(Say(`plugin\s+version\s+command name\s+command help`))
+			Expect(testUI.Out).To(Say(`plugin\s+version\s+signed by\s+command name\s+command help`))
 			Expect(testUI.Out).To(Say(""))
 			Expect(testUI.Out).To(Say(`Use 'faceman repo-plugins' to list plugins in registered repos available to install\.`))
 			Expect(testUI.Out).ToNot(Say("[A-Za-z0-9]+"))
@@ -93,7 +93,8 @@ var _ = Describe("plugins Command", func() {
 					},
 				},
 				{
-					Name: "sorted-second",
+					Name:     "sorted-second",
+					SignedBy: "release-key",
 					Version: configv3.PluginVersion{
 						Major: 0,
 						Minor: 0,
@@ -119,11 +120,11 @@ var _ = Describe("plugins Command", func() {
 
 			Expect(testUI.Out).To(Say("Listing installed plugins..."))
 			Expect(testUI.Out).To(Say(""))
-			Expect(testUI.Out).To(Say(`plugin\s+version\s+command name\s+command help`))
+			Expect(testUI.Out).To(Say(`plugin\s+version\s+signed by\s+command name\s+command help`))
 			Expect(testUI.Out).To(Say(`Sorted-first\s+1\.1\.0\s+command-1, c\s+help-command-1`))
 			Expect(testUI.Out).To(Say(`Sorted-first\s+1\.1\.0\s+command-2\s+help-command-2`))
-			Expect(testUI.Out).To(Say(`sorted-second\s+N/A\s+bar\s+help-bar`))
-			Expect(testUI.Out).To(Say(`sorted-second\s+N/A\s+foo\s+help-foo`))
+			Expect(testUI.Out).To(Say(`sorted-second\s+N/A\s+release-key\s+bar\s+help-bar`))
+			Expect(testUI.Out).To(Say(`sorted-second\s+N/A\s+release-key\s+foo\s+help-foo`))
 			Expect(testUI.Out).To(Say(""))
 			Expect(testUI.Out).To(Say(`Use 'faceman repo-plugins' to list plugins in registered repos available to install\.`))
 		})
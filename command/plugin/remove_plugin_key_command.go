@@ -0,0 +1,41 @@
+package plugin
+
+import (
+	"code.cloudfoundry.org/cli/v9/actor/pluginaction"
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . RemovePluginKeyActor
+
+type RemovePluginKeyActor interface {
+	RemoveTrustedPluginKey(keyName string) error
+}
+
+type RemovePluginKeyCommand struct {
+	RequiredArgs    flag.PluginKeyName `positional-args:"yes"`
+	usage           interface{}        `usage:"CF_NAME remove-plugin-key KEY_NAME\n\nEXAMPLES:\n   CF_NAME remove-plugin-key release-key"`
+	relatedCommands interface{}        `related_commands:"add-plugin-key, plugin-keys"`
+	UI              command.UI
+	Config          command.Config
+	Actor           RemovePluginKeyActor
+}
+
+func (cmd *RemovePluginKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, nil)
+	return nil
+}
+
+func (cmd RemovePluginKeyCommand) Execute(args []string) error {
+	err := cmd.Actor.RemoveTrustedPluginKey(cmd.RequiredArgs.KeyName)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Plugins signed with {{.KeyName}} are no longer trusted.", map[string]interface{}{
+		"KeyName": cmd.RequiredArgs.KeyName,
+	})
+	return nil
+}
@@ -0,0 +1,50 @@
+package plugin_test
+
+import (
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	. "code.cloudfoundry.org/cli/v9/command/plugin"
+	"code.cloudfoundry.org/cli/v9/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("remove-plugin-key command", func() {
+	var (
+		cmd        RemovePluginKeyCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakeRemovePluginKeyActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakeRemovePluginKeyActor)
+		cmd = RemovePluginKeyCommand{UI: testUI, Config: fakeConfig, Actor: fakeActor}
+		cmd.RequiredArgs.KeyName = "some-key"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("stops trusting the key", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(fakeActor.RemoveTrustedPluginKeyArgsForCall(0)).To(Equal("some-key"))
+		Expect(testUI.Out).To(Say("Plugins signed with some-key are no longer trusted."))
+	})
+
+	When("the key does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.RemoveTrustedPluginKeyReturns(actionerror.PluginKeyNotFoundError{Name: "some-key"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.PluginKeyNotFoundError{Name: "some-key"}))
+		})
+	})
+})
@@ -0,0 +1,9 @@
+package command
+
+// StructuredOutputCommand is implemented by commands that can render their
+// output as a JSON or YAML document. The --output-format global flag and
+// $CF_OUTPUT_FORMAT only change the output, warnings and errors of these
+// commands.
+type StructuredOutputCommand interface {
+	SupportsStructuredOutput()
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type CannotDeleteCurrentContextError struct {
+	Name string
+}
+
+func (e CannotDeleteCurrentContextError) Error() string {
+	return "Context '{{.Name}}' is the current context and cannot be deleted. Switch to another context first."
+}
+
+func (e CannotDeleteCurrentContextError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
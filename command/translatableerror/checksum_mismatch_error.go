@@ -0,0 +1,19 @@
+package translatableerror
+
+type ChecksumMismatchError struct {
+	Algorithm string
+	Expected  string
+	Actual    string
+}
+
+func (ChecksumMismatchError) Error() string {
+	return "Downloaded file does not match its {{.Algorithm}} checksum: expected {{.Expected}}, got {{.Actual}}.\nThe download was discarded; run the command again to start over."
+}
+
+func (e ChecksumMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Algorithm": e.Algorithm,
+		"Expected":  e.Expected,
+		"Actual":    e.Actual,
+	})
+}
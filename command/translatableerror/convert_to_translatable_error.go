@@ -58,6 +58,10 @@ func ConvertToTranslatableError(err error) error {
 		return HTTPHealthCheckInvalidError{}
 	case actionerror.InvalidBuildpacksError:
 		return InvalidBuildpacksError{}
+	case actionerror.InvalidPluginKeyError:
+		return InvalidPluginKeyError(e)
+	case actionerror.InvalidPluginLockFileError:
+		return InvalidPluginLockFileError(e)
 	case actionerror.InvalidHTTPRouteSettings:
 		return PortNotAllowedWithHTTPDomainError(e)
 	case actionerror.InvalidRouteError:
@@ -66,6 +70,8 @@ func ConvertToTranslatableError(err error) error {
 		return HostAndPathNotAllowedWithTCPDomainError(e)
 	case actionerror.IsolationSegmentNotFoundError:
 		return IsolationSegmentNotFoundError(e)
+	case actionerror.LockedPluginNoCompatibleBinaryError:
+		return LockedPluginNoCompatibleBinaryError(e)
 	case actionerror.MissingNameError:
 		return AppNameOrManifestRequiredError{}
 	case actionerror.MultipleBuildpacksFoundError:
@@ -82,6 +88,8 @@ func ConvertToTranslatableError(err error) error {
 		return FileNotFoundError(e)
 	case actionerror.NoOrganizationTargetedError:
 		return NoOrganizationTargetedError(e)
+	case actionerror.NoRunningProcessInstancesError:
+		return NoRunningProcessInstancesError(e)
 	case actionerror.NoSpaceTargetedError:
 		return NoSpaceTargetedError(e)
 	case actionerror.NotLoggedInError:
@@ -96,8 +104,20 @@ func ConvertToTranslatableError(err error) error {
 		return PluginCommandsConflictError(e)
 	case actionerror.PluginInvalidError:
 		return PluginInvalidError(e)
+	case actionerror.PluginKeyNameTakenError:
+		return PluginKeyNameTakenError(e)
+	case actionerror.PluginKeyNotFoundError:
+		return PluginKeyNotFoundError(e)
 	case actionerror.PluginNotFoundError:
 		return PluginNotFoundError(e)
+	case actionerror.PluginNotSignedError:
+		return PluginNotSignedError(e)
+	case actionerror.PluginSignatureInvalidError:
+		return PluginSignatureInvalidError(e)
+	case actionerror.PluginSignatureNotTrustedError:
+		return PluginSignatureNotTrustedError(e)
+	case actionerror.PluginVersionNotFoundError:
+		return PluginVersionNotFoundError(e)
 	case actionerror.ProcessInstanceNotFoundError:
 		return ProcessInstanceNotFoundError(e)
 	case actionerror.ProcessInstanceNotRunningError:
@@ -135,6 +155,8 @@ func ConvertToTranslatableError(err error) error {
 		return ServicePlanNotFoundError(e)
 	case actionerror.SharedServiceInstanceNotFoundError:
 		return SharedServiceInstanceNotFoundError(e)
+	case actionerror.SidecarNotFoundError:
+		return SidecarNotFoundError(e)
 	case actionerror.SpaceNotFoundError:
 		return SpaceNotFoundError{Name: e.Name}
 	case actionerror.StackNotFoundError:
@@ -143,6 +165,10 @@ func ConvertToTranslatableError(err error) error {
 		return StagingFailedError{Message: e.Reason}
 	case actionerror.StagingTimeoutError:
 		return StagingTimeoutError(e)
+	case actionerror.TaskFailedError:
+		return TaskFailedError(e)
+	case actionerror.TaskTimeoutError:
+		return TaskTimeoutError(e)
 	case actionerror.TaskWorkersUnavailableError:
 		return RunTaskError{Message: "Task workers are unavailable."}
 	case actionerror.TCPRouteOptionsNotProvidedError:
@@ -232,8 +258,16 @@ func ConvertToTranslatableError(err error) error {
 		return InvalidRefreshTokenError{}
 
 	// Other Errors
+	case download.ChecksumMismatchError:
+		return ChecksumMismatchError{Algorithm: e.Algorithm, Expected: e.Expected, Actual: e.Actual}
+	case download.ConnectionError:
+		return DownloadConnectionError{URL: e.URL, Err: e.Err}
+	case download.InterruptedError:
+		return DownloadInterruptedError{BytesReceived: e.BytesReceived, Err: e.Err}
 	case download.RawHTTPStatusError:
 		return HTTPStatusError{Status: e.Status}
+	case download.UnsupportedChecksumAlgorithmError:
+		return UnsupportedChecksumAlgorithmError{Algorithm: e.Algorithm}
 	}
 
 	return err
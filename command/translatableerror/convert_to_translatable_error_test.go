@@ -112,6 +112,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.InvalidBuildpacksError{},
 			InvalidBuildpacksError{}),
 
+		Entry("actionerror.InvalidPluginKeyError -> InvalidPluginKeyError",
+			actionerror.InvalidPluginKeyError{Name: "some-key", Message: "some-message"},
+			InvalidPluginKeyError{Name: "some-key", Message: "some-message"}),
+
+		Entry("actionerror.InvalidPluginLockFileError -> InvalidPluginLockFileError",
+			actionerror.InvalidPluginLockFileError{Path: "some-path", Message: "some-message"},
+			InvalidPluginLockFileError{Path: "some-path", Message: "some-message"}),
+
 		Entry("actionerror.InvalidHTTPRouteSettings -> PortNotAllowedWithHTTPDomainError",
 			actionerror.InvalidHTTPRouteSettings{Domain: "some-domain"},
 			PortNotAllowedWithHTTPDomainError{Domain: "some-domain"}),
@@ -124,6 +132,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.InvalidTCPRouteSettings{Domain: "some-domain"},
 			HostAndPathNotAllowedWithTCPDomainError{Domain: "some-domain"}),
 
+		Entry("actionerror.LockedPluginNoCompatibleBinaryError -> LockedPluginNoCompatibleBinaryError",
+			actionerror.LockedPluginNoCompatibleBinaryError{PluginName: "some-plugin", Version: "1.2.3"},
+			LockedPluginNoCompatibleBinaryError{PluginName: "some-plugin", Version: "1.2.3"}),
+
 		Entry("actionerror.MissingNameError -> AppNameOrManifestRequiredError",
 			actionerror.MissingNameError{},
 			AppNameOrManifestRequiredError{}),
@@ -156,6 +168,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.NoOrganizationTargetedError{BinaryName: "faceman"},
 			NoOrganizationTargetedError{BinaryName: "faceman"}),
 
+		Entry("actionerror.NoRunningProcessInstancesError -> NoRunningProcessInstancesError",
+			actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"},
+			NoRunningProcessInstancesError{ProcessType: "some-process-type"}),
+
 		Entry("actionerror.NoSpaceTargetedError -> NoSpaceTargetedError",
 			actionerror.NoSpaceTargetedError{BinaryName: "faceman"},
 			NoSpaceTargetedError{BinaryName: "faceman"}),
@@ -198,10 +214,34 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.PluginInvalidError{Err: genericErr},
 			PluginInvalidError{Err: genericErr}),
 
+		Entry("actionerror.PluginKeyNameTakenError -> PluginKeyNameTakenError",
+			actionerror.PluginKeyNameTakenError{Name: "some-key"},
+			PluginKeyNameTakenError{Name: "some-key"}),
+
+		Entry("actionerror.PluginKeyNotFoundError -> PluginKeyNotFoundError",
+			actionerror.PluginKeyNotFoundError{Name: "some-key"},
+			PluginKeyNotFoundError{Name: "some-key"}),
+
 		Entry("actionerror.PluginNotFoundError -> PluginNotFoundError",
 			actionerror.PluginNotFoundError{PluginName: "some-plugin"},
 			PluginNotFoundError{PluginName: "some-plugin"}),
 
+		Entry("actionerror.PluginNotSignedError -> PluginNotSignedError",
+			actionerror.PluginNotSignedError{},
+			PluginNotSignedError{}),
+
+		Entry("actionerror.PluginSignatureInvalidError -> PluginSignatureInvalidError",
+			actionerror.PluginSignatureInvalidError{Message: "some-message"},
+			PluginSignatureInvalidError{Message: "some-message"}),
+
+		Entry("actionerror.PluginSignatureNotTrustedError -> PluginSignatureNotTrustedError",
+			actionerror.PluginSignatureNotTrustedError{},
+			PluginSignatureNotTrustedError{}),
+
+		Entry("actionerror.PluginVersionNotFoundError -> PluginVersionNotFoundError",
+			actionerror.PluginVersionNotFoundError{PluginName: "some-plugin", Version: "1.2.3"},
+			PluginVersionNotFoundError{PluginName: "some-plugin", Version: "1.2.3"}),
+
 		Entry("actionerror.ProcessInstanceNotFoundError -> ProcessInstanceNotFoundError",
 			actionerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42},
 			ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42}),
@@ -267,6 +307,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.SharedServiceInstanceNotFoundError{},
 			SharedServiceInstanceNotFoundError{}),
 
+		Entry("actionerror.SidecarNotFoundError -> SidecarNotFoundError",
+			actionerror.SidecarNotFoundError{Name: "some-sidecar", AppName: "some-app"},
+			SidecarNotFoundError{Name: "some-sidecar", AppName: "some-app"}),
+
 		Entry("actionerror.SpaceNotFoundError -> SpaceNotFoundError",
 			actionerror.SpaceNotFoundError{Name: "some-space"},
 			SpaceNotFoundError{Name: "some-space"}),
@@ -275,6 +319,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"},
 			StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"}),
 
+		Entry("actionerror.TaskFailedError -> TaskFailedError",
+			actionerror.TaskFailedError{TaskName: "some-task", FailureReason: "Exited with status 1"},
+			TaskFailedError{TaskName: "some-task", FailureReason: "Exited with status 1"}),
+
+		Entry("actionerror.TaskTimeoutError -> TaskTimeoutError",
+			actionerror.TaskTimeoutError{TaskName: "some-task", Timeout: time.Minute},
+			TaskTimeoutError{TaskName: "some-task", Timeout: time.Minute}),
+
 		Entry("actionerror.TaskWorkersUnavailableError -> RunTaskError",
 			actionerror.TaskWorkersUnavailableError{Message: "fooo: Banana Pants"},
 			RunTaskError{Message: "Task workers are unavailable."}),
@@ -375,11 +427,31 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			unprocessableEntityError,
 			unprocessableEntityError),
 
+		Entry("download.ChecksumMismatchError -> ChecksumMismatchError",
+			download.ChecksumMismatchError{Algorithm: "sha256", Expected: "some-digest", Actual: "other-digest"},
+			ChecksumMismatchError{Algorithm: "sha256", Expected: "some-digest", Actual: "other-digest"},
+		),
+
+		Entry("download.ConnectionError -> DownloadConnectionError",
+			download.ConnectionError{URL: "some-url", Err: err},
+			DownloadConnectionError{URL: "some-url", Err: err},
+		),
+
+		Entry("download.InterruptedError -> DownloadInterruptedError",
+			download.InterruptedError{BytesReceived: 42, Err: err},
+			DownloadInterruptedError{BytesReceived: 42, Err: err},
+		),
+
 		Entry("download.RawHTTPStatusError -> HTTPStatusError",
 			download.RawHTTPStatusError{Status: "some status"},
 			HTTPStatusError{Status: "some status"},
 		),
 
+		Entry("download.UnsupportedChecksumAlgorithmError -> UnsupportedChecksumAlgorithmError",
+			download.UnsupportedChecksumAlgorithmError{Algorithm: "md5"},
+			UnsupportedChecksumAlgorithmError{Algorithm: "md5"},
+		),
+
 		Entry("json.SyntaxError -> JSONSyntaxError",
 			jsonErr,
 			JSONSyntaxError{Err: jsonErr},
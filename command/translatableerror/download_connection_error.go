@@ -0,0 +1,17 @@
+package translatableerror
+
+type DownloadConnectionError struct {
+	URL string
+	Err error
+}
+
+func (DownloadConnectionError) Error() string {
+	return "Unable to download {{.URL}}: {{.Error}}\nCheck your network connection and run the command again."
+}
+
+func (e DownloadConnectionError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"URL":   e.URL,
+		"Error": e.Err,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type DownloadInterruptedError struct {
+	BytesReceived int64
+	Err           error
+}
+
+func (DownloadInterruptedError) Error() string {
+	return "Download interrupted after {{.BytesReceived}} bytes: {{.Error}}\nRun the command again to resume the download."
+}
+
+func (e DownloadInterruptedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"BytesReceived": e.BytesReceived,
+		"Error":         e.Err,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type DropletDownloadStatusError struct {
+	Status string
+}
+
+func (DropletDownloadStatusError) Error() string {
+	return "Droplet download failed; server returned {{.Status}}"
+}
+
+func (e DropletDownloadStatusError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Status": e.Status,
+	})
+}
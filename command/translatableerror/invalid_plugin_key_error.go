@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidPluginKeyError is returned when a trusted plugin key is not an
+// ed25519 public key.
+type InvalidPluginKeyError struct {
+	Name    string
+	Message string
+}
+
+func (InvalidPluginKeyError) Error() string {
+	return "Plugin key '{{.Name}}' is invalid: {{.Message}}"
+}
+
+func (e InvalidPluginKeyError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":    e.Name,
+		"Message": e.Message,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidPluginLockFileError is returned when a plugin lock file cannot be
+// parsed.
+type InvalidPluginLockFileError struct {
+	Path    string
+	Message string
+}
+
+func (InvalidPluginLockFileError) Error() string {
+	return "Plugin lock file {{.Path}} is invalid: {{.Message}}"
+}
+
+func (e InvalidPluginLockFileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":    e.Path,
+		"Message": e.Message,
+	})
+}
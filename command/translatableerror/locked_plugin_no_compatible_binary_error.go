@@ -0,0 +1,19 @@
+package translatableerror
+
+// LockedPluginNoCompatibleBinaryError is returned when a plugin lock file
+// does not record a binary of a plugin for the current platform.
+type LockedPluginNoCompatibleBinaryError struct {
+	PluginName string
+	Version    string
+}
+
+func (LockedPluginNoCompatibleBinaryError) Error() string {
+	return "Plugin lock file has no binary of {{.PluginName}} {{.Version}} for your platform."
+}
+
+func (e LockedPluginNoCompatibleBinaryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName": e.PluginName,
+		"Version":    e.Version,
+	})
+}
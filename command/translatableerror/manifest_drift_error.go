@@ -0,0 +1,20 @@
+package translatableerror
+
+// ManifestDriftError is returned by diff-manifest when at least one app of the
+// manifest differs from the app in the space. The command displays the
+// differences itself, so the CLI only exits with status 2.
+type ManifestDriftError struct {
+	DriftedCount int
+	TotalCount   int
+}
+
+func (ManifestDriftError) Error() string {
+	return "{{.DriftedCount}} of {{.TotalCount}} apps differ from the manifest"
+}
+
+func (e ManifestDriftError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"DriftedCount": e.DriftedCount,
+		"TotalCount":   e.TotalCount,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type PluginKeyNotFoundError struct {
+	Name string
+}
+
+func (PluginKeyNotFoundError) Error() string {
+	return "Plugin key {{.Name}} not found.\nUse 'cf plugin-keys' to list trusted keys."
+}
+
+func (e PluginKeyNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+// PluginNotSignedError is returned when installing a plugin without a
+// signature while signatures are required.
+type PluginNotSignedError struct{}
+
+func (PluginNotSignedError) Error() string {
+	return "Plugin binary is not signed and plugin signatures are required.\nProvide a signature with --signature or use 'cf config --plugin-signatures optional' to install unsigned plugins."
+}
+
+func (e PluginNotSignedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// PluginSignatureInvalidError is returned when a plugin signature cannot be
+// parsed.
+type PluginSignatureInvalidError struct {
+	Message string
+}
+
+func (PluginSignatureInvalidError) Error() string {
+	return "Plugin signature is invalid: {{.Message}}"
+}
+
+func (e PluginSignatureInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Message": e.Message,
+	})
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+// PluginSignatureNotTrustedError is returned when a plugin signature cannot be
+// verified with any of the trusted plugin keys.
+type PluginSignatureNotTrustedError struct{}
+
+func (PluginSignatureNotTrustedError) Error() string {
+	return "Plugin binary's signature could not be verified with any trusted plugin key.\nUse 'cf plugin-keys' to list trusted keys."
+}
+
+func (e PluginSignatureNotTrustedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
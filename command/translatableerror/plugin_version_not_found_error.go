@@ -0,0 +1,19 @@
+package translatableerror
+
+// PluginVersionNotFoundError is returned when a requested version of a plugin
+// cannot be found in the plugin repositories.
+type PluginVersionNotFoundError struct {
+	PluginName string
+	Version    string
+}
+
+func (PluginVersionNotFoundError) Error() string {
+	return "Plugin {{.PluginName}} {{.Version}} not found in the plugin repositories."
+}
+
+func (e PluginVersionNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName": e.PluginName,
+		"Version":    e.Version,
+	})
+}
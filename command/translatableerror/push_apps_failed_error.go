@@ -0,0 +1,19 @@
+package translatableerror
+
+// PushAppsFailedError is returned when one or more of the apps pushed with
+// --parallel failed to push or were skipped.
+type PushAppsFailedError struct {
+	FailedCount int
+	TotalCount  int
+}
+
+func (PushAppsFailedError) Error() string {
+	return "{{.FailedCount}} of {{.TotalCount}} apps failed to push"
+}
+
+func (e PushAppsFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FailedCount": e.FailedCount,
+		"TotalCount":  e.TotalCount,
+	})
+}
@@ -0,0 +1,21 @@
+package translatableerror
+
+// SSHCommandFailedOnInstancesError is returned when a command run with cf ssh
+// on several instances fails on at least one of them. ExitStatus is the exit
+// status the CLI exits with.
+type SSHCommandFailedOnInstancesError struct {
+	ExitStatus  int
+	FailedCount int
+	TotalCount  int
+}
+
+func (SSHCommandFailedOnInstancesError) Error() string {
+	return "Command failed on {{.FailedCount}} of {{.TotalCount}} instances"
+}
+
+func (e SSHCommandFailedOnInstancesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FailedCount": e.FailedCount,
+		"TotalCount":  e.TotalCount,
+	})
+}
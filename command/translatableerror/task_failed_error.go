@@ -0,0 +1,20 @@
+package translatableerror
+
+type TaskFailedError struct {
+	TaskName      string
+	FailureReason string
+}
+
+func (e TaskFailedError) Error() string {
+	if e.FailureReason == "" {
+		return "Task {{.TaskName}} failed."
+	}
+	return "Task {{.TaskName}} failed: {{.FailureReason}}"
+}
+
+func (e TaskFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"TaskName":      e.TaskName,
+		"FailureReason": e.FailureReason,
+	})
+}
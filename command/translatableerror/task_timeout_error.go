@@ -0,0 +1,19 @@
+package translatableerror
+
+import "time"
+
+type TaskTimeoutError struct {
+	TaskName string
+	Timeout  time.Duration
+}
+
+func (TaskTimeoutError) Error() string {
+	return "Task {{.TaskName}} did not complete within {{.Timeout}} and has been cancelled."
+}
+
+func (e TaskTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"TaskName": e.TaskName,
+		"Timeout":  e.Timeout.String(),
+	})
+}
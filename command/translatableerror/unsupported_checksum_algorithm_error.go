@@ -0,0 +1,15 @@
+package translatableerror
+
+type UnsupportedChecksumAlgorithmError struct {
+	Algorithm string
+}
+
+func (UnsupportedChecksumAlgorithmError) Error() string {
+	return "Cannot verify the download: unsupported checksum algorithm '{{.Algorithm}}'."
+}
+
+func (e UnsupportedChecksumAlgorithmError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Algorithm": e.Algorithm,
+	})
+}
@@ -0,0 +1,245 @@
+package v7
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+)
+
+type AnalyzeSecurityGroupsCommand struct {
+	BaseCommand
+
+	usage           interface{} `usage:"CF_NAME analyze-security-groups"`
+	relatedCommands interface{} `related_commands:"bind-security-group, security-group, security-groups"`
+}
+
+func (AnalyzeSecurityGroupsCommand) SupportsStructuredOutput() {}
+
+func (cmd AnalyzeSecurityGroupsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Analyzing security groups as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	analysis, warnings, err := cmd.Actor.AnalyzeSecurityGroups()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructured(newSecurityGroupAnalysisDocument(analysis))
+	}
+
+	cmd.displayFindings(analysis.Findings)
+	cmd.UI.DisplayNewline()
+	cmd.displaySpaceRules(analysis.SpaceRules)
+
+	return nil
+}
+
+func (cmd AnalyzeSecurityGroupsCommand) displayFindings(findings []v7action.SecurityGroupFinding) {
+	if len(findings) == 0 {
+		cmd.UI.DisplayText("No problems found.")
+		return
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("security group"),
+		cmd.UI.TranslateText("finding"),
+		cmd.UI.TranslateText("rule"),
+		cmd.UI.TranslateText("lifecycle"),
+		cmd.UI.TranslateText("details"),
+	}}
+	for _, finding := range findings {
+		row := []string{
+			finding.SecurityGroupName,
+			cmd.UI.TranslateText(strings.ReplaceAll(finding.Type, "-", " ")),
+			"",
+			strings.Join(finding.Lifecycles, ", "),
+			"",
+		}
+		if finding.Type != v7action.SecurityGroupUnbound {
+			row[2] = describeSecurityGroupRule(finding.Rule)
+		}
+		if finding.Type == v7action.SecurityGroupShadowedRule {
+			row[4] = cmd.UI.TranslateText("by {{.SecurityGroupName}} in {{.Spaces}}", map[string]interface{}{
+				"SecurityGroupName": finding.ShadowedBy,
+				"Spaces":            describeSecurityGroupSpaces(finding.Spaces),
+			})
+		}
+		table = append(table, row)
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd AnalyzeSecurityGroupsCommand) displaySpaceRules(spaceRules []v7action.SpaceSecurityGroupRules) {
+	if len(spaceRules) == 0 {
+		cmd.UI.DisplayText("No egress rules in effect.")
+		return
+	}
+
+	cmd.UI.DisplayText("Effective egress rules:")
+	table := [][]string{{
+		cmd.UI.TranslateText("organization"),
+		cmd.UI.TranslateText("space"),
+		cmd.UI.TranslateText("lifecycle"),
+		cmd.UI.TranslateText("protocol"),
+		cmd.UI.TranslateText("destination"),
+		cmd.UI.TranslateText("ports"),
+		cmd.UI.TranslateText("security groups"),
+	}}
+	for _, space := range spaceRules {
+		for _, effectiveRule := range space.Rules {
+			table = append(table, []string{
+				space.OrgName,
+				space.SpaceName,
+				space.Lifecycle,
+				effectiveRule.Rule.Protocol,
+				effectiveRule.Rule.Destination,
+				securityGroupRulePorts(effectiveRule.Rule),
+				strings.Join(effectiveRule.SecurityGroupNames, ", "),
+			})
+		}
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+// describeSecurityGroupRule formats a rule on one line, such as
+// "tcp 10.0.0.0/8 443".
+func describeSecurityGroupRule(rule resources.Rule) string {
+	parts := []string{rule.Protocol, rule.Destination}
+	if ports := securityGroupRulePorts(rule); ports != "" {
+		parts = append(parts, ports)
+	}
+	return strings.Join(parts, " ")
+}
+
+// securityGroupRulePorts returns the ports of a tcp or udp rule, or the type
+// and code of an icmp rule.
+func securityGroupRulePorts(rule resources.Rule) string {
+	switch {
+	case rule.Ports != nil:
+		return *rule.Ports
+	case rule.Type != nil && rule.Code != nil:
+		return fmt.Sprintf("type %d code %d", *rule.Type, *rule.Code)
+	case rule.Type != nil:
+		return fmt.Sprintf("type %d", *rule.Type)
+	default:
+		return ""
+	}
+}
+
+func describeSecurityGroupSpaces(spaces []v7action.SecurityGroupSpace) string {
+	var names []string
+	for _, space := range spaces {
+		if space.OrgName == "<all>" {
+			names = append(names, "all spaces")
+			continue
+		}
+		names = append(names, space.OrgName+" / "+space.SpaceName)
+	}
+	return strings.Join(names, ", ")
+}
+
+type securityGroupAnalysisDocument struct {
+	Findings []securityGroupFindingDocument    `json:"findings" yaml:"findings"`
+	Spaces   []securityGroupSpaceRulesDocument `json:"spaces" yaml:"spaces"`
+}
+
+type securityGroupFindingDocument struct {
+	SecurityGroup string                       `json:"security_group" yaml:"security_group"`
+	Type          string                       `json:"type" yaml:"type"`
+	Rule          *securityGroupRuleDocument   `json:"rule,omitempty" yaml:"rule,omitempty"`
+	Lifecycles    []string                     `json:"lifecycles" yaml:"lifecycles"`
+	ShadowedBy    string                       `json:"shadowed_by,omitempty" yaml:"shadowed_by,omitempty"`
+	Spaces        []securityGroupSpaceDocument `json:"spaces,omitempty" yaml:"spaces,omitempty"`
+}
+
+type securityGroupSpaceRulesDocument struct {
+	Org       string                      `json:"org" yaml:"org"`
+	Space     string                      `json:"space" yaml:"space"`
+	Lifecycle string                      `json:"lifecycle" yaml:"lifecycle"`
+	Rules     []securityGroupRuleDocument `json:"rules" yaml:"rules"`
+}
+
+type securityGroupRuleDocument struct {
+	Protocol       string   `json:"protocol" yaml:"protocol"`
+	Destination    string   `json:"destination" yaml:"destination"`
+	Ports          string   `json:"ports,omitempty" yaml:"ports,omitempty"`
+	Type           *int     `json:"type,omitempty" yaml:"type,omitempty"`
+	Code           *int     `json:"code,omitempty" yaml:"code,omitempty"`
+	SecurityGroups []string `json:"security_groups,omitempty" yaml:"security_groups,omitempty"`
+}
+
+func newSecurityGroupRuleDocument(rule resources.Rule, securityGroupNames []string) securityGroupRuleDocument {
+	document := securityGroupRuleDocument{
+		Protocol:       rule.Protocol,
+		Destination:    rule.Destination,
+		Type:           rule.Type,
+		Code:           rule.Code,
+		SecurityGroups: securityGroupNames,
+	}
+	if rule.Ports != nil {
+		document.Ports = *rule.Ports
+	}
+	return document
+}
+
+func newSecurityGroupAnalysisDocument(analysis v7action.SecurityGroupAnalysis) securityGroupAnalysisDocument {
+	document := securityGroupAnalysisDocument{
+		Findings: []securityGroupFindingDocument{},
+		Spaces:   []securityGroupSpaceRulesDocument{},
+	}
+
+	for _, finding := range analysis.Findings {
+		findingDocument := securityGroupFindingDocument{
+			SecurityGroup: finding.SecurityGroupName,
+			Type:          finding.Type,
+			Lifecycles:    append([]string{}, finding.Lifecycles...),
+			ShadowedBy:    finding.ShadowedBy,
+		}
+		if finding.Type != v7action.SecurityGroupUnbound {
+			rule := newSecurityGroupRuleDocument(finding.Rule, nil)
+			findingDocument.Rule = &rule
+		}
+		for _, space := range finding.Spaces {
+			findingDocument.Spaces = append(findingDocument.Spaces, securityGroupSpaceDocument{
+				Org:       space.OrgName,
+				Space:     space.SpaceName,
+				Lifecycle: space.Lifecycle,
+			})
+		}
+		document.Findings = append(document.Findings, findingDocument)
+	}
+
+	for _, space := range analysis.SpaceRules {
+		spaceDocument := securityGroupSpaceRulesDocument{
+			Org:       space.OrgName,
+			Space:     space.SpaceName,
+			Lifecycle: space.Lifecycle,
+			Rules:     []securityGroupRuleDocument{},
+		}
+		for _, effectiveRule := range space.Rules {
+			spaceDocument.Rules = append(spaceDocument.Rules, newSecurityGroupRuleDocument(effectiveRule.Rule, effectiveRule.SecurityGroupNames))
+		}
+		document.Spaces = append(document.Spaces, spaceDocument)
+	}
+
+	return document
+}
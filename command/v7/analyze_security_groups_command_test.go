@@ -0,0 +1,175 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	. "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("analyze-security-groups Command", func() {
+	var (
+		cmd             AnalyzeSecurityGroupsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	ports := func(value string) *string { return &value }
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = AnalyzeSecurityGroupsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.AnalyzeSecurityGroupsReturns(
+			v7action.SecurityGroupAnalysis{
+				Findings: []v7action.SecurityGroupFinding{
+					{
+						Type:              v7action.SecurityGroupAllDestinations,
+						SecurityGroupName: "public",
+						Rule:              resources.Rule{Protocol: "tcp", Destination: "0.0.0.0/0", Ports: ports("443")},
+						Lifecycles:        []string{"running", "staging"},
+					},
+					{
+						Type:              v7action.SecurityGroupShadowedRule,
+						SecurityGroupName: "web-db",
+						Rule:              resources.Rule{Protocol: "tcp", Destination: "10.1.0.1", Ports: ports("5432")},
+						Lifecycles:        []string{"running"},
+						ShadowedBy:        "internal",
+						Spaces: []v7action.SecurityGroupSpace{
+							{OrgName: "shop", SpaceName: "web", Lifecycle: "running"},
+							{OrgName: "shop", SpaceName: "api", Lifecycle: "running"},
+						},
+					},
+					{Type: v7action.SecurityGroupUnbound, SecurityGroupName: "orphan"},
+				},
+				SpaceRules: []v7action.SpaceSecurityGroupRules{
+					{
+						SecurityGroupSpace: v7action.SecurityGroupSpace{OrgName: "<all>", SpaceName: "<all>", Lifecycle: "running"},
+						Rules: []v7action.SecurityGroupEffectiveRule{
+							{Rule: resources.Rule{Protocol: "tcp", Destination: "0.0.0.0/0", Ports: ports("443")}, SecurityGroupNames: []string{"public", "public-copy"}},
+						},
+					},
+				},
+			},
+			v7action.Warnings{"analyze-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "binaryName"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "binaryName"}))
+
+			targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrganizationRequired).To(BeFalse())
+			Expect(targetedSpaceRequired).To(BeFalse())
+			Expect(fakeActor.AnalyzeSecurityGroupsCallCount()).To(Equal(0))
+		})
+	})
+
+	It("displays the findings and the effective egress rules", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(testUI.Err).To(Say("analyze-warning"))
+
+		Expect(testUI.Out).To(Say("Analyzing security groups as some-user..."))
+		Expect(testUI.Out).To(Say(`security group\s+finding\s+rule\s+lifecycle\s+details`))
+		Expect(testUI.Out).To(Say(`public\s+all destinations\s+tcp 0\.0\.0\.0/0 443\s+running, staging`))
+		Expect(testUI.Out).To(Say(`web-db\s+shadowed\s+tcp 10\.1\.0\.1 5432\s+running\s+by internal in shop / web, shop / api`))
+		Expect(testUI.Out).To(Say(`orphan\s+unbound`))
+		Expect(testUI.Out).To(Say("Effective egress rules:"))
+		Expect(testUI.Out).To(Say(`organization\s+space\s+lifecycle\s+protocol\s+destination\s+ports\s+security groups`))
+		Expect(testUI.Out).To(Say(`<all>\s+<all>\s+running\s+tcp\s+0\.0\.0\.0/0\s+443\s+public, public-copy`))
+	})
+
+	When("there is nothing to report", func() {
+		BeforeEach(func() {
+			fakeActor.AnalyzeSecurityGroupsReturns(v7action.SecurityGroupAnalysis{}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say("No problems found."))
+			Expect(testUI.Out).To(Say("No egress rules in effect."))
+		})
+	})
+
+	When("a structured output format is set", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+		})
+
+		It("outputs the analysis as a document", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).NotTo(Say("Analyzing security groups"))
+			Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+				"findings": [
+					{"security_group": "public", "type": "all-destinations", "rule": {"protocol": "tcp", "destination": "0.0.0.0/0", "ports": "443"}, "lifecycles": ["running", "staging"]},
+					{
+						"security_group": "web-db",
+						"type": "shadowed",
+						"rule": {"protocol": "tcp", "destination": "10.1.0.1", "ports": "5432"},
+						"lifecycles": ["running"],
+						"shadowed_by": "internal",
+						"spaces": [
+							{"org": "shop", "space": "web", "lifecycle": "running"},
+							{"org": "shop", "space": "api", "lifecycle": "running"}
+						]
+					},
+					{"security_group": "orphan", "type": "unbound", "lifecycles": []}
+				],
+				"spaces": [
+					{
+						"org": "<all>",
+						"space": "<all>",
+						"lifecycle": "running",
+						"rules": [{"protocol": "tcp", "destination": "0.0.0.0/0", "ports": "443", "security_groups": ["public", "public-copy"]}]
+					}
+				]
+			}`))
+		})
+	})
+
+	When("the analysis fails", func() {
+		BeforeEach(func() {
+			fakeActor.AnalyzeSecurityGroupsReturns(v7action.SecurityGroupAnalysis{}, v7action.Warnings{"analyze-warning"}, errors.New("analyze-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("analyze-error"))
+			Expect(testUI.Err).To(Say("analyze-warning"))
+		})
+	})
+})
@@ -238,4 +238,63 @@ var _ = Describe("app Command", func() {
 			})
 		})
 	})
+
+	When("a structured output format is set", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+			fakeActor.GetDetailedAppSummaryReturns(v7action.DetailedApplicationSummary{
+				ApplicationSummary: v7action.ApplicationSummary{
+					Application: resources.Application{
+						GUID:          "some-app-guid",
+						Name:          "some-app",
+						State:         constant.ApplicationStarted,
+						LifecycleType: constant.AppLifecycleTypeBuildpack,
+					},
+					ProcessSummaries: v7action.ProcessSummaries{
+						{
+							Process: resources.Process{
+								Type:       constant.ProcessTypeWeb,
+								MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
+							},
+							Sidecars: []resources.Sidecar{
+								{Name: "authenticator", MemoryInMB: types.NullUint64{Value: 8, IsSet: true}},
+								{Name: "clock"},
+							},
+						},
+					},
+				},
+				CurrentDroplet: resources.Droplet{
+					Stack:     "cflinuxfs4",
+					CreatedAt: "2018-10-22T19:29:36Z",
+					Buildpacks: []resources.DropletBuildpack{
+						{Name: "ruby_buildpack", BuildpackName: "ruby", Version: "1.2.3", DetectOutput: "ruby"},
+					},
+				},
+			}, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("outputs the app as a document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).NotTo(Say("Showing health and status"))
+			Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+				"name": "some-app",
+				"guid": "some-app-guid",
+				"requested_state": "started",
+				"routes": [],
+				"last_uploaded": "2018-10-22T19:29:36Z",
+				"stack": "cflinuxfs4",
+				"buildpacks": [{"name": "ruby_buildpack", "buildpack_name": "ruby", "version": "1.2.3", "detect_output": "ruby"}],
+				"processes": [{
+					"type": "web",
+					"sidecars": ["authenticator", "clock"],
+					"sidecar_details": [{"name": "authenticator", "memory_in_mb": 8}, {"name": "clock"}],
+					"instances": 0,
+					"running_instances": 0,
+					"memory_in_mb": 32
+				}]
+			}`))
+			Expect(testUI.Err).To(Say(`{"warning":"warning-1"}`))
+		})
+	})
 })
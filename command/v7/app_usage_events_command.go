@@ -0,0 +1,162 @@
+package v7
+
+import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+)
+
+type AppUsageEventsCommand struct {
+	BaseCommand
+
+	Start           flag.TimeOrDuration `long:"start" description:"Only list events created at or after this time, given as an RFC 3339 timestamp or a duration before now such as 24h"`
+	End             flag.TimeOrDuration `long:"end" description:"Only list events created before this time, given as an RFC 3339 timestamp or a duration before now such as 1h"`
+	States          []string            `long:"state" description:"Only list events in this state: STARTED, STOPPED, BUILDPACK_SET, TASK_STARTED or TASK_STOPPED; can specify multiple times"`
+	CSV             bool                `long:"csv" description:"Export the events as CSV instead of displaying a table. All other output is suppressed."`
+	usage           interface{}         `usage:"CF_NAME app-usage-events [--start TIME] [--end TIME] [--state STATE]... [--csv]"`
+	relatedCommands interface{}         `related_commands:"service-usage-events, usage-report"`
+}
+
+type exportedAppUsageEvent struct {
+	GUID                          string `json:"guid" yaml:"guid"`
+	CreatedAt                     string `json:"created_at" yaml:"created_at"`
+	State                         string `json:"state" yaml:"state"`
+	PreviousState                 string `json:"previous_state" yaml:"previous_state"`
+	OrganizationGUID              string `json:"organization_guid" yaml:"organization_guid"`
+	SpaceGUID                     string `json:"space_guid" yaml:"space_guid"`
+	SpaceName                     string `json:"space_name" yaml:"space_name"`
+	AppGUID                       string `json:"app_guid" yaml:"app_guid"`
+	AppName                       string `json:"app_name" yaml:"app_name"`
+	ProcessGUID                   string `json:"process_guid" yaml:"process_guid"`
+	ProcessType                   string `json:"process_type" yaml:"process_type"`
+	TaskGUID                      string `json:"task_guid" yaml:"task_guid"`
+	TaskName                      string `json:"task_name" yaml:"task_name"`
+	BuildpackName                 string `json:"buildpack_name" yaml:"buildpack_name"`
+	InstanceCount                 int    `json:"instance_count" yaml:"instance_count"`
+	PreviousInstanceCount         int    `json:"previous_instance_count" yaml:"previous_instance_count"`
+	MemoryInMBPerInstance         int    `json:"memory_in_mb_per_instance" yaml:"memory_in_mb_per_instance"`
+	PreviousMemoryInMBPerInstance int    `json:"previous_memory_in_mb_per_instance" yaml:"previous_memory_in_mb_per_instance"`
+}
+
+var exportedAppUsageEventHeader = []string{
+	"guid", "created_at", "state", "previous_state", "organization_guid", "space_guid", "space_name",
+	"app_guid", "app_name", "process_guid", "process_type", "task_guid", "task_name", "buildpack_name",
+	"instance_count", "previous_instance_count", "memory_in_mb_per_instance", "previous_memory_in_mb_per_instance",
+}
+
+func (e exportedAppUsageEvent) row() []string {
+	return []string{
+		e.GUID, e.CreatedAt, e.State, e.PreviousState, e.OrganizationGUID, e.SpaceGUID, e.SpaceName,
+		e.AppGUID, e.AppName, e.ProcessGUID, e.ProcessType, e.TaskGUID, e.TaskName, e.BuildpackName,
+		strconv.Itoa(e.InstanceCount), strconv.Itoa(e.PreviousInstanceCount),
+		strconv.Itoa(e.MemoryInMBPerInstance), strconv.Itoa(e.PreviousMemoryInMBPerInstance),
+	}
+}
+
+func (AppUsageEventsCommand) SupportsStructuredOutput() {}
+
+func (cmd AppUsageEventsCommand) Execute(args []string) error {
+	if cmd.CSV && cmd.UI.IsStructuredOutput() {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--csv", "--output-format"},
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.CSV && !cmd.UI.IsStructuredOutput() {
+		user, err := cmd.Actor.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Getting app usage events as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	events, warnings, err := cmd.Actor.GetAppUsageEvents(v7action.UsageEventFilter{
+		Start:  cmd.Start.Resolve(time.Now()),
+		End:    cmd.End.Resolve(time.Now()),
+		States: cmd.States,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.CSV || cmd.UI.IsStructuredOutput() {
+		exported := []exportedAppUsageEvent{}
+		rows := [][]string{exportedAppUsageEventHeader}
+		for _, event := range events {
+			e := exportedAppUsageEvent{
+				GUID:                          event.GUID,
+				CreatedAt:                     event.CreatedAt.UTC().Format(time.RFC3339),
+				State:                         event.State,
+				PreviousState:                 event.PreviousState,
+				OrganizationGUID:              event.OrganizationGUID,
+				SpaceGUID:                     event.SpaceGUID,
+				SpaceName:                     event.SpaceName,
+				AppGUID:                       event.AppGUID,
+				AppName:                       event.AppName,
+				ProcessGUID:                   event.ProcessGUID,
+				ProcessType:                   event.ProcessType,
+				TaskGUID:                      event.TaskGUID,
+				TaskName:                      event.TaskName,
+				BuildpackName:                 event.BuildpackName,
+				InstanceCount:                 event.InstanceCount,
+				PreviousInstanceCount:         event.PreviousInstanceCount,
+				MemoryInMBPerInstance:         event.MemoryInMBPerInstance,
+				PreviousMemoryInMBPerInstance: event.PreviousMemoryInMBPerInstance,
+			}
+			exported = append(exported, e)
+			rows = append(rows, e.row())
+		}
+		return exportUsage(cmd.UI, cmd.CSV, rows, exported)
+	}
+
+	if len(events) == 0 {
+		cmd.UI.DisplayText("No app usage events found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("state"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("process or task"),
+			cmd.UI.TranslateText("instances"),
+			cmd.UI.TranslateText("memory per instance"),
+		},
+	}
+
+	for _, event := range events {
+		processOrTask := event.ProcessType
+		if event.TaskName != "" {
+			processOrTask = event.TaskName
+		}
+
+		table = append(table, []string{
+			event.CreatedAt.Local().Format("2006-01-02T15:04:05.00-0700"),
+			event.State,
+			event.SpaceName,
+			event.AppName,
+			processOrTask,
+			strconv.Itoa(event.InstanceCount),
+			strconv.Itoa(event.MemoryInMBPerInstance) + "M",
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
@@ -0,0 +1,196 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	. "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("app-usage-events Command", func() {
+	var (
+		cmd             AppUsageEventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+		createdAt       time.Time
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = AppUsageEventsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				Actor:       fakeActor,
+				SharedActor: fakeSharedActor,
+			},
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		createdAt = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		fakeActor.GetAppUsageEventsReturns(
+			[]resources.AppUsageEvent{
+				{
+					GUID:                  "event-guid-1",
+					CreatedAt:             createdAt,
+					State:                 "STARTED",
+					PreviousState:         "STOPPED",
+					OrganizationGUID:      "org-guid",
+					SpaceGUID:             "space-guid",
+					SpaceName:             "some-space",
+					AppGUID:               "app-guid",
+					AppName:               "some-app",
+					ProcessGUID:           "process-guid",
+					ProcessType:           "web",
+					InstanceCount:         2,
+					MemoryInMBPerInstance: 256,
+				},
+				{
+					GUID:                  "event-guid-2",
+					CreatedAt:             createdAt.Add(time.Hour),
+					State:                 "TASK_STARTED",
+					SpaceName:             "some-space",
+					AppName:               "some-app",
+					TaskGUID:              "task-guid",
+					TaskName:              "migrate",
+					InstanceCount:         1,
+					MemoryInMBPerInstance: 128,
+				},
+			},
+			v7action.Warnings{"warning-1", "warning-2"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	It("passes the time window and states to the actor", func() {
+		Expect(fakeActor.GetAppUsageEventsCallCount()).To(Equal(1))
+		Expect(fakeActor.GetAppUsageEventsArgsForCall(0)).To(Equal(v7action.UsageEventFilter{}))
+	})
+
+	When("a time window and states are given", func() {
+		BeforeEach(func() {
+			cmd.Start = flag.TimeOrDuration{Time: createdAt, IsSet: true}
+			cmd.End = flag.TimeOrDuration{Duration: time.Hour, IsSet: true}
+			cmd.States = []string{"STARTED", "STOPPED"}
+		})
+
+		It("resolves the window and passes it to the actor", func() {
+			filter := fakeActor.GetAppUsageEventsArgsForCall(0)
+			Expect(filter.Start).To(Equal(createdAt))
+			Expect(filter.End).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Minute))
+			Expect(filter.States).To(Equal([]string{"STARTED", "STOPPED"}))
+		})
+	})
+
+	It("displays the events and warnings", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting app usage events as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`time\s+state\s+space\s+app\s+process or task\s+instances\s+memory per instance`))
+		Expect(testUI.Out).To(Say(`STARTED\s+some-space\s+some-app\s+web\s+2\s+256M`))
+		Expect(testUI.Out).To(Say(`TASK_STARTED\s+some-space\s+some-app\s+migrate\s+1\s+128M`))
+
+		Expect(testUI.Err).To(Say("warning-1"))
+		Expect(testUI.Err).To(Say("warning-2"))
+	})
+
+	When("there are no events", func() {
+		BeforeEach(func() {
+			fakeActor.GetAppUsageEventsReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No app usage events found\.`))
+		})
+	})
+
+	When("getting the events fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetAppUsageEventsReturns(nil, v7action.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	When("exporting as CSV", func() {
+		BeforeEach(func() {
+			cmd.CSV = true
+		})
+
+		It("writes only the header and one row per event", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetCurrentUserCallCount()).To(Equal(0))
+			Expect(testUI.Out).ToNot(Say("Getting app usage events"))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(
+				"guid,created_at,state,previous_state,organization_guid,space_guid,space_name,app_guid,app_name,process_guid,process_type,task_guid,task_name,buildpack_name,instance_count,previous_instance_count,memory_in_mb_per_instance,previous_memory_in_mb_per_instance\n" +
+					"event-guid-1,2024-03-01T12:00:00Z,STARTED,STOPPED,org-guid,space-guid,some-space,app-guid,some-app,process-guid,web,,,,2,0,256,0\n" +
+					"event-guid-2,2024-03-01T13:00:00Z,TASK_STARTED,,,,some-space,,some-app,,,task-guid,migrate,,1,0,128,0\n",
+			))
+		})
+	})
+
+	When("the output format is json", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+		})
+
+		It("writes the events as a JSON array", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Getting app usage events"))
+			Expect(testUI.Out).To(Say(`"guid": "event-guid-1"`))
+			Expect(testUI.Out).To(Say(`"created_at": "2024-03-01T12:00:00Z"`))
+			Expect(testUI.Out).To(Say(`"instance_count": 2`))
+			Expect(testUI.Out).To(Say(`"guid": "event-guid-2"`))
+			Expect(testUI.Out).To(Say(`"task_name": "migrate"`))
+		})
+	})
+})
@@ -3,6 +3,7 @@ package v7
 import (
 	"strings"
 
+	"code.cloudfoundry.org/cli/v9/command/v7/shared"
 	"code.cloudfoundry.org/cli/v9/resources"
 	"code.cloudfoundry.org/cli/v9/util/ui"
 )
@@ -17,6 +18,8 @@ type AppsCommand struct {
 	OmitStats bool   `long:"no-stats" description:"Do not retrieve process stats"`
 }
 
+func (AppsCommand) SupportsStructuredOutput() {}
+
 func (cmd AppsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -28,12 +31,14 @@ func (cmd AppsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	summaries, warnings, err := cmd.Actor.GetAppSummariesForSpace(cmd.Config.TargetedSpace().GUID, cmd.Labels, cmd.OmitStats)
 	cmd.UI.DisplayWarnings(warnings)
@@ -41,6 +46,14 @@ func (cmd AppsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		documents := []shared.AppSummaryDocument{}
+		for _, summary := range summaries {
+			documents = append(documents, shared.NewAppSummaryDocument(summary))
+		}
+		return cmd.UI.DisplayStructured(documents)
+	}
+
 	if len(summaries) == 0 {
 		cmd.UI.DisplayText("No apps found")
 		return nil
@@ -346,4 +346,37 @@ var _ = Describe("apps Command", func() {
 			Expect(omitStats).To(Equal(true))
 		})
 	})
+
+	When("a structured output format is set", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+			fakeActor.GetAppSummariesForSpaceReturns([]v7action.ApplicationSummary{
+				{
+					Application: resources.Application{
+						GUID:  "app-guid-1",
+						Name:  "some-app-1",
+						State: constant.ApplicationStarted,
+					},
+					ProcessSummaries: []v7action.ProcessSummary{
+						{Process: resources.Process{Type: constant.ProcessTypeWeb}},
+					},
+					Routes: []resources.Route{{URL: "some-app-1.some-domain"}},
+				},
+			}, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("outputs the apps as a document and warnings as objects", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).NotTo(Say("Getting apps"))
+			Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`[{
+				"name": "some-app-1",
+				"guid": "app-guid-1",
+				"requested_state": "started",
+				"routes": ["some-app-1.some-domain"],
+				"processes": [{"type": "web", "sidecars": [], "sidecar_details": [], "instances": 0, "running_instances": 0, "memory_in_mb": 0}]
+			}]`))
+			Expect(testUI.Err).To(Say(`{"warning":"warning-1"}`))
+		})
+	})
 })
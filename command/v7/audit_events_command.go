@@ -0,0 +1,289 @@
+package v7
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+)
+
+type AuditEventsCommand struct {
+	BaseCommand
+
+	Org             string              `short:"o" long:"org" description:"List events in this org"`
+	Space           string              `short:"s" long:"space" description:"List events in this space of the targeted org, or of the org given with -o"`
+	AllOrgs         bool                `long:"all" description:"List events across all orgs the user can see"`
+	Types           []string            `long:"type" description:"Only list events of this type, such as audit.app.update; can specify multiple times"`
+	Actors          []string            `long:"actor" description:"Only list events performed by this user or client, given as a name or GUID; can specify multiple times"`
+	TargetTypes     []string            `long:"target-type" description:"Only list events whose target is of this type, such as app or service_instance; can specify multiple times"`
+	TargetGUIDs     []string            `long:"target-guid" description:"Only list events whose target has this GUID; can specify multiple times"`
+	Start           flag.TimeOrDuration `long:"start" description:"Only list events created at or after this time, given as an RFC 3339 timestamp or a duration before now such as 24h"`
+	End             flag.TimeOrDuration `long:"end" description:"Only list events created before this time, given as an RFC 3339 timestamp or a duration before now such as 1h"`
+	Follow          bool                `long:"follow" description:"Keep polling for new events until interrupted"`
+	usage           interface{}         `usage:"List audit events in the targeted space, in an org or across all orgs, oldest first.\n\nCF_NAME audit-events [-o ORG] [-s SPACE | --all] [--type TYPE]... [--actor ACTOR]...\n   [--target-type TYPE]... [--target-guid GUID]... [--start TIME] [--end TIME] [--follow]\n\nEXAMPLES:\n   CF_NAME audit-events --all --type audit.user.organization_manager_add --start 168h\n   CF_NAME audit-events -o my-org --actor alice --follow --output-format json"`
+	relatedCommands interface{}         `related_commands:"events, app-usage-events"`
+}
+
+type auditEventDocument struct {
+	GUID             string                 `json:"guid" yaml:"guid"`
+	CreatedAt        string                 `json:"created_at" yaml:"created_at"`
+	Type             string                 `json:"type" yaml:"type"`
+	ActorGUID        string                 `json:"actor_guid" yaml:"actor_guid"`
+	ActorType        string                 `json:"actor_type" yaml:"actor_type"`
+	ActorName        string                 `json:"actor_name" yaml:"actor_name"`
+	TargetGUID       string                 `json:"target_guid" yaml:"target_guid"`
+	TargetType       string                 `json:"target_type" yaml:"target_type"`
+	TargetName       string                 `json:"target_name" yaml:"target_name"`
+	SpaceGUID        string                 `json:"space_guid,omitempty" yaml:"space_guid,omitempty"`
+	OrganizationGUID string                 `json:"organization_guid,omitempty" yaml:"organization_guid,omitempty"`
+	Data             map[string]interface{} `json:"data" yaml:"data"`
+}
+
+func (AuditEventsCommand) SupportsStructuredOutput() {}
+
+func (cmd AuditEventsCommand) Execute(args []string) error {
+	if cmd.AllOrgs && (cmd.Org != "" || cmd.Space != "") {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--all", "--org, -o", "--space, -s"},
+		}
+	}
+
+	if cmd.Follow && cmd.End.IsSet {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--follow", "--end"},
+		}
+	}
+
+	filter := v7action.AuditEventFilter{
+		Types:       cmd.Types,
+		Actors:      cmd.Actors,
+		TargetTypes: cmd.TargetTypes,
+		TargetGUIDs: cmd.TargetGUIDs,
+		Start:       cmd.Start.Resolve(time.Now()),
+		End:         cmd.End.Resolve(time.Now()),
+	}
+
+	scope, err := cmd.scope(&filter)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.UI.IsStructuredOutput() {
+		user, err := cmd.Actor.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Getting audit events "+scope+" as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.orgName(),
+			"SpaceName": cmd.spaceName(),
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	events, warnings, err := cmd.Actor.GetAuditEvents(filter)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.UI.IsStructuredOutput() {
+		err = cmd.displayStructured(events)
+		if err != nil {
+			return err
+		}
+	} else if len(events) == 0 && !cmd.Follow {
+		cmd.UI.DisplayText("No events found.")
+	} else {
+		cmd.UI.DisplayTableWithHeader("", cmd.eventsTable(events, true), ui.DefaultTableSpacePadding)
+	}
+
+	if !cmd.Follow {
+		return nil
+	}
+
+	return cmd.follow(filter, events)
+}
+
+// scope restricts the filter to the requested org or space and returns the
+// flavor text template describing it.
+func (cmd AuditEventsCommand) scope(filter *v7action.AuditEventFilter) (string, error) {
+	switch {
+	case cmd.AllOrgs:
+		err := cmd.SharedActor.CheckTarget(false, false)
+		return "across all orgs", err
+	case cmd.Org != "":
+		err := cmd.SharedActor.CheckTarget(false, false)
+		if err != nil {
+			return "", err
+		}
+
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Org)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return "", err
+		}
+
+		if cmd.Space == "" {
+			filter.OrganizationGUIDs = []string{org.GUID}
+			return "in org {{.OrgName}}", nil
+		}
+
+		space, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(cmd.Space, org.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return "", err
+		}
+		filter.SpaceGUIDs = []string{space.GUID}
+		return "in org {{.OrgName}} / space {{.SpaceName}}", nil
+	case cmd.Space != "":
+		err := cmd.SharedActor.CheckTarget(true, false)
+		if err != nil {
+			return "", err
+		}
+
+		space, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(cmd.Space, cmd.Config.TargetedOrganization().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return "", err
+		}
+		filter.SpaceGUIDs = []string{space.GUID}
+		return "in org {{.OrgName}} / space {{.SpaceName}}", nil
+	default:
+		err := cmd.SharedActor.CheckTarget(true, true)
+		if err != nil {
+			return "", err
+		}
+
+		filter.SpaceGUIDs = []string{cmd.Config.TargetedSpace().GUID}
+		return "in org {{.OrgName}} / space {{.SpaceName}}", nil
+	}
+}
+
+func (cmd AuditEventsCommand) orgName() string {
+	if cmd.Org != "" {
+		return cmd.Org
+	}
+	return cmd.Config.TargetedOrganization().Name
+}
+
+func (cmd AuditEventsCommand) spaceName() string {
+	if cmd.Space != "" {
+		return cmd.Space
+	}
+	return cmd.Config.TargetedSpace().Name
+}
+
+// follow polls for events created since the newest event seen so far. Events
+// sharing the newest timestamp are remembered so that they are not shown
+// twice.
+func (cmd AuditEventsCommand) follow(filter v7action.AuditEventFilter, events []v7action.Event) error {
+	since := time.Now()
+	seen := map[string]bool{}
+	if len(events) > 0 {
+		since, seen = newestEvents(events)
+	}
+
+	for {
+		time.Sleep(cmd.Config.PollingInterval())
+
+		filter.Start = since
+		events, warnings, err := cmd.Actor.GetAuditEvents(filter)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		var newEvents []v7action.Event
+		for _, event := range events {
+			if !seen[event.GUID] {
+				newEvents = append(newEvents, event)
+			}
+		}
+		if len(newEvents) == 0 {
+			continue
+		}
+
+		if cmd.UI.IsStructuredOutput() {
+			err = cmd.displayStructured(newEvents)
+			if err != nil {
+				return err
+			}
+		} else {
+			cmd.UI.DisplayNonWrappingTable("", cmd.eventsTable(newEvents, false), ui.DefaultTableSpacePadding)
+		}
+
+		since, seen = newestEvents(events)
+	}
+}
+
+func newestEvents(events []v7action.Event) (time.Time, map[string]bool) {
+	newest := events[len(events)-1].Time
+	seen := map[string]bool{}
+	for _, event := range events {
+		if event.Time.Equal(newest) {
+			seen[event.GUID] = true
+		}
+	}
+	return newest, seen
+}
+
+func (cmd AuditEventsCommand) eventsTable(events []v7action.Event, withHeader bool) [][]string {
+	var table [][]string
+	if withHeader {
+		table = append(table, []string{
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("event"),
+			cmd.UI.TranslateText("actor"),
+			cmd.UI.TranslateText("target type"),
+			cmd.UI.TranslateText("target"),
+			cmd.UI.TranslateText("description"),
+		})
+	}
+
+	for _, event := range events {
+		target := event.TargetName
+		if target == "" {
+			target = event.TargetGUID
+		}
+
+		table = append(table, []string{
+			event.Time.Local().Format("2006-01-02T15:04:05.00-0700"),
+			event.Type,
+			event.ActorName,
+			event.TargetType,
+			target,
+			event.Description,
+		})
+	}
+
+	return table
+}
+
+// displayStructured renders the events as a list document. When following,
+// every poll that finds new events renders another list, so the output is a
+// stream of JSON arrays or a single continued YAML sequence.
+func (cmd AuditEventsCommand) displayStructured(events []v7action.Event) error {
+	documents := make([]auditEventDocument, 0, len(events))
+	for _, event := range events {
+		documents = append(documents, auditEventDocument{
+			GUID:             event.GUID,
+			CreatedAt:        event.Time.UTC().Format(time.RFC3339),
+			Type:             event.Type,
+			ActorGUID:        event.ActorGUID,
+			ActorType:        event.ActorType,
+			ActorName:        event.ActorName,
+			TargetGUID:       event.TargetGUID,
+			TargetType:       event.TargetType,
+			TargetName:       event.TargetName,
+			SpaceGUID:        event.SpaceGUID,
+			OrganizationGUID: event.OrganizationGUID,
+			Data:             event.Data,
+		})
+	}
+
+	return cmd.UI.DisplayStructured(documents)
+}
@@ -0,0 +1,320 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	. "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("audit-events Command", func() {
+	var (
+		cmd             AuditEventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+		eventTime       time.Time
+		events          []v7action.Event
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+		cmd = AuditEventsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				Actor:       fakeActor,
+				SharedActor: fakeSharedActor,
+			},
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		eventTime = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		events = []v7action.Event{
+			{
+				GUID:        "event-1",
+				Time:        eventTime,
+				Type:        "audit.app.update",
+				ActorName:   "alice",
+				TargetType:  "app",
+				TargetGUID:  "app-guid",
+				TargetName:  "some-app",
+				SpaceGUID:   "some-space-guid",
+				Description: "instances: 2",
+				Data:        map[string]interface{}{"request": map[string]interface{}{"instances": float64(2)}},
+			},
+			{
+				GUID:       "event-2",
+				Time:       eventTime.Add(time.Minute),
+				Type:       "audit.route.delete-request",
+				ActorName:  "bob",
+				TargetType: "route",
+				TargetGUID: "route-guid",
+			},
+		}
+		fakeActor.GetAuditEventsReturns(events, v7action.Warnings{"warning-1"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("lists the events in the targeted space", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkTargetedOrg).To(BeTrue())
+		Expect(checkTargetedSpace).To(BeTrue())
+
+		Expect(fakeActor.GetAuditEventsCallCount()).To(Equal(1))
+		Expect(fakeActor.GetAuditEventsArgsForCall(0)).To(Equal(v7action.AuditEventFilter{
+			SpaceGUIDs: []string{"some-space-guid"},
+		}))
+
+		Expect(testUI.Out).To(Say(`Getting audit events in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+target type\s+target\s+description`))
+		Expect(testUI.Out).To(Say(`audit\.app\.update\s+alice\s+app\s+some-app\s+instances: 2`))
+		Expect(testUI.Out).To(Say(`audit\.route\.delete-request\s+bob\s+route\s+route-guid`))
+		Expect(testUI.Err).To(Say("warning-1"))
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoSpaceTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoSpaceTargetedError{BinaryName: binaryName}))
+			Expect(fakeActor.GetAuditEventsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("filters are given", func() {
+		BeforeEach(func() {
+			cmd.Types = []string{"audit.app.update"}
+			cmd.Actors = []string{"alice"}
+			cmd.TargetTypes = []string{"app"}
+			cmd.TargetGUIDs = []string{"app-guid"}
+			cmd.Start = flag.TimeOrDuration{Time: eventTime, IsSet: true}
+			cmd.End = flag.TimeOrDuration{Time: eventTime.Add(time.Hour), IsSet: true}
+		})
+
+		It("passes them to the actor", func() {
+			Expect(fakeActor.GetAuditEventsArgsForCall(0)).To(Equal(v7action.AuditEventFilter{
+				SpaceGUIDs:  []string{"some-space-guid"},
+				Types:       []string{"audit.app.update"},
+				Actors:      []string{"alice"},
+				TargetTypes: []string{"app"},
+				TargetGUIDs: []string{"app-guid"},
+				Start:       eventTime,
+				End:         eventTime.Add(time.Hour),
+			}))
+		})
+	})
+
+	When("an org is given", func() {
+		BeforeEach(func() {
+			cmd.Org = "other-org"
+			fakeActor.GetOrganizationByNameReturns(resources.Organization{GUID: "other-org-guid"}, v7action.Warnings{"org-warning"}, nil)
+		})
+
+		It("lists the events in that org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("other-org"))
+			Expect(fakeActor.GetAuditEventsArgsForCall(0)).To(Equal(v7action.AuditEventFilter{
+				OrganizationGUIDs: []string{"other-org-guid"},
+			}))
+			Expect(testUI.Out).To(Say(`Getting audit events in org other-org as steve\.\.\.`))
+			Expect(testUI.Err).To(Say("org-warning"))
+		})
+
+		When("a space is given too", func() {
+			BeforeEach(func() {
+				cmd.Space = "other-space"
+				fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{GUID: "other-space-guid"}, nil, nil)
+			})
+
+			It("lists the events in that space", func() {
+				spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+				Expect(spaceName).To(Equal("other-space"))
+				Expect(orgGUID).To(Equal("other-org-guid"))
+				Expect(fakeActor.GetAuditEventsArgsForCall(0)).To(Equal(v7action.AuditEventFilter{
+					SpaceGUIDs: []string{"other-space-guid"},
+				}))
+				Expect(testUI.Out).To(Say(`Getting audit events in org other-org / space other-space as steve\.\.\.`))
+			})
+		})
+
+		When("the org does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationByNameReturns(resources.Organization{}, nil, actionerror.OrganizationNotFoundError{Name: "other-org"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "other-org"}))
+				Expect(fakeActor.GetAuditEventsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("a space in the targeted org is given", func() {
+		BeforeEach(func() {
+			cmd.Space = "other-space"
+			fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{GUID: "other-space-guid"}, nil, nil)
+		})
+
+		It("lists the events in that space", func() {
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			_, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(testUI.Out).To(Say(`Getting audit events in org some-org / space other-space as steve\.\.\.`))
+		})
+	})
+
+	When("--all is given", func() {
+		BeforeEach(func() {
+			cmd.AllOrgs = true
+		})
+
+		It("lists the events across all orgs", func() {
+			Expect(fakeActor.GetAuditEventsArgsForCall(0)).To(Equal(v7action.AuditEventFilter{}))
+			Expect(testUI.Out).To(Say(`Getting audit events across all orgs as steve\.\.\.`))
+		})
+
+		When("an org is given too", func() {
+			BeforeEach(func() {
+				cmd.Org = "some-org"
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--all", "--org, -o", "--space, -s"},
+				}))
+			})
+		})
+	})
+
+	When("there are no events", func() {
+		BeforeEach(func() {
+			fakeActor.GetAuditEventsReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No events found\.`))
+		})
+	})
+
+	When("getting the events fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetAuditEventsReturns(nil, v7action.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	When("the output format is json", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+		})
+
+		It("displays the events as a JSON list and nothing else", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetCurrentUserCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say(`^\[\n  \{\n    "guid": "event-1",\n    "created_at": "2024-03-01T12:00:00Z",\n    "type": "audit.app.update",`))
+			Expect(testUI.Out).To(Say(`"space_guid": "some-space-guid",\n    "data": \{\n      "request": \{\n        "instances": 2\n      \}\n    \}\n  \},`))
+			Expect(testUI.Out).To(Say(`"guid": "event-2",`))
+			Expect(testUI.Out).To(Say(`"data": null\n  \}\n\]\n`))
+		})
+	})
+
+	When("the output format is yaml", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatYAML)
+		})
+
+		It("displays the events as a YAML list and nothing else", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetCurrentUserCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say(`^- guid: event-1\n  created_at: "2024-03-01T12:00:00Z"\n  type: audit.app.update\n`))
+			Expect(testUI.Out).To(Say(`- guid: event-2\n`))
+		})
+	})
+
+	When("--follow is given", func() {
+		BeforeEach(func() {
+			cmd.Follow = true
+
+			newEvent := v7action.Event{GUID: "event-3", Time: eventTime.Add(2 * time.Minute), Type: "audit.app.start", ActorName: "carol"}
+			fakeActor.GetAuditEventsReturnsOnCall(0, events, nil, nil)
+			fakeActor.GetAuditEventsReturnsOnCall(1, []v7action.Event{events[1]}, nil, nil)
+			fakeActor.GetAuditEventsReturnsOnCall(2, []v7action.Event{events[1], newEvent}, nil, nil)
+			fakeActor.GetAuditEventsReturnsOnCall(3, nil, v7action.Warnings{"poll-warning"}, errors.New("poll-error"))
+		})
+
+		It("polls for events newer than the last one shown until polling fails", func() {
+			Expect(executeErr).To(MatchError("poll-error"))
+			Expect(fakeActor.GetAuditEventsCallCount()).To(Equal(4))
+
+			Expect(fakeActor.GetAuditEventsArgsForCall(1).Start).To(Equal(eventTime.Add(time.Minute)))
+			Expect(fakeActor.GetAuditEventsArgsForCall(2).Start).To(Equal(eventTime.Add(time.Minute)))
+			Expect(fakeActor.GetAuditEventsArgsForCall(3).Start).To(Equal(eventTime.Add(2 * time.Minute)))
+
+			Expect(testUI.Out).To(Say(`audit\.app\.update`))
+			Expect(testUI.Out).To(Say(`audit\.route\.delete-request`))
+			Expect(testUI.Out).To(Say(`audit\.app\.start\s+carol`))
+			Expect(testUI.Out).ToNot(Say(`audit\.route\.delete-request`))
+			Expect(testUI.Err).To(Say("poll-warning"))
+		})
+
+		When("--end is given too", func() {
+			BeforeEach(func() {
+				cmd.End = flag.TimeOrDuration{Duration: time.Hour, IsSet: true}
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--follow", "--end"},
+				}))
+			})
+		})
+	})
+})
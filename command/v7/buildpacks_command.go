@@ -18,6 +18,8 @@ type BuildpacksCommand struct {
 	Lifecycle       string      `long:"lifecycle" description:"Filter buildpacks by lifecycle ('buildpack' or 'cnb')"`
 }
 
+func (BuildpacksCommand) SupportsStructuredOutput() {}
+
 func (cmd BuildpacksCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -36,10 +38,12 @@ func (cmd BuildpacksCommand) Execute(args []string) error {
 		}
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting buildpacks as {{.Username}}...", map[string]interface{}{
-		"Username": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting buildpacks as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	buildpacks, warnings, err := cmd.Actor.GetBuildpacks(cmd.Labels, cmd.Lifecycle)
 	cmd.UI.DisplayWarnings(warnings)
@@ -47,6 +51,10 @@ func (cmd BuildpacksCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructured(newBuildpackDocuments(buildpacks))
+	}
+
 	if len(buildpacks) == 0 {
 		cmd.UI.DisplayTextWithFlavor("No buildpacks found")
 	} else {
@@ -77,3 +85,33 @@ func (cmd BuildpacksCommand) displayTable(buildpacks []resources.Buildpack) {
 		cmd.UI.DisplayTableWithHeader("", keyValueTable, ui.DefaultTableSpacePadding)
 	}
 }
+
+type buildpackDocument struct {
+	Position  int    `json:"position" yaml:"position"`
+	Name      string `json:"name" yaml:"name"`
+	GUID      string `json:"guid" yaml:"guid"`
+	Stack     string `json:"stack" yaml:"stack"`
+	Enabled   bool   `json:"enabled" yaml:"enabled"`
+	Locked    bool   `json:"locked" yaml:"locked"`
+	State     string `json:"state" yaml:"state"`
+	Filename  string `json:"filename" yaml:"filename"`
+	Lifecycle string `json:"lifecycle" yaml:"lifecycle"`
+}
+
+func newBuildpackDocuments(buildpacks []resources.Buildpack) []buildpackDocument {
+	documents := []buildpackDocument{}
+	for _, buildpack := range buildpacks {
+		documents = append(documents, buildpackDocument{
+			Position:  buildpack.Position.Value,
+			Name:      buildpack.Name,
+			GUID:      buildpack.GUID,
+			Stack:     buildpack.Stack,
+			Enabled:   buildpack.Enabled.Value,
+			Locked:    buildpack.Locked.Value,
+			State:     buildpack.State,
+			Filename:  buildpack.Filename,
+			Lifecycle: buildpack.Lifecycle,
+		})
+	}
+	return documents
+}
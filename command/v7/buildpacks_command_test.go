@@ -191,4 +191,40 @@ var _ = Describe("buildpacks Command", func() {
 			})
 		})
 	})
+
+	When("a structured output format is set", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+			fakeActor.GetBuildpacksReturns([]resources.Buildpack{
+				{
+					Name:      "buildpack-1",
+					GUID:      "buildpack-guid-1",
+					Position:  types.NullInt{Value: 1, IsSet: true},
+					Enabled:   types.NullBool{Value: true, IsSet: true},
+					Locked:    types.NullBool{Value: false, IsSet: true},
+					Stack:     "cflinuxfs4",
+					State:     "READY",
+					Filename:  "buildpack-1.file",
+					Lifecycle: "buildpack",
+				},
+			}, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("outputs the buildpacks as a document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).NotTo(Say("Getting buildpacks"))
+			Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`[{
+				"position": 1,
+				"name": "buildpack-1",
+				"guid": "buildpack-guid-1",
+				"stack": "cflinuxfs4",
+				"enabled": true,
+				"locked": false,
+				"state": "READY",
+				"filename": "buildpack-1.file",
+				"lifecycle": "buildpack"
+			}]`))
+		})
+	})
 })
@@ -7,13 +7,15 @@ import (
 )
 
 type ConfigCommand struct {
-	UI           command.UI
-	Config       command.Config
-	AsyncTimeout flag.Timeout      `long:"async-timeout" description:"Timeout in minutes for async HTTP requests"`
-	Color        flag.Color        `long:"color" description:"Enable or disable color in CLI output"`
-	Locale       flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
-	Trace        flag.PathWithBool `long:"trace" description:"Trace HTTP requests by default. If a file path is provided then output will write to the file provided. If the file does not exist it will be created."`
-	usage        interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"`
+	UI               command.UI
+	Config           command.Config
+	AsyncTimeout     flag.Timeout               `long:"async-timeout" description:"Timeout in minutes for async HTTP requests"`
+	Color            flag.Color                 `long:"color" description:"Enable or disable color in CLI output"`
+	CredentialHelper string                     `long:"credential-helper" description:"Store access and refresh tokens with the cf-credential-HELPER executable instead of in config.json. If HELPER is 'CLEAR', tokens are stored in config.json again."`
+	Locale           flag.Locale                `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
+	PluginSignatures flag.PluginSignaturePolicy `long:"plugin-signatures" description:"Refuse to install plugins that are not signed by a trusted plugin key, or only verify the plugins that are signed"`
+	Trace            flag.PathWithBool          `long:"trace" description:"Trace HTTP requests by default. If a file path is provided then output will write to the file provided. If the file does not exist it will be created."`
+	usage            interface{}                `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)] [--credential-helper (HELPER | CLEAR)] [--plugin-signatures (required | optional)]"`
 }
 
 func (cmd *ConfigCommand) Setup(config command.Config, ui command.UI) error {
@@ -24,7 +26,7 @@ func (cmd *ConfigCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd ConfigCommand) Execute(args []string) error {
-	if !cmd.Color.IsSet && cmd.Trace == "" && cmd.Locale.Locale == "" && !cmd.AsyncTimeout.IsSet {
+	if !cmd.Color.IsSet && cmd.Trace == "" && cmd.Locale.Locale == "" && !cmd.AsyncTimeout.IsSet && cmd.CredentialHelper == "" && cmd.PluginSignatures == "" {
 		return translatableerror.IncorrectUsageError{Message: "at least one flag must be provided"}
 	}
 
@@ -38,10 +40,26 @@ func (cmd ConfigCommand) Execute(args []string) error {
 		cmd.Config.SetColorEnabled(cmd.Color.Value)
 	}
 
+	if cmd.CredentialHelper != "" {
+		helper := cmd.CredentialHelper
+		if helper == "CLEAR" {
+			helper = ""
+		}
+
+		err := cmd.Config.SetCredentialHelper(helper)
+		if err != nil {
+			return err
+		}
+	}
+
 	if cmd.Locale.Locale != "" {
 		cmd.Config.SetLocale(cmd.Locale.Locale)
 	}
 
+	if cmd.PluginSignatures != "" {
+		cmd.Config.SetPluginSignaturePolicy(string(cmd.PluginSignatures))
+	}
+
 	if cmd.Trace != "" {
 		cmd.Config.SetTrace(string(cmd.Trace))
 	}
@@ -1,6 +1,8 @@
 package v7_test
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/cli/v9/command/commandfakes"
 	"code.cloudfoundry.org/cli/v9/command/flag"
 	"code.cloudfoundry.org/cli/v9/command/translatableerror"
@@ -68,6 +70,42 @@ var _ = Describe("ConfigCommand", func() {
 		})
 	})
 
+	When("using the credential helper flag", func() {
+		BeforeEach(func() {
+			cmd.CredentialHelper = "vault"
+		})
+
+		It("successfully updates the config", func() {
+			Expect(executeErr).To(Not(HaveOccurred()))
+			Expect(fakeConfig.SetCredentialHelperCallCount()).To(Equal(1))
+			value := fakeConfig.SetCredentialHelperArgsForCall(0)
+			Expect(value).To(Equal("vault"))
+		})
+
+		When("the value is CLEAR", func() {
+			BeforeEach(func() {
+				cmd.CredentialHelper = "CLEAR"
+			})
+
+			It("unsets the credential helper", func() {
+				Expect(executeErr).To(Not(HaveOccurred()))
+				Expect(fakeConfig.SetCredentialHelperCallCount()).To(Equal(1))
+				value := fakeConfig.SetCredentialHelperArgsForCall(0)
+				Expect(value).To(BeEmpty())
+			})
+		})
+
+		When("the credential helper fails", func() {
+			BeforeEach(func() {
+				fakeConfig.SetCredentialHelperReturns(errors.New("helper failed"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("helper failed"))
+			})
+		})
+	})
+
 	When("using the locale flag", func() {
 		BeforeEach(func() {
 			cmd.Locale = flag.Locale{Locale: "en-US"}
@@ -81,6 +119,19 @@ var _ = Describe("ConfigCommand", func() {
 		})
 	})
 
+	When("using the plugin signatures flag", func() {
+		BeforeEach(func() {
+			cmd.PluginSignatures = "required"
+		})
+
+		It("successfully updates the config", func() {
+			Expect(executeErr).To(Not(HaveOccurred()))
+			Expect(fakeConfig.SetPluginSignaturePolicyCallCount()).To(Equal(1))
+			value := fakeConfig.SetPluginSignaturePolicyArgsForCall(0)
+			Expect(value).To(Equal("required"))
+		})
+	})
+
 	When("using the trace flag", func() {
 		BeforeEach(func() {
 			cmd.Trace = "my-trace-file"
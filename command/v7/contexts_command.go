@@ -0,0 +1,85 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+)
+
+type ContextsCommand struct {
+	UI              command.UI
+	Config          command.Config
+	usage           interface{} `usage:"CF_NAME contexts"`
+	relatedCommands interface{} `related_commands:"create-context, delete-context, rename-context, switch-context"`
+}
+
+func (cmd *ContextsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (ContextsCommand) SupportsStructuredOutput() {}
+
+func (cmd ContextsCommand) Execute(args []string) error {
+	contexts := cmd.Config.Contexts()
+	currentContext := cmd.Config.CurrentContextName()
+
+	if cmd.UI.IsStructuredOutput() {
+		documents := []contextDocument{}
+		for _, context := range contexts {
+			documents = append(documents, contextDocument{
+				Name:         context.Name,
+				Current:      context.Name == currentContext,
+				API:          context.Target,
+				Organization: context.TargetedOrganization.Name,
+				Space:        context.TargetedSpace.Name,
+			})
+		}
+		return cmd.UI.DisplayStructured(documents)
+	}
+
+	cmd.UI.DisplayText("Getting contexts...")
+	cmd.UI.DisplayNewline()
+
+	if len(contexts) == 0 {
+		cmd.UI.DisplayText("No contexts found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("api endpoint"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+		},
+	}
+
+	for _, context := range contexts {
+		var current string
+		if context.Name == currentContext {
+			current = "*"
+		}
+		table = append(table, []string{
+			current,
+			context.Name,
+			context.Target,
+			context.TargetedOrganization.Name,
+			context.TargetedSpace.Name,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+type contextDocument struct {
+	Name         string `json:"name" yaml:"name"`
+	Current      bool   `json:"current" yaml:"current"`
+	API          string `json:"api_endpoint" yaml:"api_endpoint"`
+	Organization string `json:"org" yaml:"org"`
+	Space        string `json:"space" yaml:"space"`
+}
@@ -0,0 +1,83 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	v7 "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("contexts Command", func() {
+	var (
+		cmd        v7.ContextsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = v7.ContextsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("there are no contexts", func() {
+		It("displays that no contexts were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting contexts..."))
+			Expect(testUI.Out).To(Say("No contexts found."))
+		})
+	})
+
+	When("there are contexts", func() {
+		BeforeEach(func() {
+			fakeConfig.ContextsReturns([]configv3.TargetContext{
+				{
+					Name:                 "default",
+					Target:               "https://api.foo.com",
+					TargetedOrganization: configv3.Organization{Name: "foo-org"},
+					TargetedSpace:        configv3.Space{Name: "foo-space"},
+				},
+				{
+					Name:   "staging",
+					Target: "https://api.bar.com",
+				},
+			})
+			fakeConfig.CurrentContextNameReturns("staging")
+		})
+
+		It("displays the contexts, marking the current one", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting contexts..."))
+			Expect(testUI.Out).To(Say(`name\s+api endpoint\s+org\s+space`))
+			Expect(testUI.Out).To(Say(`default\s+https://api.foo.com\s+foo-org\s+foo-space`))
+			Expect(testUI.Out).To(Say(`\*\s+staging\s+https://api.bar.com`))
+		})
+
+		When("a structured output format is set", func() {
+			BeforeEach(func() {
+				testUI.SetOutputFormat(configv3.OutputFormatJSON)
+			})
+
+			It("displays the contexts as a JSON document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Getting contexts..."))
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+					{"name": "default", "current": false, "api_endpoint": "https://api.foo.com", "org": "foo-org", "space": "foo-space"},
+					{"name": "staging", "current": true, "api_endpoint": "https://api.bar.com", "org": "", "space": ""}
+				]`))
+			})
+		})
+	})
+})
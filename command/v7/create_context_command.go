@@ -0,0 +1,43 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+)
+
+type CreateContextCommand struct {
+	UI              command.UI
+	Config          command.Config
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME create-context CONTEXT_NAME\n\nEXAMPLES:\n   CF_NAME create-context staging\n   CF_NAME switch-context staging\n   CF_NAME api https://api.staging.example.com"`
+	relatedCommands interface{}      `related_commands:"api, contexts, login, switch-context"`
+}
+
+func (cmd *CreateContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd CreateContextCommand) Execute(args []string) error {
+	contextName := cmd.RequiredArgs.Name
+
+	cmd.UI.DisplayText("Creating context {{.ContextName}}...", map[string]interface{}{
+		"ContextName": contextName,
+	})
+
+	if cmd.Config.HasContext(contextName) {
+		return translatableerror.ContextAlreadyExistsError{Name: contextName}
+	}
+
+	cmd.Config.AddContext(contextName)
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use '{{.Command}}' to start using this context.", map[string]interface{}{
+		"Command": cmd.Config.BinaryName() + " switch-context " + contextName,
+	})
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-context Command", func() {
+	var (
+		cmd        v7.CreateContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		cmd = v7.CreateContextCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			RequiredArgs: flag.ContextName{Name: "staging"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("creates the context", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(fakeConfig.AddContextCallCount()).To(Equal(1))
+		Expect(fakeConfig.AddContextArgsForCall(0)).To(Equal("staging"))
+
+		Expect(testUI.Out).To(Say("Creating context staging..."))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say("TIP: Use 'faceman switch-context staging' to start using this context."))
+	})
+
+	When("the context already exists", func() {
+		BeforeEach(func() {
+			fakeConfig.HasContextReturns(true)
+		})
+
+		It("returns a ContextAlreadyExistsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ContextAlreadyExistsError{Name: "staging"}))
+			Expect(fakeConfig.AddContextCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,66 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/types"
+)
+
+type CreateSidecarCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppSidecar `positional-args:"yes"`
+	Command         string          `short:"c" required:"true" description:"Command the sidecar runs"`
+	MemoryLimit     flag.Megabytes  `short:"m" description:"Memory reserved for the sidecar out of the process memory (e.g. 64M, 1G). Shares the process memory when omitted"`
+	ProcessTypes    []string        `long:"process" description:"App process the sidecar runs alongside; may be specified multiple times (Default: web)"`
+	relatedCommands interface{}     `related_commands:"delete-sidecar, sidecars, update-sidecar"`
+}
+
+func (cmd CreateSidecarCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating sidecar {{.SidecarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"SidecarName": cmd.RequiredArgs.Sidecar,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+
+	processTypes := cmd.ProcessTypes
+	if len(processTypes) == 0 {
+		processTypes = []string{"web"}
+	}
+
+	_, warnings, err := cmd.Actor.CreateApplicationSidecar(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, resources.Sidecar{
+		Name:         cmd.RequiredArgs.Sidecar,
+		Command:      types.FilteredString{IsSet: true, Value: cmd.Command},
+		ProcessTypes: processTypes,
+		MemoryInMB:   cmd.MemoryLimit.NullUint64,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Restart the app for the sidecar to start running.")
+
+	return nil
+}
+
+func (cmd CreateSidecarCommand) Usage() string {
+	return `CF_NAME create-sidecar APP_NAME SIDECAR_NAME -c COMMAND [--process PROCESS]... [-m MEMORY]`
+}
+
+func (cmd CreateSidecarCommand) Examples() string {
+	return `CF_NAME create-sidecar my-app apm -c "./apm-agent" -m 64M
+CF_NAME create-sidecar my-app envoy -c "/etc/envoy/start" --process web --process worker`
+}
@@ -0,0 +1,113 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	v7 "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/types"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-sidecar Command", func() {
+	var (
+		cmd             v7.CreateSidecarCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.CreateSidecarCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.AppSidecar{AppName: "some-app", Sidecar: "apm"},
+			Command:      "./apm-agent",
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CreateApplicationSidecarReturns(resources.Sidecar{}, v7action.Warnings{"some-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.CreateApplicationSidecarCallCount()).To(Equal(0))
+		})
+	})
+
+	It("creates the sidecar for the web process", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Creating sidecar apm for app some-app in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Err).To(Say("some-warning"))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say("TIP: Restart the app for the sidecar to start running."))
+
+		appName, spaceGUID, sidecar := fakeActor.CreateApplicationSidecarArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(sidecar).To(Equal(resources.Sidecar{
+			Name:         "apm",
+			Command:      types.FilteredString{IsSet: true, Value: "./apm-agent"},
+			ProcessTypes: []string{"web"},
+		}))
+	})
+
+	When("process types and memory are provided", func() {
+		BeforeEach(func() {
+			cmd.ProcessTypes = []string{"web", "worker"}
+			cmd.MemoryLimit = flag.Megabytes{NullUint64: types.NullUint64{IsSet: true, Value: 64}}
+		})
+
+		It("creates the sidecar with them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, _, sidecar := fakeActor.CreateApplicationSidecarArgsForCall(0)
+			Expect(sidecar.ProcessTypes).To(Equal([]string{"web", "worker"}))
+			Expect(sidecar.MemoryInMB).To(Equal(types.NullUint64{IsSet: true, Value: 64}))
+		})
+	})
+
+	When("creating the sidecar fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateApplicationSidecarReturns(resources.Sidecar{}, v7action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
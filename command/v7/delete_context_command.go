@@ -0,0 +1,69 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+)
+
+type DeleteContextCommand struct {
+	UI              command.UI
+	Config          command.Config
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	Force           bool             `long:"force" short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}      `usage:"CF_NAME delete-context CONTEXT_NAME [-f]"`
+	relatedCommands interface{}      `related_commands:"contexts, switch-context"`
+}
+
+func (cmd *DeleteContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd DeleteContextCommand) Execute(args []string) error {
+	contextName := cmd.RequiredArgs.Name
+
+	if !cmd.Force {
+		confirmedDelete, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the context {{.ContextName}}?", map[string]interface{}{
+			"ContextName": contextName,
+		})
+
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !confirmedDelete {
+			cmd.UI.DisplayText("Context '{{.ContextName}}' has not been deleted.", map[string]interface{}{
+				"ContextName": contextName,
+			})
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayText("Deleting context {{.ContextName}}...", map[string]interface{}{
+		"ContextName": contextName,
+	})
+
+	if !cmd.Config.HasContext(contextName) {
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayWarning("Context '{{.ContextName}}' does not exist.", map[string]interface{}{
+			"ContextName": contextName,
+		})
+		return nil
+	}
+
+	if contextName == cmd.Config.CurrentContextName() {
+		return translatableerror.CannotDeleteCurrentContextError{Name: contextName}
+	}
+
+	err := cmd.Config.RemoveContext(contextName)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-context Command", func() {
+	var (
+		cmd        v7.DeleteContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		input      *Buffer
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.HasContextReturns(true)
+		fakeConfig.CurrentContextNameReturns("default")
+
+		cmd = v7.DeleteContextCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			RequiredArgs: flag.ContextName{Name: "staging"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the user confirms the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes the context", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Really delete the context staging\?`))
+			Expect(testUI.Out).To(Say("Deleting context staging..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeConfig.RemoveContextCallCount()).To(Equal(1))
+			Expect(fakeConfig.RemoveContextArgsForCall(0)).To(Equal("staging"))
+		})
+	})
+
+	When("the user declines the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not delete the context", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Context 'staging' has not been deleted."))
+			Expect(fakeConfig.RemoveContextCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the force flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("deletes the context without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Really delete"))
+			Expect(fakeConfig.RemoveContextCallCount()).To(Equal(1))
+		})
+
+		When("the context does not exist", func() {
+			BeforeEach(func() {
+				fakeConfig.HasContextReturns(false)
+			})
+
+			It("displays a warning and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("Context 'staging' does not exist."))
+				Expect(fakeConfig.RemoveContextCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the context is the current context", func() {
+			BeforeEach(func() {
+				fakeConfig.CurrentContextNameReturns("staging")
+			})
+
+			It("returns a CannotDeleteCurrentContextError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.CannotDeleteCurrentContextError{Name: "staging"}))
+				Expect(fakeConfig.RemoveContextCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+)
+
+type DeleteSidecarCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppSidecar `positional-args:"yes"`
+	Force           bool            `short:"f" description:"Force deletion without confirmation"`
+	relatedCommands interface{}     `related_commands:"create-sidecar, sidecars"`
+}
+
+func (cmd DeleteSidecarCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		delete, err := cmd.UI.DisplayBoolPrompt(false, "Really delete the sidecar {{.SidecarName}} of app {{.AppName}}?", cmd.names())
+		if err != nil {
+			return err
+		}
+
+		if !delete {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	names := cmd.names()
+	names["OrgName"] = cmd.Config.TargetedOrganization().Name
+	names["SpaceName"] = cmd.Config.TargetedSpace().Name
+	names["Username"] = user.Name
+	cmd.UI.DisplayTextWithFlavor("Deleting sidecar {{.SidecarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", names)
+
+	warnings, err := cmd.Actor.DeleteApplicationSidecar(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.RequiredArgs.Sidecar,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+	case actionerror.SidecarNotFoundError:
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Sidecar {{.SidecarName}} does not exist for app {{.AppName}}.", cmd.names())
+	default:
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd DeleteSidecarCommand) Usage() string {
+	return `CF_NAME delete-sidecar APP_NAME SIDECAR_NAME [-f]`
+}
+
+func (cmd DeleteSidecarCommand) Examples() string {
+	return `CF_NAME delete-sidecar my-app apm`
+}
+
+func (cmd DeleteSidecarCommand) names() map[string]interface{} {
+	return map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"SidecarName": cmd.RequiredArgs.Sidecar,
+	}
+}
@@ -0,0 +1,130 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	v7 "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-sidecar Command", func() {
+	var (
+		cmd             v7.DeleteSidecarCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.DeleteSidecarCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.AppSidecar{AppName: "some-app", Sidecar: "apm"},
+			Force:        true,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.DeleteApplicationSidecarReturns(v7action.Warnings{"some-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("deletes the sidecar", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Deleting sidecar apm for app some-app in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Err).To(Say("some-warning"))
+		Expect(testUI.Out).To(Say("OK"))
+
+		appName, spaceGUID, sidecarName := fakeActor.DeleteApplicationSidecarArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(sidecarName).To(Equal("apm"))
+	})
+
+	Describe("prompting the user", func() {
+		BeforeEach(func() {
+			cmd.Force = false
+		})
+
+		It("prompts the user", func() {
+			Expect(testUI.Out).To(Say(`Really delete the sidecar apm of app some-app\? \[yN\]:`))
+		})
+
+		When("the user says no", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("cancels the delete", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("Delete cancelled\n"))
+				Expect(fakeActor.DeleteApplicationSidecarCallCount()).To(BeZero())
+			})
+		})
+
+		When("the user says yes", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("deletes the sidecar", func() {
+				Expect(fakeActor.DeleteApplicationSidecarCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	When("the sidecar does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.DeleteApplicationSidecarReturns(
+				v7action.Warnings{"some-warning"},
+				actionerror.SidecarNotFoundError{Name: "apm", AppName: "some-app"},
+			)
+		})
+
+		It("succeeds with a message", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Sidecar apm does not exist for app some-app\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("deleting the sidecar fails", func() {
+		BeforeEach(func() {
+			fakeActor.DeleteApplicationSidecarReturns(v7action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
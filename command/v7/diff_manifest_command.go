@@ -0,0 +1,251 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	"code.cloudfoundry.org/cli/v9/command/v7/shared"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/util/manifestparser"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"gopkg.in/yaml.v2"
+)
+
+type DiffManifestCommand struct {
+	BaseCommand
+
+	PathToManifest   flag.ManifestPathWithExistenceCheck `short:"f" description:"Path to app manifest"`
+	RedactEnv        bool                                `long:"redact-env" description:"Do not print values for environment vars set in the application manifest"`
+	Vars             []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	PathsToOpsFiles  []flag.PathWithExistenceCheck       `long:"ops-file" description:"Path to an operations file applied to the manifest after variable substitution; can specify multiple times"`
+	usage            interface{}                         `usage:"CF_NAME diff-manifest [-f APP_MANIFEST_PATH] [--redact-env]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]... [--ops-file OPS_FILE_PATH]...\n\n   Exits with status 0 if every app in the space matches the manifest, 2 if at least one app differs and 1 on errors."`
+	relatedCommands  interface{}                         `related_commands:"apply-manifest, create-app-manifest, push, validate-manifest"`
+
+	ManifestLocator ManifestLocator
+	ManifestParser  ManifestParser
+
+	DiffDisplayer DiffDisplayer
+	CWD           string
+}
+
+// appManifestDiff holds the differences between one app of the manifest and
+// the app in the space. The paths of the diffs are relative to the app.
+type appManifestDiff struct {
+	Name   string           `json:"name" yaml:"name"`
+	InSync bool             `json:"in_sync" yaml:"in_sync"`
+	Diffs  []resources.Diff `json:"diff" yaml:"diff"`
+}
+
+type spaceManifestDiff struct {
+	InSync       bool              `json:"in_sync" yaml:"in_sync"`
+	Applications []appManifestDiff `json:"applications" yaml:"applications"`
+}
+
+func (cmd *DiffManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.ManifestLocator = manifestparser.NewLocator()
+	cmd.ManifestParser = manifestparser.ManifestParser{}
+	cmd.DiffDisplayer = &shared.ManifestDiffDisplayer{
+		UI:        ui,
+		RedactEnv: cmd.RedactEnv,
+	}
+
+	currentDir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	cmd.CWD = currentDir
+
+	return cmd.BaseCommand.Setup(config, ui)
+}
+
+func (DiffManifestCommand) SupportsStructuredOutput() {}
+
+func (cmd DiffManifestCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	readPath := cmd.CWD
+	if cmd.PathToManifest != "" {
+		readPath = string(cmd.PathToManifest)
+	}
+
+	pathToManifest, exists, err := cmd.ManifestLocator.Path(readPath)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: readPath}
+	}
+
+	if !cmd.UI.IsStructuredOutput() {
+		user, err := cmd.Actor.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Comparing manifest {{.ManifestPath}} with apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"ManifestPath": pathToManifest,
+			"OrgName":      cmd.Config.TargetedOrganization().Name,
+			"SpaceName":    cmd.Config.TargetedSpace().Name,
+			"Username":     user.Name,
+		})
+	}
+
+	var pathsToVarsFiles []string
+	for _, varFilePath := range cmd.PathsToVarsFiles {
+		pathsToVarsFiles = append(pathsToVarsFiles, string(varFilePath))
+	}
+
+	var pathsToOpsFiles []string
+	for _, opsFilePath := range cmd.PathsToOpsFiles {
+		pathsToOpsFiles = append(pathsToOpsFiles, string(opsFilePath))
+	}
+
+	rawManifest, err := cmd.ManifestParser.InterpolateManifest(pathToManifest, pathsToVarsFiles, cmd.Vars, pathsToOpsFiles)
+	if err != nil {
+		if _, ok := err.(*yaml.TypeError); ok {
+			return fmt.Errorf("Unable to compare manifest because %s is not valid yaml.", pathToManifest)
+		}
+		return err
+	}
+
+	manifest, err := cmd.ManifestParser.ParseManifest(pathToManifest, rawManifest)
+	if err != nil {
+		return err
+	}
+
+	diff, warnings, err := cmd.Actor.DiffSpaceManifest(cmd.Config.TargetedSpace().GUID, rawManifest)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	spaceDiff := cmd.groupDiffsByApp(manifest, diff)
+
+	if cmd.UI.IsStructuredOutput() {
+		err = cmd.UI.DisplayStructured(spaceDiff)
+	} else {
+		err = cmd.displayAppDiffs(rawManifest, spaceDiff)
+	}
+	if err != nil {
+		return err
+	}
+
+	return cmd.driftError(spaceDiff)
+}
+
+func (cmd DiffManifestCommand) groupDiffsByApp(manifest manifestparser.Manifest, diff resources.ManifestDiff) spaceManifestDiff {
+	spaceDiff := spaceManifestDiff{InSync: true}
+
+	for index, app := range manifest.Applications {
+		appDiff := appManifestDiff{Name: app.Name, InSync: true, Diffs: []resources.Diff{}}
+
+		prefix := appDiffPath(index)
+		for _, d := range diff.Diffs {
+			if d.Path != prefix && !strings.HasPrefix(d.Path, prefix+"/") {
+				continue
+			}
+
+			d.Path = strings.TrimPrefix(d.Path, prefix)
+			if d.Path == "" {
+				d.Path = "/"
+			}
+			if cmd.UI.IsStructuredOutput() {
+				d = shared.RedactDiff(d, cmd.RedactEnv)
+			}
+			appDiff.Diffs = append(appDiff.Diffs, d)
+		}
+
+		if len(appDiff.Diffs) > 0 {
+			appDiff.InSync = false
+			spaceDiff.InSync = false
+		}
+		spaceDiff.Applications = append(spaceDiff.Applications, appDiff)
+	}
+
+	return spaceDiff
+}
+
+func (cmd DiffManifestCommand) displayAppDiffs(rawManifest []byte, spaceDiff spaceManifestDiff) error {
+	var rawApps struct {
+		Applications []yaml.MapSlice `yaml:"applications"`
+	}
+	err := yaml.Unmarshal(rawManifest, &rawApps)
+	if err != nil {
+		return err
+	}
+
+	for index, appDiff := range spaceDiff.Applications {
+		cmd.UI.DisplayNewline()
+
+		if appDiff.InSync {
+			cmd.UI.DisplayText("App {{.AppName}} matches the manifest.", map[string]interface{}{
+				"AppName": appDiff.Name,
+			})
+			continue
+		}
+
+		cmd.UI.DisplayText("App {{.AppName}} differs from the manifest:", map[string]interface{}{
+			"AppName": appDiff.Name,
+		})
+
+		// display each app as a manifest of its own, so the diff paths are
+		// relative to the first app
+		rawAppManifest, err := yaml.Marshal(yaml.MapSlice{
+			{Key: "applications", Value: []yaml.MapSlice{rawApps.Applications[index]}},
+		})
+		if err != nil {
+			return err
+		}
+
+		var diffs []resources.Diff
+		for _, d := range appDiff.Diffs {
+			d.Path = strings.TrimSuffix(appDiffPath(0)+d.Path, "/")
+			diffs = append(diffs, d)
+		}
+
+		err = cmd.DiffDisplayer.DisplayDiff(rawAppManifest, resources.ManifestDiff{Diffs: diffs})
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd DiffManifestCommand) driftError(spaceDiff spaceManifestDiff) error {
+	drifted := 0
+	for _, appDiff := range spaceDiff.Applications {
+		if !appDiff.InSync {
+			drifted++
+		}
+	}
+
+	if drifted == 0 {
+		if !cmd.UI.IsStructuredOutput() {
+			cmd.UI.DisplayOK()
+		}
+		return nil
+	}
+
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayText("{{.DriftedCount}} of {{.TotalCount}} apps differ from the manifest.", map[string]interface{}{
+			"DriftedCount": drifted,
+			"TotalCount":   len(spaceDiff.Applications),
+		})
+	}
+	return translatableerror.ManifestDriftError{DriftedCount: drifted, TotalCount: len(spaceDiff.Applications)}
+}
+
+func appDiffPath(index int) string {
+	return fmt.Sprintf("/applications/%d", index)
+}
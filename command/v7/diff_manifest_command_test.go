@@ -0,0 +1,257 @@
+package v7_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	. "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/manifestparser"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("diff-manifest Command", func() {
+	var (
+		cmd               DiffManifestCommand
+		testUI            *ui.UI
+		fakeConfig        *commandfakes.FakeConfig
+		fakeSharedActor   *commandfakes.FakeSharedActor
+		fakeActor         *v7fakes.FakeActor
+		fakeParser        *v7fakes.FakeManifestParser
+		fakeLocator       *v7fakes.FakeManifestLocator
+		fakeDiffDisplayer *v7fakes.FakeDiffDisplayer
+		binaryName        string
+		rawManifest       []byte
+		executeErr        error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeParser = new(v7fakes.FakeManifestParser)
+		fakeLocator = new(v7fakes.FakeManifestLocator)
+		fakeDiffDisplayer = new(v7fakes.FakeDiffDisplayer)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		cmd = DiffManifestCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			ManifestParser:  fakeParser,
+			ManifestLocator: fakeLocator,
+			DiffDisplayer:   fakeDiffDisplayer,
+			CWD:             "fake-directory",
+		}
+
+		rawManifest = []byte(`applications:
+- name: app-1
+  instances: 2
+- name: app-2
+  env:
+    SECRET: shh
+`)
+		fakeLocator.PathReturns("fake-directory/manifest.yml", true, nil)
+		fakeParser.InterpolateManifestReturns(rawManifest, nil)
+		fakeParser.ParseManifestReturns(manifestparser.Manifest{
+			Applications: []manifestparser.Application{
+				{Name: "app-1"},
+				{Name: "app-2"},
+			},
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("no manifest is found", func() {
+		BeforeEach(func() {
+			fakeLocator.PathReturns("", false, nil)
+		})
+
+		It("returns a ManifestFileNotFoundInDirectoryError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: "fake-directory"}))
+			Expect(fakeActor.DiffSpaceManifestCallCount()).To(Equal(0))
+		})
+	})
+
+	When("vars, vars files and ops files are provided", func() {
+		BeforeEach(func() {
+			cmd.PathToManifest = flag.ManifestPathWithExistenceCheck("some/manifest.yml")
+			cmd.Vars = []template.VarKV{{Name: "some-var", Value: "some-value"}}
+			cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"vars.yml"}
+			cmd.PathsToOpsFiles = []flag.PathWithExistenceCheck{"ops.yml"}
+		})
+
+		It("interpolates the manifest with them", func() {
+			Expect(fakeLocator.PathArgsForCall(0)).To(Equal("some/manifest.yml"))
+
+			path, varsFiles, vars, opsFiles := fakeParser.InterpolateManifestArgsForCall(0)
+			Expect(path).To(Equal("fake-directory/manifest.yml"))
+			Expect(varsFiles).To(Equal([]string{"vars.yml"}))
+			Expect(vars).To(Equal([]template.VarKV{{Name: "some-var", Value: "some-value"}}))
+			Expect(opsFiles).To(Equal([]string{"ops.yml"}))
+
+			spaceGUID, diffedManifest := fakeActor.DiffSpaceManifestArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(diffedManifest).To(Equal(rawManifest))
+		})
+	})
+
+	When("interpolating the manifest fails", func() {
+		BeforeEach(func() {
+			fakeParser.InterpolateManifestReturns(nil, errors.New("interpolate-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("interpolate-error"))
+			Expect(fakeActor.DiffSpaceManifestCallCount()).To(Equal(0))
+		})
+	})
+
+	When("diffing the manifest fails", func() {
+		BeforeEach(func() {
+			fakeActor.DiffSpaceManifestReturns(resources.ManifestDiff{}, v7action.Warnings{"diff-warning"}, errors.New("diff-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("diff-error"))
+			Expect(testUI.Err).To(Say("diff-warning"))
+		})
+	})
+
+	When("every app matches the manifest", func() {
+		BeforeEach(func() {
+			fakeActor.DiffSpaceManifestReturns(resources.ManifestDiff{}, v7action.Warnings{"diff-warning"}, nil)
+		})
+
+		It("says so and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Comparing manifest fake-directory/manifest.yml with apps in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("App app-1 matches the manifest."))
+			Expect(testUI.Out).To(Say("App app-2 matches the manifest."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("diff-warning"))
+
+			Expect(fakeDiffDisplayer.DisplayDiffCallCount()).To(Equal(0))
+		})
+	})
+
+	When("an app differs from the manifest", func() {
+		BeforeEach(func() {
+			fakeActor.DiffSpaceManifestReturns(resources.ManifestDiff{
+				Diffs: []resources.Diff{
+					{Op: resources.ReplaceOperation, Path: "/applications/1/env/SECRET", Was: "old", Value: "shh"},
+					{Op: resources.AddOperation, Path: "/applications/1/memory", Value: "1G"},
+				},
+			}, nil, nil)
+		})
+
+		It("displays the differences of that app as a manifest of its own", func() {
+			Expect(testUI.Out).To(Say("App app-1 matches the manifest."))
+			Expect(testUI.Out).To(Say("App app-2 differs from the manifest:"))
+			Expect(testUI.Out).To(Say(`1 of 2 apps differ from the manifest\.`))
+			Expect(testUI.Out).ToNot(Say("OK"))
+
+			Expect(fakeDiffDisplayer.DisplayDiffCallCount()).To(Equal(1))
+			rawAppManifest, diff := fakeDiffDisplayer.DisplayDiffArgsForCall(0)
+			Expect(string(rawAppManifest)).To(MatchYAML(`applications:
+- name: app-2
+  env:
+    SECRET: shh
+`))
+			Expect(diff.Diffs).To(Equal([]resources.Diff{
+				{Op: resources.ReplaceOperation, Path: "/applications/0/env/SECRET", Was: "old", Value: "shh"},
+				{Op: resources.AddOperation, Path: "/applications/0/memory", Value: "1G"},
+			}))
+		})
+
+		It("returns a ManifestDriftError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestDriftError{DriftedCount: 1, TotalCount: 2}))
+		})
+
+		When("the output format is json", func() {
+			BeforeEach(func() {
+				testUI.SetOutputFormat(configv3.OutputFormatJSON)
+				cmd.RedactEnv = true
+			})
+
+			It("only displays the differences of each app as JSON", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ManifestDriftError{DriftedCount: 1, TotalCount: 2}))
+				Expect(fakeActor.GetCurrentUserCallCount()).To(Equal(0))
+				Expect(fakeDiffDisplayer.DisplayDiffCallCount()).To(Equal(0))
+
+				var output map[string]interface{}
+				Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &output)).To(Succeed())
+				Expect(output).To(Equal(map[string]interface{}{
+					"in_sync": false,
+					"applications": []interface{}{
+						map[string]interface{}{
+							"name":    "app-1",
+							"in_sync": true,
+							"diff":    []interface{}{},
+						},
+						map[string]interface{}{
+							"name":    "app-2",
+							"in_sync": false,
+							"diff": []interface{}{
+								map[string]interface{}{"op": "replace", "path": "/env/SECRET", "was": "<redacted>", "value": "<redacted>"},
+								map[string]interface{}{"op": "add", "path": "/memory", "was": nil, "value": "1G"},
+							},
+						},
+					},
+				}))
+			})
+		})
+
+		When("the output format is yaml", func() {
+			BeforeEach(func() {
+				testUI.SetOutputFormat(configv3.OutputFormatYAML)
+			})
+
+			It("displays the differences of each app as YAML", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ManifestDriftError{DriftedCount: 1, TotalCount: 2}))
+				Expect(testUI.Out).To(Say(`in_sync: false`))
+				Expect(testUI.Out).To(Say(`- name: app-1`))
+				Expect(testUI.Out).To(Say(`- name: app-2`))
+				Expect(testUI.Out).To(Say(`path: /memory`))
+			})
+		})
+	})
+})
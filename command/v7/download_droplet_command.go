@@ -1,14 +1,19 @@
 package v7
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 
 	"code.cloudfoundry.org/cli/v9/actor/actionerror"
 	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/command"
 	"code.cloudfoundry.org/cli/v9/command/flag"
 	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/util/download"
+	pb "gopkg.in/cheggaaa/pb.v1"
 )
 
 type DownloadDropletCommand struct {
@@ -17,8 +22,27 @@ type DownloadDropletCommand struct {
 	RequiredArgs    flag.AppName `positional-args:"yes"`
 	Droplet         string       `long:"droplet" description:"The guid of the droplet to download (default: app's current droplet)."`
 	Path            string       `long:"path" short:"p" description:"File path to download droplet to (default: current working directory)."`
-	usage           interface{}  `usage:"CF_NAME download-droplet APP_NAME [--droplet DROPLET_GUID] [--path /path/to/droplet.tgz]"`
+	usage           interface{}  `usage:"CF_NAME download-droplet APP_NAME [--droplet DROPLET_GUID] [--path /path/to/droplet.tgz]\n\n   An interrupted download is resumed when the command is run again with the same path."`
 	relatedCommands interface{}  `related_commands:"apps, droplets, push, set-droplet"`
+
+	Downloader  v7action.DropletDownloader
+	ProgressBar download.ProgressBar
+}
+
+func (cmd *DownloadDropletCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, _ := cmd.GetClients()
+	cmd.Downloader = &download.Downloader{HTTPClient: ccClient.StreamingHTTPClient()}
+
+	progressBar := pb.New(0).SetUnits(pb.U_BYTES)
+	progressBar.Output = ui.Writer()
+	cmd.ProgressBar = progressBar
+
+	return nil
 }
 
 func (cmd DownloadDropletCommand) Execute(args []string) error {
@@ -33,23 +57,20 @@ func (cmd DownloadDropletCommand) Execute(args []string) error {
 	}
 
 	var (
-		rawDropletBytes []byte
-		dropletGUID     string
-		warnings        v7action.Warnings
+		droplet  resources.Droplet
+		warnings v7action.Warnings
 	)
 
 	if cmd.Droplet != "" {
-		dropletGUID = cmd.Droplet
-
 		cmd.UI.DisplayTextWithFlavor("Downloading droplet {{.DropletGUID}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-			"DropletGUID": dropletGUID,
+			"DropletGUID": cmd.Droplet,
 			"AppName":     cmd.RequiredArgs.AppName,
 			"OrgName":     cmd.Config.TargetedOrganization().Name,
 			"SpaceName":   cmd.Config.TargetedSpace().Name,
 			"Username":    user.Name,
 		})
 
-		rawDropletBytes, warnings, err = cmd.Actor.DownloadDropletByGUIDAndAppName(dropletGUID, cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		droplet, warnings, err = cmd.Actor.GetDropletByGUIDAndAppName(cmd.Droplet, cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
 	} else {
 		cmd.UI.DisplayTextWithFlavor("Downloading current droplet for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 			"AppName":   cmd.RequiredArgs.AppName,
@@ -58,7 +79,7 @@ func (cmd DownloadDropletCommand) Execute(args []string) error {
 			"Username":  user.Name,
 		})
 
-		rawDropletBytes, dropletGUID, warnings, err = cmd.Actor.DownloadCurrentDropletByAppName(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		droplet, warnings, err = cmd.Actor.GetCurrentDropletByAppName(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -70,28 +91,22 @@ func (cmd DownloadDropletCommand) Execute(args []string) error {
 		return err
 	}
 
-	var pathToDroplet string
+	pathToDroplet, err := cmd.dropletPath(droplet.GUID)
+	if err != nil {
+		return err
+	}
 
-	if cmd.Path == "" {
-		currentDir, err := os.Getwd()
-		if err != nil {
-			return err
+	err = cmd.Actor.DownloadDroplet(droplet, pathToDroplet, cmd.Downloader, cmd.ProgressBar)
+	if err != nil {
+		if statusErr, ok := err.(download.RawHTTPStatusError); ok {
+			return translatableerror.DropletDownloadStatusError{Status: statusErr.Status}
 		}
 
-		pathToDroplet = filepath.Join(currentDir, fmt.Sprintf("droplet_%s.tgz", dropletGUID))
-	} else {
-		stats, err := os.Stat(cmd.Path)
-
-		if err == nil && stats.IsDir() {
-			pathToDroplet = filepath.Join(cmd.Path, fmt.Sprintf("droplet_%s.tgz", dropletGUID))
-		} else {
-			pathToDroplet = cmd.Path
+		var pathErr *os.PathError
+		if errors.As(err, &pathErr) {
+			return translatableerror.DropletFileError{Err: err}
 		}
-	}
-
-	err = os.WriteFile(pathToDroplet, rawDropletBytes, 0666)
-	if err != nil {
-		return translatableerror.DropletFileError{Err: err}
+		return err
 	}
 
 	cmd.UI.DisplayText("Droplet downloaded successfully at {{.FilePath}}", map[string]interface{}{
@@ -101,3 +116,23 @@ func (cmd DownloadDropletCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd DownloadDropletCommand) dropletPath(dropletGUID string) (string, error) {
+	fileName := fmt.Sprintf("droplet_%s.tgz", dropletGUID)
+
+	if cmd.Path == "" {
+		currentDir, err := os.Getwd()
+		if err != nil {
+			return "", err
+		}
+
+		return filepath.Join(currentDir, fileName), nil
+	}
+
+	stats, err := os.Stat(cmd.Path)
+	if err == nil && stats.IsDir() {
+		return filepath.Join(cmd.Path, fileName), nil
+	}
+
+	return cmd.Path, nil
+}
@@ -9,11 +9,15 @@ import (
 
 	"code.cloudfoundry.org/cli/v9/actor/actionerror"
 	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/v9/command/commandfakes"
 	"code.cloudfoundry.org/cli/v9/command/translatableerror"
 	. "code.cloudfoundry.org/cli/v9/command/v7"
 	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/resources"
 	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/download"
+	"code.cloudfoundry.org/cli/v9/util/download/downloadfakes"
 	"code.cloudfoundry.org/cli/v9/util/ui"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -28,6 +32,8 @@ var _ = Describe("download-droplet Command", func() {
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v7fakes.FakeActor
+		fakeDownloader  *v7actionfakes.FakeDropletDownloader
+		fakeProgressBar *downloadfakes.FakeProgressBar
 		binaryName      string
 		executeErr      error
 	)
@@ -37,6 +43,8 @@ var _ = Describe("download-droplet Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v7fakes.FakeActor)
+		fakeDownloader = new(v7actionfakes.FakeDropletDownloader)
+		fakeProgressBar = new(downloadfakes.FakeProgressBar)
 
 		cmd = DownloadDropletCommand{
 			BaseCommand: BaseCommand{
@@ -45,6 +53,8 @@ var _ = Describe("download-droplet Command", func() {
 				SharedActor: fakeSharedActor,
 				Actor:       fakeActor,
 			},
+			Downloader:  fakeDownloader,
+			ProgressBar: fakeProgressBar,
 		}
 
 		cmd.RequiredArgs.AppName = "some-app"
@@ -82,34 +92,30 @@ var _ = Describe("download-droplet Command", func() {
 	})
 
 	When("downloading the droplet succeeds", func() {
-		var (
-			pathToDropletFile string
-			dropletGUID       string
-		)
+		var dropletGUID string
 
 		BeforeEach(func() {
 			dropletGUID = RandomString("fake-droplet-guid")
-			fakeActor.DownloadCurrentDropletByAppNameReturns([]byte("some-droplet-bytes"), dropletGUID, v7action.Warnings{"some-warning"}, nil)
-
-			currentDir, _ := os.Getwd()
-			pathToDropletFile = filepath.Join(currentDir, fmt.Sprintf("droplet_%s.tgz", dropletGUID))
-		})
-
-		AfterEach(func() {
-			Expect(os.Remove(pathToDropletFile)).ToNot(HaveOccurred())
+			fakeActor.GetCurrentDropletByAppNameReturns(resources.Droplet{GUID: dropletGUID}, v7action.Warnings{"some-warning"}, nil)
 		})
 
-		It("creates a droplet tarball in the current directory", func() {
+		It("downloads the current droplet to a tarball in the current directory", func() {
 			Expect(executeErr).ToNot(HaveOccurred())
 
-			Expect(fakeActor.DownloadCurrentDropletByAppNameCallCount()).To(Equal(1))
-			appArg, spaceGUIDArg := fakeActor.DownloadCurrentDropletByAppNameArgsForCall(0)
+			Expect(fakeActor.GetCurrentDropletByAppNameCallCount()).To(Equal(1))
+			appArg, spaceGUIDArg := fakeActor.GetCurrentDropletByAppNameArgsForCall(0)
 			Expect(appArg).To(Equal("some-app"))
 			Expect(spaceGUIDArg).To(Equal("some-space-guid"))
 
-			fileContents, err := os.ReadFile(pathToDropletFile)
+			currentDir, err := os.Getwd()
 			Expect(err).ToNot(HaveOccurred())
-			Expect(string(fileContents)).To(Equal("some-droplet-bytes"))
+
+			Expect(fakeActor.DownloadDropletCallCount()).To(Equal(1))
+			dropletArg, pathArg, downloaderArg, progressBarArg := fakeActor.DownloadDropletArgsForCall(0)
+			Expect(dropletArg).To(Equal(resources.Droplet{GUID: dropletGUID}))
+			Expect(pathArg).To(Equal(filepath.Join(currentDir, fmt.Sprintf("droplet_%s.tgz", dropletGUID))))
+			Expect(downloaderArg).To(Equal(fakeDownloader))
+			Expect(progressBarArg).To(Equal(fakeProgressBar))
 		})
 
 		It("displays the file it created and returns no errors", func() {
@@ -122,36 +128,27 @@ var _ = Describe("download-droplet Command", func() {
 	})
 
 	When("the droplet guid is passed in", func() {
-		var (
-			dropletGUID       string
-			pathToDropletFile string
-		)
+		var dropletGUID string
 
 		BeforeEach(func() {
 			dropletGUID = RandomString("fake-droplet-guid")
-			pathToDropletFile = fmt.Sprintf("droplet_%s.tgz", dropletGUID)
 
 			setFlag(&cmd, "--droplet", dropletGUID)
 
-			fakeActor.DownloadDropletByGUIDAndAppNameReturns([]byte("some-droplet-bytes"), v7action.Warnings{"some-warning"}, nil)
-		})
-
-		AfterEach(func() {
-			Expect(os.Remove(pathToDropletFile)).ToNot(HaveOccurred())
+			fakeActor.GetDropletByGUIDAndAppNameReturns(resources.Droplet{GUID: dropletGUID}, v7action.Warnings{"some-warning"}, nil)
 		})
 
-		It("creates a droplet tarball in the current directory", func() {
+		It("downloads the given droplet", func() {
 			Expect(executeErr).ToNot(HaveOccurred())
 
-			Expect(fakeActor.DownloadDropletByGUIDAndAppNameCallCount()).To(Equal(1))
-			dropletGUIDArg, appArg, spaceGUIDArg := fakeActor.DownloadDropletByGUIDAndAppNameArgsForCall(0)
+			Expect(fakeActor.GetDropletByGUIDAndAppNameCallCount()).To(Equal(1))
+			dropletGUIDArg, appArg, spaceGUIDArg := fakeActor.GetDropletByGUIDAndAppNameArgsForCall(0)
 			Expect(dropletGUIDArg).To(Equal(dropletGUID))
 			Expect(appArg).To(Equal("some-app"))
 			Expect(spaceGUIDArg).To(Equal("some-space-guid"))
 
-			fileContents, err := os.ReadFile(pathToDropletFile)
-			Expect(err).ToNot(HaveOccurred())
-			Expect(string(fileContents)).To(Equal("some-droplet-bytes"))
+			dropletArg, _, _, _ := fakeActor.DownloadDropletArgsForCall(0)
+			Expect(dropletArg.GUID).To(Equal(dropletGUID))
 		})
 
 		It("displays the file it created and returns no errors", func() {
@@ -165,31 +162,21 @@ var _ = Describe("download-droplet Command", func() {
 
 	When("a path to a file is passed in", func() {
 		var filePath string
+
 		BeforeEach(func() {
 			filePath = RandomString("fake-file")
 
 			setFlag(&cmd, "--path", filePath)
-			fakeActor.DownloadCurrentDropletByAppNameReturns([]byte("some-droplet"), "some-droplet-guid", v7action.Warnings{"some-warning"}, nil)
-		})
-
-		AfterEach(func() {
-			Expect(os.Remove(filePath)).ToNot(HaveOccurred())
+			fakeActor.GetCurrentDropletByAppNameReturns(resources.Droplet{GUID: "some-droplet-guid"}, v7action.Warnings{"some-warning"}, nil)
 		})
 
-		It("creates a droplet tarball at the specified path", func() {
+		It("downloads the droplet to the specified path", func() {
 			Expect(executeErr).ToNot(HaveOccurred())
 
-			fileContents, err := os.ReadFile(filePath)
-			Expect(err).ToNot(HaveOccurred())
-			Expect(string(fileContents)).To(Equal("some-droplet"))
-		})
-
-		It("displays the file it created and returns no errors", func() {
-			Expect(testUI.Out).To(Say("Downloading current droplet for app some-app in org some-org / space some-space as some-user..."))
-			Expect(testUI.Err).To(Say("some-warning"))
+			_, pathArg, _, _ := fakeActor.DownloadDropletArgsForCall(0)
+			Expect(pathArg).To(Equal(filePath))
 			Expect(testUI.Out).To(Say(`Droplet downloaded successfully at %s`, filePath))
 			Expect(testUI.Out).To(Say("OK"))
-			Expect(executeErr).ToNot(HaveOccurred())
 		})
 	})
 
@@ -197,41 +184,30 @@ var _ = Describe("download-droplet Command", func() {
 		var tmpDir string
 
 		BeforeEach(func() {
-			var err error
-			tmpDir, err = os.MkdirTemp("", "droplets")
-			Expect(err).NotTo(HaveOccurred())
+			tmpDir = GinkgoT().TempDir()
 
 			setFlag(&cmd, "--path", tmpDir)
 
-			fakeActor.DownloadCurrentDropletByAppNameReturns([]byte("some-droplet"), "some-droplet-guid", v7action.Warnings{"some-warning"}, nil)
-		})
-
-		AfterEach(func() {
-			Expect(os.RemoveAll(tmpDir)).ToNot(HaveOccurred())
+			fakeActor.GetCurrentDropletByAppNameReturns(resources.Droplet{GUID: "some-droplet-guid"}, v7action.Warnings{"some-warning"}, nil)
 		})
 
-		It("creates a droplet tarball at the specified path", func() {
+		It("downloads the droplet into the directory", func() {
 			Expect(executeErr).ToNot(HaveOccurred())
 
-			fileContents, err := os.ReadFile(filepath.Join(tmpDir, "droplet_some-droplet-guid.tgz"))
-			Expect(err).ToNot(HaveOccurred())
-			Expect(string(fileContents)).To(Equal("some-droplet"))
-		})
+			_, pathArg, _, _ := fakeActor.DownloadDropletArgsForCall(0)
+			Expect(pathArg).To(Equal(filepath.Join(tmpDir, "droplet_some-droplet-guid.tgz")))
 
-		It("displays the file it created and returns no errors", func() {
-			Expect(executeErr).ToNot(HaveOccurred())
-			Expect(testUI.Out).To(Say("Downloading current droplet for app some-app in org some-org / space some-space as some-user..."))
-			Expect(testUI.Err).To(Say("some-warning"))
 			pathRegExp := regexp.QuoteMeta(filepath.Join(tmpDir, "droplet_some-droplet-guid.tgz"))
 			Expect(testUI.Out).To(Say(`Droplet downloaded successfully at %s`, pathRegExp))
 			Expect(testUI.Out).To(Say("OK"))
 		})
 	})
 
-	When("a path to a file in an invalid directory is passed in", func() {
+	When("the droplet file cannot be written", func() {
 		BeforeEach(func() {
 			cmd.Path = "not/exist/some-file.tgz"
-			fakeActor.DownloadCurrentDropletByAppNameReturns([]byte("some-droplet"), "some-droplet-guid", v7action.Warnings{"some-warning"}, nil)
+			fakeActor.GetCurrentDropletByAppNameReturns(resources.Droplet{GUID: "some-droplet-guid"}, v7action.Warnings{"some-warning"}, nil)
+			fakeActor.DownloadDropletReturns(&os.PathError{Op: "open", Path: "not/exist/some-file.tgz.part", Err: os.ErrNotExist})
 		})
 
 		It("returns an appropriate error", func() {
@@ -240,20 +216,45 @@ var _ = Describe("download-droplet Command", func() {
 		})
 	})
 
-	When("there is an error downloading the droplet", func() {
+	When("the server responds with an error status", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentDropletByAppNameReturns(resources.Droplet{GUID: "some-droplet-guid"}, v7action.Warnings{"some-warning"}, nil)
+			fakeActor.DownloadDropletReturns(download.RawHTTPStatusError{Status: "403 Forbidden"})
+		})
+
+		It("returns a droplet download error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DropletDownloadStatusError{Status: "403 Forbidden"}))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	When("the download fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentDropletByAppNameReturns(resources.Droplet{GUID: "some-droplet-guid"}, v7action.Warnings{"some-warning"}, nil)
+			fakeActor.DownloadDropletReturns(download.InterruptedError{BytesReceived: 42, Err: errors.New("connection reset")})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(download.InterruptedError{BytesReceived: 42, Err: errors.New("connection reset")}))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	When("there is an error getting the droplet", func() {
 		BeforeEach(func() {
-			fakeActor.DownloadCurrentDropletByAppNameReturns([]byte{}, "", v7action.Warnings{"some-warning"}, errors.New("something went wrong"))
+			fakeActor.GetCurrentDropletByAppNameReturns(resources.Droplet{}, v7action.Warnings{"some-warning"}, errors.New("something went wrong"))
 		})
 
 		It("displays warnings and returns an error", func() {
 			Expect(testUI.Err).To(Say("some-warning"))
 			Expect(executeErr).To(MatchError("something went wrong"))
+			Expect(fakeActor.DownloadDropletCallCount()).To(Equal(0))
 		})
 	})
 
 	When("the app does not have a current droplet", func() {
 		BeforeEach(func() {
-			fakeActor.DownloadCurrentDropletByAppNameReturns([]byte{}, "", v7action.Warnings{"some-warning"}, actionerror.DropletNotFoundError{})
+			fakeActor.GetCurrentDropletByAppNameReturns(resources.Droplet{}, v7action.Warnings{"some-warning"}, actionerror.DropletNotFoundError{})
 		})
 
 		It("displays warnings and returns an error", func() {
@@ -0,0 +1,112 @@
+package v7
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/v9/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/v7/shared"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ExportNetworkPoliciesActor
+
+type ExportNetworkPoliciesActor interface {
+	NetworkPoliciesBySpace(spaceGUID string) ([]cfnetworkingaction.Policy, cfnetworkingaction.Warnings, error)
+}
+
+type ExportNetworkPoliciesCommand struct {
+	BaseCommand
+
+	FilePath flag.Path `short:"f" description:"Write the policies to this file instead of printing them"`
+
+	usage           interface{} `usage:"Export the network policies of the apps in the targeted space in the format read by sync-network-policies.\n\nCF_NAME export-network-policies [-f FILE]"`
+	relatedCommands interface{} `related_commands:"network-policies, sync-network-policies"`
+
+	NetworkingActor ExportNetworkPoliciesActor
+}
+
+func (cmd *ExportNetworkPoliciesCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+
+	return nil
+}
+
+func (cmd ExportNetworkPoliciesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	if cmd.FilePath != "" {
+		user, err := cmd.Actor.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Exporting network policies in org {{.Org}} / space {{.Space}} to {{.Path}} as {{.User}}...", map[string]interface{}{
+			"Org":   cmd.Config.TargetedOrganization().Name,
+			"Space": cmd.Config.TargetedSpace().Name,
+			"Path":  cmd.FilePath,
+			"User":  user.Name,
+		})
+	}
+
+	policies, warnings, err := cmd.NetworkingActor.NetworkPoliciesBySpace(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	source := cfnetworkingaction.PolicyEndpoint{
+		OrgName:   cmd.Config.TargetedOrganization().Name,
+		SpaceName: cmd.Config.TargetedSpace().Name,
+	}
+
+	var desired []cfnetworkingaction.DesiredPolicy
+	for _, policy := range policies {
+		policySource := source
+		policySource.AppName = policy.SourceName
+
+		desired = append(desired, cfnetworkingaction.DesiredPolicy{
+			Source: policySource,
+			Destination: cfnetworkingaction.PolicyEndpoint{
+				OrgName:   policy.DestinationOrgName,
+				SpaceName: policy.DestinationSpaceName,
+				AppName:   policy.DestinationName,
+			},
+			Protocol:  policy.Protocol,
+			StartPort: policy.StartPort,
+			EndPort:   policy.EndPort,
+		})
+	}
+
+	raw, err := cfnetworkingaction.MarshalNetworkPolicyFile(desired)
+	if err != nil {
+		return err
+	}
+
+	if cmd.FilePath == "" {
+		_, err = cmd.UI.GetOut().Write(raw)
+		return err
+	}
+
+	err = os.WriteFile(string(cmd.FilePath), raw, 0666)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,126 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	. "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-network-policies Command", func() {
+	var (
+		cmd             ExportNetworkPoliciesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeExportActor *v7fakes.FakeExportNetworkPoliciesActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeExportActor = new(v7fakes.FakeExportNetworkPoliciesActor)
+
+		cmd = ExportNetworkPoliciesCommand{
+			BaseCommand: BaseCommand{
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				UI:          testUI,
+				Actor:       fakeActor,
+			},
+			NetworkingActor: fakeExportActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeExportActor.NetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{
+			{
+				SourceName:           "frontend",
+				DestinationName:      "backend",
+				Protocol:             "tcp",
+				StartPort:            8080,
+				EndPort:              8090,
+				DestinationSpaceName: "other-space",
+				DestinationOrgName:   "other-org",
+			},
+		}, cfnetworkingaction.Warnings{"list-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoSpaceTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoSpaceTargetedError{BinaryName: "faceman"}))
+			Expect(fakeExportActor.NetworkPoliciesBySpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	It("prints the policies of the targeted space as a network policy file", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(fakeExportActor.NetworkPoliciesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+		Expect(testUI.Err).To(Say("list-warning"))
+
+		policies, err := cfnetworkingaction.ParseNetworkPolicyFile(testUI.Out.(*Buffer).Contents())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policies).To(Equal([]cfnetworkingaction.DesiredPolicy{{
+			Source:      cfnetworkingaction.PolicyEndpoint{OrgName: "some-org", SpaceName: "some-space", AppName: "frontend"},
+			Destination: cfnetworkingaction.PolicyEndpoint{OrgName: "other-org", SpaceName: "other-space", AppName: "backend"},
+			Protocol:    "tcp",
+			StartPort:   8080,
+			EndPort:     8090,
+		}}))
+	})
+
+	When("a file is given", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(GinkgoT().TempDir(), "policies.yml")
+			cmd.FilePath = flag.Path(path)
+		})
+
+		It("writes the policies to the file", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Exporting network policies in org some-org / space some-space to .*policies\.yml as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			raw, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(raw)).To(ContainSubstring("ports: 8080-8090"))
+		})
+	})
+
+	When("listing the policies fails", func() {
+		BeforeEach(func() {
+			fakeExportActor.NetworkPoliciesBySpaceReturns(nil, cfnetworkingaction.Warnings{"list-warning"}, errors.New("list-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("list-error"))
+			Expect(testUI.Err).To(Say("list-warning"))
+		})
+	})
+})
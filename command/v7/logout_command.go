@@ -40,6 +40,10 @@ func (cmd LogoutCommand) Execute(args []string) error {
 
 	cmd.Actor.RevokeAccessAndRefreshTokens() //nolint:errcheck
 	cmd.Config.UnsetUserInformation()
+	err = cmd.Config.PersistTokens()
+	if err != nil {
+		return err
+	}
 	cmd.UI.DisplayOK()
 
 	return nil
@@ -1,6 +1,8 @@
 package v7_test
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/cli/v9/api/uaa"
 	"code.cloudfoundry.org/cli/v9/command/commandfakes"
 	. "code.cloudfoundry.org/cli/v9/command/v7"
@@ -56,6 +58,21 @@ var _ = Describe("logout command", func() {
 		Expect(fakeActor.RevokeAccessAndRefreshTokensCallCount()).To(Equal(1))
 	})
 
+	It("erases the tokens from the credential helper", func() {
+		Expect(fakeConfig.PersistTokensCallCount()).To(Equal(1))
+	})
+
+	When("erasing the tokens fails", func() {
+		BeforeEach(func() {
+			fakeConfig.PersistTokensReturns(errors.New("vault is sealed"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("vault is sealed"))
+			Expect(testUI.Out).NotTo(Say("OK"))
+		})
+	})
+
 	When("unable to revoke token", func() {
 		When("because the user is not logged in", func() {
 			BeforeEach(func() {
@@ -1,8 +1,10 @@
 package v7
 
 import (
+	"context"
 	"os"
 	"os/signal"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/v9/actor/actionerror"
@@ -11,15 +13,24 @@ import (
 	"code.cloudfoundry.org/cli/v9/api/logcache"
 	"code.cloudfoundry.org/cli/v9/command"
 	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	"code.cloudfoundry.org/cli/v9/resources"
 )
 
 type LogsCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	OptionalArgs    flag.OptionalAppNames `positional-args:"yes"`
+	Labels          string                `long:"labels" description:"Show logs of all apps that match this label selector"`
+	AllApps         bool                  `long:"all-apps" description:"Show logs of all apps in the targeted space"`
+	Recent          bool                  `long:"recent" description:"Dump recent logs instead of tailing"`
+	SourceTypes     []flag.LogSourceType  `long:"source-type" description:"Only show logs from this source: APP, RTR, STG, CELL or API. Can be specified multiple times"`
+	InstanceIndexes []int                 `long:"instance" description:"Only show logs from the instance with this index. Can be specified multiple times"`
+	ProcessTypes    []string              `long:"process" description:"Only show logs from this process type, such as web. Can be specified multiple times"`
+	Since           flag.TimeOrDuration   `long:"since" description:"Show logs after this time, given as an RFC 3339 timestamp or a duration before now such as 1h"`
+	Until           flag.TimeOrDuration   `long:"until" description:"Show logs before this time, given as an RFC 3339 timestamp or a duration before now such as 10m. Requires --recent"`
+	usage           interface{}           `usage:"CF_NAME logs (APP_NAME... | --labels SELECTOR | --all-apps) [--recent] [--source-type SOURCE_TYPE] [--instance INDEX] [--process PROCESS_TYPE] [--since TIME] [--until TIME]\n\nTIP: Use '--output-format json' to print each log as a line of JSON, or '--output-format yaml' to print the logs as a YAML sequence.\n\nEXAMPLES:\n   CF_NAME logs my-app --source-type RTR\n   CF_NAME logs frontend orders payments\n   CF_NAME logs --labels 'team=checkout'\n   CF_NAME logs my-app --recent --process worker --since 2h --until 1h\n   CF_NAME logs my-app --recent --since 2024-03-01T12:00:00Z --output-format json"`
+	relatedCommands interface{}           `related_commands:"app, apps, ssh"`
 
 	LogCacheClient sharedaction.LogCacheClient
 }
@@ -34,8 +45,15 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 	return err
 }
 
+func (LogsCommand) SupportsStructuredOutput() {}
+
 func (cmd LogsCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	err := cmd.validateArgs()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
@@ -45,28 +63,121 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-		map[string]interface{}{
-			"AppName":   cmd.RequiredArgs.AppName,
-			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	if len(cmd.OptionalArgs.AppNames) == 1 {
+		return cmd.displayAppLogs(cmd.OptionalArgs.AppNames[0], user.Name)
+	}
+
+	apps, warnings, err := cmd.getApplications()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	return cmd.displayLogsForApps(apps, user.Name)
+}
+
+func (cmd LogsCommand) validateArgs() error {
+	var selections []string
+	if len(cmd.OptionalArgs.AppNames) > 0 {
+		selections = append(selections, "APP_NAME")
+	}
+	if cmd.Labels != "" {
+		selections = append(selections, "--labels")
+	}
+	if cmd.AllApps {
+		selections = append(selections, "--all-apps")
+	}
+
+	switch {
+	case len(selections) == 0:
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	case len(selections) > 1:
+		return translatableerror.ArgumentCombinationError{Args: selections}
+	case cmd.Until.IsSet && !cmd.Recent:
+		return translatableerror.RequiredFlagsError{Arg1: "--until", Arg2: "--recent"}
+	}
+
+	return nil
+}
+
+func (cmd LogsCommand) getApplications() ([]resources.Application, v7action.Warnings, error) {
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	switch {
+	case cmd.Labels != "":
+		return cmd.Actor.GetApplicationsBySpaceAndLabelSelector(spaceGUID, cmd.Labels)
+	case cmd.AllApps:
+		return cmd.Actor.GetApplicationsBySpace(spaceGUID)
+	default:
+		return cmd.Actor.GetApplicationsByNamesAndSpace(cmd.OptionalArgs.AppNames, spaceGUID)
+	}
+}
+
+func (cmd LogsCommand) displayAppLogs(appName string, username string) error {
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppName":   appName,
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  username,
+			})
+		cmd.UI.DisplayNewline()
+	}
 
 	if cmd.Recent {
-		return cmd.displayRecentLogs()
+		return cmd.displayRecentLogs(appName)
 	}
 
+	return cmd.refreshTokenWhile(func() error {
+		return cmd.streamLogs(appName)
+	})
+}
+
+func (cmd LogsCommand) displayLogsForApps(apps []resources.Application, username string) error {
+	if len(apps) == 0 {
+		if !cmd.UI.IsStructuredOutput() {
+			cmd.UI.DisplayText("No apps found.")
+		}
+		return nil
+	}
+
+	if !cmd.UI.IsStructuredOutput() {
+		var appNames []string
+		for _, app := range apps {
+			appNames = append(appNames, app.Name)
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for apps {{.AppNames}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppNames":  strings.Join(appNames, ", "),
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  username,
+			})
+		cmd.UI.DisplayNewline()
+	}
+
+	if cmd.Recent {
+		return cmd.displayRecentLogsForApps(apps)
+	}
+
+	return cmd.refreshTokenWhile(func() error {
+		return cmd.streamLogsForApps(apps)
+	})
+}
+
+// refreshTokenWhile keeps the access token fresh while the logs are streamed.
+func (cmd LogsCommand) refreshTokenWhile(streamLogs func() error) error {
 	stop := make(chan struct{})
 	stoppedRefreshing := make(chan struct{})
 	stoppedOutputtingRefreshErrors := make(chan struct{})
-	err = cmd.refreshTokenPeriodically(stop, stoppedRefreshing, stoppedOutputtingRefreshErrors)
+	err := cmd.refreshTokenPeriodically(stop, stoppedRefreshing, stoppedOutputtingRefreshErrors)
 	if err != nil {
 		return err
 	}
 
-	err = cmd.streamLogs()
+	err = streamLogs()
 
 	close(stop)
 	<-stoppedRefreshing
@@ -75,21 +186,65 @@ func (cmd LogsCommand) Execute(args []string) error {
 	return err
 }
 
-func (cmd LogsCommand) displayRecentLogs() error {
+func (cmd LogsCommand) displayRecentLogs(appName string) error {
 	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
+		appName,
 		cmd.Config.TargetedSpace().GUID,
 		cmd.LogCacheClient,
+		cmd.logFilter(),
 	)
 
 	for _, message := range messages {
-		cmd.UI.DisplayLogMessage(message, true)
+		displayErr := cmd.displayLogMessage(appName, message, false)
+		if displayErr != nil {
+			return displayErr
+		}
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
 	return err
 }
 
+func (cmd LogsCommand) displayRecentLogsForApps(apps []resources.Application) error {
+	messages, err := cmd.Actor.GetRecentLogsForApplications(apps, cmd.LogCacheClient, cmd.logFilter())
+
+	for _, message := range messages {
+		displayErr := cmd.displayLogMessage(message.AppName, message.LogMessage, true)
+		if displayErr != nil {
+			return displayErr
+		}
+	}
+
+	return err
+}
+
+func (cmd LogsCommand) displayLogMessage(appName string, message sharedaction.LogMessage, showAppName bool) error {
+	switch {
+	case cmd.UI.IsStructuredOutput():
+		return cmd.UI.DisplayLogMessageStructured(appName, message)
+	case showAppName:
+		cmd.UI.DisplayLogMessageForApp(appName, message, true)
+	default:
+		cmd.UI.DisplayLogMessage(message, true)
+	}
+
+	return nil
+}
+
+func (cmd LogsCommand) logFilter() sharedaction.LogFilter {
+	filter := sharedaction.LogFilter{
+		InstanceIndexes: cmd.InstanceIndexes,
+		ProcessTypes:    cmd.ProcessTypes,
+		Since:           cmd.Since.Resolve(time.Now()),
+		Until:           cmd.Until.Resolve(time.Now()),
+	}
+	for _, sourceType := range cmd.SourceTypes {
+		filter.SourceTypes = append(filter.SourceTypes, string(sourceType))
+	}
+
+	return filter
+}
+
 func (cmd LogsCommand) refreshTokenPeriodically(
 	stop chan struct{},
 	stoppedRefreshing chan struct{},
@@ -127,17 +282,35 @@ func (cmd LogsCommand) handleLogErr(logErr error) {
 	}
 }
 
-func (cmd LogsCommand) streamLogs() error {
+func (cmd LogsCommand) streamLogs(appName string) error {
 	messages, logErrs, stopStreaming, warnings, err := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
+		appName,
 		cmd.Config.TargetedSpace().GUID,
 		cmd.LogCacheClient,
+		cmd.logFilter(),
 	)
 
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
+
+	return followLogStream(cmd, messages, logErrs, stopStreaming, func(message sharedaction.LogMessage) error {
+		return cmd.displayLogMessage(appName, message, false)
+	})
+}
+
+func (cmd LogsCommand) streamLogsForApps(apps []resources.Application) error {
+	messages, logErrs, stopStreaming := cmd.Actor.GetStreamingLogsForApplications(apps, cmd.LogCacheClient, cmd.logFilter())
+
+	return followLogStream(cmd, messages, logErrs, stopStreaming, func(message v7action.ApplicationLogMessage) error {
+		return cmd.displayLogMessage(message.AppName, message.LogMessage, true)
+	})
+}
+
+// followLogStream displays messages as they arrive until both channels are
+// closed or the user interrupts, then stops the stream.
+func followLogStream[T any](cmd LogsCommand, messages <-chan T, logErrs <-chan error, stopStreaming context.CancelFunc, display func(T) error) error {
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt)
 
@@ -150,7 +323,10 @@ func (cmd LogsCommand) streamLogs() error {
 				messagesClosed = true
 				break
 			}
-			cmd.UI.DisplayLogMessage(message, true)
+			displayErr := display(message)
+			if displayErr != nil {
+				return displayErr
+			}
 		case logErr, ok := <-logErrs:
 			if !ok {
 				errLogsClosed = true
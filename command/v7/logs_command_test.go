@@ -10,8 +10,11 @@ import (
 	"code.cloudfoundry.org/cli/v9/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/v9/actor/v7action"
 	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
 	. "code.cloudfoundry.org/cli/v9/command/v7"
 	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/resources"
 	"code.cloudfoundry.org/cli/v9/util/configv3"
 	"code.cloudfoundry.org/cli/v9/util/ui"
 	. "github.com/onsi/ginkgo/v2"
@@ -50,7 +53,7 @@ var _ = Describe("logs command", func() {
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
-		cmd.RequiredArgs.AppName = "some-app"
+		cmd.OptionalArgs.AppNames = []string{"some-app"}
 		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
 	})
 
@@ -58,6 +61,31 @@ var _ = Describe("logs command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	When("no app names, labels or --all-apps are provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppNames = nil
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("app names are combined with --labels or --all-apps", func() {
+		BeforeEach(func() {
+			cmd.Labels = "team=checkout"
+			cmd.AllApps = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"APP_NAME", "--labels", "--all-apps"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	When("the checkTarget fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(
@@ -151,12 +179,71 @@ var _ = Describe("logs command", func() {
 					Expect(testUI.Out).To(Say("i am message 2"))
 
 					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-					appName, spaceGUID, client := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+					appName, spaceGUID, client, filter := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
 
 					Expect(appName).To(Equal("some-app"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(client).To(Equal(logCacheClient))
+					Expect(filter).To(Equal(sharedaction.LogFilter{}))
+				})
+
+				When("filters are provided", func() {
+					BeforeEach(func() {
+						cmd.SourceTypes = []flag.LogSourceType{"APP", "RTR"}
+						cmd.InstanceIndexes = []int{0, 2}
+						cmd.ProcessTypes = []string{"worker"}
+						Expect(cmd.Since.UnmarshalFlag("2h")).To(Succeed())
+						Expect(cmd.Until.UnmarshalFlag("2024-03-01T12:00:00Z")).To(Succeed())
+					})
+
+					It("passes the filter to the actor", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+
+						_, _, _, filter := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+						Expect(filter.SourceTypes).To(Equal([]string{"APP", "RTR"}))
+						Expect(filter.InstanceIndexes).To(Equal([]int{0, 2}))
+						Expect(filter.ProcessTypes).To(Equal([]string{"worker"}))
+						Expect(filter.Since).To(BeTemporally("~", time.Now().Add(-2*time.Hour), time.Minute))
+						Expect(filter.Until).To(Equal(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)))
+					})
+				})
+
+				When("the output format is json", func() {
+					BeforeEach(func() {
+						testUI.SetOutputFormat(configv3.OutputFormatJSON)
+					})
+
+					It("displays each log message as a line of JSON without flavor text", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).ToNot(Say("Retrieving logs"))
+						Expect(testUI.Out).To(Say(`\{"app":"some-app","timestamp":"1970-01-01T00:00:00Z","source_type":"app","source_instance":"1","type":"1","message":"i am message 1"\}\n`))
+						Expect(testUI.Out).To(Say(`\{"app":"some-app","timestamp":"1970-01-01T00:00:01Z","source_type":"another-app","source_instance":"2","type":"1","message":"i am message 2"\}\n`))
+					})
 				})
+
+				When("the output format is yaml", func() {
+					BeforeEach(func() {
+						testUI.SetOutputFormat(configv3.OutputFormatYAML)
+					})
+
+					It("displays the log messages as a YAML sequence without flavor text", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).ToNot(Say("Retrieving logs"))
+						Expect(testUI.Out).To(Say(`- app: some-app\n  timestamp: 1970-01-01T00:00:00Z\n  source_type: app\n  source_instance: "1"\n  type: "1"\n  message: i am message 1\n`))
+						Expect(testUI.Out).To(Say(`- app: some-app\n  timestamp: 1970-01-01T00:00:01Z\n  source_type: another-app\n`))
+					})
+				})
+			})
+		})
+
+		When("the --until flag is provided without --recent", func() {
+			BeforeEach(func() {
+				Expect(cmd.Until.UnmarshalFlag("1h")).To(Succeed())
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--until", Arg2: "--recent"}))
+				Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
 			})
 		})
 
@@ -205,7 +292,7 @@ var _ = Describe("logs command", func() {
 					expectedErr = errors.New("banana")
 
 					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub =
-						func(appName string, spaceGUID string, client sharedaction.LogCacheClient) (
+						func(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (
 							<-chan sharedaction.LogMessage,
 							<-chan error,
 							context.CancelFunc,
@@ -258,7 +345,7 @@ var _ = Describe("logs command", func() {
 			When("the logs actor returns logs", func() {
 				BeforeEach(func() {
 					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub =
-						func(_ string, _ string, _ sharedaction.LogCacheClient) (
+						func(_ string, _ string, _ sharedaction.LogCacheClient, _ sharedaction.LogFilter) (
 							<-chan sharedaction.LogMessage,
 							<-chan error, context.CancelFunc,
 							v7action.Warnings,
@@ -291,7 +378,7 @@ var _ = Describe("logs command", func() {
 					Expect(testUI.Out).To(Say("Here are some other staging logs!"))
 
 					Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-					appName, spaceGUID, client := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+					appName, spaceGUID, client, _ := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
 
 					Expect(appName).To(Equal("some-app"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
@@ -313,7 +400,7 @@ var _ = Describe("logs command", func() {
 					BeforeEach(func() {
 						cmd.Recent = false
 						fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub =
-							func(_ string, _ string, _ sharedaction.LogCacheClient) (
+							func(_ string, _ string, _ sharedaction.LogCacheClient, _ sharedaction.LogFilter) (
 								<-chan sharedaction.LogMessage,
 								<-chan error, context.CancelFunc,
 								v7action.Warnings,
@@ -350,5 +437,172 @@ var _ = Describe("logs command", func() {
 				})
 			})
 		})
+
+		When("logs for multiple apps are requested", func() {
+			var apps []resources.Application
+
+			BeforeEach(func() {
+				cmd.OptionalArgs.AppNames = []string{"frontend", "orders"}
+				apps = []resources.Application{
+					{Name: "frontend", GUID: "frontend-guid"},
+					{Name: "orders", GUID: "orders-guid"},
+				}
+				fakeActor.GetApplicationsByNamesAndSpaceReturns(apps, v7action.Warnings{"get-apps-warning"}, nil)
+			})
+
+			When("getting the apps fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationsByNamesAndSpaceReturns(nil, v7action.Warnings{"get-apps-warning"}, actionerror.ApplicationsNotFoundError{})
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(executeErr).To(MatchError(actionerror.ApplicationsNotFoundError{}))
+					Expect(testUI.Err).To(Say("get-apps-warning"))
+					Expect(fakeActor.GetRecentLogsForApplicationsCallCount()).To(Equal(0))
+					Expect(fakeActor.GetStreamingLogsForApplicationsCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the --recent flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Recent = true
+					fakeActor.GetRecentLogsForApplicationsReturns([]v7action.ApplicationLogMessage{
+						{
+							LogMessage: *sharedaction.NewLogMessage("frontend message", "OUT", time.Unix(0, 0), "APP/PROC/WEB", "0"),
+							AppName:    "frontend",
+						},
+						{
+							LogMessage: *sharedaction.NewLogMessage("orders message", "OUT", time.Unix(1, 0), "APP/PROC/WEB", "1"),
+							AppName:    "orders",
+						},
+					}, nil)
+				})
+
+				It("displays the logs of all apps prefixed with the app name", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Err).To(Say("get-apps-warning"))
+					Expect(testUI.Out).To(Say("Retrieving logs for apps frontend, orders in org some-org-name / space some-space-name as some-user..."))
+					Expect(testUI.Out).To(Say(`frontend .*frontend message`))
+					Expect(testUI.Out).To(Say(`orders .*orders message`))
+
+					appNames, spaceGUID := fakeActor.GetApplicationsByNamesAndSpaceArgsForCall(0)
+					Expect(appNames).To(Equal([]string{"frontend", "orders"}))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+
+					Expect(fakeActor.GetRecentLogsForApplicationsCallCount()).To(Equal(1))
+					actualApps, client, _ := fakeActor.GetRecentLogsForApplicationsArgsForCall(0)
+					Expect(actualApps).To(Equal(apps))
+					Expect(client).To(Equal(logCacheClient))
+				})
+
+				When("the output format is json", func() {
+					BeforeEach(func() {
+						testUI.SetOutputFormat(configv3.OutputFormatJSON)
+					})
+
+					It("includes the app name in each line of JSON", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).ToNot(Say("Retrieving logs"))
+						Expect(testUI.Out).To(Say(`\{"app":"frontend",.*"message":"frontend message"\}\n`))
+						Expect(testUI.Out).To(Say(`\{"app":"orders",.*"message":"orders message"\}\n`))
+					})
+				})
+			})
+
+			When("the --recent flag is not provided", func() {
+				var cancelFunctionHasBeenCalled bool
+
+				BeforeEach(func() {
+					cancelFunctionHasBeenCalled = false
+					fakeActor.ScheduleTokenRefreshStub = func(
+						after func(time.Duration) <-chan time.Time,
+						stop chan struct{}, stoppedRefreshing chan struct{}) (<-chan error, error) {
+						errCh := make(chan error, 1)
+						go func() {
+							<-stop
+							close(stoppedRefreshing)
+						}()
+						return errCh, nil
+					}
+					fakeActor.GetStreamingLogsForApplicationsStub = func(_ []resources.Application, _ sharedaction.LogCacheClient, _ sharedaction.LogFilter) (
+						<-chan v7action.ApplicationLogMessage,
+						<-chan error,
+						context.CancelFunc) {
+
+						logStream := make(chan v7action.ApplicationLogMessage)
+						errorStream := make(chan error)
+
+						go func() {
+							logStream <- v7action.ApplicationLogMessage{
+								LogMessage: *sharedaction.NewLogMessage("streamed frontend message", "OUT", time.Now(), "APP/PROC/WEB", "0"),
+								AppName:    "frontend",
+							}
+							errorStream <- actionerror.LogCacheTimeoutError{}
+							logStream <- v7action.ApplicationLogMessage{
+								LogMessage: *sharedaction.NewLogMessage("streamed orders message", "OUT", time.Now(), "APP/PROC/WEB", "0"),
+								AppName:    "orders",
+							}
+							close(logStream)
+							close(errorStream)
+						}()
+
+						return logStream, errorStream, func() { cancelFunctionHasBeenCalled = true }
+					}
+				})
+
+				It("streams the logs of all apps prefixed with the app name", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say(`frontend .*streamed frontend message`))
+					Expect(testUI.Out).To(Say(`orders .*streamed orders message`))
+					Expect(testUI.Err).To(Say("timeout connecting to log server"))
+
+					Expect(fakeActor.GetStreamingLogsForApplicationsCallCount()).To(Equal(1))
+					actualApps, client, _ := fakeActor.GetStreamingLogsForApplicationsArgsForCall(0)
+					Expect(actualApps).To(Equal(apps))
+					Expect(client).To(Equal(logCacheClient))
+					Expect(cancelFunctionHasBeenCalled).To(BeTrue())
+				})
+			})
+		})
+
+		When("the --labels flag is provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.AppNames = nil
+				cmd.Labels = "team=checkout"
+				cmd.Recent = true
+			})
+
+			It("gets the apps matching the label selector", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.GetApplicationsBySpaceAndLabelSelectorCallCount()).To(Equal(1))
+				spaceGUID, labelSelector := fakeActor.GetApplicationsBySpaceAndLabelSelectorArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(labelSelector).To(Equal("team=checkout"))
+			})
+
+			When("no apps match the selector", func() {
+				It("displays a message and does not read any logs", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("No apps found."))
+					Expect(fakeActor.GetRecentLogsForApplicationsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("the --all-apps flag is provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.AppNames = nil
+				cmd.AllApps = true
+				cmd.Recent = true
+				fakeActor.GetApplicationsBySpaceReturns([]resources.Application{{Name: "frontend"}}, nil, nil)
+			})
+
+			It("gets all apps in the targeted space", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.GetApplicationsBySpaceCallCount()).To(Equal(1))
+				Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeActor.GetRecentLogsForApplicationsCallCount()).To(Equal(1))
+			})
+		})
 	})
 })
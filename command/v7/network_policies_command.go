@@ -45,6 +45,8 @@ func (cmd *NetworkPoliciesCommand) Setup(config command.Config, ui command.UI) e
 	return nil
 }
 
+func (NetworkPoliciesCommand) SupportsStructuredOutput() {}
+
 func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -60,19 +62,23 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 	var warnings cfnetworkingaction.Warnings
 
 	if cmd.SourceApp != "" {
-		cmd.UI.DisplayTextWithFlavor("Listing network policies of app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
-			"SrcAppName": cmd.SourceApp,
-			"Org":        cmd.Config.TargetedOrganization().Name,
-			"Space":      cmd.Config.TargetedSpace().Name,
-			"User":       user.Name,
-		})
+		if !cmd.UI.IsStructuredOutput() {
+			cmd.UI.DisplayTextWithFlavor("Listing network policies of app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+				"SrcAppName": cmd.SourceApp,
+				"Org":        cmd.Config.TargetedOrganization().Name,
+				"Space":      cmd.Config.TargetedSpace().Name,
+				"User":       user.Name,
+			})
+		}
 		policies, warnings, err = cmd.NetworkingActor.NetworkPoliciesBySpaceAndAppName(cmd.Config.TargetedSpace().GUID, cmd.SourceApp)
 	} else {
-		cmd.UI.DisplayTextWithFlavor("Listing network policies in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
-			"Org":   cmd.Config.TargetedOrganization().Name,
-			"Space": cmd.Config.TargetedSpace().Name,
-			"User":  user.Name,
-		})
+		if !cmd.UI.IsStructuredOutput() {
+			cmd.UI.DisplayTextWithFlavor("Listing network policies in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+				"Org":   cmd.Config.TargetedOrganization().Name,
+				"Space": cmd.Config.TargetedSpace().Name,
+				"User":  user.Name,
+			})
+		}
 		policies, warnings, err = cmd.NetworkingActor.NetworkPoliciesBySpace(cmd.Config.TargetedSpace().GUID)
 	}
 
@@ -81,6 +87,10 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructured(newNetworkPolicyDocuments(policies))
+	}
+
 	cmd.UI.DisplayNewline()
 
 	table := [][]string{
@@ -115,3 +125,29 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 
 	return nil
 }
+
+type networkPolicyDocument struct {
+	Source           string `json:"source" yaml:"source"`
+	Destination      string `json:"destination" yaml:"destination"`
+	Protocol         string `json:"protocol" yaml:"protocol"`
+	StartPort        int    `json:"start_port" yaml:"start_port"`
+	EndPort          int    `json:"end_port" yaml:"end_port"`
+	DestinationSpace string `json:"destination_space" yaml:"destination_space"`
+	DestinationOrg   string `json:"destination_org" yaml:"destination_org"`
+}
+
+func newNetworkPolicyDocuments(policies []cfnetworkingaction.Policy) []networkPolicyDocument {
+	documents := []networkPolicyDocument{}
+	for _, policy := range policies {
+		documents = append(documents, networkPolicyDocument{
+			Source:           policy.SourceName,
+			Destination:      policy.DestinationName,
+			Protocol:         policy.Protocol,
+			StartPort:        policy.StartPort,
+			EndPort:          policy.EndPort,
+			DestinationSpace: policy.DestinationSpaceName,
+			DestinationOrg:   policy.DestinationOrgName,
+		})
+	}
+	return documents
+}
@@ -188,4 +188,37 @@ var _ = Describe("network-policies Command", func() {
 			})
 		})
 	})
+
+	When("a structured output format is set", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+			fakeNetworkPoliciesActor.NetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{
+				{
+					SourceName:           "app1",
+					DestinationName:      "app2",
+					Protocol:             "tcp",
+					StartPort:            8080,
+					EndPort:              8081,
+					DestinationSpaceName: "space",
+					DestinationOrgName:   "org",
+				},
+			}, cfnetworkingaction.Warnings{"warning-1"}, nil)
+		})
+
+		It("outputs the policies as a document", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).NotTo(Say("Listing network policies"))
+			Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`[{
+				"source": "app1",
+				"destination": "app2",
+				"protocol": "tcp",
+				"start_port": 8080,
+				"end_port": 8081,
+				"destination_space": "space",
+				"destination_org": "org"
+			}]`))
+			Expect(testUI.Err).To(Say(`{"warning":"warning-1"}`))
+		})
+	})
 })
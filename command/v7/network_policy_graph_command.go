@@ -0,0 +1,328 @@
+package v7
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"code.cloudfoundry.org/cli/v9/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	"code.cloudfoundry.org/cli/v9/command/v7/shared"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . NetworkPolicyGraphActor
+
+type NetworkPolicyGraphActor interface {
+	GetNetworkPolicyGraph(orgGUID string, spaceGUID string, withRoutePolicies bool) (cfnetworkingaction.PolicyGraph, cfnetworkingaction.Warnings, error)
+}
+
+type NetworkPolicyGraphCommand struct {
+	BaseCommand
+
+	Format        flag.GraphFormat `long:"format" description:"Format of the graph: dot or mermaid (Default: dot)"`
+	Org           string           `short:"o" description:"Only include policies from or to apps in this org"`
+	Space         string           `short:"s" description:"Only include policies from or to apps in this space of the targeted org, or of the org given with -o"`
+	RoutePolicies bool             `long:"route-policies" description:"Add the route policies of domains that enforce them"`
+
+	usage           interface{} `usage:"Print the graph of network policies for rendering with Graphviz or Mermaid. With --output-format, print it as adjacency lists instead.\n\nCF_NAME network-policy-graph [--format (dot | mermaid)] [-o ORG] [-s SPACE] [--route-policies]\n\nEXAMPLES:\n   CF_NAME network-policy-graph | dot -Tsvg > policies.svg\n   CF_NAME network-policy-graph -s backend-space --format mermaid --route-policies"`
+	relatedCommands interface{} `related_commands:"export-network-policies, network-policies, route-policies"`
+
+	NetworkingActor NetworkPolicyGraphActor
+}
+
+type policyGraphNode struct {
+	ID    string `json:"id" yaml:"id"`
+	Type  string `json:"type" yaml:"type"`
+	Name  string `json:"name" yaml:"name"`
+	Space string `json:"space,omitempty" yaml:"space,omitempty"`
+	Org   string `json:"org,omitempty" yaml:"org,omitempty"`
+}
+
+type policyGraphEdge struct {
+	Destination string `json:"destination" yaml:"destination"`
+	Type        string `json:"type" yaml:"type"`
+	Protocol    string `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Ports       string `json:"ports,omitempty" yaml:"ports,omitempty"`
+}
+
+type policyGraphDocument struct {
+	Nodes     []policyGraphNode            `json:"nodes" yaml:"nodes"`
+	Adjacency map[string][]policyGraphEdge `json:"adjacency" yaml:"adjacency"`
+}
+
+func (cmd *NetworkPolicyGraphCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+
+	return nil
+}
+
+func (NetworkPolicyGraphCommand) SupportsStructuredOutput() {}
+
+func (cmd NetworkPolicyGraphCommand) Execute(args []string) error {
+	if cmd.Format != "" && cmd.UI.IsStructuredOutput() {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--format", "--output-format"},
+		}
+	}
+
+	if cmd.RoutePolicies {
+		err := command.MinimumCCAPIVersionCheck(cmd.Config.APIVersion(), ccversion.MinVersionRoutePolicies, "--route-policies")
+		if err != nil {
+			return err
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Space != "" && cmd.Org == "", false)
+	if err != nil {
+		return err
+	}
+
+	var orgGUID, spaceGUID string
+	if cmd.Org != "" {
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Org)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		orgGUID = org.GUID
+	}
+
+	if cmd.Space != "" {
+		spaceOrgGUID := orgGUID
+		if spaceOrgGUID == "" {
+			spaceOrgGUID = cmd.Config.TargetedOrganization().GUID
+		}
+
+		space, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(cmd.Space, spaceOrgGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+		spaceGUID = space.GUID
+	}
+
+	graph, warnings, err := cmd.NetworkingActor.GetNetworkPolicyGraph(orgGUID, spaceGUID, cmd.RoutePolicies)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructured(newPolicyGraphDocument(graph))
+	}
+
+	switch cmd.Format {
+	case "mermaid":
+		return writePolicyGraphMermaid(cmd.UI.GetOut(), graph)
+	default:
+		return writePolicyGraphDOT(cmd.UI.GetOut(), graph)
+	}
+}
+
+func writePolicyGraphDOT(out io.Writer, graph cfnetworkingaction.PolicyGraph) error {
+	ids := policyGraphNodeIDs(graph)
+
+	var b strings.Builder
+	b.WriteString("digraph network_policies {\n")
+	b.WriteString("  rankdir=LR;\n")
+
+	for i, group := range groupPolicyGraphApps(graph) {
+		if group.label == "" {
+			for _, node := range group.nodes {
+				fmt.Fprintf(&b, "  %s [label=%s];\n", ids[node.ID], dotQuote(node.Name))
+			}
+			continue
+		}
+
+		fmt.Fprintf(&b, "  subgraph cluster_%d {\n", i)
+		fmt.Fprintf(&b, "    label=%s;\n", dotQuote(group.label))
+		for _, node := range group.nodes {
+			fmt.Fprintf(&b, "    %s [label=%s];\n", ids[node.ID], dotQuote(node.Name))
+		}
+		b.WriteString("  }\n")
+	}
+
+	for _, node := range graph.Nodes {
+		switch node.Type {
+		case cfnetworkingaction.PolicyGraphAppNode:
+		case cfnetworkingaction.PolicyGraphRouteNode:
+			fmt.Fprintf(&b, "  %s [label=%s, shape=box];\n", ids[node.ID], dotQuote(node.Name))
+		default:
+			fmt.Fprintf(&b, "  %s [label=%s, shape=hexagon];\n", ids[node.ID], dotQuote(policyGraphSourceLabel(node)))
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		if edge.Type == cfnetworkingaction.PolicyGraphRouteEdge {
+			fmt.Fprintf(&b, "  %s -> %s [style=dashed];\n", ids[edge.SourceID], ids[edge.DestinationID])
+			continue
+		}
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", ids[edge.SourceID], ids[edge.DestinationID], dotQuote(policyGraphEdgeLabel(edge)))
+	}
+
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(out, b.String())
+	return err
+}
+
+func writePolicyGraphMermaid(out io.Writer, graph cfnetworkingaction.PolicyGraph) error {
+	ids := policyGraphNodeIDs(graph)
+
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+
+	for i, group := range groupPolicyGraphApps(graph) {
+		if group.label == "" {
+			for _, node := range group.nodes {
+				fmt.Fprintf(&b, "  %s[%s]\n", ids[node.ID], mermaidQuote(node.Name))
+			}
+			continue
+		}
+
+		fmt.Fprintf(&b, "  subgraph s%d[%s]\n", i, mermaidQuote(group.label))
+		for _, node := range group.nodes {
+			fmt.Fprintf(&b, "    %s[%s]\n", ids[node.ID], mermaidQuote(node.Name))
+		}
+		b.WriteString("  end\n")
+	}
+
+	for _, node := range graph.Nodes {
+		switch node.Type {
+		case cfnetworkingaction.PolicyGraphAppNode:
+		case cfnetworkingaction.PolicyGraphRouteNode:
+			fmt.Fprintf(&b, "  %s([%s])\n", ids[node.ID], mermaidQuote(node.Name))
+		default:
+			fmt.Fprintf(&b, "  %s{{%s}}\n", ids[node.ID], mermaidQuote(policyGraphSourceLabel(node)))
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		if edge.Type == cfnetworkingaction.PolicyGraphRouteEdge {
+			fmt.Fprintf(&b, "  %s -.-> %s\n", ids[edge.SourceID], ids[edge.DestinationID])
+			continue
+		}
+		fmt.Fprintf(&b, "  %s -->|%s| %s\n", ids[edge.SourceID], mermaidQuote(policyGraphEdgeLabel(edge)), ids[edge.DestinationID])
+	}
+
+	_, err := io.WriteString(out, b.String())
+	return err
+}
+
+func newPolicyGraphDocument(graph cfnetworkingaction.PolicyGraph) policyGraphDocument {
+	document := policyGraphDocument{
+		Nodes:     []policyGraphNode{},
+		Adjacency: map[string][]policyGraphEdge{},
+	}
+
+	for _, node := range graph.Nodes {
+		document.Nodes = append(document.Nodes, policyGraphNode{
+			ID:    node.ID,
+			Type:  node.Type,
+			Name:  node.Name,
+			Space: node.SpaceName,
+			Org:   node.OrgName,
+		})
+		document.Adjacency[node.ID] = []policyGraphEdge{}
+	}
+
+	for _, edge := range graph.Edges {
+		documentEdge := policyGraphEdge{
+			Destination: edge.DestinationID,
+			Type:        edge.Type,
+		}
+		if edge.Type == cfnetworkingaction.PolicyGraphNetworkEdge {
+			documentEdge.Protocol = edge.Protocol
+			documentEdge.Ports = policyGraphPorts(edge)
+		}
+		document.Adjacency[edge.SourceID] = append(document.Adjacency[edge.SourceID], documentEdge)
+	}
+
+	return document
+}
+
+type policyGraphAppGroup struct {
+	label string
+	nodes []cfnetworkingaction.PolicyGraphNode
+}
+
+// groupPolicyGraphApps groups the app nodes by org and space, keeping the
+// node order. Apps whose space is unknown are grouped under an empty label.
+func groupPolicyGraphApps(graph cfnetworkingaction.PolicyGraph) []policyGraphAppGroup {
+	var groups []policyGraphAppGroup
+	positions := map[string]int{}
+	for _, node := range graph.Nodes {
+		if node.Type != cfnetworkingaction.PolicyGraphAppNode {
+			continue
+		}
+
+		label := ""
+		if node.SpaceName != "" {
+			label = node.OrgName + " / " + node.SpaceName
+		}
+
+		position, ok := positions[label]
+		if !ok {
+			position = len(groups)
+			positions[label] = position
+			groups = append(groups, policyGraphAppGroup{label: label})
+		}
+		groups[position].nodes = append(groups[position].nodes, node)
+	}
+	return groups
+}
+
+func policyGraphNodeIDs(graph cfnetworkingaction.PolicyGraph) map[string]string {
+	ids := map[string]string{}
+	for i, node := range graph.Nodes {
+		ids[node.ID] = fmt.Sprintf("n%d", i)
+	}
+	return ids
+}
+
+func policyGraphSourceLabel(node cfnetworkingaction.PolicyGraphNode) string {
+	switch node.Type {
+	case cfnetworkingaction.PolicyGraphSpaceNode:
+		if node.OrgName != "" {
+			return "space " + node.OrgName + " / " + node.Name
+		}
+		return "space " + node.Name
+	case cfnetworkingaction.PolicyGraphOrgNode:
+		return "org " + node.Name
+	default:
+		return node.Name
+	}
+}
+
+func policyGraphEdgeLabel(edge cfnetworkingaction.PolicyGraphEdge) string {
+	return edge.Protocol + ":" + policyGraphPorts(edge)
+}
+
+func policyGraphPorts(edge cfnetworkingaction.PolicyGraphEdge) string {
+	if edge.StartPort == edge.EndPort {
+		return fmt.Sprint(edge.StartPort)
+	}
+	return fmt.Sprintf("%d-%d", edge.StartPort, edge.EndPort)
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func mermaidQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+}
@@ -0,0 +1,285 @@
+package v7_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	. "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("network-policy-graph Command", func() {
+	var (
+		cmd             NetworkPolicyGraphCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeGraphActor  *v7fakes.FakeNetworkPolicyGraphActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeGraphActor = new(v7fakes.FakeNetworkPolicyGraphActor)
+
+		cmd = NetworkPolicyGraphCommand{
+			BaseCommand: BaseCommand{
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				UI:          testUI,
+				Actor:       fakeActor,
+			},
+			NetworkingActor: fakeGraphActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.APIVersionReturns(ccversion.MinVersionRoutePolicies)
+
+		fakeGraphActor.GetNetworkPolicyGraphReturns(cfnetworkingaction.PolicyGraph{
+			Nodes: []cfnetworkingaction.PolicyGraphNode{
+				{ID: "db-guid", Type: cfnetworkingaction.PolicyGraphAppNode, Name: "db-guid"},
+				{ID: "backend-guid", Type: cfnetworkingaction.PolicyGraphAppNode, Name: "backend", SpaceName: "api", OrgName: "shop"},
+				{ID: "frontend-guid", Type: cfnetworkingaction.PolicyGraphAppNode, Name: "frontend", SpaceName: "web", OrgName: "shop"},
+				{ID: "route-guid", Type: cfnetworkingaction.PolicyGraphRouteNode, Name: "backend.apps.internal"},
+				{ID: "web-guid", Type: cfnetworkingaction.PolicyGraphSpaceNode, Name: "web", SpaceName: "web", OrgName: "shop"},
+			},
+			Edges: []cfnetworkingaction.PolicyGraphEdge{
+				{SourceID: "backend-guid", DestinationID: "db-guid", Type: cfnetworkingaction.PolicyGraphNetworkEdge, Protocol: "udp", StartPort: 5432, EndPort: 5432},
+				{SourceID: "frontend-guid", DestinationID: "backend-guid", Type: cfnetworkingaction.PolicyGraphNetworkEdge, Protocol: "tcp", StartPort: 8080, EndPort: 8090},
+				{SourceID: "web-guid", DestinationID: "route-guid", Type: cfnetworkingaction.PolicyGraphRouteEdge},
+			},
+		}, cfnetworkingaction.Warnings{"graph-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	It("prints the graph of all policies as DOT", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(testUI.Err).To(Say("graph-warning"))
+
+		orgGUID, spaceGUID, withRoutePolicies := fakeGraphActor.GetNetworkPolicyGraphArgsForCall(0)
+		Expect(orgGUID).To(BeEmpty())
+		Expect(spaceGUID).To(BeEmpty())
+		Expect(withRoutePolicies).To(BeFalse())
+
+		Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(`digraph network_policies {
+  rankdir=LR;
+  n0 [label="db-guid"];
+  subgraph cluster_1 {
+    label="shop / api";
+    n1 [label="backend"];
+  }
+  subgraph cluster_2 {
+    label="shop / web";
+    n2 [label="frontend"];
+  }
+  n3 [label="backend.apps.internal", shape=box];
+  n4 [label="space shop / web", shape=hexagon];
+  n1 -> n0 [label="udp:5432"];
+  n2 -> n1 [label="tcp:8080-8090"];
+  n4 -> n3 [style=dashed];
+}
+`))
+	})
+
+	When("the format is mermaid", func() {
+		BeforeEach(func() {
+			cmd.Format = "mermaid"
+		})
+
+		It("prints the graph as a Mermaid flowchart", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(`flowchart LR
+  n0["db-guid"]
+  subgraph s1["shop / api"]
+    n1["backend"]
+  end
+  subgraph s2["shop / web"]
+    n2["frontend"]
+  end
+  n3(["backend.apps.internal"])
+  n4{{"space shop / web"}}
+  n1 -->|"udp:5432"| n0
+  n2 -->|"tcp:8080-8090"| n1
+  n4 -.-> n3
+`))
+		})
+	})
+
+	When("the output format is json", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+		})
+
+		It("prints the graph as JSON adjacency lists", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			var data struct {
+				Nodes []struct {
+					ID   string `json:"id"`
+					Type string `json:"type"`
+					Name string `json:"name"`
+				} `json:"nodes"`
+				Adjacency map[string][]map[string]string `json:"adjacency"`
+			}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &data)).To(Succeed())
+
+			Expect(data.Nodes).To(HaveLen(5))
+			Expect(data.Adjacency["frontend-guid"]).To(ConsistOf(map[string]string{
+				"destination": "backend-guid",
+				"type":        "network",
+				"protocol":    "tcp",
+				"ports":       "8080-8090",
+			}))
+			Expect(data.Adjacency["web-guid"]).To(ConsistOf(map[string]string{
+				"destination": "route-guid",
+				"type":        "route",
+			}))
+			Expect(data.Adjacency["db-guid"]).To(BeEmpty())
+		})
+	})
+
+	When("the output format is yaml", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatYAML)
+		})
+
+		It("prints the graph as YAML adjacency lists", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`nodes:\n- id: db-guid\n  type: app\n  name: db-guid\n`))
+			Expect(testUI.Out).To(Say(`  frontend-guid:\n  - destination: backend-guid\n    type: network\n    protocol: tcp\n    ports: 8080-8090\n`))
+		})
+	})
+
+	When("--format and --output-format are both given", func() {
+		BeforeEach(func() {
+			cmd.Format = "mermaid"
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--format", "--output-format"},
+			}))
+			Expect(fakeGraphActor.GetNetworkPolicyGraphCallCount()).To(Equal(0))
+		})
+	})
+
+	When("scoped to a space of the targeted org", func() {
+		BeforeEach(func() {
+			cmd.Space = "web"
+			fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{GUID: "web-guid"}, v7action.Warnings{"space-warning"}, nil)
+		})
+
+		It("graphs the policies of the space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Err).To(Say("space-warning"))
+
+			checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+
+			spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(spaceName).To(Equal("web"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+
+			orgGUID, spaceGUID, _ := fakeGraphActor.GetNetworkPolicyGraphArgsForCall(0)
+			Expect(orgGUID).To(BeEmpty())
+			Expect(spaceGUID).To(Equal("web-guid"))
+		})
+	})
+
+	When("scoped to an org", func() {
+		BeforeEach(func() {
+			cmd.Org = "shop"
+			fakeActor.GetOrganizationByNameReturns(resources.Organization{GUID: "shop-guid"}, v7action.Warnings{"org-warning"}, nil)
+		})
+
+		It("graphs the policies of the org", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Err).To(Say("org-warning"))
+
+			orgGUID, spaceGUID, _ := fakeGraphActor.GetNetworkPolicyGraphArgsForCall(0)
+			Expect(orgGUID).To(Equal("shop-guid"))
+			Expect(spaceGUID).To(BeEmpty())
+		})
+
+		When("the org does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationByNameReturns(resources.Organization{}, nil, actionerror.OrganizationNotFoundError{Name: "shop"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "shop"}))
+				Expect(fakeGraphActor.GetNetworkPolicyGraphCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("--route-policies is set", func() {
+		BeforeEach(func() {
+			cmd.RoutePolicies = true
+		})
+
+		It("adds route policies to the graph", func() {
+			_, _, withRoutePolicies := fakeGraphActor.GetNetworkPolicyGraphArgsForCall(0)
+			Expect(withRoutePolicies).To(BeTrue())
+		})
+
+		When("the API does not support route policies", func() {
+			BeforeEach(func() {
+				fakeConfig.APIVersionReturns("3.100.0")
+			})
+
+			It("returns a minimum version error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+					Command:        "--route-policies",
+					CurrentVersion: "3.100.0",
+					MinimumVersion: ccversion.MinVersionRoutePolicies,
+				}))
+			})
+		})
+	})
+
+	When("building the graph fails", func() {
+		BeforeEach(func() {
+			fakeGraphActor.GetNetworkPolicyGraphReturns(cfnetworkingaction.PolicyGraph{}, cfnetworkingaction.Warnings{"graph-warning"}, errors.New("graph-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("graph-error"))
+			Expect(testUI.Err).To(Say("graph-warning"))
+		})
+	})
+})
@@ -13,6 +13,8 @@ type OrgsCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter orgs by labels"`
 }
 
+func (OrgsCommand) SupportsStructuredOutput() {}
+
 func (cmd OrgsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -24,10 +26,12 @@ func (cmd OrgsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting orgs as {{.CurrentUser}}...", map[string]interface{}{
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting orgs as {{.CurrentUser}}...", map[string]interface{}{
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	orgs, warnings, err := cmd.Actor.GetOrganizations(cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
@@ -35,6 +39,10 @@ func (cmd OrgsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructured(newOrgDocuments(orgs))
+	}
+
 	if len(orgs) == 0 {
 		cmd.UI.DisplayText("No orgs found.")
 	} else {
@@ -51,3 +59,21 @@ func (cmd OrgsCommand) displayOrgs(orgs []resources.Organization) {
 	}
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 }
+
+type orgDocument struct {
+	Name      string `json:"name" yaml:"name"`
+	GUID      string `json:"guid" yaml:"guid"`
+	Suspended bool   `json:"suspended" yaml:"suspended"`
+}
+
+func newOrgDocuments(orgs []resources.Organization) []orgDocument {
+	documents := []orgDocument{}
+	for _, org := range orgs {
+		documents = append(documents, orgDocument{
+			Name:      org.Name,
+			GUID:      org.GUID,
+			Suspended: org.Suspended,
+		})
+	}
+	return documents
+}
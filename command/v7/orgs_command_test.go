@@ -161,4 +161,31 @@ var _ = Describe("orgs Command", func() {
 			})
 		})
 	})
+
+	When("a structured output format is set", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatYAML)
+			fakeActor.GetOrganizationsReturns(
+				[]resources.Organization{
+					{Name: "org-1", GUID: "org-guid-1"},
+					{Name: "org-2", GUID: "org-guid-2", Suspended: true},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("outputs the orgs as a document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(`- name: org-1
+  guid: org-guid-1
+  suspended: false
+- name: org-2
+  guid: org-guid-2
+  suspended: true
+`))
+			Expect(testUI.Err).To(Say(`{"warning":"warning-1"}`))
+		})
+	})
 })
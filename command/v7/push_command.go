@@ -1,12 +1,17 @@
 package v7
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 
+	"code.cloudfoundry.org/bytefmt"
 	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccversion"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	log "github.com/sirupsen/logrus"
@@ -28,6 +33,7 @@ import (
 	"code.cloudfoundry.org/cli/v9/util/configv3"
 	"code.cloudfoundry.org/cli/v9/util/manifestparser"
 	"code.cloudfoundry.org/cli/v9/util/progressbar"
+	"code.cloudfoundry.org/cli/v9/util/ui"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ProgressBar
@@ -44,6 +50,7 @@ type PushActor interface {
 	HandleFlagOverrides(baseManifest manifestparser.Manifest, flagOverrides v7pushaction.FlagOverrides) (manifestparser.Manifest, error)
 	HandleDeploymentScaleFlagOverrides(manifest manifestparser.Manifest, flagOverrides v7pushaction.FlagOverrides) (manifestparser.Manifest, error)
 	CreatePushPlans(spaceGUID string, orgGUID string, manifest manifestparser.Manifest, overrides v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)
+	PreviewUpload(plan v7pushaction.PushPlan) ([]v7pushaction.UploadPreviewFile, v7pushaction.Warnings, error)
 	// Actualize applies any necessary changes.
 	Actualize(plan v7pushaction.PushPlan, progressBar v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent
 }
@@ -54,16 +61,18 @@ type V7ActorForPush interface {
 	GetApplicationByNameAndSpace(name string, spaceGUID string) (resources.Application, v7action.Warnings, error)
 	GetDetailedAppSummary(appName string, spaceGUID string, withObfuscatedValues bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
 	SetSpaceManifest(spaceGUID string, rawManifest []byte) (v7action.Warnings, error)
-	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
+	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
 	RestartApplication(appGUID string, noWait bool) (v7action.Warnings, error)
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ManifestParser
 
 type ManifestParser interface {
-	InterpolateManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]byte, error)
+	InterpolateManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV, pathsToOpsFiles []string) ([]byte, error)
 	ParseManifest(pathToManifest string, rawManifest []byte) (manifestparser.Manifest, error)
 	MarshalManifest(manifest manifestparser.Manifest) ([]byte, error)
+	ValidateManifest(pathToManifest string) error
+	ValidateInterpolatedManifest(pathToManifest string, rawManifest []byte) error
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ManifestLocator
@@ -81,39 +90,44 @@ type DiffDisplayer interface {
 type PushCommand struct {
 	BaseCommand
 
-	OptionalArgs            flag.OptionalAppName                `positional-args:"yes"`
-	HealthCheckTimeout      flag.PositiveInteger                `long:"app-start-timeout" short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
-	Buildpacks              []string                            `long:"buildpack" short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
-	Disk                    string                              `long:"disk" short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	DockerImage             flag.DockerImage                    `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
-	DockerUsername          string                              `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
-	DropletPath             flag.PathWithExistenceCheck         `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
-	HealthCheckHTTPEndpoint string                              `long:"endpoint"  description:"Valid path on the app for an HTTP health check. Only used when specifying --health-check-type=http"`
-	HealthCheckType         flag.HealthCheckType                `long:"health-check-type" short:"u" description:"Application health check type. Defaults to 'port'. 'http' requires a valid endpoint, for example, '/health'."`
-	Instances               flag.Instances                      `long:"instances" short:"i" description:"Number of instances"`
-	InstanceSteps           string                              `long:"instance-steps" description:"An array of percentage steps to deploy when using deployment strategy canary. (e.g. 20,40,60)"`
-	Lifecycle               constant.AppLifecycleType           `long:"lifecycle" description:"App lifecycle type to stage and run the app" default:""`
-	LogRateLimit            string                              `long:"log-rate-limit" short:"l" description:"Log rate limit per second, in bytes (e.g. 128B, 4K, 1M). -l=-1 represents unlimited"`
-	PathToManifest          flag.ManifestPathWithExistenceCheck `long:"manifest" short:"f" description:"Path to manifest"`
-	MaxInFlight             *int                                `long:"max-in-flight" description:"Defines the maximum number of instances that will be actively being started. Only applies when --strategy flag is specified."`
-	Memory                  string                              `long:"memory" short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	NoManifest              bool                                `long:"no-manifest" description:"Ignore manifest file"`
-	NoRoute                 bool                                `long:"no-route" description:"Do not map a route to this app"`
-	NoStart                 bool                                `long:"no-start" description:"Do not stage and start the app after pushing"`
-	NoWait                  bool                                `long:"no-wait" description:"Exit when the first instance of the web process is healthy"`
-	AppPath                 flag.PathWithExistenceCheck         `long:"path" short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
-	RandomRoute             bool                                `long:"random-route" description:"Create a random route for this app (except when no-route is specified in the manifest)"`
-	RedactEnv               bool                                `long:"redact-env" description:"Do not print values for environment vars set in the application manifest"`
-	Stack                   string                              `long:"stack" short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
-	StartCommand            flag.Command                        `long:"start-command" short:"c" description:"Startup command, set to null to reset to default start command"`
-	Strategy                flag.DeploymentStrategy             `long:"strategy" description:"Deployment strategy can be canary, rolling or null."`
-	Task                    bool                                `long:"task" description:"Push an app that is used only to execute tasks. The app will be staged, but not started and will have no route assigned."`
-	Vars                    []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
-	PathsToVarsFiles        []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
-	dockerPassword          interface{}                         `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--lifecycle (buildpack | docker | cnb)] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
-	envCFStagingTimeout     interface{}                         `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
-	envCFStartupTimeout     interface{}                         `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	OptionalArgs             flag.OptionalAppName                `positional-args:"yes"`
+	HealthCheckTimeout       flag.PositiveInteger                `long:"app-start-timeout" short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
+	Buildpacks               []string                            `long:"buildpack" short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
+	Disk                     string                              `long:"disk" short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	DockerImage              flag.DockerImage                    `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
+	DockerUsername           string                              `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
+	DropletPath              flag.PathWithExistenceCheck         `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
+	HealthCheckHTTPEndpoint  string                              `long:"endpoint"  description:"Valid path on the app for an HTTP health check. Only used when specifying --health-check-type=http"`
+	FailFast                 bool                                `long:"fail-fast" description:"Stop pushing the remaining apps after the first app fails to push. Only applies when --parallel flag is specified."`
+	HealthCheckType          flag.HealthCheckType                `long:"health-check-type" short:"u" description:"Application health check type. Defaults to 'port'. 'http' requires a valid endpoint, for example, '/health'."`
+	Instances                flag.Instances                      `long:"instances" short:"i" description:"Number of instances"`
+	InstanceSteps            string                              `long:"instance-steps" description:"An array of percentage steps to deploy when using deployment strategy canary. (e.g. 20,40,60)"`
+	Lifecycle                constant.AppLifecycleType           `long:"lifecycle" description:"App lifecycle type to stage and run the app" default:""`
+	LogRateLimit             string                              `long:"log-rate-limit" short:"l" description:"Log rate limit per second, in bytes (e.g. 128B, 4K, 1M). -l=-1 represents unlimited"`
+	PathToManifest           flag.ManifestPathWithExistenceCheck `long:"manifest" short:"f" description:"Path to manifest"`
+	MaxInFlight              *int                                `long:"max-in-flight" description:"Defines the maximum number of instances that will be actively being started. Only applies when --strategy flag is specified."`
+	Memory                   string                              `long:"memory" short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	NoManifest               bool                                `long:"no-manifest" description:"Ignore manifest file"`
+	NoRoute                  bool                                `long:"no-route" description:"Do not map a route to this app"`
+	NoStart                  bool                                `long:"no-start" description:"Do not stage and start the app after pushing"`
+	NoWait                   bool                                `long:"no-wait" description:"Exit when the first instance of the web process is healthy"`
+	PathsToOpsFiles          []flag.PathWithExistenceCheck       `long:"ops-file" description:"Path to an operations file applied to the manifest after variable substitution; can specify multiple times"`
+	Parallel                 flag.PositiveInteger                `long:"parallel" description:"Push up to this many apps from the manifest at the same time. Output is grouped per app and a summary is displayed at the end."`
+	AppPath                  flag.PathWithExistenceCheck         `long:"path" short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	PreviewUpload            bool                                `long:"preview-upload" description:"List the files that would be uploaded for each app and which of them resource matching would skip, without pushing"`
+	RandomRoute              bool                                `long:"random-route" description:"Create a random route for this app (except when no-route is specified in the manifest)"`
+	RedactEnv                bool                                `long:"redact-env" description:"Do not print values for environment vars set in the application manifest"`
+	Stack                    string                              `long:"stack" short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	StartCommand             flag.Command                        `long:"start-command" short:"c" description:"Startup command, set to null to reset to default start command"`
+	Strategy                 flag.DeploymentStrategy             `long:"strategy" description:"Deployment strategy can be canary, rolling or null."`
+	StrictManifestValidation bool                                `long:"strict-manifest-validation" description:"Fail instead of warning when the manifest has unknown fields or invalid values"`
+	Task                     bool                                `long:"task" description:"Push an app that is used only to execute tasks. The app will be staged, but not started and will have no route assigned."`
+	Vars                     []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles         []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	dockerPassword           interface{}                         `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
+	usage                    interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--lifecycle (buildpack | docker | cnb)] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
+	envCFStagingTimeout      interface{}                         `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout      interface{}                         `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
 	LogCacheClient  sharedaction.LogCacheClient
 	PushActor       PushActor
@@ -210,6 +224,10 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.PreviewUpload {
+		return cmd.displayUploadPreviews(transformedFinalManifest, flagOverrides, user)
+	}
+
 	cmd.announcePushing(transformedFinalManifest.AppNames(), user)
 
 	hasManifest := transformedFinalManifest.PathToManifest != ""
@@ -260,19 +278,14 @@ func (cmd PushCommand) Execute(args []string) error {
 		}
 	}()
 
+	if cmd.Parallel.Value > 0 {
+		return cmd.actualizePlansInParallel(pushPlans, transformedFinalManifest)
+	}
+
 	for _, plan := range pushPlans {
-		log.WithField("app_name", plan.Application.Name).Info("actualizing")
-		eventStream := cmd.PushActor.Actualize(plan, cmd.ProgressBar)
-		err := cmd.eventStreamHandler(eventStream)
-
-		if cmd.shouldDisplaySummary(err) {
-			summaryErr := cmd.displayAppSummary(plan)
-			if summaryErr != nil {
-				return summaryErr
-			}
-		}
+		err := cmd.actualizePlan(plan)
 		if err != nil {
-			return cmd.mapErr(plan.Application.Name, err)
+			return err
 		}
 	}
 
@@ -308,7 +321,7 @@ func (cmd PushCommand) GetBaseManifest(flagOverrides v7pushaction.FlagOverrides)
 	}
 
 	log.WithField("manifestPath", pathToManifest).Debug("path to manifest")
-	rawManifest, err := cmd.ManifestParser.InterpolateManifest(pathToManifest, flagOverrides.PathsToVarsFiles, flagOverrides.Vars)
+	rawManifest, err := cmd.ManifestParser.InterpolateManifest(pathToManifest, flagOverrides.PathsToVarsFiles, flagOverrides.Vars, flagOverrides.PathsToOpsFiles)
 	if err != nil {
 		log.Errorln("reading manifest:", err)
 		if _, ok := err.(*yaml.TypeError); ok {
@@ -317,6 +330,12 @@ func (cmd PushCommand) GetBaseManifest(flagOverrides v7pushaction.FlagOverrides)
 		return manifestparser.Manifest{}, err
 	}
 
+	err = cmd.validateManifest(pathToManifest, rawManifest, flagOverrides)
+	if err != nil {
+		log.Errorln("validating manifest:", err)
+		return manifestparser.Manifest{}, err
+	}
+
 	manifest, err := cmd.ManifestParser.ParseManifest(pathToManifest, rawManifest)
 	if err != nil {
 		log.Errorln("parsing manifest:", err)
@@ -326,6 +345,33 @@ func (cmd PushCommand) GetBaseManifest(flagOverrides v7pushaction.FlagOverrides)
 	return manifest, nil
 }
 
+// validateManifest checks the manifest against the schema of app manifests
+// once its variables have been interpolated and its ops files applied. The
+// problems are displayed as warnings unless --strict-manifest-validation is
+// set. Without vars or ops files the file itself is validated, so that the
+// warnings point at its lines.
+func (cmd PushCommand) validateManifest(pathToManifest string, rawManifest []byte, flagOverrides v7pushaction.FlagOverrides) error {
+	var err error
+	if len(flagOverrides.PathsToVarsFiles) == 0 && len(flagOverrides.Vars) == 0 && len(flagOverrides.PathsToOpsFiles) == 0 {
+		err = cmd.ManifestParser.ValidateManifest(pathToManifest)
+	} else {
+		err = cmd.ManifestParser.ValidateInterpolatedManifest(pathToManifest, rawManifest)
+	}
+
+	validationErr, ok := err.(manifestparser.ManifestValidationError)
+	if !ok || cmd.StrictManifestValidation {
+		return err
+	}
+
+	for _, fieldErr := range validationErr.Errors {
+		cmd.UI.DisplayWarning("Manifest {{.Path}}: {{.Problem}}", map[string]interface{}{
+			"Path":    validationErr.Path,
+			"Problem": fieldErr.Error(),
+		})
+	}
+	return nil
+}
+
 func (cmd PushCommand) GetDockerPassword(dockerUsername string, containsPrivateDockerImages bool) (string, error) {
 	if dockerUsername == "" && !containsPrivateDockerImages { // no need for a password without a username
 		return "", nil
@@ -346,6 +392,11 @@ func (cmd PushCommand) GetFlagOverrides() (v7pushaction.FlagOverrides, error) {
 		pathsToVarsFiles = append(pathsToVarsFiles, string(varFilePath))
 	}
 
+	var pathsToOpsFiles []string
+	for _, opsFilePath := range cmd.PathsToOpsFiles {
+		pathsToOpsFiles = append(pathsToOpsFiles, string(opsFilePath))
+	}
+
 	var instanceSteps []int64
 	if len(cmd.InstanceSteps) > 0 {
 		for _, v := range strings.Split(cmd.InstanceSteps, ",") {
@@ -381,6 +432,7 @@ func (cmd PushCommand) GetFlagOverrides() (v7pushaction.FlagOverrides, error) {
 		Strategy:            cmd.Strategy.Name,
 		ManifestPath:        string(cmd.PathToManifest),
 		PathsToVarsFiles:    pathsToVarsFiles,
+		PathsToOpsFiles:     pathsToOpsFiles,
 		Vars:                cmd.Vars,
 		NoManifest:          cmd.NoManifest,
 		Task:                cmd.Task,
@@ -495,6 +547,14 @@ func (cmd PushCommand) ValidateFlags() error {
 			},
 		}
 
+	case cmd.NoManifest && len(cmd.PathsToOpsFiles) > 0:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--no-manifest",
+				"--ops-file",
+			},
+		}
+
 	case cmd.NoManifest && len(cmd.Vars) > 0:
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
@@ -575,6 +635,22 @@ func (cmd PushCommand) ValidateFlags() error {
 		return translatableerror.IncorrectUsageError{Message: "--max-in-flight must be greater than or equal to 1"}
 	case len(cmd.InstanceSteps) > 0 && cmd.Strategy.Name != constant.DeploymentStrategyCanary:
 		return translatableerror.ArgumentCombinationError{Args: []string{"--instance-steps", "--strategy=rolling or --strategy not provided"}}
+	case cmd.PreviewUpload && cmd.DockerImage.Path != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--preview-upload",
+				"--docker-image, -o",
+			},
+		}
+	case cmd.PreviewUpload && cmd.DropletPath != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--preview-upload",
+				"--droplet",
+			},
+		}
+	case cmd.FailFast && cmd.Parallel.Value == 0:
+		return translatableerror.RequiredFlagsError{Arg1: "--fail-fast", Arg2: "--parallel"}
 	case len(cmd.InstanceSteps) > 0 && !validateInstanceSteps(cmd.InstanceSteps):
 		return translatableerror.ParseArgumentError{ArgumentName: "--instance-steps", ExpectedType: "list of weights"}
 	}
@@ -605,6 +681,286 @@ func (cmd PushCommand) validBuildpacks() bool {
 	return true
 }
 
+func (cmd *PushCommand) actualizePlan(plan v7pushaction.PushPlan) error {
+	log.WithField("app_name", plan.Application.Name).Info("actualizing")
+	eventStream := cmd.PushActor.Actualize(plan, cmd.ProgressBar)
+	err := cmd.eventStreamHandler(eventStream)
+
+	if cmd.shouldDisplaySummary(err) {
+		summaryErr := cmd.displayAppSummary(plan)
+		if summaryErr != nil {
+			return summaryErr
+		}
+	}
+	if err != nil {
+		return cmd.mapErr(plan.Application.Name, err)
+	}
+
+	return nil
+}
+
+// actualizePlansInParallel pushes up to cmd.Parallel apps at a time. Apps
+// that share a route or a service instance are pushed one after another. The
+// output of each app is collected and displayed in one piece once the app has
+// finished, followed by a summary of all apps at the end. A failed app does
+// not stop the others unless --fail-fast is set, in which case the apps that
+// have not started yet are skipped.
+func (cmd PushCommand) actualizePlansInParallel(pushPlans []v7pushaction.PushPlan, manifest manifestparser.Manifest) error {
+	var (
+		wg         sync.WaitGroup
+		outputLock sync.Mutex
+		failed     atomic.Bool
+	)
+	statuses := make([]string, len(pushPlans))
+	inFlight := make(chan struct{}, cmd.Parallel.Value)
+
+	groups := parallelPushGroups(pushPlans, manifest)
+	for _, group := range groups {
+		if len(group) > 1 {
+			var appNames []string
+			for _, i := range group {
+				appNames = append(appNames, pushPlans[i].Application.Name)
+			}
+			cmd.UI.DisplayText("Apps {{.AppNames}} share routes or service instances and will be pushed one after another.", map[string]interface{}{
+				"AppNames": strings.Join(appNames, ", "),
+			})
+		}
+	}
+
+	for _, group := range groups {
+		inFlight <- struct{}{}
+
+		wg.Add(1)
+		go func(group []int) {
+			defer func() {
+				<-inFlight
+				wg.Done()
+			}()
+
+			for _, i := range group {
+				if cmd.FailFast && failed.Load() {
+					statuses[i] = "skipped"
+					continue
+				}
+
+				statuses[i] = "pushed"
+				if err := cmd.actualizePlanWithGroupedOutput(pushPlans[i], &outputLock); err != nil {
+					statuses[i] = "failed"
+					failed.Store(true)
+				}
+			}
+		}(group)
+	}
+	wg.Wait()
+
+	return cmd.displayParallelPushSummary(pushPlans, statuses)
+}
+
+// parallelPushGroups groups the indexes of the plans whose apps share a route
+// or a service instance in the manifest, directly or through other apps. The
+// groups and the plans within them keep the order of pushPlans.
+func parallelPushGroups(pushPlans []v7pushaction.PushPlan, manifest manifestparser.Manifest) [][]int {
+	appsByName := map[string]manifestparser.Application{}
+	for _, app := range manifest.Applications {
+		appsByName[app.Name] = app
+	}
+
+	parents := make([]int, len(pushPlans))
+	var root func(i int) int
+	root = func(i int) int {
+		if parents[i] != i {
+			parents[i] = root(parents[i])
+		}
+		return parents[i]
+	}
+
+	firstUsers := map[string]int{}
+	for i, plan := range pushPlans {
+		parents[i] = i
+
+		app := appsByName[plan.Application.Name]
+		var resources []string
+		for _, route := range app.RouteNames() {
+			resources = append(resources, "route:"+route)
+		}
+		for _, serviceInstance := range app.ServiceInstanceNames() {
+			resources = append(resources, "service:"+serviceInstance)
+		}
+
+		for _, resource := range resources {
+			j, used := firstUsers[resource]
+			if !used {
+				firstUsers[resource] = i
+				continue
+			}
+			if rootI, rootJ := root(i), root(j); rootI != rootJ {
+				parents[max(rootI, rootJ)] = min(rootI, rootJ)
+			}
+		}
+	}
+
+	var groups [][]int
+	groupIndexes := map[int]int{}
+	for i := range pushPlans {
+		r := root(i)
+		groupIndex, exists := groupIndexes[r]
+		if !exists {
+			groupIndex = len(groups)
+			groupIndexes[r] = groupIndex
+			groups = append(groups, nil)
+		}
+		groups[groupIndex] = append(groups[groupIndex], i)
+	}
+	return groups
+}
+
+func (cmd PushCommand) actualizePlanWithGroupedOutput(plan v7pushaction.PushPlan, outputLock *sync.Mutex) error {
+	var out, errOut lockedBuffer
+	appUI, err := ui.NewPluginUI(cmd.Config, &out, &errOut)
+	if err != nil {
+		return err
+	}
+
+	appCmd := cmd
+	appCmd.UI = appUI
+	appCmd.ProgressBar = noProgressBar{}
+	appCmd.stopStreamingFunc = nil
+
+	appUI.DisplayNewline()
+	appUI.DisplayTextWithFlavor("Push output for app {{.AppName}}:", map[string]interface{}{
+		"AppName": plan.Application.Name,
+	})
+
+	err = appCmd.actualizePlan(plan)
+	if appCmd.stopStreamingFunc != nil {
+		appCmd.stopStreamingFunc()
+	}
+	if err != nil {
+		appUI.DisplayError(translatableerror.ConvertToTranslatableError(err))
+	}
+
+	outputLock.Lock()
+	defer outputLock.Unlock()
+
+	_, _ = cmd.UI.GetOut().Write(out.Bytes())
+	_, _ = cmd.UI.GetErr().Write(errOut.Bytes())
+
+	return err
+}
+
+func (cmd PushCommand) displayParallelPushSummary(pushPlans []v7pushaction.PushPlan, statuses []string) error {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("status"),
+		},
+	}
+
+	var failedCount int
+	for i, plan := range pushPlans {
+		if statuses[i] != "pushed" {
+			failedCount++
+		}
+		table = append(table, []string{plan.Application.Name, cmd.UI.TranslateText(statuses[i])})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Push summary:")
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if failedCount > 0 {
+		return translatableerror.PushAppsFailedError{
+			FailedCount: failedCount,
+			TotalCount:  len(pushPlans),
+		}
+	}
+
+	return nil
+}
+
+// displayUploadPreviews lists the files each app in the manifest would upload.
+// Unlike a push, it does not apply the manifest, so apps that do not exist yet
+// are not created.
+func (cmd PushCommand) displayUploadPreviews(manifest manifestparser.Manifest, flagOverrides v7pushaction.FlagOverrides, user configv3.User) error {
+	appNames := manifest.AppNames()
+	cmd.UI.DisplayTextWithFlavor("Previewing upload for {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   strings.Join(appNames, ", "),
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	pushPlans, warnings, err := cmd.PushActor.CreatePushPlans(
+		cmd.Config.TargetedSpace().GUID,
+		cmd.Config.TargetedOrganization().GUID,
+		manifest,
+		flagOverrides,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	for i, plan := range pushPlans {
+		cmd.UI.DisplayNewline()
+		if len(plan.AllResources) == 0 {
+			cmd.UI.DisplayText("No files would be uploaded for app {{.AppName}}.", map[string]interface{}{
+				"AppName": appNames[i],
+			})
+			continue
+		}
+
+		files, warnings, err := cmd.PushActor.PreviewUpload(plan)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		cmd.displayUploadPreview(appNames[i], plan.BitsPath, files)
+	}
+
+	return nil
+}
+
+func (cmd PushCommand) displayUploadPreview(appName string, bitsPath string, files []v7pushaction.UploadPreviewFile) {
+	cmd.UI.DisplayText("Files for app {{.AppName}} from {{.Path}}:", map[string]interface{}{
+		"AppName": appName,
+		"Path":    bitsPath,
+	})
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("file"),
+			cmd.UI.TranslateText("size"),
+			cmd.UI.TranslateText("upload"),
+		},
+	}
+
+	var (
+		uploadCount int
+		uploadSize  int64
+	)
+	for _, file := range files {
+		upload := cmd.UI.TranslateText("yes")
+		if file.Matched {
+			upload = cmd.UI.TranslateText("no, matched")
+		} else {
+			uploadCount++
+			uploadSize += file.SizeInBytes
+		}
+		table = append(table, []string{file.Path, bytefmt.ByteSize(uint64(file.SizeInBytes)), upload})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("{{.UploadCount}} of {{.FileCount}} files would be uploaded ({{.UploadSize}}); {{.MatchedCount}} skipped by resource matching.", map[string]interface{}{
+		"UploadCount":  uploadCount,
+		"FileCount":    len(files),
+		"UploadSize":   bytefmt.ByteSize(uint64(uploadSize)),
+		"MatchedCount": len(files) - uploadCount,
+	})
+}
+
 func (cmd PushCommand) shouldDisplaySummary(err error) bool {
 	if err == nil {
 		return true
@@ -712,7 +1068,7 @@ func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) e
 	case v7pushaction.StartingStaging:
 		cmd.UI.DisplayNewline()
 		cmd.UI.DisplayText("Staging app and tracing logs...")
-		logStream, errStream, cancelFunc, warnings, err := cmd.VersionActor.GetStreamingLogsForApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID, cmd.LogCacheClient)
+		logStream, errStream, cancelFunc, warnings, err := cmd.VersionActor.GetStreamingLogsForApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID, cmd.LogCacheClient, sharedaction.LogFilter{})
 		cmd.UI.DisplayWarnings(warnings)
 		if err != nil {
 			return err
@@ -799,3 +1155,34 @@ func (cmd PushCommand) showManifestDiff(spaceGUID string, transformedRawFinalMan
 
 	return nil
 }
+
+// lockedBuffer collects the output of an app pushed in parallel. It is written
+// to by both the push events and the staging log stream.
+type lockedBuffer struct {
+	lock   sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buffer.Write(p)
+}
+
+func (b *lockedBuffer) Bytes() []byte {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return append([]byte(nil), b.buffer.Bytes()...)
+}
+
+// noProgressBar is used for apps pushed in parallel, since their upload
+// progress bars would draw over each other.
+type noProgressBar struct{}
+
+func (noProgressBar) NewProgressBarWrapper(reader io.Reader, _ int64) io.Reader {
+	return reader
+}
+
+func (noProgressBar) Ready() {}
+
+func (noProgressBar) Complete() {}
@@ -55,8 +55,8 @@ type LogEvent struct {
 	Error error
 }
 
-func ReturnLogs(logevents []LogEvent, passedWarnings v7action.Warnings, passedError error) func(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
-	return func(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+func ReturnLogs(logevents []LogEvent, passedWarnings v7action.Warnings, passedError error) func(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+	return func(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
 		logStream := make(chan sharedaction.LogMessage)
 		errStream := make(chan error)
 		go func() {
@@ -339,6 +339,73 @@ var _ = Describe("push Command", func() {
 								Expect(actualManifestBytes).To(Equal([]byte("our-manifest")))
 							})
 
+							When("--preview-upload is provided", func() {
+								BeforeEach(func() {
+									cmd.PreviewUpload = true
+									fakeActor.HandleDeploymentScaleFlagOverridesReturns(
+										manifestparser.Manifest{
+											Applications: []manifestparser.Application{
+												{Name: "some-app-name"},
+												{Name: "some-docker-app"},
+											},
+										},
+										nil,
+									)
+									fakeActor.CreatePushPlansReturns(
+										[]v7pushaction.PushPlan{
+											{
+												BitsPath: "/some/path",
+												AllResources: []sharedaction.V3Resource{
+													{FilePath: "some-file", SizeInBytes: 2048},
+												},
+											},
+											{DockerImageCredentials: v7action.DockerImageCredentials{Path: "some-image"}},
+										},
+										v7action.Warnings{"create-push-plans-warnings"},
+										nil,
+									)
+									fakeActor.PreviewUploadReturns(
+										[]v7pushaction.UploadPreviewFile{
+											{Path: "some-file", SizeInBytes: 2048},
+											{Path: "some-matched-file", SizeInBytes: 1024, Matched: true},
+										},
+										v7pushaction.Warnings{"preview-warnings"},
+										nil,
+									)
+								})
+
+								It("lists the files of each app without pushing", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+
+									Expect(testUI.Out).To(Say(`Previewing upload for some-app-name, some-docker-app in org some-org / space some-space as some-user\.\.\.`))
+									Expect(testUI.Err).To(Say("create-push-plans-warnings"))
+									Expect(testUI.Err).To(Say("preview-warnings"))
+									Expect(testUI.Out).To(Say("Files for app some-app-name from /some/path:"))
+									Expect(testUI.Out).To(Say(`file\s+size\s+upload`))
+									Expect(testUI.Out).To(Say(`some-file\s+2K\s+yes`))
+									Expect(testUI.Out).To(Say(`some-matched-file\s+1K\s+no, matched`))
+									Expect(testUI.Out).To(Say(`1 of 2 files would be uploaded \(2K\); 1 skipped by resource matching\.`))
+									Expect(testUI.Out).To(Say(`No files would be uploaded for app some-docker-app\.`))
+
+									Expect(fakeActor.PreviewUploadCallCount()).To(Equal(1))
+									Expect(fakeActor.PreviewUploadArgsForCall(0).BitsPath).To(Equal("/some/path"))
+
+									Expect(fakeVersionActor.SetSpaceManifestCallCount()).To(Equal(0))
+									Expect(fakeActor.ActualizeCallCount()).To(Equal(0))
+								})
+
+								When("previewing the upload fails", func() {
+									BeforeEach(func() {
+										fakeActor.PreviewUploadReturns(nil, v7pushaction.Warnings{"preview-warnings"}, errors.New("preview-error"))
+									})
+
+									It("returns the error and warnings", func() {
+										Expect(executeErr).To(MatchError("preview-error"))
+										Expect(testUI.Err).To(Say("preview-warnings"))
+									})
+								})
+							})
+
 							When("the manifest is successfully parsed", func() {
 								var expectedDiff resources.ManifestDiff
 
@@ -599,10 +666,10 @@ var _ = Describe("push Command", func() {
 														Eventually(testUI.Out).ShouldNot(Say("log-message-3"))
 
 														Expect(fakeVersionActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(2))
-														passedAppName, spaceGUID, _ := fakeVersionActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+														passedAppName, spaceGUID, _, _ := fakeVersionActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
 														Expect(passedAppName).To(Equal(appName1))
 														Expect(spaceGUID).To(Equal("some-space-guid"))
-														passedAppName, spaceGUID, _ = fakeVersionActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(1)
+														passedAppName, spaceGUID, _, _ = fakeVersionActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(1)
 														Expect(passedAppName).To(Equal(appName2))
 														Expect(spaceGUID).To(Equal("some-space-guid"))
 													})
@@ -758,6 +825,118 @@ var _ = Describe("push Command", func() {
 											})
 										})
 									})
+
+									Describe("pushing the apps in parallel", func() {
+										BeforeEach(func() {
+											cmd.Parallel = flag.PositiveInteger{Value: 2}
+
+											fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+												plan := v7pushaction.PushPlan{Application: resources.Application{GUID: pushPlan.Application.GUID, Name: pushPlan.Application.Name}}
+												return FillInEvents([]Step{
+													{Plan: plan, Event: v7pushaction.ApplyManifest, Warnings: v7pushaction.Warnings{pushPlan.Application.Name + "-warning"}},
+													{Plan: plan, Event: v7pushaction.ApplyManifestComplete},
+												})
+											}
+										})
+
+										It("actualizes every plan and groups the output per app", func() {
+											Expect(executeErr).ToNot(HaveOccurred())
+											Expect(fakeActor.ActualizeCallCount()).To(Equal(2))
+											Expect(fakeVersionActor.GetDetailedAppSummaryCallCount()).To(Equal(2))
+
+											_, progressBar := fakeActor.ActualizeArgsForCall(0)
+											Expect(progressBar).ToNot(Equal(fakeProgressBar))
+
+											output := string(testUI.Out.(*Buffer).Contents())
+											Expect(output).To(MatchRegexp(`Push output for app first-app:\nApplying manifest\.\.\.\nManifest applied`))
+											Expect(output).To(MatchRegexp(`Push output for app second-app:\nApplying manifest\.\.\.\nManifest applied`))
+
+											Expect(testUI.Err).To(Say("first-app-warning|second-app-warning"))
+										})
+
+										It("displays a summary of the pushed apps", func() {
+											Expect(testUI.Out).To(Say(`Push summary:`))
+											Expect(testUI.Out).To(Say(`name\s+status`))
+											Expect(testUI.Out).To(Say(`first-app\s+pushed`))
+											Expect(testUI.Out).To(Say(`second-app\s+pushed`))
+										})
+
+										When("one of the apps fails to push", func() {
+											BeforeEach(func() {
+												fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+													if pushPlan.Application.Name == "first-app" {
+														return FillInEvents([]Step{{Error: errors.New("first-app-error")}})
+													}
+													return FillInEvents([]Step{{Plan: pushPlan, Event: v7pushaction.ApplyManifest}})
+												}
+											})
+
+											It("still pushes the other apps and reports the failure", func() {
+												Expect(executeErr).To(MatchError(translatableerror.PushAppsFailedError{FailedCount: 1, TotalCount: 2}))
+												Expect(fakeActor.ActualizeCallCount()).To(Equal(2))
+												Expect(testUI.Err).To(Say("first-app-error"))
+
+												Expect(testUI.Out).To(Say(`Push summary:`))
+												Expect(testUI.Out).To(Say(`first-app\s+failed`))
+												Expect(testUI.Out).To(Say(`second-app\s+pushed`))
+											})
+
+											When("--fail-fast is provided", func() {
+												BeforeEach(func() {
+													cmd.Parallel = flag.PositiveInteger{Value: 1}
+													cmd.FailFast = true
+												})
+
+												It("skips the apps that have not started pushing", func() {
+													Expect(executeErr).To(MatchError(translatableerror.PushAppsFailedError{FailedCount: 2, TotalCount: 2}))
+													Expect(fakeActor.ActualizeCallCount()).To(Equal(1))
+
+													Expect(testUI.Out).To(Say(`first-app\s+failed`))
+													Expect(testUI.Out).To(Say(`second-app\s+skipped`))
+												})
+											})
+										})
+
+										When("the apps share a route or a service instance", func() {
+											BeforeEach(func() {
+												fakeActor.HandleDeploymentScaleFlagOverridesReturns(manifestparser.Manifest{
+													PathToManifest: "path/to/manifest",
+													Applications: []manifestparser.Application{
+														{
+															Name: "first-app",
+															RemainingManifestFields: map[string]interface{}{
+																"routes": []interface{}{map[interface{}]interface{}{"route": "shared.example.com"}},
+															},
+														},
+														{
+															Name: "second-app",
+															RemainingManifestFields: map[string]interface{}{
+																"routes":   []interface{}{map[interface{}]interface{}{"route": "shared.example.com"}},
+																"services": []interface{}{"some-service"},
+															},
+														},
+													},
+												}, nil)
+
+												cmd.FailFast = true
+												fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+													if pushPlan.Application.Name == "first-app" {
+														return FillInEvents([]Step{{Error: errors.New("first-app-error")}})
+													}
+													return FillInEvents([]Step{{Plan: pushPlan, Event: v7pushaction.ApplyManifest}})
+												}
+											})
+
+											It("pushes the apps one after another", func() {
+												Expect(testUI.Out).To(Say(`Apps first-app, second-app share routes or service instances and will be pushed one after another\.`))
+
+												Expect(executeErr).To(MatchError(translatableerror.PushAppsFailedError{FailedCount: 2, TotalCount: 2}))
+												Expect(fakeActor.ActualizeCallCount()).To(Equal(1))
+												Expect(testUI.Out).To(Say(`first-app\s+failed`))
+												Expect(testUI.Out).To(Say(`second-app\s+skipped`))
+											})
+										})
+									})
 								})
 							})
 						})
@@ -945,7 +1124,7 @@ var _ = Describe("push Command", func() {
 					Expect(fakeManifestLocator.PathArgsForCall(0)).To(Equal(cmd.CWD))
 
 					Expect(fakeManifestParser.InterpolateManifestCallCount()).To(Equal(1))
-					actualManifestPath, _, _ := fakeManifestParser.InterpolateManifestArgsForCall(0)
+					actualManifestPath, _, _, _ := fakeManifestParser.InterpolateManifestArgsForCall(0)
 					Expect(actualManifestPath).To(Equal("/manifest/path"))
 
 					Expect(fakeManifestParser.ParseManifestCallCount()).To(Equal(1))
@@ -1016,6 +1195,62 @@ var _ = Describe("push Command", func() {
 					Expect(fakeManifestParser.ParseManifestCallCount()).To(Equal(1))
 				})
 			})
+
+			When("the manifest does not match the schema", func() {
+				var validationErr manifestparser.ManifestValidationError
+
+				BeforeEach(func() {
+					fakeManifestLocator.PathReturns("/manifest/path", true, nil)
+					validationErr = manifestparser.ManifestValidationError{
+						Path: "/manifest/path",
+						Errors: []manifestparser.ManifestFieldError{
+							{Line: 3, Column: 3, Field: "applications[0]", Message: "unknown field 'helth-check-type'"},
+						},
+					}
+					fakeManifestParser.ValidateManifestReturns(validationErr)
+				})
+
+				It("validates the manifest after interpolating it and warns about the problems", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeManifestParser.InterpolateManifestCallCount()).To(Equal(1))
+					Expect(fakeManifestParser.ValidateManifestCallCount()).To(Equal(1))
+					Expect(fakeManifestParser.ValidateManifestArgsForCall(0)).To(Equal("/manifest/path"))
+					Expect(fakeManifestParser.ValidateInterpolatedManifestCallCount()).To(Equal(0))
+
+					Expect(testUI.Err).To(Say(`Manifest /manifest/path: line 3, column 3: applications\[0\]: unknown field 'helth-check-type'`))
+					Expect(fakeManifestParser.ParseManifestCallCount()).To(Equal(1))
+				})
+
+				When("--strict-manifest-validation is provided", func() {
+					BeforeEach(func() {
+						cmd.StrictManifestValidation = true
+					})
+
+					It("returns the validation error", func() {
+						Expect(executeErr).To(MatchError(validationErr))
+						Expect(fakeManifestParser.ParseManifestCallCount()).To(Equal(0))
+					})
+				})
+
+				When("vars or ops files are provided", func() {
+					BeforeEach(func() {
+						flagOverrides.Vars = []template.VarKV{{Name: "some-var", Value: "some-value"}}
+						fakeManifestParser.InterpolateManifestReturns([]byte("interpolated-manifest"), nil)
+						fakeManifestParser.ValidateInterpolatedManifestReturns(validationErr)
+					})
+
+					It("validates the interpolated manifest", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeManifestParser.ValidateManifestCallCount()).To(Equal(0))
+						Expect(fakeManifestParser.ValidateInterpolatedManifestCallCount()).To(Equal(1))
+						path, rawManifest := fakeManifestParser.ValidateInterpolatedManifestArgsForCall(0)
+						Expect(path).To(Equal("/manifest/path"))
+						Expect(rawManifest).To(Equal([]byte("interpolated-manifest")))
+
+						Expect(testUI.Err).To(Say("unknown field 'helth-check-type'"))
+					})
+				})
+			})
 		})
 
 		When("The -f flag is specified", func() {
@@ -1040,7 +1275,7 @@ var _ = Describe("push Command", func() {
 				Expect(fakeManifestLocator.PathArgsForCall(0)).To(Equal(somePath))
 
 				Expect(fakeManifestParser.InterpolateManifestCallCount()).To(Equal(1))
-				actualManifestPath, _, _ := fakeManifestParser.InterpolateManifestArgsForCall(0)
+				actualManifestPath, _, _, _ := fakeManifestParser.InterpolateManifestArgsForCall(0)
 				Expect(actualManifestPath).To(Equal("/manifest/path"))
 				Expect(manifest).To(Equal(
 					manifestparser.Manifest{
@@ -1065,11 +1300,26 @@ var _ = Describe("push Command", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 
 				Expect(fakeManifestParser.InterpolateManifestCallCount()).To(Equal(1))
-				_, actualVarsFiles, _ := fakeManifestParser.InterpolateManifestArgsForCall(0)
+				_, actualVarsFiles, _, _ := fakeManifestParser.InterpolateManifestArgsForCall(0)
 				Expect(actualVarsFiles).To(Equal(varsFiles))
 			})
 		})
 
+		When("--ops-files are specified", func() {
+			BeforeEach(func() {
+				fakeManifestLocator.PathReturns("/manifest/path", true, nil)
+				flagOverrides.PathsToOpsFiles = []string{"ops1", "ops2"}
+			})
+
+			It("passes the ops files to the manifest parser in order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeManifestParser.InterpolateManifestCallCount()).To(Equal(1))
+				_, _, _, actualOpsFiles := fakeManifestParser.InterpolateManifestArgsForCall(0)
+				Expect(actualOpsFiles).To(Equal([]string{"ops1", "ops2"}))
+			})
+		})
+
 		When("The --var flag is provided", func() {
 			var vars []template.VarKV
 
@@ -1085,7 +1335,7 @@ var _ = Describe("push Command", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 
 				Expect(fakeManifestParser.InterpolateManifestCallCount()).To(Equal(1))
-				_, _, actualVars := fakeManifestParser.InterpolateManifestArgsForCall(0)
+				_, _, actualVars, _ := fakeManifestParser.InterpolateManifestArgsForCall(0)
 				Expect(actualVars).To(Equal(vars))
 			})
 		})
@@ -1118,6 +1368,7 @@ var _ = Describe("push Command", func() {
 			cmd.PathToManifest = "/manifest/path"
 			cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"/vars1", "/vars2"}
 			cmd.Vars = []template.VarKV{{Name: "key", Value: "val"}}
+			cmd.PathsToOpsFiles = []flag.PathWithExistenceCheck{"/ops1", "/ops2"}
 			cmd.Task = true
 			cmd.LogRateLimit = "512M"
 			cmd.Lifecycle = constant.AppLifecycleTypeBuildpack
@@ -1148,6 +1399,7 @@ var _ = Describe("push Command", func() {
 			Expect(overrides.ManifestPath).To(Equal("/manifest/path"))
 			Expect(overrides.PathsToVarsFiles).To(Equal([]string{"/vars1", "/vars2"}))
 			Expect(overrides.Vars).To(Equal([]template.VarKV{{Name: "key", Value: "val"}}))
+			Expect(overrides.PathsToOpsFiles).To(Equal([]string{"/ops1", "/ops2"}))
 			Expect(overrides.Task).To(BeTrue())
 			Expect(overrides.LogRateLimit).To(Equal("512M"))
 			Expect(*overrides.MaxInFlight).To(Equal(1))
@@ -1355,6 +1607,42 @@ var _ = Describe("push Command", func() {
 				Message: "--max-in-flight must be greater than or equal to 1",
 			}),
 
+		Entry("no-manifest and ops-file",
+			func() {
+				cmd.NoManifest = true
+				cmd.PathsToOpsFiles = []flag.PathWithExistenceCheck{"/ops"}
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{"--no-manifest", "--ops-file"},
+			}),
+
+		Entry("preview-upload and docker-image",
+			func() {
+				cmd.PreviewUpload = true
+				cmd.DockerImage.Path = "some-image"
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{"--preview-upload", "--docker-image, -o"},
+			}),
+
+		Entry("preview-upload and droplet",
+			func() {
+				cmd.PreviewUpload = true
+				cmd.DropletPath = "some-droplet.tgz"
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{"--preview-upload", "--droplet"},
+			}),
+
+		Entry("fail-fast is passed without parallel",
+			func() {
+				cmd.FailFast = true
+			},
+			translatableerror.RequiredFlagsError{
+				Arg1: "--fail-fast",
+				Arg2: "--parallel",
+			}),
+
 		Entry("lifecycle buildpack and docker-image flags are passed",
 			func() {
 				cmd.Lifecycle = constant.AppLifecycleTypeBuildpack
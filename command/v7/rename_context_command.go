@@ -0,0 +1,49 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+)
+
+type RenameContextCommand struct {
+	UI              command.UI
+	Config          command.Config
+	RequiredArgs    flag.RenameContextArgs `positional-args:"yes"`
+	usage           interface{}            `usage:"CF_NAME rename-context CONTEXT_NAME NEW_CONTEXT_NAME"`
+	relatedCommands interface{}            `related_commands:"contexts"`
+}
+
+func (cmd *RenameContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd RenameContextCommand) Execute(args []string) error {
+	oldName := cmd.RequiredArgs.OldName
+	newName := cmd.RequiredArgs.NewName
+
+	cmd.UI.DisplayText("Renaming context {{.OldName}} to {{.NewName}}...", map[string]interface{}{
+		"OldName": oldName,
+		"NewName": newName,
+	})
+
+	if !cmd.Config.HasContext(oldName) {
+		return translatableerror.ContextNotFoundError{Name: oldName}
+	}
+
+	if cmd.Config.HasContext(newName) {
+		return translatableerror.ContextAlreadyExistsError{Name: newName}
+	}
+
+	err := cmd.Config.RenameContext(oldName, newName)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
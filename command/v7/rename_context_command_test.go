@@ -0,0 +1,73 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rename-context Command", func() {
+	var (
+		cmd        v7.RenameContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = v7.RenameContextCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			RequiredArgs: flag.RenameContextArgs{OldName: "staging", NewName: "qa"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the context exists and the new name is free", func() {
+		BeforeEach(func() {
+			fakeConfig.HasContextStub = func(name string) bool {
+				return name == "staging"
+			}
+		})
+
+		It("renames the context", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeConfig.RenameContextCallCount()).To(Equal(1))
+			oldName, newName := fakeConfig.RenameContextArgsForCall(0)
+			Expect(oldName).To(Equal("staging"))
+			Expect(newName).To(Equal("qa"))
+
+			Expect(testUI.Out).To(Say("Renaming context staging to qa..."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("the context does not exist", func() {
+		It("returns a ContextNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ContextNotFoundError{Name: "staging"}))
+			Expect(fakeConfig.RenameContextCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a context with the new name already exists", func() {
+		BeforeEach(func() {
+			fakeConfig.HasContextReturns(true)
+		})
+
+		It("returns a ContextAlreadyExistsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ContextAlreadyExistsError{Name: "qa"}))
+			Expect(fakeConfig.RenameContextCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -18,6 +18,8 @@ type RoutesCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter routes by labels"`
 }
 
+func (RoutesCommand) SupportsStructuredOutput() {}
+
 func (cmd RoutesCommand) Execute(args []string) error {
 	var (
 		routes   []resources.Route
@@ -39,17 +41,21 @@ func (cmd RoutesCommand) Execute(args []string) error {
 	targetedSpace := cmd.Config.TargetedSpace()
 
 	if cmd.Orglevel {
-		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} as {{.CurrentUser}}...\n", map[string]interface{}{
-			"CurrentOrg":  targetedOrg.Name,
-			"CurrentUser": currentUser.Name,
-		})
+		if !cmd.UI.IsStructuredOutput() {
+			cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} as {{.CurrentUser}}...\n", map[string]interface{}{
+				"CurrentOrg":  targetedOrg.Name,
+				"CurrentUser": currentUser.Name,
+			})
+		}
 		routes, warnings, err = cmd.Actor.GetRoutesByOrg(targetedOrg.GUID, cmd.Labels)
 	} else {
-		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...\n", map[string]interface{}{
-			"CurrentOrg":   targetedOrg.Name,
-			"CurrentSpace": targetedSpace.Name,
-			"CurrentUser":  currentUser.Name,
-		})
+		if !cmd.UI.IsStructuredOutput() {
+			cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...\n", map[string]interface{}{
+				"CurrentOrg":   targetedOrg.Name,
+				"CurrentSpace": targetedSpace.Name,
+				"CurrentUser":  currentUser.Name,
+			})
+		}
 		routes, warnings, err = cmd.Actor.GetRoutesBySpace(targetedSpace.GUID, cmd.Labels)
 	}
 
@@ -64,6 +70,10 @@ func (cmd RoutesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructured(newRouteDocuments(routeSummaries))
+	}
+
 	if len(routes) > 0 {
 		cmd.displayRoutesTable(routeSummaries)
 	} else {
@@ -112,3 +122,49 @@ func (cmd RoutesCommand) displayRoutesTable(routeSummaries []v7action.RouteSumma
 
 	cmd.UI.DisplayTableWithHeader("", routesTable, ui.DefaultTableSpacePadding)
 }
+
+type routeDocument struct {
+	GUID                string   `json:"guid" yaml:"guid"`
+	URL                 string   `json:"url" yaml:"url"`
+	Space               string   `json:"space" yaml:"space"`
+	Host                string   `json:"host" yaml:"host"`
+	Domain              string   `json:"domain" yaml:"domain"`
+	Port                int      `json:"port,omitempty" yaml:"port,omitempty"`
+	Path                string   `json:"path" yaml:"path"`
+	Protocol            string   `json:"protocol" yaml:"protocol"`
+	AppProtocols        []string `json:"app_protocols" yaml:"app_protocols"`
+	AppPorts            []string `json:"app_ports" yaml:"app_ports"`
+	Apps                []string `json:"apps" yaml:"apps"`
+	ServiceInstanceName string   `json:"service_instance" yaml:"service_instance"`
+	Options             string   `json:"options,omitempty" yaml:"options,omitempty"`
+}
+
+func newRouteDocuments(routeSummaries []v7action.RouteSummary) []routeDocument {
+	documents := []routeDocument{}
+	for _, routeSummary := range routeSummaries {
+		documents = append(documents, routeDocument{
+			GUID:                routeSummary.GUID,
+			URL:                 routeSummary.URL,
+			Space:               routeSummary.SpaceName,
+			Host:                routeSummary.Host,
+			Domain:              routeSummary.DomainName,
+			Port:                routeSummary.Port,
+			Path:                routeSummary.Path,
+			Protocol:            routeSummary.Protocol,
+			AppProtocols:        nonNilStrings(routeSummary.AppProtocols),
+			AppPorts:            nonNilStrings(routeSummary.AppPorts),
+			Apps:                nonNilStrings(routeSummary.AppNames),
+			ServiceInstanceName: routeSummary.ServiceInstanceName,
+			Options:             routeSummary.Route.FormattedOptions(),
+		})
+	}
+	return documents
+}
+
+// nonNilStrings makes sure empty lists are rendered as [] rather than null.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
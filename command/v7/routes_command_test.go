@@ -273,4 +273,41 @@ var _ = Describe("routes Command", func() {
 			})
 		})
 	})
+
+	When("a structured output format is set", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+			routes := []resources.Route{{GUID: "route-guid-1", Host: "host-1", Path: "/path", URL: "host-1.domain-1/path", Protocol: "http"}}
+			fakeActor.GetRoutesBySpaceReturns(routes, v7action.Warnings{"warning-1"}, nil)
+			fakeActor.GetRouteSummariesReturns([]v7action.RouteSummary{
+				{
+					Route:      routes[0],
+					AppNames:   []string{"app-1"},
+					DomainName: "domain-1",
+					SpaceName:  "some-space",
+				},
+			}, v7action.Warnings{"warning-2"}, nil)
+		})
+
+		It("outputs the routes as a document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).NotTo(Say("Getting routes"))
+			Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`[{
+				"guid": "route-guid-1",
+				"url": "host-1.domain-1/path",
+				"space": "some-space",
+				"host": "host-1",
+				"domain": "domain-1",
+				"path": "/path",
+				"protocol": "http",
+				"app_protocols": [],
+				"app_ports": [],
+				"apps": ["app-1"],
+				"service_instance": ""
+			}]`))
+			Expect(testUI.Err).To(Say(`{"warning":"warning-1"}`))
+			Expect(testUI.Err).To(Say(`{"warning":"warning-2"}`))
+		})
+	})
 })
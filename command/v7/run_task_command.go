@@ -2,8 +2,15 @@ package v7
 
 import (
 	"fmt"
+	"time"
 
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/sharedaction"
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/api/logcache"
+	"code.cloudfoundry.org/cli/v9/command"
 	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
 	"code.cloudfoundry.org/cli/v9/resources"
 )
 
@@ -17,12 +24,29 @@ type RunTaskCommand struct {
 	Memory          flag.Megabytes          `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	Name            string                  `long:"name" description:"Name to give the task (generated if omitted)"`
 	Process         string                  `long:"process" description:"Process type to use as a template for command, memory, and disk for the created task."`
-	Wait            bool                    `long:"wait" short:"w" description:"Wait for the task to complete before exiting"`
-	usage           interface{}             `usage:"CF_NAME run-task APP_NAME [--command COMMAND] [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [--name TASK_NAME] [--process PROCESS_TYPE]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app --command \"bundle exec rake db:migrate\" --name migrate\n\n   CF_NAME run-task my-app --process batch_job\n\n   CF_NAME run-task my-app"`
+	Wait            bool                    `long:"wait" short:"w" description:"Wait for the task to complete before exiting, streaming its logs. Exits with an error if the task fails"`
+	Timeout         flag.Timeout            `long:"timeout" description:"Time in minutes to wait for the task to complete before cancelling it. Requires --wait"`
+	usage           interface{}             `usage:"CF_NAME run-task APP_NAME [--command COMMAND] [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [--name TASK_NAME] [--process PROCESS_TYPE] [--wait [--timeout MINUTES]]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app --command \"bundle exec rake db:migrate\" --name migrate\n\n   CF_NAME run-task my-app --process batch_job\n\n   CF_NAME run-task my-app"`
 	relatedCommands interface{}             `related_commands:"logs, tasks, task, terminate-task"`
+
+	LogCacheClient sharedaction.LogCacheClient
+}
+
+func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	return err
 }
 
 func (cmd RunTaskCommand) Execute(args []string) error {
+	if cmd.Timeout.IsSet && !cmd.Wait {
+		return translatableerror.RequiredFlagsError{Arg1: "--timeout", Arg2: "--wait"}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -96,11 +120,7 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 	}, 3)
 
 	if cmd.Wait {
-		cmd.UI.DisplayNewline()
-		cmd.UI.DisplayText("Waiting for task to complete execution...")
-
-		_, pollWarnings, err := cmd.Actor.PollTask(task)
-		cmd.UI.DisplayWarnings(pollWarnings)
+		err = cmd.waitForTask(application.GUID, task)
 		if err != nil {
 			return err
 		}
@@ -113,3 +133,54 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// waitForTask polls the task until it finishes while streaming its logs.
+func (cmd RunTaskCommand) waitForTask(appGUID string, task resources.Task) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for task to complete execution...")
+	cmd.UI.DisplayNewline()
+
+	messages, logErrs, stopStreaming := cmd.Actor.GetStreamingLogsForTask(appGUID, task, cmd.LogCacheClient)
+	streamingDone := make(chan struct{})
+	go func() {
+		defer close(streamingDone)
+		for messages != nil || logErrs != nil {
+			select {
+			case message, ok := <-messages:
+				if !ok {
+					messages = nil
+					continue
+				}
+				cmd.UI.DisplayLogMessage(message, true)
+			case logErr, ok := <-logErrs:
+				if !ok {
+					logErrs = nil
+					continue
+				}
+				if _, isTimeout := logErr.(actionerror.LogCacheTimeoutError); isTimeout {
+					cmd.UI.DisplayWarning("timeout connecting to log server, no log will be shown")
+					continue
+				}
+				cmd.UI.DisplayWarning("Failed to retrieve logs from Log Cache: {{.Error}}", map[string]interface{}{
+					"Error": logErr,
+				})
+			}
+		}
+	}()
+
+	var timeout time.Duration
+	if cmd.Timeout.IsSet {
+		timeout = time.Duration(cmd.Timeout.Value) * time.Minute
+	}
+
+	_, pollWarnings, err := cmd.Actor.PollTaskWithTimeout(task, timeout)
+
+	// Log Cache serves logs with a delay, so the last lines of output are
+	// given a polling interval to arrive before streaming stops.
+	time.Sleep(cmd.Config.PollingInterval())
+	stopStreaming()
+	<-streamingDone
+
+	cmd.UI.DisplayWarnings(pollWarnings)
+	return err
+}
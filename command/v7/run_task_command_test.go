@@ -1,13 +1,17 @@
 package v7_test
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/sharedaction"
 	"code.cloudfoundry.org/cli/v9/actor/v7action"
 	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/v9/command/commandfakes"
 	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
 	. "code.cloudfoundry.org/cli/v9/command/v7"
 	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/v9/resources"
@@ -50,12 +54,32 @@ var _ = Describe("run-task Command", func() {
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
+
+		fakeActor.GetStreamingLogsForTaskStub = func(string, resources.Task, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc) {
+			messages := make(chan sharedaction.LogMessage)
+			logErrs := make(chan error)
+			return messages, logErrs, func() {
+				close(messages)
+				close(logErrs)
+			}
+		}
 	})
 
 	JustBeforeEach(func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	When("--timeout is provided without --wait", func() {
+		BeforeEach(func() {
+			cmd.Timeout = flag.Timeout{NullInt: types.NullInt{Value: 10, IsSet: true}}
+		})
+
+		It("returns a required flags error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--timeout", Arg2: "--wait"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	When("checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
@@ -355,7 +379,7 @@ var _ = Describe("run-task Command", func() {
 							},
 							v7action.Warnings{"get-application-warning-3"},
 							nil)
-						fakeActor.PollTaskReturns(
+						fakeActor.PollTaskWithTimeoutReturns(
 							resources.Task{
 								Name:       "some-task-name",
 								SequenceID: 3,
@@ -383,6 +407,64 @@ var _ = Describe("run-task Command", func() {
 						Expect(testUI.Err).To(Say("get-application-warning-3"))
 						Expect(testUI.Err).To(Say("poll-warnings"))
 
+						Expect(fakeActor.PollTaskWithTimeoutCallCount()).To(Equal(1))
+						_, timeout := fakeActor.PollTaskWithTimeoutArgsForCall(0)
+						Expect(timeout).To(BeZero())
+					})
+
+					When("the task logs", func() {
+						BeforeEach(func() {
+							fakeActor.GetStreamingLogsForTaskStub = func(string, resources.Task, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc) {
+								messages := make(chan sharedaction.LogMessage, 1)
+								logErrs := make(chan error, 1)
+								messages <- *sharedaction.NewLogMessage("migrating", "OUT", time.Now(), "APP/TASK/some-task-name", "0")
+								logErrs <- errors.New("log-cache-error")
+								return messages, logErrs, func() {
+									close(messages)
+									close(logErrs)
+								}
+							}
+						})
+
+						It("streams the task's logs while waiting", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.GetStreamingLogsForTaskCallCount()).To(Equal(1))
+							appGUID, task, _ := fakeActor.GetStreamingLogsForTaskArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(task.Name).To(Equal("some-task-name"))
+
+							Expect(testUI.Out).To(Say(`Waiting for task to complete execution...`))
+							Expect(testUI.Out).To(Say(`\[APP/TASK/some-task-name/0\] OUT migrating`))
+							Expect(testUI.Out).To(Say(`Task has completed successfully.`))
+							Expect(testUI.Err).To(Say("Failed to retrieve logs from Log Cache: log-cache-error"))
+						})
+					})
+
+					When("a timeout is provided", func() {
+						BeforeEach(func() {
+							cmd.Timeout = flag.Timeout{NullInt: types.NullInt{Value: 10, IsSet: true}}
+						})
+
+						It("passes the timeout to the actor", func() {
+							_, timeout := fakeActor.PollTaskWithTimeoutArgsForCall(0)
+							Expect(timeout).To(Equal(10 * time.Minute))
+						})
+					})
+
+					When("the task fails", func() {
+						BeforeEach(func() {
+							fakeActor.PollTaskWithTimeoutReturns(
+								resources.Task{},
+								v7action.Warnings{"poll-warnings"},
+								actionerror.TaskFailedError{TaskName: "some-task-name", FailureReason: "Exited with status 1"})
+						})
+
+						It("returns the error with the failure reason", func() {
+							Expect(executeErr).To(MatchError(actionerror.TaskFailedError{TaskName: "some-task-name", FailureReason: "Exited with status 1"}))
+							Expect(testUI.Out).NotTo(Say(`Task has completed successfully.`))
+							Expect(testUI.Err).To(Say("poll-warnings"))
+						})
 					})
 				})
 			})
@@ -443,7 +525,7 @@ var _ = Describe("run-task Command", func() {
 								resources.Task{},
 								nil,
 								nil)
-							fakeActor.PollTaskReturns(
+							fakeActor.PollTaskWithTimeoutReturns(
 								resources.Task{},
 								nil,
 								returnedErr)
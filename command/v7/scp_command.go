@@ -0,0 +1,137 @@
+package v7
+
+import (
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/v9/actor/sharedaction"
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	"code.cloudfoundry.org/cli/v9/util/clissh"
+)
+
+type SCPCommand struct {
+	BaseCommand
+
+	RequiredArgs       flag.SourceAndDestination `positional-args:"yes"`
+	ProcessIndex       uint                      `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	ProcessType        string                    `long:"process" default:"web" description:"App process name"`
+	SkipHostValidation bool                      `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+
+	usage           interface{} `usage:"CF_NAME scp [APP_NAME:]SOURCE [APP_NAME:]DESTINATION [--process PROCESS] [-i INDEX] [--skip-host-validation]\n\n   Exactly one of SOURCE and DESTINATION must be a path in an app container, given as APP_NAME:PATH.\n   Directories are copied recursively.\n\nEXAMPLES:\n   CF_NAME scp my-app:/home/vcap/app/heap.hprof ./heap.hprof\n   CF_NAME scp ./config my-app:app/config --process worker -i 1"`
+	relatedCommands interface{} `related_commands:"ssh, ssh-code, ssh-enabled"`
+	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
+
+	SSHActor  SharedSSHActor
+	SSHClient *clissh.SecureShell
+}
+
+func (cmd *SCPCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.SSHActor = sharedActor
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+
+	return nil
+}
+
+func (cmd SCPCommand) Execute(args []string) error {
+	appName, copyOptions, err := cmd.parsePaths()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Copying {{.Source}} to {{.Destination}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Source":      cmd.RequiredArgs.Source,
+		"Destination": cmd.RequiredArgs.Destination,
+		"AppName":     appName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		appName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.ProcessIndex,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	copyOptions.Endpoint = sshAuth.Endpoint
+	copyOptions.HostKeyFingerprint = sshAuth.HostKeyFingerprint
+	copyOptions.Passcode = sshAuth.Passcode
+	copyOptions.Username = sshAuth.Username
+	copyOptions.SkipHostValidation = cmd.SkipHostValidation
+
+	err = cmd.SSHActor.ExecuteSecureCopy(cmd.SSHClient, copyOptions)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// parsePaths works out which of the source and destination is in the app
+// container, and returns the app name along with the local and remote paths.
+func (cmd SCPCommand) parsePaths() (string, sharedaction.SecureCopyOptions, error) {
+	sourceApp, sourcePath, sourceIsRemote := parseRemotePath(cmd.RequiredArgs.Source)
+	destinationApp, destinationPath, destinationIsRemote := parseRemotePath(cmd.RequiredArgs.Destination)
+
+	switch {
+	case sourceIsRemote && !destinationIsRemote:
+		return sourceApp, sharedaction.SecureCopyOptions{
+			LocalPath:  cmd.RequiredArgs.Destination,
+			RemotePath: sourcePath,
+			Direction:  sharedaction.CopyFromApp,
+		}, nil
+	case destinationIsRemote && !sourceIsRemote:
+		return destinationApp, sharedaction.SecureCopyOptions{
+			LocalPath:  cmd.RequiredArgs.Source,
+			RemotePath: destinationPath,
+			Direction:  sharedaction.CopyToApp,
+		}, nil
+	default:
+		return "", sharedaction.SecureCopyOptions{}, translatableerror.IncorrectUsageError{
+			Message: "exactly one of SOURCE and DESTINATION must be an app path in the form APP_NAME:PATH",
+		}
+	}
+}
+
+// parseRemotePath splits a path of the form APP_NAME:PATH. Paths with a
+// Windows volume name, such as C:\, are always local.
+func parseRemotePath(path string) (string, string, bool) {
+	if filepath.VolumeName(path) != "" {
+		return "", "", false
+	}
+
+	appName, remotePath, found := strings.Cut(path, ":")
+	if !found || appName == "" || strings.ContainsAny(appName, `/\`) {
+		return "", "", false
+	}
+
+	if remotePath == "" {
+		remotePath = "."
+	}
+	return appName, remotePath, true
+}
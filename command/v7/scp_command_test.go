@@ -0,0 +1,182 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/sharedaction"
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	. "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("scp Command", func() {
+	var (
+		cmd             SCPCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeSSHActor    *v7fakes.FakeSharedSSHActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeSSHActor = new(v7fakes.FakeSharedSSHActor)
+
+		cmd = SCPCommand{
+			RequiredArgs: flag.SourceAndDestination{
+				Source:      "some-app:/home/vcap/app/heap.hprof",
+				Destination: "./heap.hprof",
+			},
+
+			ProcessType:        "some-process-type",
+			ProcessIndex:       1,
+			SkipHostValidation: true,
+
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			SSHActor: fakeSSHActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("neither path is in the app", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Source = "./heap.hprof"
+		})
+
+		It("returns an incorrect usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+				Message: "exactly one of SOURCE and DESTINATION must be an app path in the form APP_NAME:PATH",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("both paths are in an app", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Destination = "other-app:/tmp"
+		})
+
+		It("returns an incorrect usage error", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.IncorrectUsageError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "steve"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "steve"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the secure shell authentication information fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+				v7action.SSHAuthentication{}, v7action.Warnings{"some-warnings"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("some-warnings"))
+			Expect(fakeSSHActor.ExecuteSecureCopyCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the secure shell authentication information succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(v7action.SSHAuthentication{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				Username:           "some-username",
+			}, v7action.Warnings{"some-warnings"}, nil)
+		})
+
+		It("copies the file from the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Copying some-app:/home/vcap/app/heap.hprof to \./heap\.hprof for app some-app in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Err).To(Say("some-warnings"))
+			Expect(testUI.Out).To(Say("OK"))
+
+			appNameArg, spaceGUIDArg, processTypeArg, processIndexArg := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+			Expect(appNameArg).To(Equal("some-app"))
+			Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+			Expect(processTypeArg).To(Equal("some-process-type"))
+			Expect(processIndexArg).To(Equal(uint(1)))
+
+			Expect(fakeSSHActor.ExecuteSecureCopyCallCount()).To(Equal(1))
+			_, copyOptionsArg := fakeSSHActor.ExecuteSecureCopyArgsForCall(0)
+			Expect(copyOptionsArg).To(Equal(sharedaction.SecureCopyOptions{
+				Username:           "some-username",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				SkipHostValidation: true,
+				LocalPath:          "./heap.hprof",
+				RemotePath:         "/home/vcap/app/heap.hprof",
+				Direction:          sharedaction.CopyFromApp,
+			}))
+		})
+
+		When("the destination is in the app", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Source = "./config"
+				cmd.RequiredArgs.Destination = "some-app:"
+			})
+
+			It("copies the local path into the app's home directory", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, copyOptionsArg := fakeSSHActor.ExecuteSecureCopyArgsForCall(0)
+				Expect(copyOptionsArg.LocalPath).To(Equal("./config"))
+				Expect(copyOptionsArg.RemotePath).To(Equal("."))
+				Expect(copyOptionsArg.Direction).To(Equal(sharedaction.CopyToApp))
+			})
+		})
+
+		When("copying fails", func() {
+			BeforeEach(func() {
+				fakeSSHActor.ExecuteSecureCopyReturns(errors.New("some-copy-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-copy-error"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
 	"code.cloudfoundry.org/cli/v9/util/ui"
 )
 
@@ -11,6 +12,8 @@ type SecurityGroupsCommand struct {
 	relatedCommands interface{} `related_commands:"bind-running-security-group, bind-security-group, bind-staging-security-group, security-group"`
 }
 
+func (SecurityGroupsCommand) SupportsStructuredOutput() {}
+
 func (cmd SecurityGroupsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -22,10 +25,12 @@ func (cmd SecurityGroupsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting security groups as {{.Username}}...", map[string]interface{}{
-		"Username": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting security groups as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	securityGroupSummaries, warnings, err := cmd.Actor.GetSecurityGroups()
 	cmd.UI.DisplayWarnings(warnings)
@@ -33,6 +38,10 @@ func (cmd SecurityGroupsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructured(newSecurityGroupDocuments(securityGroupSummaries))
+	}
+
 	if len(securityGroupSummaries) == 0 {
 		cmd.UI.DisplayText("No security groups found.")
 		return nil
@@ -67,3 +76,33 @@ func (cmd SecurityGroupsCommand) Execute(args []string) error {
 
 	return nil
 }
+
+type securityGroupDocument struct {
+	Name     string                       `json:"name" yaml:"name"`
+	Bindings []securityGroupSpaceDocument `json:"bindings" yaml:"bindings"`
+}
+
+type securityGroupSpaceDocument struct {
+	Org       string `json:"org" yaml:"org"`
+	Space     string `json:"space" yaml:"space"`
+	Lifecycle string `json:"lifecycle" yaml:"lifecycle"`
+}
+
+func newSecurityGroupDocuments(securityGroupSummaries []v7action.SecurityGroupSummary) []securityGroupDocument {
+	documents := []securityGroupDocument{}
+	for _, securityGroupSummary := range securityGroupSummaries {
+		document := securityGroupDocument{
+			Name:     securityGroupSummary.Name,
+			Bindings: []securityGroupSpaceDocument{},
+		}
+		for _, securityGroupSpace := range securityGroupSummary.SecurityGroupSpaces {
+			document.Bindings = append(document.Bindings, securityGroupSpaceDocument{
+				Org:       securityGroupSpace.OrgName,
+				Space:     securityGroupSpace.SpaceName,
+				Lifecycle: securityGroupSpace.Lifecycle,
+			})
+		}
+		documents = append(documents, document)
+	}
+	return documents
+}
@@ -167,4 +167,33 @@ var _ = Describe("Security Groups Command", func() {
 			})
 		})
 	})
+
+	When("a structured output format is set", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+			fakeActor.GetSecurityGroupsReturns(
+				[]v7action.SecurityGroupSummary{
+					{
+						Name: "security-group-1",
+						SecurityGroupSpaces: []v7action.SecurityGroupSpace{
+							{OrgName: "org-1", SpaceName: "space-1", Lifecycle: "running"},
+						},
+					},
+					{Name: "security-group-2"},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("outputs the security groups as a document", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).NotTo(Say("Getting security groups"))
+			Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`[
+				{"name": "security-group-1", "bindings": [{"org": "org-1", "space": "space-1", "lifecycle": "running"}]},
+				{"name": "security-group-2", "bindings": []}
+			]`))
+		})
+	})
 })
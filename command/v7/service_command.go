@@ -23,6 +23,8 @@ type ServiceCommand struct {
 	relatedCommands interface{}          `related_commands:"bind-service, rename-service, update-service"`
 }
 
+func (ServiceCommand) SupportsStructuredOutput() {}
+
 func (cmd ServiceCommand) Execute(args []string) error {
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
@@ -82,6 +84,10 @@ func (cmd ServiceCommand) fetchAndDisplayDetails() error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructured(newServiceInstanceDetailsDocument(serviceInstanceWithDetails))
+	}
+
 	switch {
 	case serviceInstanceWithDetails.Type == resources.UserProvidedServiceInstance:
 		cmd.displayPropertiesUserProvided(serviceInstanceWithDetails)
@@ -104,6 +110,10 @@ func (cmd ServiceCommand) displayIntro() error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return nil
+	}
+
 	cmd.UI.DisplayTextWithFlavor(
 		"Showing info of service {{.ServiceInstanceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
 		map[string]interface{}{
@@ -266,3 +276,117 @@ func (cmd ServiceCommand) displayBoundApps(serviceInstanceWithDetails v7action.S
 	cmd.UI.DisplayTableWithHeader(indent, table, ui.DefaultTableSpacePadding)
 	cmd.UI.DisplayNewline()
 }
+
+type serviceInstanceDetailsDocument struct {
+	Name            string                        `json:"name" yaml:"name"`
+	GUID            string                        `json:"guid" yaml:"guid"`
+	Type            string                        `json:"type" yaml:"type"`
+	Broker          string                        `json:"broker,omitempty" yaml:"broker,omitempty"`
+	Offering        string                        `json:"offering,omitempty" yaml:"offering,omitempty"`
+	Plan            string                        `json:"plan,omitempty" yaml:"plan,omitempty"`
+	Tags            []string                      `json:"tags" yaml:"tags"`
+	DashboardURL    string                        `json:"dashboard_url,omitempty" yaml:"dashboard_url,omitempty"`
+	RouteServiceURL string                        `json:"route_service_url,omitempty" yaml:"route_service_url,omitempty"`
+	SyslogDrainURL  string                        `json:"syslog_drain_url,omitempty" yaml:"syslog_drain_url,omitempty"`
+	LastOperation   *serviceLastOperationDocument `json:"last_operation" yaml:"last_operation"`
+	BoundApps       []serviceBoundAppDocument     `json:"bound_apps" yaml:"bound_apps"`
+	SharedWith      []serviceSharedSpaceDocument  `json:"shared_with,omitempty" yaml:"shared_with,omitempty"`
+	SharedFrom      *serviceSharedSpaceDocument   `json:"shared_from,omitempty" yaml:"shared_from,omitempty"`
+	Upgrade         *serviceUpgradeStatusDocument `json:"upgrade,omitempty" yaml:"upgrade,omitempty"`
+}
+
+type serviceLastOperationDocument struct {
+	Type        string `json:"type" yaml:"type"`
+	State       string `json:"state" yaml:"state"`
+	Description string `json:"description" yaml:"description"`
+	CreatedAt   string `json:"created_at" yaml:"created_at"`
+	UpdatedAt   string `json:"updated_at" yaml:"updated_at"`
+}
+
+type serviceBoundAppDocument struct {
+	App           string                       `json:"app" yaml:"app"`
+	BindingName   string                       `json:"binding_name" yaml:"binding_name"`
+	GUID          string                       `json:"guid" yaml:"guid"`
+	CreatedAt     string                       `json:"created_at" yaml:"created_at"`
+	LastOperation serviceLastOperationDocument `json:"last_operation" yaml:"last_operation"`
+}
+
+type serviceSharedSpaceDocument struct {
+	Org       string `json:"org" yaml:"org"`
+	Space     string `json:"space" yaml:"space"`
+	BoundApps int    `json:"bound_apps,omitempty" yaml:"bound_apps,omitempty"`
+}
+
+type serviceUpgradeStatusDocument struct {
+	Supported   bool   `json:"supported" yaml:"supported"`
+	Available   bool   `json:"available" yaml:"available"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+func newServiceLastOperationDocument(lastOperation resources.LastOperation) serviceLastOperationDocument {
+	return serviceLastOperationDocument{
+		Type:        string(lastOperation.Type),
+		State:       string(lastOperation.State),
+		Description: lastOperation.Description,
+		CreatedAt:   lastOperation.CreatedAt,
+		UpdatedAt:   lastOperation.UpdatedAt,
+	}
+}
+
+func newServiceInstanceDetailsDocument(details v7action.ServiceInstanceDetails) serviceInstanceDetailsDocument {
+	document := serviceInstanceDetailsDocument{
+		Name:            details.Name,
+		GUID:            details.GUID,
+		Type:            string(details.Type),
+		Tags:            nonNilStrings(details.Tags.Value),
+		DashboardURL:    details.DashboardURL.Value,
+		RouteServiceURL: details.RouteServiceURL.Value,
+		SyslogDrainURL:  details.SyslogDrainURL.Value,
+		BoundApps:       []serviceBoundAppDocument{},
+	}
+
+	if details.LastOperation != (resources.LastOperation{}) {
+		lastOperation := newServiceLastOperationDocument(details.LastOperation)
+		document.LastOperation = &lastOperation
+	}
+
+	for _, binding := range details.BoundApps {
+		document.BoundApps = append(document.BoundApps, serviceBoundAppDocument{
+			App:           binding.AppName,
+			BindingName:   binding.Name,
+			GUID:          binding.GUID,
+			CreatedAt:     binding.CreatedAt,
+			LastOperation: newServiceLastOperationDocument(binding.LastOperation),
+		})
+	}
+
+	if details.Type == resources.UserProvidedServiceInstance {
+		return document
+	}
+
+	document.Broker = details.ServiceBrokerName
+	document.Offering = details.ServiceOffering.Name
+	document.Plan = details.ServicePlan.Name
+
+	if details.SharedStatus.IsSharedFromOriginalSpace {
+		document.SharedFrom = &serviceSharedSpaceDocument{
+			Org:   details.OrganizationName,
+			Space: details.SpaceName,
+		}
+	}
+	for _, usage := range details.SharedStatus.UsageSummary {
+		document.SharedWith = append(document.SharedWith, serviceSharedSpaceDocument{
+			Org:       usage.OrganizationName,
+			Space:     usage.SpaceName,
+			BoundApps: usage.BoundAppCount,
+		})
+	}
+
+	document.Upgrade = &serviceUpgradeStatusDocument{
+		Supported:   details.UpgradeStatus.State != v7action.ServiceInstanceUpgradeNotSupported,
+		Available:   details.UpgradeStatus.State == v7action.ServiceInstanceUpgradeAvailable,
+		Description: details.UpgradeStatus.Description,
+	}
+
+	return document
+}
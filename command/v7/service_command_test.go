@@ -852,4 +852,48 @@ var _ = Describe("service command", func() {
 			Expect(executeErr).To(MatchError("explode"))
 		})
 	})
+
+	When("a structured output format is set", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+			fakeActor.GetServiceInstanceDetailsReturns(
+				v7action.ServiceInstanceDetails{
+					ServiceInstance: resources.ServiceInstance{
+						GUID: serviceInstanceGUID,
+						Name: serviceInstanceName,
+						Type: resources.ManagedServiceInstance,
+						Tags: types.NewOptionalStringSlice("foo"),
+					},
+					ServiceBrokerName: "some-broker",
+					ServiceOffering:   resources.ServiceOffering{Name: "some-offering"},
+					ServicePlan:       resources.ServicePlan{Name: "some-plan"},
+					UpgradeStatus: v7action.ServiceInstanceUpgradeStatus{
+						State:       v7action.ServiceInstanceUpgradeAvailable,
+						Description: "new version",
+					},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("outputs the service instance as a document", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).NotTo(Say("Showing info of service"))
+			Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+				"name": "fake-service-instance-name",
+				"guid": "fake-service-instance-guid",
+				"type": "managed",
+				"broker": "some-broker",
+				"offering": "some-offering",
+				"plan": "some-plan",
+				"tags": ["foo"],
+				"last_operation": null,
+				"bound_apps": [],
+				"upgrade": {"supported": true, "available": true, "description": "new version"}
+			}`))
+			Expect(testUI.Err).To(Say(`{"warning":"warning-1"}`))
+		})
+	})
 })
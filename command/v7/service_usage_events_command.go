@@ -0,0 +1,150 @@
+package v7
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+)
+
+type ServiceUsageEventsCommand struct {
+	BaseCommand
+
+	Start           flag.TimeOrDuration `long:"start" description:"Only list events created at or after this time, given as an RFC 3339 timestamp or a duration before now such as 24h"`
+	End             flag.TimeOrDuration `long:"end" description:"Only list events created before this time, given as an RFC 3339 timestamp or a duration before now such as 1h"`
+	States          []string            `long:"state" description:"Only list events in this state: CREATED, UPDATED or DELETED; can specify multiple times"`
+	CSV             bool                `long:"csv" description:"Export the events as CSV instead of displaying a table. All other output is suppressed."`
+	usage           interface{}         `usage:"CF_NAME service-usage-events [--start TIME] [--end TIME] [--state STATE]... [--csv]"`
+	relatedCommands interface{}         `related_commands:"app-usage-events, usage-report"`
+}
+
+type exportedServiceUsageEvent struct {
+	GUID                string `json:"guid" yaml:"guid"`
+	CreatedAt           string `json:"created_at" yaml:"created_at"`
+	State               string `json:"state" yaml:"state"`
+	OrganizationGUID    string `json:"organization_guid" yaml:"organization_guid"`
+	SpaceGUID           string `json:"space_guid" yaml:"space_guid"`
+	SpaceName           string `json:"space_name" yaml:"space_name"`
+	ServiceInstanceGUID string `json:"service_instance_guid" yaml:"service_instance_guid"`
+	ServiceInstanceName string `json:"service_instance_name" yaml:"service_instance_name"`
+	ServiceInstanceType string `json:"service_instance_type" yaml:"service_instance_type"`
+	ServiceOfferingName string `json:"service_offering_name" yaml:"service_offering_name"`
+	ServicePlanName     string `json:"service_plan_name" yaml:"service_plan_name"`
+	ServiceBrokerName   string `json:"service_broker_name" yaml:"service_broker_name"`
+}
+
+var exportedServiceUsageEventHeader = []string{
+	"guid", "created_at", "state", "organization_guid", "space_guid", "space_name",
+	"service_instance_guid", "service_instance_name", "service_instance_type",
+	"service_offering_name", "service_plan_name", "service_broker_name",
+}
+
+func (e exportedServiceUsageEvent) row() []string {
+	return []string{
+		e.GUID, e.CreatedAt, e.State, e.OrganizationGUID, e.SpaceGUID, e.SpaceName,
+		e.ServiceInstanceGUID, e.ServiceInstanceName, e.ServiceInstanceType,
+		e.ServiceOfferingName, e.ServicePlanName, e.ServiceBrokerName,
+	}
+}
+
+func (ServiceUsageEventsCommand) SupportsStructuredOutput() {}
+
+func (cmd ServiceUsageEventsCommand) Execute(args []string) error {
+	if cmd.CSV && cmd.UI.IsStructuredOutput() {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--csv", "--output-format"},
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.CSV && !cmd.UI.IsStructuredOutput() {
+		user, err := cmd.Actor.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Getting service usage events as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	events, warnings, err := cmd.Actor.GetServiceUsageEvents(v7action.UsageEventFilter{
+		Start:  cmd.Start.Resolve(time.Now()),
+		End:    cmd.End.Resolve(time.Now()),
+		States: cmd.States,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.CSV || cmd.UI.IsStructuredOutput() {
+		exported := []exportedServiceUsageEvent{}
+		rows := [][]string{exportedServiceUsageEventHeader}
+		for _, event := range events {
+			e := exportedServiceUsageEvent{
+				GUID:                event.GUID,
+				CreatedAt:           event.CreatedAt.UTC().Format(time.RFC3339),
+				State:               event.State,
+				OrganizationGUID:    event.OrganizationGUID,
+				SpaceGUID:           event.SpaceGUID,
+				SpaceName:           event.SpaceName,
+				ServiceInstanceGUID: event.ServiceInstanceGUID,
+				ServiceInstanceName: event.ServiceInstanceName,
+				ServiceInstanceType: event.ServiceInstanceType,
+				ServiceOfferingName: event.ServiceOfferingName,
+				ServicePlanName:     event.ServicePlanName,
+				ServiceBrokerName:   event.ServiceBrokerName,
+			}
+			exported = append(exported, e)
+			rows = append(rows, e.row())
+		}
+		return exportUsage(cmd.UI, cmd.CSV, rows, exported)
+	}
+
+	if len(events) == 0 {
+		cmd.UI.DisplayText("No service usage events found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("state"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("service instance"),
+			cmd.UI.TranslateText("type"),
+			cmd.UI.TranslateText("offering"),
+			cmd.UI.TranslateText("plan"),
+			cmd.UI.TranslateText("broker"),
+		},
+	}
+
+	for _, event := range events {
+		instanceType := "managed"
+		if event.ServiceInstanceType == "user_provided_service_instance" {
+			instanceType = "user-provided"
+		}
+
+		table = append(table, []string{
+			event.CreatedAt.Local().Format("2006-01-02T15:04:05.00-0700"),
+			event.State,
+			event.SpaceName,
+			event.ServiceInstanceName,
+			instanceType,
+			event.ServiceOfferingName,
+			event.ServicePlanName,
+			event.ServiceBrokerName,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
@@ -0,0 +1,122 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	. "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-usage-events Command", func() {
+	var (
+		cmd             ServiceUsageEventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = ServiceUsageEventsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				Actor:       fakeActor,
+				SharedActor: fakeSharedActor,
+			},
+			States: []string{"CREATED"},
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetServiceUsageEventsReturns(
+			[]resources.ServiceUsageEvent{
+				{
+					GUID:                "event-guid-1",
+					CreatedAt:           time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+					State:               "CREATED",
+					OrganizationGUID:    "org-guid",
+					SpaceGUID:           "space-guid",
+					SpaceName:           "some-space",
+					ServiceInstanceGUID: "instance-guid",
+					ServiceInstanceName: "some-db",
+					ServiceInstanceType: "managed_service_instance",
+					ServiceOfferingName: "postgres",
+					ServicePlanName:     "small",
+					ServiceBrokerName:   "some-broker",
+				},
+				{
+					GUID:                "event-guid-2",
+					CreatedAt:           time.Date(2024, 3, 1, 13, 0, 0, 0, time.UTC),
+					State:               "CREATED",
+					SpaceName:           "some-space",
+					ServiceInstanceName: "some-ups",
+					ServiceInstanceType: "user_provided_service_instance",
+				},
+			},
+			v7action.Warnings{"warning-1"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("passes the states to the actor", func() {
+		Expect(fakeActor.GetServiceUsageEventsCallCount()).To(Equal(1))
+		Expect(fakeActor.GetServiceUsageEventsArgsForCall(0).States).To(Equal([]string{"CREATED"}))
+	})
+
+	It("displays the events and warnings", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting service usage events as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`time\s+state\s+space\s+service instance\s+type\s+offering\s+plan\s+broker`))
+		Expect(testUI.Out).To(Say(`CREATED\s+some-space\s+some-db\s+managed\s+postgres\s+small\s+some-broker`))
+		Expect(testUI.Out).To(Say(`CREATED\s+some-space\s+some-ups\s+user-provided`))
+
+		Expect(testUI.Err).To(Say("warning-1"))
+	})
+
+	When("getting the events fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceUsageEventsReturns(nil, v7action.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	When("exporting as CSV", func() {
+		BeforeEach(func() {
+			cmd.CSV = true
+		})
+
+		It("writes only the header and one row per event", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(
+				"guid,created_at,state,organization_guid,space_guid,space_name,service_instance_guid,service_instance_name,service_instance_type,service_offering_name,service_plan_name,service_broker_name\n" +
+					"event-guid-1,2024-03-01T12:00:00Z,CREATED,org-guid,space-guid,some-space,instance-guid,some-db,managed_service_instance,postgres,small,some-broker\n" +
+					"event-guid-2,2024-03-01T13:00:00Z,CREATED,,,some-space,,some-ups,user_provided_service_instance,,,\n",
+			))
+		})
+	})
+})
@@ -17,6 +17,8 @@ type ServicesCommand struct {
 	relatedCommands interface{} `related_commands:"create-service, marketplace"`
 }
 
+func (ServicesCommand) SupportsStructuredOutput() {}
+
 func (cmd ServicesCommand) Execute(args []string) error {
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
@@ -32,6 +34,10 @@ func (cmd ServicesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructured(newServiceInstanceDocuments(instances))
+	}
+
 	cmd.displayTable(instances)
 	return nil
 }
@@ -46,6 +52,10 @@ func (cmd ServicesCommand) displayMessage() error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return nil
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Getting service instances in org {{.OrgName}} / space {{.SpaceName}} as {{.UserName}}...", map[string]interface{}{
 		"OrgName":   cmd.Config.TargetedOrganization().Name,
 		"SpaceName": cmd.Config.TargetedSpace().Name,
@@ -135,3 +145,35 @@ func (t *ServicesTable) AppendRow(si v7action.ServiceInstance) {
 	}
 	t.table = append(t.table, row)
 }
+
+type serviceInstanceDocument struct {
+	Name             string   `json:"name" yaml:"name"`
+	Type             string   `json:"type" yaml:"type"`
+	Offering         string   `json:"offering" yaml:"offering"`
+	Plan             string   `json:"plan" yaml:"plan"`
+	BoundApps        []string `json:"bound_apps" yaml:"bound_apps"`
+	LastOperation    string   `json:"last_operation" yaml:"last_operation"`
+	Broker           string   `json:"broker" yaml:"broker"`
+	UpgradeAvailable *bool    `json:"upgrade_available" yaml:"upgrade_available"`
+}
+
+func newServiceInstanceDocuments(instances []v7action.ServiceInstance) []serviceInstanceDocument {
+	documents := []serviceInstanceDocument{}
+	for _, si := range instances {
+		document := serviceInstanceDocument{
+			Name:          si.Name,
+			Type:          string(si.Type),
+			Offering:      serviceOfferingName(si),
+			Plan:          si.ServicePlanName,
+			BoundApps:     nonNilStrings(si.BoundApps),
+			LastOperation: si.LastOperation,
+			Broker:        si.ServiceBrokerName,
+		}
+		if si.UpgradeAvailable.IsSet {
+			upgradeAvailable := si.UpgradeAvailable.Value
+			document.UpgradeAvailable = &upgradeAvailable
+		}
+		documents = append(documents, document)
+	}
+	return documents
+}
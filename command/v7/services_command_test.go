@@ -213,4 +213,58 @@ var _ = Describe("services command", func() {
 			Expect(executeErr).To(MatchError("a bad thing happened"))
 		})
 	})
+
+	When("a structured output format is set", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+			fakeActor.GetServiceInstancesForSpaceReturns(
+				[]v7action.ServiceInstance{
+					{
+						Name:                "msp",
+						Type:                resources.ManagedServiceInstance,
+						ServicePlanName:     "fake-plan-1",
+						ServiceOfferingName: "fake-offering-1",
+						ServiceBrokerName:   "fake-broker-1",
+						BoundApps:           []string{"app-1"},
+						LastOperation:       "create succeeded",
+						UpgradeAvailable:    types.NewOptionalBoolean(true),
+					},
+					{
+						Name: "upsi",
+						Type: resources.UserProvidedServiceInstance,
+					},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("outputs the service instances as a document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).NotTo(Say("Getting service instances"))
+			Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`[
+				{
+					"name": "msp",
+					"type": "managed",
+					"offering": "fake-offering-1",
+					"plan": "fake-plan-1",
+					"bound_apps": ["app-1"],
+					"last_operation": "create succeeded",
+					"broker": "fake-broker-1",
+					"upgrade_available": true
+				},
+				{
+					"name": "upsi",
+					"type": "user-provided",
+					"offering": "user-provided",
+					"plan": "",
+					"bound_apps": [],
+					"last_operation": "",
+					"broker": "",
+					"upgrade_available": null
+				}
+			]`))
+		})
+	})
 })
@@ -63,7 +63,7 @@ var _ = Describe("app stager", func() {
 			maxInFlight = 2
 			appAction = constant.ApplicationRestarting
 
-			fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+			fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
 				logStream := make(chan sharedaction.LogMessage)
 				errorStream := make(chan error)
 				closedTheStreams = false
@@ -237,7 +237,7 @@ var _ = Describe("app stager", func() {
 			organization = configv3.Organization{Name: "some-org"}
 			strategy = constant.DeploymentStrategyDefault
 
-			fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+			fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
 				logStream := make(chan sharedaction.LogMessage)
 				errorStream := make(chan error)
 				closedTheStreams = false
@@ -87,6 +87,14 @@ func formatRoutable(b *bool) string {
 	return fmt.Sprintf("%t", *b)
 }
 
+func formatSidecar(sidecar resources.Sidecar) string {
+	if !sidecar.MemoryInMB.IsSet {
+		return sidecar.Name
+	}
+
+	return fmt.Sprintf("%s (%dM)", sidecar.Name, sidecar.MemoryInMB.Value)
+}
+
 func (display AppSummaryDisplayer) displayAppInstancesTable(processSummary v7action.ProcessSummary) {
 	table := [][]string{
 		{
@@ -139,7 +147,7 @@ func (display AppSummaryDisplayer) displayProcessTable(summary v7action.Detailed
 
 		var processSidecars []string
 		for _, sidecar := range process.Sidecars {
-			processSidecars = append(processSidecars, sidecar.Name)
+			processSidecars = append(processSidecars, formatSidecar(sidecar))
 		}
 
 		keyValueTable := [][]string{
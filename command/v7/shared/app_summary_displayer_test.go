@@ -472,7 +472,7 @@ var _ = Describe("app summary displayer", func() {
 									DiskInMB:   types.NullUint64{Value: 1024, IsSet: true},
 								},
 								Sidecars: []resources.Sidecar{
-									{Name: "authenticator"},
+									{Name: "authenticator", MemoryInMB: types.NullUint64{Value: 8, IsSet: true}},
 									{Name: "clock"},
 								},
 							},
@@ -483,7 +483,7 @@ var _ = Describe("app summary displayer", func() {
 
 			It("lists information for each of the processes", func() {
 				Expect(testUI.Out).To(Say(`type:\s+web`))
-				Expect(testUI.Out).To(Say(`sidecars:\s+authenticator, clock`))
+				Expect(testUI.Out).To(Say(`sidecars:\s+authenticator \(8M\), clock`))
 				Expect(testUI.Out).To(Say(`instances:\s+0/0`))
 				Expect(testUI.Out).To(Say(`memory usage:\s+32M`))
 				Expect(testUI.Out).To(Say("There are no running instances of this process."))
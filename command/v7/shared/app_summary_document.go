@@ -0,0 +1,158 @@
+package shared
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3/constant"
+)
+
+// AppSummaryDocument is the structured representation of an app rendered by
+// the 'apps' and 'app' commands when an output format is requested.
+type AppSummaryDocument struct {
+	Name             string              `json:"name" yaml:"name"`
+	GUID             string              `json:"guid" yaml:"guid"`
+	RequestedState   string              `json:"requested_state" yaml:"requested_state"`
+	IsolationSegment string              `json:"isolation_segment,omitempty" yaml:"isolation_segment,omitempty"`
+	Routes           []string            `json:"routes" yaml:"routes"`
+	LastUploaded     string              `json:"last_uploaded,omitempty" yaml:"last_uploaded,omitempty"`
+	Stack            string              `json:"stack,omitempty" yaml:"stack,omitempty"`
+	DockerImage      string              `json:"docker_image,omitempty" yaml:"docker_image,omitempty"`
+	Buildpacks       []BuildpackDocument `json:"buildpacks,omitempty" yaml:"buildpacks,omitempty"`
+	Processes        []ProcessDocument   `json:"processes" yaml:"processes"`
+}
+
+// BuildpackDocument is a buildpack used to stage the current droplet.
+type BuildpackDocument struct {
+	Name          string `json:"name" yaml:"name"`
+	BuildpackName string `json:"buildpack_name" yaml:"buildpack_name"`
+	Version       string `json:"version" yaml:"version"`
+	DetectOutput  string `json:"detect_output" yaml:"detect_output"`
+}
+
+// ProcessDocument is a process of an app together with its instances.
+type ProcessDocument struct {
+	Type             string             `json:"type" yaml:"type"`
+	Sidecars         []string           `json:"sidecars" yaml:"sidecars"`
+	SidecarDetails   []SidecarDocument  `json:"sidecar_details" yaml:"sidecar_details"`
+	Instances        int                `json:"instances" yaml:"instances"`
+	RunningInstances int                `json:"running_instances" yaml:"running_instances"`
+	MemoryInMB       uint64             `json:"memory_in_mb" yaml:"memory_in_mb"`
+	InstanceDetails  []InstanceDocument `json:"instance_details,omitempty" yaml:"instance_details,omitempty"`
+}
+
+// SidecarDocument is a sidecar running alongside a process. MemoryInMB is
+// omitted when the sidecar shares the memory of the process.
+type SidecarDocument struct {
+	Name       string `json:"name" yaml:"name"`
+	MemoryInMB uint64 `json:"memory_in_mb,omitempty" yaml:"memory_in_mb,omitempty"`
+}
+
+// InstanceDocument is a single process instance. Usage values are in bytes.
+type InstanceDocument struct {
+	Index          int64    `json:"index" yaml:"index"`
+	State          string   `json:"state" yaml:"state"`
+	Since          string   `json:"since" yaml:"since"`
+	CPUEntitlement *float64 `json:"cpu_entitlement" yaml:"cpu_entitlement"`
+	MemoryUsage    uint64   `json:"memory_usage" yaml:"memory_usage"`
+	MemoryQuota    uint64   `json:"memory_quota" yaml:"memory_quota"`
+	DiskUsage      uint64   `json:"disk_usage" yaml:"disk_usage"`
+	DiskQuota      uint64   `json:"disk_quota" yaml:"disk_quota"`
+	LogRate        uint64   `json:"log_rate" yaml:"log_rate"`
+	LogRateLimit   int64    `json:"log_rate_limit" yaml:"log_rate_limit"`
+	Details        string   `json:"details" yaml:"details"`
+	Routable       *bool    `json:"routable" yaml:"routable"`
+}
+
+// NewAppSummaryDocument converts an application summary, as listed by the
+// 'apps' command, into its structured representation.
+func NewAppSummaryDocument(summary v7action.ApplicationSummary) AppSummaryDocument {
+	document := AppSummaryDocument{
+		Name:           summary.Name,
+		GUID:           summary.GUID,
+		RequestedState: strings.ToLower(string(summary.State)),
+		Routes:         []string{},
+		Processes:      []ProcessDocument{},
+	}
+
+	if name, exists := summary.GetIsolationSegmentName(); exists {
+		document.IsolationSegment = name
+	}
+
+	for _, route := range summary.Routes {
+		document.Routes = append(document.Routes, route.URL)
+	}
+
+	for _, process := range summary.ProcessSummaries {
+		document.Processes = append(document.Processes, newProcessDocument(process))
+	}
+
+	return document
+}
+
+// NewDetailedAppSummaryDocument converts a detailed application summary, as
+// displayed by the 'app' command, into its structured representation.
+func NewDetailedAppSummaryDocument(summary v7action.DetailedApplicationSummary) AppSummaryDocument {
+	document := NewAppSummaryDocument(summary.ApplicationSummary)
+	document.LastUploaded = summary.CurrentDroplet.CreatedAt
+	document.Stack = summary.CurrentDroplet.Stack
+
+	if summary.LifecycleType == constant.AppLifecycleTypeDocker {
+		document.DockerImage = summary.CurrentDroplet.Image
+		return document
+	}
+
+	for _, buildpack := range summary.CurrentDroplet.Buildpacks {
+		document.Buildpacks = append(document.Buildpacks, BuildpackDocument{
+			Name:          buildpack.Name,
+			BuildpackName: buildpack.BuildpackName,
+			Version:       buildpack.Version,
+			DetectOutput:  buildpack.DetectOutput,
+		})
+	}
+
+	return document
+}
+
+func newProcessDocument(process v7action.ProcessSummary) ProcessDocument {
+	document := ProcessDocument{
+		Type:             process.Type,
+		Sidecars:         []string{},
+		SidecarDetails:   []SidecarDocument{},
+		Instances:        process.TotalInstanceCount(),
+		RunningInstances: process.HealthyInstanceCount(),
+		MemoryInMB:       process.MemoryInMB.Value,
+	}
+
+	for _, sidecar := range process.Sidecars {
+		document.Sidecars = append(document.Sidecars, sidecar.Name)
+		document.SidecarDetails = append(document.SidecarDetails, SidecarDocument{
+			Name:       sidecar.Name,
+			MemoryInMB: sidecar.MemoryInMB.Value,
+		})
+	}
+
+	for _, instance := range process.InstanceDetails {
+		instanceDocument := InstanceDocument{
+			Index:        instance.Index,
+			State:        strings.ToLower(string(instance.State)),
+			Since:        instance.StartTime().UTC().Format(time.RFC3339),
+			MemoryUsage:  instance.MemoryUsage,
+			MemoryQuota:  instance.MemoryQuota,
+			DiskUsage:    instance.DiskUsage,
+			DiskQuota:    instance.DiskQuota,
+			LogRate:      instance.LogRate,
+			LogRateLimit: instance.LogRateLimit,
+			Details:      instance.Details,
+			Routable:     instance.Routable,
+		}
+		if instance.CPUEntitlement.IsSet {
+			cpuEntitlement := instance.CPUEntitlement.Value
+			instanceDocument.CPUEntitlement = &cpuEntitlement
+		}
+		document.InstanceDetails = append(document.InstanceDetails, instanceDocument)
+	}
+
+	return document
+}
@@ -191,12 +191,18 @@ func redactCNBCredentials(diff resources.Diff) resources.Diff {
 	return diff
 }
 
-func (display *ManifestDiffDisplayer) formatDiff(field string, diff resources.Diff, depth int, addHyphen bool) {
-	if display.RedactEnv {
+// RedactDiff hides the CNB credentials of a diff and, if redactEnv is set, the
+// values of environment variables.
+func RedactDiff(diff resources.Diff, redactEnv bool) resources.Diff {
+	if redactEnv {
 		diff = redactDiff(diff)
 	}
 
-	diff = redactCNBCredentials(diff)
+	return redactCNBCredentials(diff)
+}
+
+func (display *ManifestDiffDisplayer) formatDiff(field string, diff resources.Diff, depth int, addHyphen bool) {
+	diff = RedactDiff(diff, display.RedactEnv)
 	addHyphen = isInt(field) || addHyphen
 	switch diff.Op {
 	case resources.AddOperation:
@@ -48,14 +48,15 @@ func NewWrappedCloudControllerClient(config command.Config, ui command.UI, extra
 
 	ccWrappers = append(ccWrappers, extraWrappers...)
 	ccWrappers = append(ccWrappers, ccWrapper.NewCCTraceHeaderRequest(config.B3TraceID()))
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
+	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount(), config.RetryTimeout()))
 
 	return ccv3.NewClient(ccv3.Config{
-		AppName:            config.BinaryName(),
-		AppVersion:         config.BinaryVersion(),
-		JobPollingTimeout:  config.OverallPollingTimeout(),
-		JobPollingInterval: config.PollingInterval(),
-		Wrappers:           ccWrappers,
+		AppName:               config.BinaryName(),
+		AppVersion:            config.BinaryVersion(),
+		JobPollingTimeout:     config.OverallPollingTimeout(),
+		JobPollingInterval:    config.PollingInterval(),
+		PaginationConcurrency: config.PaginationConcurrency(),
+		Wrappers:              ccWrappers,
 	})
 }
 
@@ -87,7 +88,7 @@ func newWrappedUAAClient(config command.Config, ui command.UI) (*uaa.Client, err
 	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(uaaClient, config)
 	uaaClient.WrapConnection(uaaAuthWrapper)
 	uaaClient.WrapConnection(uaaWrapper.NewUAATraceHeaderRequest(config.B3TraceID()))
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryCount()))
+	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryCount(), config.RetryTimeout()))
 
 	err = uaaClient.SetupResources(config.UAAEndpoint(), config.AuthorizationEndpoint())
 	if err != nil {
@@ -0,0 +1,92 @@
+package v7
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+)
+
+type SidecarsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	relatedCommands interface{}  `related_commands:"app, create-sidecar, delete-sidecar, update-sidecar"`
+}
+
+func (cmd SidecarsCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
+		return err
+	}
+
+	if err := cmd.displayIntro(); err != nil {
+		return err
+	}
+
+	sidecars, warnings, err := cmd.Actor.GetApplicationSidecars(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	switch len(sidecars) {
+	case 0:
+		cmd.UI.DisplayText("No sidecars found.")
+	default:
+		cmd.displaySidecarsTable(sidecars)
+	}
+	return nil
+}
+
+func (cmd SidecarsCommand) Usage() string {
+	return `CF_NAME sidecars APP_NAME`
+}
+
+func (cmd SidecarsCommand) Examples() string {
+	return `CF_NAME sidecars my-app`
+}
+
+func (cmd SidecarsCommand) displayIntro() error {
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting sidecars for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	return nil
+}
+
+func (cmd SidecarsCommand) displaySidecarsTable(sidecars []resources.Sidecar) {
+	table := [][]string{{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("process types"),
+		cmd.UI.TranslateText("command"),
+		cmd.UI.TranslateText("memory"),
+		cmd.UI.TranslateText("origin"),
+	}}
+	for _, sidecar := range sidecars {
+		memory := ""
+		if sidecar.MemoryInMB.IsSet {
+			memory = fmt.Sprintf("%dM", sidecar.MemoryInMB.Value)
+		}
+
+		table = append(table, []string{
+			sidecar.Name,
+			strings.Join(sidecar.ProcessTypes, ", "),
+			sidecar.Command.Value,
+			memory,
+			sidecar.Origin,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
@@ -0,0 +1,125 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	v7 "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/types"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("sidecars Command", func() {
+	var (
+		cmd             v7.SidecarsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.SidecarsCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the app has sidecars", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSidecarsReturns(
+				[]resources.Sidecar{
+					{
+						Name:         "apm",
+						Command:      types.FilteredString{IsSet: true, Value: "./apm-agent"},
+						ProcessTypes: []string{"web", "worker"},
+						MemoryInMB:   types.NullUint64{IsSet: true, Value: 64},
+						Origin:       "user",
+					},
+					{
+						Name:         "envoy",
+						Command:      types.FilteredString{IsSet: true, Value: "/etc/envoy/start"},
+						ProcessTypes: []string{"web"},
+						Origin:       "buildpack",
+					},
+				},
+				v7action.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("displays the sidecars in a table", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Getting sidecars for app some-app in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`name\s+process types\s+command\s+memory\s+origin`))
+			Expect(testUI.Out).To(Say(`apm\s+web, worker\s+\./apm-agent\s+64M\s+user`))
+			Expect(testUI.Out).To(Say(`envoy\s+web\s+/etc/envoy/start\s+buildpack`))
+			Expect(testUI.Err).To(Say("some-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationSidecarsArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	When("the app has no sidecars", func() {
+		It("says that no sidecars were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No sidecars found."))
+			Expect(testUI.Out).ToNot(Say("name"))
+		})
+	})
+
+	When("getting the sidecars fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSidecarsReturns(nil, v7action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
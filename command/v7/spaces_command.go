@@ -13,6 +13,8 @@ type SpacesCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter spaces by labels"`
 }
 
+func (SpacesCommand) SupportsStructuredOutput() {}
+
 func (cmd SpacesCommand) Execute([]string) error {
 	err := cmd.SharedActor.CheckTarget(true, false)
 	if err != nil {
@@ -24,11 +26,13 @@ func (cmd SpacesCommand) Execute([]string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting spaces in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
-		"OrgName":     cmd.Config.TargetedOrganization().Name,
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting spaces in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	spaces, warnings, err := cmd.Actor.GetOrganizationSpacesWithLabelSelector(cmd.Config.TargetedOrganization().GUID, cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
@@ -36,6 +40,10 @@ func (cmd SpacesCommand) Execute([]string) error {
 		return err
 	}
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructured(newSpaceDocuments(spaces))
+	}
+
 	if len(spaces) == 0 {
 		cmd.UI.DisplayText("No spaces found.")
 	} else {
@@ -54,3 +62,19 @@ func (cmd SpacesCommand) displaySpaces(spaces []resources.Space) {
 
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 }
+
+type spaceDocument struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+}
+
+func newSpaceDocuments(spaces []resources.Space) []spaceDocument {
+	documents := []spaceDocument{}
+	for _, space := range spaces {
+		documents = append(documents, spaceDocument{
+			Name: space.Name,
+			GUID: space.GUID,
+		})
+	}
+	return documents
+}
@@ -183,4 +183,33 @@ var _ = Describe("spaces Command", func() {
 			})
 		})
 	})
+
+	When("a structured output format is set", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+			fakeActor.GetOrganizationSpacesWithLabelSelectorReturns(
+				[]resources.Space{{Name: "space-1", GUID: "space-guid-1"}},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("outputs the spaces as a document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).NotTo(Say("Getting spaces"))
+			Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`[{"name": "space-1", "guid": "space-guid-1"}]`))
+		})
+
+		When("there are no spaces", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationSpacesWithLabelSelectorReturns(nil, nil, nil)
+			})
+
+			It("outputs an empty list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`[]`))
+			})
+		})
+	})
 })
@@ -1,34 +1,46 @@
 package v7
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
 	"code.cloudfoundry.org/cli/v9/actor/sharedaction"
 	"code.cloudfoundry.org/cli/v9/command"
 	"code.cloudfoundry.org/cli/v9/command/flag"
 	"code.cloudfoundry.org/cli/v9/command/translatableerror"
 	"code.cloudfoundry.org/cli/v9/util/clissh"
+	"code.cloudfoundry.org/cli/v9/util/ui"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SharedSSHActor
 
 type SharedSSHActor interface {
+	ExecuteSecureCopy(sshClient sharedaction.SecureShellClient, copyOptions sharedaction.SecureCopyOptions) error
 	ExecuteSecureShell(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions) error
+	ExecuteSecureShellOnInstances(newClient func() sharedaction.SecureShellClient, instances []sharedaction.SSHInstanceOptions, maxInFlight int, output func(instanceIndex uint) (io.Writer, io.Writer)) []sharedaction.SSHCommandResult
 }
 
 type SSHCommand struct {
 	BaseCommand
 
 	RequiredArgs          flag.AppName             `positional-args:"yes"`
+	AllInstances          bool                     `long:"all-instances" description:"Run the command on all running instances of the process"`
 	ProcessIndex          uint                     `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
 	Commands              []string                 `long:"command" short:"c" description:"Command to run"`
 	DisablePseudoTTY      bool                     `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
 	ForcePseudoTTY        bool                     `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	InstanceRange         flag.InstanceRange       `long:"instances" description:"Run the command on the given instances of the process, such as 0-2,5"`
 	LocalPortForwardSpecs []flag.SSHPortForwarding `short:"L" description:"Local port forward specification"`
+	MaxInFlight           flag.PositiveInteger     `long:"max-in-flight" default:"4" description:"Maximum number of instances to run the command on at once, with --all-instances or --instances"`
 	ProcessType           string                   `long:"process" default:"web" description:"App process name"`
 	RequestPseudoTTY      bool                     `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
 	SkipHostValidation    bool                     `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
 	SkipRemoteExecution   bool                     `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
 
-	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]"`
+	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]\n\n   CF_NAME ssh APP_NAME (--all-instances | --instances INSTANCES) -c COMMAND...\n   [--process PROCESS] [--max-in-flight MAX_IN_FLIGHT] [--skip-host-validation]"`
 	relatedCommands interface{} `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
 
@@ -57,6 +69,10 @@ func (cmd SSHCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.AllInstances || cmd.InstanceRange.Indexes != nil {
+		return cmd.executeOnInstances()
+	}
+
 	ttyOption, err := cmd.EvaluateTTYOption()
 	if err != nil {
 		return err
@@ -98,6 +114,146 @@ func (cmd SSHCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd SSHCommand) executeOnInstances() error {
+	err := cmd.validateInstancesArgs()
+	if err != nil {
+		return err
+	}
+
+	var processIndexes []uint
+	if !cmd.AllInstances {
+		processIndexes = cmd.InstanceRange.Indexes
+	}
+
+	instanceAuths, warnings, err := cmd.Actor.GetSecureShellConfigurationsForProcessInstances(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		processIndexes,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var (
+		instances  []sharedaction.SSHInstanceOptions
+		writers    []*instanceOutputWriter
+		outputLock sync.Mutex
+	)
+	stdouts := map[uint]*instanceOutputWriter{}
+	stderrs := map[uint]*instanceOutputWriter{}
+	for _, instanceAuth := range instanceAuths {
+		instances = append(instances, sharedaction.SSHInstanceOptions{
+			SSHOptions: sharedaction.SSHOptions{
+				Commands:           cmd.Commands,
+				Endpoint:           instanceAuth.Endpoint,
+				HostKeyFingerprint: instanceAuth.HostKeyFingerprint,
+				SkipHostValidation: cmd.SkipHostValidation,
+				TTYOption:          sharedaction.RequestTTYNo,
+				Username:           instanceAuth.Username,
+			},
+			InstanceIndex: instanceAuth.InstanceIndex,
+			GetPasscode:   cmd.Actor.GetSSHPasscode,
+		})
+
+		prefix := fmt.Sprintf("[%s/%d] ", cmd.ProcessType, instanceAuth.InstanceIndex)
+		stdouts[instanceAuth.InstanceIndex] = &instanceOutputWriter{prefix: prefix, out: cmd.UI.GetOut(), lock: &outputLock}
+		stderrs[instanceAuth.InstanceIndex] = &instanceOutputWriter{prefix: prefix, out: cmd.UI.GetErr(), lock: &outputLock}
+		writers = append(writers, stdouts[instanceAuth.InstanceIndex], stderrs[instanceAuth.InstanceIndex])
+	}
+
+	results := cmd.SSHActor.ExecuteSecureShellOnInstances(
+		func() sharedaction.SecureShellClient { return clissh.NewDefaultSecureShell() },
+		instances,
+		int(cmd.MaxInFlight.Value),
+		func(instanceIndex uint) (io.Writer, io.Writer) {
+			return stdouts[instanceIndex], stderrs[instanceIndex]
+		},
+	)
+
+	for _, writer := range writers {
+		writer.Flush()
+	}
+
+	return cmd.displayInstanceResults(results)
+}
+
+func (cmd SSHCommand) validateInstancesArgs() error {
+	if cmd.AllInstances && cmd.InstanceRange.Indexes != nil {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--all-instances", "--instances"}}
+	}
+
+	instancesFlag := "--instances"
+	if cmd.AllInstances {
+		instancesFlag = "--all-instances"
+	}
+
+	if len(cmd.Commands) == 0 {
+		return translatableerror.RequiredFlagsError{Arg1: instancesFlag, Arg2: "--command"}
+	}
+
+	if len(cmd.LocalPortForwardSpecs) > 0 {
+		return translatableerror.ArgumentCombinationError{Args: []string{instancesFlag, "-L"}}
+	}
+
+	if cmd.SkipRemoteExecution {
+		return translatableerror.ArgumentCombinationError{Args: []string{instancesFlag, "--skip-remote-execution"}}
+	}
+
+	if cmd.DisablePseudoTTY || cmd.ForcePseudoTTY || cmd.RequestPseudoTTY {
+		return translatableerror.ArgumentCombinationError{Args: []string{
+			instancesFlag, "--disable-pseudo-tty", "--force-pseudo-tty", "--request-pseudo-tty",
+		}}
+	}
+
+	return nil
+}
+
+func (cmd SSHCommand) displayInstanceResults(results []sharedaction.SSHCommandResult) error {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("instance"),
+			cmd.UI.TranslateText("exit status"),
+		},
+	}
+
+	var failedCount, exitStatus int
+	for _, result := range results {
+		status := strconv.Itoa(result.ExitStatus)
+		resultExitStatus := result.ExitStatus
+		if result.Err != nil {
+			status = cmd.UI.TranslateText("error: {{.Error}}", map[string]interface{}{"Error": result.Err.Error()})
+			resultExitStatus = 255
+		}
+
+		if resultExitStatus != 0 {
+			failedCount++
+		}
+		if resultExitStatus > exitStatus {
+			exitStatus = resultExitStatus
+		}
+
+		table = append(table, []string{
+			fmt.Sprintf("%s/%d", cmd.ProcessType, result.InstanceIndex),
+			status,
+		})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if failedCount > 0 {
+		return translatableerror.SSHCommandFailedOnInstancesError{
+			ExitStatus:  exitStatus,
+			FailedCount: failedCount,
+			TotalCount:  len(results),
+		}
+	}
+
+	return nil
+}
+
 // EvaluateTTYOption determines which TTY options are mutually exclusive and
 // returns an error accordingly.
 func (cmd SSHCommand) EvaluateTTYOption() (sharedaction.TTYOption, error) {
@@ -125,3 +281,49 @@ func (cmd SSHCommand) EvaluateTTYOption() (sharedaction.TTYOption, error) {
 
 	return option, nil
 }
+
+// instanceOutputWriter prefixes each line written to it with the instance the
+// line came from, so that output from several instances can be interleaved.
+// Incomplete lines are held back until they are completed or flushed.
+type instanceOutputWriter struct {
+	prefix string
+	out    io.Writer
+	lock   *sync.Mutex
+	buffer []byte
+}
+
+func (w *instanceOutputWriter) Write(p []byte) (int, error) {
+	w.buffer = append(w.buffer, p...)
+	for {
+		i := bytes.IndexByte(w.buffer, '\n')
+		if i < 0 {
+			break
+		}
+
+		err := w.writeLine(w.buffer[:i+1])
+		if err != nil {
+			return 0, err
+		}
+		w.buffer = w.buffer[i+1:]
+	}
+
+	return len(p), nil
+}
+
+// Flush writes any incomplete line that is still buffered.
+func (w *instanceOutputWriter) Flush() {
+	if len(w.buffer) == 0 {
+		return
+	}
+
+	_ = w.writeLine(append(w.buffer, '\n'))
+	w.buffer = nil
+}
+
+func (w *instanceOutputWriter) writeLine(line []byte) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	_, err := fmt.Fprintf(w.out, "%s%s", w.prefix, line)
+	return err
+}
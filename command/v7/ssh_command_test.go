@@ -2,6 +2,8 @@ package v7_test
 
 import (
 	"errors"
+	"fmt"
+	"io"
 
 	"code.cloudfoundry.org/cli/v9/actor/actionerror"
 	"code.cloudfoundry.org/cli/v9/actor/sharedaction"
@@ -183,6 +185,192 @@ var _ = Describe("ssh Command", func() {
 					Expect(testUI.Err).To(Say("some-warnings"))
 				})
 			})
+
+			When("running the command on several instances", func() {
+				BeforeEach(func() {
+					cmd.AllInstances = true
+					cmd.SkipRemoteExecution = false
+					cmd.MaxInFlight = flag.PositiveInteger{Value: 2}
+
+					fakeActor.GetSecureShellConfigurationsForProcessInstancesReturns([]v7action.InstanceSSHAuthentication{
+						{
+							SSHAuthentication: v7action.SSHAuthentication{Endpoint: "some-endpoint", HostKeyFingerprint: "some-fingerprint", Username: "username-0"},
+							InstanceIndex:     0,
+						},
+						{
+							SSHAuthentication: v7action.SSHAuthentication{Endpoint: "some-endpoint", HostKeyFingerprint: "some-fingerprint", Username: "username-2"},
+							InstanceIndex:     2,
+						},
+					}, v7action.Warnings{"some-warnings"}, nil)
+
+					fakeSSHActor.ExecuteSecureShellOnInstancesStub = func(_ func() sharedaction.SecureShellClient, instances []sharedaction.SSHInstanceOptions, _ int, output func(uint) (io.Writer, io.Writer)) []sharedaction.SSHCommandResult {
+						var results []sharedaction.SSHCommandResult
+						for _, instance := range instances {
+							stdout, stderr := output(instance.InstanceIndex)
+							fmt.Fprintf(stdout, "out line one\nout line two")
+							fmt.Fprintf(stderr, "err line\n")
+							results = append(results, sharedaction.SSHCommandResult{InstanceIndex: instance.InstanceIndex})
+						}
+						return results
+					}
+				})
+
+				It("runs the command on every running instance", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("some-warnings"))
+
+					appNameArg, spaceGUIDArg, processTypeArg, processIndexesArg := fakeActor.GetSecureShellConfigurationsForProcessInstancesArgsForCall(0)
+					Expect(appNameArg).To(Equal(appName))
+					Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+					Expect(processTypeArg).To(Equal("some-process-type"))
+					Expect(processIndexesArg).To(BeNil())
+
+					Expect(fakeSSHActor.ExecuteSecureShellOnInstancesCallCount()).To(Equal(1))
+					_, instancesArg, maxInFlightArg, _ := fakeSSHActor.ExecuteSecureShellOnInstancesArgsForCall(0)
+					Expect(maxInFlightArg).To(Equal(2))
+					for i := range instancesArg {
+						Expect(instancesArg[i].GetPasscode).ToNot(BeNil())
+						instancesArg[i].GetPasscode = nil
+					}
+					Expect(instancesArg).To(Equal([]sharedaction.SSHInstanceOptions{
+						{
+							SSHOptions: sharedaction.SSHOptions{
+								Commands:           []string{"some", "commands"},
+								Endpoint:           "some-endpoint",
+								HostKeyFingerprint: "some-fingerprint",
+								SkipHostValidation: true,
+								TTYOption:          sharedaction.RequestTTYNo,
+								Username:           "username-0",
+							},
+							InstanceIndex: 0,
+						},
+						{
+							SSHOptions: sharedaction.SSHOptions{
+								Commands:           []string{"some", "commands"},
+								Endpoint:           "some-endpoint",
+								HostKeyFingerprint: "some-fingerprint",
+								SkipHostValidation: true,
+								TTYOption:          sharedaction.RequestTTYNo,
+								Username:           "username-2",
+							},
+							InstanceIndex: 2,
+						},
+					}))
+					Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(0))
+				})
+
+				It("gets a passcode for each instance when its session starts", func() {
+					fakeActor.GetSSHPasscodeReturns("some-passcode", nil)
+
+					_, instancesArg, _, _ := fakeSSHActor.ExecuteSecureShellOnInstancesArgsForCall(0)
+					Expect(fakeActor.GetSSHPasscodeCallCount()).To(Equal(0))
+
+					passcode, err := instancesArg[1].GetPasscode()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(passcode).To(Equal("some-passcode"))
+					Expect(fakeActor.GetSSHPasscodeCallCount()).To(Equal(1))
+				})
+
+				It("prefixes the output of each instance and displays a summary", func() {
+					Expect(testUI.Out).To(Say(`\[some-process-type/0\] out line one\n`))
+					Expect(testUI.Out).To(Say(`\[some-process-type/2\] out line one\n`))
+					Expect(testUI.Out).To(Say(`\[some-process-type/0\] out line two\n`))
+					Expect(testUI.Out).To(Say(`\[some-process-type/2\] out line two\n`))
+					Expect(testUI.Out).To(Say(`instance\s+exit status`))
+					Expect(testUI.Out).To(Say(`some-process-type/0\s+0`))
+					Expect(testUI.Out).To(Say(`some-process-type/2\s+0`))
+
+					Expect(testUI.Err).To(Say(`\[some-process-type/0\] err line\n`))
+					Expect(testUI.Err).To(Say(`\[some-process-type/2\] err line\n`))
+				})
+
+				When("instances are selected", func() {
+					BeforeEach(func() {
+						cmd.AllInstances = false
+						cmd.InstanceRange = flag.InstanceRange{Indexes: []uint{0, 2}}
+					})
+
+					It("asks for only those instances", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						_, _, _, processIndexesArg := fakeActor.GetSecureShellConfigurationsForProcessInstancesArgsForCall(0)
+						Expect(processIndexesArg).To(Equal([]uint{0, 2}))
+					})
+				})
+
+				When("the command fails on some instances", func() {
+					BeforeEach(func() {
+						fakeSSHActor.ExecuteSecureShellOnInstancesStub = nil
+						fakeSSHActor.ExecuteSecureShellOnInstancesReturns([]sharedaction.SSHCommandResult{
+							{InstanceIndex: 0, ExitStatus: 3},
+							{InstanceIndex: 2, Err: errors.New("some-connection-error")},
+						})
+					})
+
+					It("summarizes the failures and exits with the highest exit status", func() {
+						Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedOnInstancesError{
+							ExitStatus:  255,
+							FailedCount: 2,
+							TotalCount:  2,
+						}))
+						Expect(testUI.Out).To(Say(`some-process-type/0\s+3`))
+						Expect(testUI.Out).To(Say(`some-process-type/2\s+error: some-connection-error`))
+					})
+				})
+
+				When("getting the secure shell authentication fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetSecureShellConfigurationsForProcessInstancesReturns(nil, v7action.Warnings{"some-warnings"}, errors.New("some-error"))
+					})
+
+					It("returns the error and displays all warnings", func() {
+						Expect(executeErr).To(MatchError("some-error"))
+						Expect(testUI.Err).To(Say("some-warnings"))
+						Expect(fakeSSHActor.ExecuteSecureShellOnInstancesCallCount()).To(Equal(0))
+					})
+				})
+
+				When("both --all-instances and --instances are provided", func() {
+					BeforeEach(func() {
+						cmd.InstanceRange = flag.InstanceRange{Indexes: []uint{1}}
+					})
+
+					It("returns an ArgumentCombinationError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--all-instances", "--instances"}}))
+					})
+				})
+
+				When("no command is provided", func() {
+					BeforeEach(func() {
+						cmd.Commands = nil
+					})
+
+					It("returns a RequiredFlagsError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command"}))
+						Expect(fakeActor.GetSecureShellConfigurationsForProcessInstancesCallCount()).To(Equal(0))
+					})
+				})
+
+				When("local port forwarding is requested", func() {
+					BeforeEach(func() {
+						cmd.LocalPortForwardSpecs = []flag.SSHPortForwarding{{LocalAddress: "localhost:8888", RemoteAddress: "remote:4444"}}
+					})
+
+					It("returns an ArgumentCombinationError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--all-instances", "-L"}}))
+					})
+				})
+
+				When("a pseudo-tty option is provided", func() {
+					BeforeEach(func() {
+						cmd.RequestPseudoTTY = true
+					})
+
+					It("returns an ArgumentCombinationError", func() {
+						Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.ArgumentCombinationError{}))
+					})
+				})
+			})
 		})
 	})
 
@@ -16,6 +16,8 @@ type StacksCommand struct {
 	Labels          string      `long:"labels" description:"Selector to filter stacks by labels"`
 }
 
+func (StacksCommand) SupportsStructuredOutput() {}
+
 func (cmd StacksCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -27,10 +29,12 @@ func (cmd StacksCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting stacks as {{.Username}}...", map[string]interface{}{
-		"Username": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.IsStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting stacks as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	stacks, warnings, err := cmd.Actor.GetStacks(cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
@@ -40,6 +44,10 @@ func (cmd StacksCommand) Execute(args []string) error {
 
 	sort.Slice(stacks, func(i, j int) bool { return sorting.LessIgnoreCase(stacks[i].Name, stacks[j].Name) })
 
+	if cmd.UI.IsStructuredOutput() {
+		return cmd.UI.DisplayStructured(newStackDocuments(stacks))
+	}
+
 	cmd.displayTable(stacks)
 
 	return nil
@@ -82,3 +90,23 @@ func (cmd StacksCommand) displayTable(stacks []resources.Stack) {
 		cmd.UI.DisplayText("No stacks found.")
 	}
 }
+
+type stackDocument struct {
+	Name        string `json:"name" yaml:"name"`
+	GUID        string `json:"guid" yaml:"guid"`
+	Description string `json:"description" yaml:"description"`
+	State       string `json:"state,omitempty" yaml:"state,omitempty"`
+}
+
+func newStackDocuments(stacks []resources.Stack) []stackDocument {
+	documents := []stackDocument{}
+	for _, stack := range stacks {
+		documents = append(documents, stackDocument{
+			Name:        stack.Name,
+			GUID:        stack.GUID,
+			Description: stack.Description,
+			State:       stack.State,
+		})
+	}
+	return documents
+}
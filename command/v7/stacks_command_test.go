@@ -159,4 +159,24 @@ var _ = Describe("stacks Command", func() {
 			})
 		})
 	})
+
+	When("a structured output format is set", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+			fakeActor.GetStacksReturns([]resources.Stack{
+				{Name: "Stack2", GUID: "stack-guid-2", Description: "desc2"},
+				{Name: "stack1", GUID: "stack-guid-1", Description: "desc1", State: "ACTIVE"},
+			}, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("outputs the sorted stacks as a document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).NotTo(Say("Getting stacks"))
+			Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`[
+				{"name": "stack1", "guid": "stack-guid-1", "description": "desc1", "state": "ACTIVE"},
+				{"name": "Stack2", "guid": "stack-guid-2", "description": "desc2"}
+			]`))
+		})
+	})
 })
@@ -76,7 +76,7 @@ func (cmd StagePackageCommand) Execute(args []string) error {
 		packageGUID = pkg.GUID
 	}
 
-	logStream, logErrStream, stopLogStreamFunc, logWarnings, logErr := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.LogCacheClient)
+	logStream, logErrStream, stopLogStreamFunc, logWarnings, logErr := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.LogCacheClient, sharedaction.LogFilter{})
 	cmd.UI.DisplayWarnings(logWarnings)
 	if logErr != nil {
 		return logErr
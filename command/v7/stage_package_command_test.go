@@ -84,7 +84,7 @@ var _ = Describe("stage-package Command", func() {
 		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
 
 		allLogsWritten = make(chan bool)
-		fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+		fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
 			logStream := make(chan sharedaction.LogMessage)
 			errorStream := make(chan error)
 			closedTheStreams = false
@@ -219,7 +219,7 @@ var _ = Describe("stage-package Command", func() {
 			allLogsWritten = make(chan bool)
 			expectedErr = errors.New("banana")
 
-			fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+			fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
 				logStream := make(chan sharedaction.LogMessage)
 				errorStream := make(chan error)
 				closedTheStreams = false
@@ -364,7 +364,7 @@ var _ = Describe("stage-package Command", func() {
 		Eventually(testUI.Err).Should(Say("Failed to retrieve logs from Log Cache: problem getting more staging logs"))
 
 		Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-		appNameArg, spaceGUID, logCacheClient := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+		appNameArg, spaceGUID, logCacheClient, _ := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
 		Expect(appNameArg).To(Equal(appName))
 		Expect(spaceGUID).To(Equal("some-space-guid"))
 		Expect(logCacheClient).To(Equal(fakeLogCacheClient))
@@ -0,0 +1,43 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+)
+
+type SwitchContextCommand struct {
+	UI              command.UI
+	Config          command.Config
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME switch-context CONTEXT_NAME\n\nTIP:\n   Use the '--context' global option to run a single command against another context."`
+	relatedCommands interface{}      `related_commands:"contexts, create-context, target"`
+}
+
+func (cmd *SwitchContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd SwitchContextCommand) Execute(args []string) error {
+	contextName := cmd.RequiredArgs.Name
+
+	cmd.UI.DisplayText("Switching to context {{.ContextName}}...", map[string]interface{}{
+		"ContextName": contextName,
+	})
+
+	if !cmd.Config.HasContext(contextName) {
+		return translatableerror.ContextNotFoundError{Name: contextName}
+	}
+
+	err := cmd.Config.SwitchContext(contextName)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
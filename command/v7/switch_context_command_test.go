@@ -0,0 +1,58 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("switch-context Command", func() {
+	var (
+		cmd        v7.SwitchContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = v7.SwitchContextCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			RequiredArgs: flag.ContextName{Name: "staging"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the context exists", func() {
+		BeforeEach(func() {
+			fakeConfig.HasContextReturns(true)
+		})
+
+		It("switches to the context", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeConfig.SwitchContextCallCount()).To(Equal(1))
+			Expect(fakeConfig.SwitchContextArgsForCall(0)).To(Equal("staging"))
+
+			Expect(testUI.Out).To(Say("Switching to context staging..."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("the context does not exist", func() {
+		It("returns a ContextNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ContextNotFoundError{Name: "staging"}))
+			Expect(fakeConfig.SwitchContextCallCount()).To(Equal(0))
+		})
+	})
+})
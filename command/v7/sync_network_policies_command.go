@@ -0,0 +1,141 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/cli/v9/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/v7/shared"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SyncNetworkPoliciesActor
+
+type SyncNetworkPoliciesActor interface {
+	PlanNetworkPolicySync(desired []cfnetworkingaction.DesiredPolicy, prune bool) (cfnetworkingaction.PolicySyncPlan, cfnetworkingaction.Warnings, error)
+	ApplyNetworkPolicySync(plan cfnetworkingaction.PolicySyncPlan) error
+}
+
+type SyncNetworkPoliciesCommand struct {
+	BaseCommand
+
+	PathToFile flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to the network policy file"`
+	Prune      bool                        `long:"prune" description:"Remove policies that are not in the file from apps in the spaces of the source apps in the file"`
+	DryRun     bool                        `long:"dry-run" description:"Show the changes without making them"`
+
+	usage           interface{} `usage:"Make the network policies of the apps in a file match the file.\n\nCF_NAME sync-network-policies -f FILE [--prune] [--dry-run]\n\n   The file lists policies by app name. An app's space and org default to the targeted space and org:\n\n   policies:\n   - source: {app: frontend}\n     destination: {app: backend, space: backend-space, org: backend-org}\n     protocol: tcp\n     ports: 8080-8090\n\n   The protocol defaults to tcp and the ports to 8080.\n\nEXAMPLES:\n   CF_NAME sync-network-policies -f policies.yml --dry-run\n   CF_NAME sync-network-policies -f policies.yml --prune"`
+	relatedCommands interface{} `related_commands:"add-network-policy, export-network-policies, network-policies, remove-network-policy"`
+
+	NetworkingActor SyncNetworkPoliciesActor
+}
+
+func (cmd *SyncNetworkPoliciesCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+
+	return nil
+}
+
+func (cmd SyncNetworkPoliciesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	raw, err := os.ReadFile(string(cmd.PathToFile))
+	if err != nil {
+		return err
+	}
+
+	desired, err := cfnetworkingaction.ParseNetworkPolicyFile(raw)
+	if err != nil {
+		return err
+	}
+
+	for i := range desired {
+		desired[i].Source = cmd.defaultEndpoint(desired[i].Source)
+		desired[i].Destination = cmd.defaultEndpoint(desired[i].Destination)
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Syncing network policies from {{.Path}} as {{.User}}...", map[string]interface{}{
+		"Path": cmd.PathToFile,
+		"User": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	plan, warnings, err := cmd.NetworkingActor.PlanNetworkPolicySync(desired, cmd.Prune)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range plan.Additions {
+		cmd.UI.DisplayText("+ " + describeDesiredPolicy(policy))
+	}
+	for _, policy := range plan.Removals {
+		cmd.UI.DisplayText("- " + describeDesiredPolicy(policy))
+	}
+	cmd.UI.DisplayText("{{.Additions}} to add, {{.Removals}} to remove, {{.Unchanged}} unchanged.", map[string]interface{}{
+		"Additions": len(plan.Additions),
+		"Removals":  len(plan.Removals),
+		"Unchanged": plan.Unchanged,
+	})
+	cmd.UI.DisplayNewline()
+
+	if cmd.DryRun || len(plan.Additions)+len(plan.Removals) == 0 {
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	err = cmd.NetworkingActor.ApplyNetworkPolicySync(plan)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd SyncNetworkPoliciesCommand) defaultEndpoint(endpoint cfnetworkingaction.PolicyEndpoint) cfnetworkingaction.PolicyEndpoint {
+	if endpoint.SpaceName == "" {
+		endpoint.SpaceName = cmd.Config.TargetedSpace().Name
+	}
+	if endpoint.OrgName == "" {
+		endpoint.OrgName = cmd.Config.TargetedOrganization().Name
+	}
+	return endpoint
+}
+
+func describeDesiredPolicy(policy cfnetworkingaction.DesiredPolicy) string {
+	ports := fmt.Sprint(policy.StartPort)
+	if policy.EndPort != policy.StartPort {
+		ports = fmt.Sprintf("%d-%d", policy.StartPort, policy.EndPort)
+	}
+
+	return fmt.Sprintf("%s -> %s %s:%s",
+		describePolicyEndpoint(policy.Source),
+		describePolicyEndpoint(policy.Destination),
+		policy.Protocol,
+		ports,
+	)
+}
+
+func describePolicyEndpoint(endpoint cfnetworkingaction.PolicyEndpoint) string {
+	return fmt.Sprintf("%s/%s/%s", endpoint.OrgName, endpoint.SpaceName, endpoint.AppName)
+}
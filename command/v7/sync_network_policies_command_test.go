@@ -0,0 +1,186 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	. "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("sync-network-policies Command", func() {
+	var (
+		cmd             SyncNetworkPoliciesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeSyncActor   *v7fakes.FakeSyncNetworkPoliciesActor
+		policyFile      *os.File
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeSyncActor = new(v7fakes.FakeSyncNetworkPoliciesActor)
+
+		var err error
+		policyFile, err = os.CreateTemp("", "network-policies")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = policyFile.WriteString("policies:\n- source: {app: frontend}\n  destination: {app: backend, space: other-space, org: other-org}\n  ports: 8080-8090\n")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policyFile.Close()).To(Succeed())
+
+		cmd = SyncNetworkPoliciesCommand{
+			BaseCommand: BaseCommand{
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				UI:          testUI,
+				Actor:       fakeActor,
+			},
+			NetworkingActor: fakeSyncActor,
+			PathToFile:      flag.PathWithExistenceCheck(policyFile.Name()),
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeSyncActor.PlanNetworkPolicySyncReturns(cfnetworkingaction.PolicySyncPlan{
+			Additions: []cfnetworkingaction.DesiredPolicy{{
+				Source:      cfnetworkingaction.PolicyEndpoint{OrgName: "some-org", SpaceName: "some-space", AppName: "frontend"},
+				Destination: cfnetworkingaction.PolicyEndpoint{OrgName: "other-org", SpaceName: "other-space", AppName: "backend"},
+				Protocol:    "tcp",
+				StartPort:   8080,
+				EndPort:     8090,
+			}},
+			Removals: []cfnetworkingaction.DesiredPolicy{{
+				Source:      cfnetworkingaction.PolicyEndpoint{OrgName: "some-org", SpaceName: "some-space", AppName: "frontend"},
+				Destination: cfnetworkingaction.PolicyEndpoint{OrgName: "some-org", SpaceName: "some-space", AppName: "cache"},
+				Protocol:    "udp",
+				StartPort:   6379,
+				EndPort:     6379,
+			}},
+			Unchanged: 3,
+		}, cfnetworkingaction.Warnings{"plan-warning"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(policyFile.Name())).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("plans the sync with the targeted org and space as defaults", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(testUI.Out).To(Say(`Syncing network policies from .*network-policies.* as some-user\.\.\.`))
+
+		Expect(fakeSyncActor.PlanNetworkPolicySyncCallCount()).To(Equal(1))
+		desired, prune := fakeSyncActor.PlanNetworkPolicySyncArgsForCall(0)
+		Expect(prune).To(BeFalse())
+		Expect(desired).To(Equal([]cfnetworkingaction.DesiredPolicy{{
+			Source:      cfnetworkingaction.PolicyEndpoint{OrgName: "some-org", SpaceName: "some-space", AppName: "frontend"},
+			Destination: cfnetworkingaction.PolicyEndpoint{OrgName: "other-org", SpaceName: "other-space", AppName: "backend"},
+			Protocol:    "tcp",
+			StartPort:   8080,
+			EndPort:     8090,
+		}}))
+	})
+
+	It("displays the plan and applies it", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(testUI.Err).To(Say("plan-warning"))
+		Expect(testUI.Out).To(Say(`\+ some-org/some-space/frontend -> other-org/other-space/backend tcp:8080-8090`))
+		Expect(testUI.Out).To(Say(`- some-org/some-space/frontend -> some-org/some-space/cache udp:6379`))
+		Expect(testUI.Out).To(Say(`1 to add, 1 to remove, 3 unchanged\.`))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(fakeSyncActor.ApplyNetworkPolicySyncCallCount()).To(Equal(1))
+		Expect(fakeSyncActor.ApplyNetworkPolicySyncArgsForCall(0).Unchanged).To(Equal(3))
+	})
+
+	When("--prune is set", func() {
+		BeforeEach(func() {
+			cmd.Prune = true
+		})
+
+		It("plans removals", func() {
+			_, prune := fakeSyncActor.PlanNetworkPolicySyncArgsForCall(0)
+			Expect(prune).To(BeTrue())
+		})
+	})
+
+	When("--dry-run is set", func() {
+		BeforeEach(func() {
+			cmd.DryRun = true
+		})
+
+		It("displays the plan without applying it", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`1 to add, 1 to remove, 3 unchanged\.`))
+			Expect(fakeSyncActor.ApplyNetworkPolicySyncCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the file is invalid", func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(policyFile.Name(), []byte("policies:\n- source: {app: a}\n"), 0600)).To(Succeed())
+		})
+
+		It("returns the error without planning", func() {
+			Expect(executeErr).To(MatchError(actionerror.InvalidNetworkPolicyFileError{Index: 1, Message: "destination app is required"}))
+			Expect(fakeSyncActor.PlanNetworkPolicySyncCallCount()).To(Equal(0))
+		})
+	})
+
+	When("planning fails", func() {
+		BeforeEach(func() {
+			fakeSyncActor.PlanNetworkPolicySyncReturns(cfnetworkingaction.PolicySyncPlan{}, cfnetworkingaction.Warnings{"plan-warning"}, actionerror.ApplicationNotFoundError{Name: "backend"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "backend"}))
+			Expect(testUI.Err).To(Say("plan-warning"))
+			Expect(fakeSyncActor.ApplyNetworkPolicySyncCallCount()).To(Equal(0))
+		})
+	})
+
+	When("applying fails", func() {
+		BeforeEach(func() {
+			fakeSyncActor.ApplyNetworkPolicySyncReturns(errors.New("apply-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("apply-error"))
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/types"
+)
+
+type UpdateSidecarCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppSidecar      `positional-args:"yes"`
+	Command         string               `short:"c" description:"Command the sidecar runs"`
+	MemoryLimit     flag.MegabytesOrNull `short:"m" description:"Memory reserved for the sidecar out of the process memory (e.g. 64M, 1G); set to null to share the process memory again"`
+	ProcessTypes    []string             `long:"process" description:"App process the sidecar runs alongside; may be specified multiple times and replaces the current process types"`
+	relatedCommands interface{}          `related_commands:"create-sidecar, delete-sidecar, sidecars"`
+}
+
+func (cmd UpdateSidecarCommand) Execute(args []string) error {
+	if cmd.Command == "" && !cmd.MemoryLimit.IsSet && !cmd.MemoryLimit.IsNull && len(cmd.ProcessTypes) == 0 {
+		return translatableerror.IncorrectUsageError{
+			Message: "at least one of -c, -m or --process must be provided",
+		}
+	}
+
+	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating sidecar {{.SidecarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"SidecarName": cmd.RequiredArgs.Sidecar,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+
+	sidecar := resources.Sidecar{
+		ProcessTypes: cmd.ProcessTypes,
+		MemoryInMB:   cmd.MemoryLimit.NullUint64,
+		UnsetMemory:  cmd.MemoryLimit.IsNull,
+	}
+	if cmd.Command != "" {
+		sidecar.Command = types.FilteredString{IsSet: true, Value: cmd.Command}
+	}
+
+	_, warnings, err := cmd.Actor.UpdateApplicationSidecar(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.RequiredArgs.Sidecar,
+		sidecar,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Restart the app for the changes to take effect.")
+
+	return nil
+}
+
+func (cmd UpdateSidecarCommand) Usage() string {
+	return `CF_NAME update-sidecar APP_NAME SIDECAR_NAME [-c COMMAND] [--process PROCESS]... [-m MEMORY]`
+}
+
+func (cmd UpdateSidecarCommand) Examples() string {
+	return `CF_NAME update-sidecar my-app apm -m 128M
+CF_NAME update-sidecar my-app apm -m null`
+}
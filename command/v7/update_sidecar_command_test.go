@@ -0,0 +1,126 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/v9/actor/actionerror"
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/resources"
+	"code.cloudfoundry.org/cli/v9/types"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-sidecar Command", func() {
+	var (
+		cmd             v7.UpdateSidecarCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.UpdateSidecarCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.AppSidecar{AppName: "some-app", Sidecar: "apm"},
+			MemoryLimit:  flag.MegabytesOrNull{Megabytes: flag.Megabytes{NullUint64: types.NullUint64{IsSet: true, Value: 128}}},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.UpdateApplicationSidecarReturns(resources.Sidecar{}, v7action.Warnings{"some-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("no changes are requested", func() {
+		BeforeEach(func() {
+			cmd.MemoryLimit = flag.MegabytesOrNull{}
+		})
+
+		It("returns an incorrect usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+				Message: "at least one of -c, -m or --process must be provided",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	It("updates only the provided fields", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Updating sidecar apm for app some-app in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Err).To(Say("some-warning"))
+		Expect(testUI.Out).To(Say("OK"))
+
+		appName, spaceGUID, sidecarName, sidecar := fakeActor.UpdateApplicationSidecarArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(sidecarName).To(Equal("apm"))
+		Expect(sidecar).To(Equal(resources.Sidecar{
+			MemoryInMB: types.NullUint64{IsSet: true, Value: 128},
+		}))
+	})
+
+	When("the memory is set to null", func() {
+		BeforeEach(func() {
+			cmd.MemoryLimit = flag.MegabytesOrNull{IsNull: true}
+		})
+
+		It("unsets the memory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, _, _, sidecar := fakeActor.UpdateApplicationSidecarArgsForCall(0)
+			Expect(sidecar).To(Equal(resources.Sidecar{UnsetMemory: true}))
+		})
+	})
+
+	When("the command and process types are provided", func() {
+		BeforeEach(func() {
+			cmd.Command = "./apm-agent --verbose"
+			cmd.ProcessTypes = []string{"worker"}
+		})
+
+		It("updates them as well", func() {
+			_, _, _, sidecar := fakeActor.UpdateApplicationSidecarArgsForCall(0)
+			Expect(sidecar.Command).To(Equal(types.FilteredString{IsSet: true, Value: "./apm-agent --verbose"}))
+			Expect(sidecar.ProcessTypes).To(Equal([]string{"worker"}))
+		})
+	})
+
+	When("the sidecar does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateApplicationSidecarReturns(
+				resources.Sidecar{},
+				v7action.Warnings{"some-warning"},
+				actionerror.SidecarNotFoundError{Name: "apm", AppName: "some-app"},
+			)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.SidecarNotFoundError{Name: "apm", AppName: "some-app"}))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
@@ -0,0 +1,134 @@
+package v7
+
+import (
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+)
+
+type UsageReportCommand struct {
+	BaseCommand
+
+	Start           flag.TimeOrDuration `long:"start" description:"Start of the reporting window, given as an RFC 3339 timestamp or a duration before now such as 720h"`
+	End             flag.TimeOrDuration `long:"end" description:"End of the reporting window, given as an RFC 3339 timestamp or a duration before now (Default: now)"`
+	CSV             bool                `long:"csv" description:"Export the report as CSV instead of displaying a table. All other output is suppressed."`
+	usage           interface{}         `usage:"Report the instance-hours used by each app between two points in time, computed from the app usage events retained by Cloud Foundry.\n\nCF_NAME usage-report [--start TIME] [--end TIME] [--csv]\n\nEXAMPLES:\n   CF_NAME usage-report --start 720h\n   CF_NAME usage-report --start 2024-03-01T00:00:00Z --end 2024-04-01T00:00:00Z --csv"`
+	relatedCommands interface{}         `related_commands:"app-usage-events, service-usage-events"`
+}
+
+type exportedAppInstanceHours struct {
+	OrganizationGUID string  `json:"organization_guid" yaml:"organization_guid"`
+	SpaceGUID        string  `json:"space_guid" yaml:"space_guid"`
+	SpaceName        string  `json:"space_name" yaml:"space_name"`
+	AppGUID          string  `json:"app_guid" yaml:"app_guid"`
+	AppName          string  `json:"app_name" yaml:"app_name"`
+	InstanceHours    float64 `json:"instance_hours" yaml:"instance_hours"`
+}
+
+var exportedAppInstanceHoursHeader = []string{
+	"organization_guid", "space_guid", "space_name", "app_guid", "app_name", "instance_hours",
+}
+
+func (e exportedAppInstanceHours) row() []string {
+	return []string{
+		e.OrganizationGUID, e.SpaceGUID, e.SpaceName, e.AppGUID, e.AppName,
+		strconv.FormatFloat(e.InstanceHours, 'f', 2, 64),
+	}
+}
+
+func (UsageReportCommand) SupportsStructuredOutput() {}
+
+func (cmd UsageReportCommand) Execute(args []string) error {
+	if cmd.CSV && cmd.UI.IsStructuredOutput() {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--csv", "--output-format"},
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.CSV && !cmd.UI.IsStructuredOutput() {
+		user, err := cmd.Actor.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Getting usage report as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	report, warnings, err := cmd.Actor.GetAppInstanceHours(cmd.Start.Resolve(time.Now()), cmd.End.Resolve(time.Now()))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.CSV || cmd.UI.IsStructuredOutput() {
+		exported := []exportedAppInstanceHours{}
+		rows := [][]string{exportedAppInstanceHoursHeader}
+		for _, app := range report {
+			e := exportedAppInstanceHours{
+				OrganizationGUID: app.OrganizationGUID,
+				SpaceGUID:        app.SpaceGUID,
+				SpaceName:        app.SpaceName,
+				AppGUID:          app.AppGUID,
+				AppName:          app.AppName,
+				InstanceHours:    app.InstanceHours,
+			}
+			exported = append(exported, e)
+			rows = append(rows, e.row())
+		}
+		return exportUsage(cmd.UI, cmd.CSV, rows, exported)
+	}
+
+	if len(report) == 0 {
+		cmd.UI.DisplayText("No app usage found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("org guid"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("instance hours"),
+		},
+	}
+
+	for _, app := range report {
+		table = append(table, []string{
+			app.OrganizationGUID,
+			app.SpaceName,
+			app.AppName,
+			strconv.FormatFloat(app.InstanceHours, 'f', 2, 64),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
+
+// exportUsage writes a usage listing to stdout as CSV rows, the first of which
+// is the header, or as a document in the structured output format.
+func exportUsage(ui command.UI, csvRequested bool, rows [][]string, document interface{}) error {
+	if !csvRequested {
+		return ui.DisplayStructured(document)
+	}
+
+	writer := csv.NewWriter(ui.GetOut())
+	err := writer.WriteAll(rows)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/v9/actor/v7action"
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	. "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("usage-report Command", func() {
+	var (
+		cmd             UsageReportCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		start, end      time.Time
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		start = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+		end = time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+		cmd = UsageReportCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				Actor:       fakeActor,
+				SharedActor: fakeSharedActor,
+			},
+			Start: flag.TimeOrDuration{Time: start, IsSet: true},
+			End:   flag.TimeOrDuration{Time: end, IsSet: true},
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetAppInstanceHoursReturns(
+			[]v7action.AppInstanceHours{
+				{OrganizationGUID: "org-guid", SpaceGUID: "space-guid", SpaceName: "some-space", AppGUID: "app-guid-1", AppName: "app-1", InstanceHours: 12.5},
+				{OrganizationGUID: "org-guid", SpaceGUID: "space-guid", SpaceName: "some-space", AppGUID: "app-guid-2", AppName: "app-2", InstanceHours: 1.0 / 3},
+			},
+			v7action.Warnings{"warning-1"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("asks the actor for the instance-hours in the window", func() {
+		Expect(fakeActor.GetAppInstanceHoursCallCount()).To(Equal(1))
+		actualStart, actualEnd := fakeActor.GetAppInstanceHoursArgsForCall(0)
+		Expect(actualStart).To(Equal(start))
+		Expect(actualEnd).To(Equal(end))
+	})
+
+	It("displays the report and warnings", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting usage report as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`org guid\s+space\s+app\s+instance hours`))
+		Expect(testUI.Out).To(Say(`org-guid\s+some-space\s+app-1\s+12\.50`))
+		Expect(testUI.Out).To(Say(`org-guid\s+some-space\s+app-2\s+0\.33`))
+
+		Expect(testUI.Err).To(Say("warning-1"))
+	})
+
+	When("no apps ran in the window", func() {
+		BeforeEach(func() {
+			fakeActor.GetAppInstanceHoursReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No app usage found\.`))
+		})
+	})
+
+	When("building the report fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetAppInstanceHoursReturns(nil, v7action.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	When("exporting as CSV", func() {
+		BeforeEach(func() {
+			cmd.CSV = true
+		})
+
+		It("writes only the header and one row per app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(
+				"organization_guid,space_guid,space_name,app_guid,app_name,instance_hours\n" +
+					"org-guid,space-guid,some-space,app-guid-1,app-1,12.50\n" +
+					"org-guid,space-guid,some-space,app-guid-2,app-2,0.33\n",
+			))
+		})
+	})
+
+	When("the output format is json", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+		})
+
+		It("writes the report as a JSON array", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Getting usage report"))
+			Expect(testUI.Out).To(Say(`"app_name": "app-1"`))
+			Expect(testUI.Out).To(Say(`"instance_hours": 12.5`))
+		})
+	})
+
+	When("the output format is yaml", func() {
+		BeforeEach(func() {
+			testUI.SetOutputFormat(configv3.OutputFormatYAML)
+		})
+
+		It("writes the report as a YAML list", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`- organization_guid: org-guid`))
+			Expect(testUI.Out).To(Say(`app_name: app-1`))
+			Expect(testUI.Out).To(Say(`instance_hours: 12.5`))
+		})
+	})
+
+	When("--csv is combined with a structured output format", func() {
+		BeforeEach(func() {
+			cmd.CSV = true
+			testUI.SetOutputFormat(configv3.OutputFormatJSON)
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--csv", "--output-format"},
+			}))
+			Expect(fakeActor.GetAppInstanceHoursCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -18,6 +18,7 @@ import (
 	"code.cloudfoundry.org/cli/v9/resources"
 	"code.cloudfoundry.org/cli/v9/types"
 	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"code.cloudfoundry.org/cli/v9/util/download"
 	"github.com/SermoDigital/jose/jwt"
 )
 
@@ -38,6 +39,20 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	AnalyzeSecurityGroupsStub        func() (v7action.SecurityGroupAnalysis, v7action.Warnings, error)
+	analyzeSecurityGroupsMutex       sync.RWMutex
+	analyzeSecurityGroupsArgsForCall []struct {
+	}
+	analyzeSecurityGroupsReturns struct {
+		result1 v7action.SecurityGroupAnalysis
+		result2 v7action.Warnings
+		result3 error
+	}
+	analyzeSecurityGroupsReturnsOnCall map[int]struct {
+		result1 v7action.SecurityGroupAnalysis
+		result2 v7action.Warnings
+		result3 error
+	}
 	ApplyOrganizationQuotaByNameStub        func(string, string) (v7action.Warnings, error)
 	applyOrganizationQuotaByNameMutex       sync.RWMutex
 	applyOrganizationQuotaByNameArgsForCall []struct {
@@ -221,6 +236,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	CreateApplicationSidecarStub        func(string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)
+	createApplicationSidecarMutex       sync.RWMutex
+	createApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 resources.Sidecar
+	}
+	createApplicationSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	createApplicationSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	CreateBitsPackageByApplicationStub        func(string) (resources.Package, v7action.Warnings, error)
 	createBitsPackageByApplicationMutex       sync.RWMutex
 	createBitsPackageByApplicationArgsForCall []struct {
@@ -592,6 +624,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	DeleteApplicationSidecarStub        func(string, string, string) (v7action.Warnings, error)
+	deleteApplicationSidecarMutex       sync.RWMutex
+	deleteApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	deleteApplicationSidecarReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	deleteApplicationSidecarReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	DeleteBuildpackByNameAndStackAndLifecycleStub        func(string, string, string) (v7action.Warnings, error)
 	deleteBuildpackByNameAndStackAndLifecycleMutex       sync.RWMutex
 	deleteBuildpackByNameAndStackAndLifecycleArgsForCall []struct {
@@ -945,40 +992,19 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	DownloadCurrentDropletByAppNameStub        func(string, string) ([]byte, string, v7action.Warnings, error)
-	downloadCurrentDropletByAppNameMutex       sync.RWMutex
-	downloadCurrentDropletByAppNameArgsForCall []struct {
-		arg1 string
-		arg2 string
-	}
-	downloadCurrentDropletByAppNameReturns struct {
-		result1 []byte
-		result2 string
-		result3 v7action.Warnings
-		result4 error
-	}
-	downloadCurrentDropletByAppNameReturnsOnCall map[int]struct {
-		result1 []byte
-		result2 string
-		result3 v7action.Warnings
-		result4 error
-	}
-	DownloadDropletByGUIDAndAppNameStub        func(string, string, string) ([]byte, v7action.Warnings, error)
-	downloadDropletByGUIDAndAppNameMutex       sync.RWMutex
-	downloadDropletByGUIDAndAppNameArgsForCall []struct {
-		arg1 string
+	DownloadDropletStub        func(resources.Droplet, string, v7action.DropletDownloader, download.ProgressBar) error
+	downloadDropletMutex       sync.RWMutex
+	downloadDropletArgsForCall []struct {
+		arg1 resources.Droplet
 		arg2 string
-		arg3 string
+		arg3 v7action.DropletDownloader
+		arg4 download.ProgressBar
 	}
-	downloadDropletByGUIDAndAppNameReturns struct {
-		result1 []byte
-		result2 v7action.Warnings
-		result3 error
+	downloadDropletReturns struct {
+		result1 error
 	}
-	downloadDropletByGUIDAndAppNameReturnsOnCall map[int]struct {
-		result1 []byte
-		result2 v7action.Warnings
-		result3 error
+	downloadDropletReturnsOnCall map[int]struct {
+		result1 error
 	}
 	EnableFeatureFlagStub        func(string) (v7action.Warnings, error)
 	enableFeatureFlagMutex       sync.RWMutex
@@ -1041,6 +1067,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetAppInstanceHoursStub        func(time.Time, time.Time) ([]v7action.AppInstanceHours, v7action.Warnings, error)
+	getAppInstanceHoursMutex       sync.RWMutex
+	getAppInstanceHoursArgsForCall []struct {
+		arg1 time.Time
+		arg2 time.Time
+	}
+	getAppInstanceHoursReturns struct {
+		result1 []v7action.AppInstanceHours
+		result2 v7action.Warnings
+		result3 error
+	}
+	getAppInstanceHoursReturnsOnCall map[int]struct {
+		result1 []v7action.AppInstanceHours
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetAppSummariesForSpaceStub        func(string, string, bool) ([]v7action.ApplicationSummary, v7action.Warnings, error)
 	getAppSummariesForSpaceMutex       sync.RWMutex
 	getAppSummariesForSpaceArgsForCall []struct {
@@ -1058,6 +1100,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetAppUsageEventsStub        func(v7action.UsageEventFilter) ([]resources.AppUsageEvent, v7action.Warnings, error)
+	getAppUsageEventsMutex       sync.RWMutex
+	getAppUsageEventsArgsForCall []struct {
+		arg1 v7action.UsageEventFilter
+	}
+	getAppUsageEventsReturns struct {
+		result1 []resources.AppUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	getAppUsageEventsReturnsOnCall map[int]struct {
+		result1 []resources.AppUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationByNameAndSpaceStub        func(string, string) (resources.Application, v7action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -1199,6 +1256,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationSidecarsStub        func(string, string) ([]resources.Sidecar, v7action.Warnings, error)
+	getApplicationSidecarsMutex       sync.RWMutex
+	getApplicationSidecarsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationSidecarsReturns struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationSidecarsReturnsOnCall map[int]struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationTasksStub        func(string, v7action.SortOrder) ([]resources.Task, v7action.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -1246,6 +1319,52 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationsBySpaceStub        func(string) ([]resources.Application, v7action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetApplicationsBySpaceAndLabelSelectorStub        func(string, string) ([]resources.Application, v7action.Warnings, error)
+	getApplicationsBySpaceAndLabelSelectorMutex       sync.RWMutex
+	getApplicationsBySpaceAndLabelSelectorArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationsBySpaceAndLabelSelectorReturns struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceAndLabelSelectorReturnsOnCall map[int]struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetAuditEventsStub        func(v7action.AuditEventFilter) ([]v7action.Event, v7action.Warnings, error)
+	getAuditEventsMutex       sync.RWMutex
+	getAuditEventsArgsForCall []struct {
+		arg1 v7action.AuditEventFilter
+	}
+	getAuditEventsReturns struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
+	getAuditEventsReturnsOnCall map[int]struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetBuildpackLabelsStub        func(string, string, string) (map[string]types.NullString, v7action.Warnings, error)
 	getBuildpackLabelsMutex       sync.RWMutex
 	getBuildpackLabelsArgsForCall []struct {
@@ -1279,6 +1398,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetCurrentDropletByAppNameStub        func(string, string) (resources.Droplet, v7action.Warnings, error)
+	getCurrentDropletByAppNameMutex       sync.RWMutex
+	getCurrentDropletByAppNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getCurrentDropletByAppNameReturns struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
+	getCurrentDropletByAppNameReturnsOnCall map[int]struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetCurrentUserStub        func() (configv3.User, error)
 	getCurrentUserMutex       sync.RWMutex
 	getCurrentUserArgsForCall []struct {
@@ -1368,6 +1503,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetDropletByGUIDAndAppNameStub        func(string, string, string) (resources.Droplet, v7action.Warnings, error)
+	getDropletByGUIDAndAppNameMutex       sync.RWMutex
+	getDropletByGUIDAndAppNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getDropletByGUIDAndAppNameReturns struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDropletByGUIDAndAppNameReturnsOnCall map[int]struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetEffectiveIsolationSegmentBySpaceStub        func(string, string) (resources.IsolationSegment, v7action.Warnings, error)
 	getEffectiveIsolationSegmentBySpaceMutex       sync.RWMutex
 	getEffectiveIsolationSegmentBySpaceArgsForCall []struct {
@@ -1788,12 +1940,13 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	GetRecentLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error)
+	GetRecentLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error)
 	getRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
 		arg1 string
 		arg2 string
 		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
 	}
 	getRecentLogsForApplicationByNameAndSpaceReturns struct {
 		result1 []sharedaction.LogMessage
@@ -1805,6 +1958,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRecentLogsForApplicationsStub        func([]resources.Application, sharedaction.LogCacheClient, sharedaction.LogFilter) ([]v7action.ApplicationLogMessage, error)
+	getRecentLogsForApplicationsMutex       sync.RWMutex
+	getRecentLogsForApplicationsArgsForCall []struct {
+		arg1 []resources.Application
+		arg2 sharedaction.LogCacheClient
+		arg3 sharedaction.LogFilter
+	}
+	getRecentLogsForApplicationsReturns struct {
+		result1 []v7action.ApplicationLogMessage
+		result2 error
+	}
+	getRecentLogsForApplicationsReturnsOnCall map[int]struct {
+		result1 []v7action.ApplicationLogMessage
+		result2 error
+	}
 	GetRevisionByApplicationAndVersionStub        func(string, int) (resources.Revision, v7action.Warnings, error)
 	getRevisionByApplicationAndVersionMutex       sync.RWMutex
 	getRevisionByApplicationAndVersionArgsForCall []struct {
@@ -2072,6 +2240,24 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetSecureShellConfigurationsForProcessInstancesStub        func(string, string, string, []uint) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error)
+	getSecureShellConfigurationsForProcessInstancesMutex       sync.RWMutex
+	getSecureShellConfigurationsForProcessInstancesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 []uint
+	}
+	getSecureShellConfigurationsForProcessInstancesReturns struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSecureShellConfigurationsForProcessInstancesReturnsOnCall map[int]struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSecurityGroupStub        func(string) (resources.SecurityGroup, v7action.Warnings, error)
 	getSecurityGroupMutex       sync.RWMutex
 	getSecurityGroupArgsForCall []struct {
@@ -2373,6 +2559,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceUsageEventsStub        func(v7action.UsageEventFilter) ([]resources.ServiceUsageEvent, v7action.Warnings, error)
+	getServiceUsageEventsMutex       sync.RWMutex
+	getServiceUsageEventsArgsForCall []struct {
+		arg1 v7action.UsageEventFilter
+	}
+	getServiceUsageEventsReturns struct {
+		result1 []resources.ServiceUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceUsageEventsReturnsOnCall map[int]struct {
+		result1 []resources.ServiceUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSpaceByNameAndOrganizationStub        func(string, string) (resources.Space, v7action.Warnings, error)
 	getSpaceByNameAndOrganizationMutex       sync.RWMutex
 	getSpaceByNameAndOrganizationArgsForCall []struct {
@@ -2529,12 +2730,13 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	GetStreamingLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
+	GetStreamingLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
 	getStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
 		arg1 string
 		arg2 string
 		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
 	}
 	getStreamingLogsForApplicationByNameAndSpaceReturns struct {
 		result1 <-chan sharedaction.LogMessage
@@ -2550,6 +2752,40 @@ type FakeActor struct {
 		result4 v7action.Warnings
 		result5 error
 	}
+	GetStreamingLogsForApplicationsStub        func([]resources.Application, sharedaction.LogCacheClient, sharedaction.LogFilter) (<-chan v7action.ApplicationLogMessage, <-chan error, context.CancelFunc)
+	getStreamingLogsForApplicationsMutex       sync.RWMutex
+	getStreamingLogsForApplicationsArgsForCall []struct {
+		arg1 []resources.Application
+		arg2 sharedaction.LogCacheClient
+		arg3 sharedaction.LogFilter
+	}
+	getStreamingLogsForApplicationsReturns struct {
+		result1 <-chan v7action.ApplicationLogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}
+	getStreamingLogsForApplicationsReturnsOnCall map[int]struct {
+		result1 <-chan v7action.ApplicationLogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}
+	GetStreamingLogsForTaskStub        func(string, resources.Task, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc)
+	getStreamingLogsForTaskMutex       sync.RWMutex
+	getStreamingLogsForTaskArgsForCall []struct {
+		arg1 string
+		arg2 resources.Task
+		arg3 sharedaction.LogCacheClient
+	}
+	getStreamingLogsForTaskReturns struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}
+	getStreamingLogsForTaskReturnsOnCall map[int]struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}
 	GetTaskBySequenceIDAndApplicationStub        func(int, string) (resources.Task, v7action.Warnings, error)
 	getTaskBySequenceIDAndApplicationMutex       sync.RWMutex
 	getTaskBySequenceIDAndApplicationArgsForCall []struct {
@@ -2776,17 +3012,18 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
-	PollTaskStub        func(resources.Task) (resources.Task, v7action.Warnings, error)
-	pollTaskMutex       sync.RWMutex
-	pollTaskArgsForCall []struct {
+	PollTaskWithTimeoutStub        func(resources.Task, time.Duration) (resources.Task, v7action.Warnings, error)
+	pollTaskWithTimeoutMutex       sync.RWMutex
+	pollTaskWithTimeoutArgsForCall []struct {
 		arg1 resources.Task
+		arg2 time.Duration
 	}
-	pollTaskReturns struct {
+	pollTaskWithTimeoutReturns struct {
 		result1 resources.Task
 		result2 v7action.Warnings
 		result3 error
 	}
-	pollTaskReturnsOnCall map[int]struct {
+	pollTaskWithTimeoutReturnsOnCall map[int]struct {
 		result1 resources.Task
 		result2 v7action.Warnings
 		result3 error
@@ -3436,6 +3673,24 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateApplicationSidecarStub        func(string, string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)
+	updateApplicationSidecarMutex       sync.RWMutex
+	updateApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 resources.Sidecar
+	}
+	updateApplicationSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	updateApplicationSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	UpdateBuildpackByNameAndStackAndLifecycleStub        func(string, string, string, resources.Buildpack) (resources.Buildpack, v7action.Warnings, error)
 	updateBuildpackByNameAndStackAndLifecycleMutex       sync.RWMutex
 	updateBuildpackByNameAndStackAndLifecycleArgsForCall []struct {
@@ -3952,6 +4207,65 @@ func (fake *FakeActor) AddRoutePolicyReturnsOnCall(i int, result1 v7action.Warni
 	}{result1, result2}
 }
 
+func (fake *FakeActor) AnalyzeSecurityGroups() (v7action.SecurityGroupAnalysis, v7action.Warnings, error) {
+	fake.analyzeSecurityGroupsMutex.Lock()
+	ret, specificReturn := fake.analyzeSecurityGroupsReturnsOnCall[len(fake.analyzeSecurityGroupsArgsForCall)]
+	fake.analyzeSecurityGroupsArgsForCall = append(fake.analyzeSecurityGroupsArgsForCall, struct {
+	}{})
+	stub := fake.AnalyzeSecurityGroupsStub
+	fakeReturns := fake.analyzeSecurityGroupsReturns
+	fake.recordInvocation("AnalyzeSecurityGroups", []interface{}{})
+	fake.analyzeSecurityGroupsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) AnalyzeSecurityGroupsCallCount() int {
+	fake.analyzeSecurityGroupsMutex.RLock()
+	defer fake.analyzeSecurityGroupsMutex.RUnlock()
+	return len(fake.analyzeSecurityGroupsArgsForCall)
+}
+
+func (fake *FakeActor) AnalyzeSecurityGroupsCalls(stub func() (v7action.SecurityGroupAnalysis, v7action.Warnings, error)) {
+	fake.analyzeSecurityGroupsMutex.Lock()
+	defer fake.analyzeSecurityGroupsMutex.Unlock()
+	fake.AnalyzeSecurityGroupsStub = stub
+}
+
+func (fake *FakeActor) AnalyzeSecurityGroupsReturns(result1 v7action.SecurityGroupAnalysis, result2 v7action.Warnings, result3 error) {
+	fake.analyzeSecurityGroupsMutex.Lock()
+	defer fake.analyzeSecurityGroupsMutex.Unlock()
+	fake.AnalyzeSecurityGroupsStub = nil
+	fake.analyzeSecurityGroupsReturns = struct {
+		result1 v7action.SecurityGroupAnalysis
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) AnalyzeSecurityGroupsReturnsOnCall(i int, result1 v7action.SecurityGroupAnalysis, result2 v7action.Warnings, result3 error) {
+	fake.analyzeSecurityGroupsMutex.Lock()
+	defer fake.analyzeSecurityGroupsMutex.Unlock()
+	fake.AnalyzeSecurityGroupsStub = nil
+	if fake.analyzeSecurityGroupsReturnsOnCall == nil {
+		fake.analyzeSecurityGroupsReturnsOnCall = make(map[int]struct {
+			result1 v7action.SecurityGroupAnalysis
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.analyzeSecurityGroupsReturnsOnCall[i] = struct {
+		result1 v7action.SecurityGroupAnalysis
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) ApplyOrganizationQuotaByName(arg1 string, arg2 string) (v7action.Warnings, error) {
 	fake.applyOrganizationQuotaByNameMutex.Lock()
 	ret, specificReturn := fake.applyOrganizationQuotaByNameReturnsOnCall[len(fake.applyOrganizationQuotaByNameArgsForCall)]
@@ -4776,6 +5090,75 @@ func (fake *FakeActor) CreateApplicationInSpaceReturnsOnCall(i int, result1 reso
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) CreateApplicationSidecar(arg1 string, arg2 string, arg3 resources.Sidecar) (resources.Sidecar, v7action.Warnings, error) {
+	fake.createApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.createApplicationSidecarReturnsOnCall[len(fake.createApplicationSidecarArgsForCall)]
+	fake.createApplicationSidecarArgsForCall = append(fake.createApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 resources.Sidecar
+	}{arg1, arg2, arg3})
+	stub := fake.CreateApplicationSidecarStub
+	fakeReturns := fake.createApplicationSidecarReturns
+	fake.recordInvocation("CreateApplicationSidecar", []interface{}{arg1, arg2, arg3})
+	fake.createApplicationSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) CreateApplicationSidecarCallCount() int {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	return len(fake.createApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeActor) CreateApplicationSidecarCalls(stub func(string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = stub
+}
+
+func (fake *FakeActor) CreateApplicationSidecarArgsForCall(i int) (string, string, resources.Sidecar) {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.createApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) CreateApplicationSidecarReturns(result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	fake.createApplicationSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) CreateApplicationSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	if fake.createApplicationSidecarReturnsOnCall == nil {
+		fake.createApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) CreateBitsPackageByApplication(arg1 string) (resources.Package, v7action.Warnings, error) {
 	fake.createBitsPackageByApplicationMutex.Lock()
 	ret, specificReturn := fake.createBitsPackageByApplicationReturnsOnCall[len(fake.createBitsPackageByApplicationArgsForCall)]
@@ -6384,18 +6767,18 @@ func (fake *FakeActor) DeleteApplicationByNameAndSpaceReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
-func (fake *FakeActor) DeleteBuildpackByNameAndStackAndLifecycle(arg1 string, arg2 string, arg3 string) (v7action.Warnings, error) {
-	fake.deleteBuildpackByNameAndStackAndLifecycleMutex.Lock()
-	ret, specificReturn := fake.deleteBuildpackByNameAndStackAndLifecycleReturnsOnCall[len(fake.deleteBuildpackByNameAndStackAndLifecycleArgsForCall)]
-	fake.deleteBuildpackByNameAndStackAndLifecycleArgsForCall = append(fake.deleteBuildpackByNameAndStackAndLifecycleArgsForCall, struct {
+func (fake *FakeActor) DeleteApplicationSidecar(arg1 string, arg2 string, arg3 string) (v7action.Warnings, error) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationSidecarReturnsOnCall[len(fake.deleteApplicationSidecarArgsForCall)]
+	fake.deleteApplicationSidecarArgsForCall = append(fake.deleteApplicationSidecarArgsForCall, struct {
 		arg1 string
 		arg2 string
 		arg3 string
 	}{arg1, arg2, arg3})
-	stub := fake.DeleteBuildpackByNameAndStackAndLifecycleStub
-	fakeReturns := fake.deleteBuildpackByNameAndStackAndLifecycleReturns
-	fake.recordInvocation("DeleteBuildpackByNameAndStackAndLifecycle", []interface{}{arg1, arg2, arg3})
-	fake.deleteBuildpackByNameAndStackAndLifecycleMutex.Unlock()
+	stub := fake.DeleteApplicationSidecarStub
+	fakeReturns := fake.deleteApplicationSidecarReturns
+	fake.recordInvocation("DeleteApplicationSidecar", []interface{}{arg1, arg2, arg3})
+	fake.deleteApplicationSidecarMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
@@ -6405,26 +6788,92 @@ func (fake *FakeActor) DeleteBuildpackByNameAndStackAndLifecycle(arg1 string, ar
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeActor) DeleteBuildpackByNameAndStackAndLifecycleCallCount() int {
-	fake.deleteBuildpackByNameAndStackAndLifecycleMutex.RLock()
-	defer fake.deleteBuildpackByNameAndStackAndLifecycleMutex.RUnlock()
-	return len(fake.deleteBuildpackByNameAndStackAndLifecycleArgsForCall)
+func (fake *FakeActor) DeleteApplicationSidecarCallCount() int {
+	fake.deleteApplicationSidecarMutex.RLock()
+	defer fake.deleteApplicationSidecarMutex.RUnlock()
+	return len(fake.deleteApplicationSidecarArgsForCall)
 }
 
-func (fake *FakeActor) DeleteBuildpackByNameAndStackAndLifecycleCalls(stub func(string, string, string) (v7action.Warnings, error)) {
-	fake.deleteBuildpackByNameAndStackAndLifecycleMutex.Lock()
-	defer fake.deleteBuildpackByNameAndStackAndLifecycleMutex.Unlock()
-	fake.DeleteBuildpackByNameAndStackAndLifecycleStub = stub
+func (fake *FakeActor) DeleteApplicationSidecarCalls(stub func(string, string, string) (v7action.Warnings, error)) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	defer fake.deleteApplicationSidecarMutex.Unlock()
+	fake.DeleteApplicationSidecarStub = stub
 }
 
-func (fake *FakeActor) DeleteBuildpackByNameAndStackAndLifecycleArgsForCall(i int) (string, string, string) {
-	fake.deleteBuildpackByNameAndStackAndLifecycleMutex.RLock()
-	defer fake.deleteBuildpackByNameAndStackAndLifecycleMutex.RUnlock()
-	argsForCall := fake.deleteBuildpackByNameAndStackAndLifecycleArgsForCall[i]
+func (fake *FakeActor) DeleteApplicationSidecarArgsForCall(i int) (string, string, string) {
+	fake.deleteApplicationSidecarMutex.RLock()
+	defer fake.deleteApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.deleteApplicationSidecarArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeActor) DeleteBuildpackByNameAndStackAndLifecycleReturns(result1 v7action.Warnings, result2 error) {
+func (fake *FakeActor) DeleteApplicationSidecarReturns(result1 v7action.Warnings, result2 error) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	defer fake.deleteApplicationSidecarMutex.Unlock()
+	fake.DeleteApplicationSidecarStub = nil
+	fake.deleteApplicationSidecarReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	defer fake.deleteApplicationSidecarMutex.Unlock()
+	fake.DeleteApplicationSidecarStub = nil
+	if fake.deleteApplicationSidecarReturnsOnCall == nil {
+		fake.deleteApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationSidecarReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteBuildpackByNameAndStackAndLifecycle(arg1 string, arg2 string, arg3 string) (v7action.Warnings, error) {
+	fake.deleteBuildpackByNameAndStackAndLifecycleMutex.Lock()
+	ret, specificReturn := fake.deleteBuildpackByNameAndStackAndLifecycleReturnsOnCall[len(fake.deleteBuildpackByNameAndStackAndLifecycleArgsForCall)]
+	fake.deleteBuildpackByNameAndStackAndLifecycleArgsForCall = append(fake.deleteBuildpackByNameAndStackAndLifecycleArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteBuildpackByNameAndStackAndLifecycleStub
+	fakeReturns := fake.deleteBuildpackByNameAndStackAndLifecycleReturns
+	fake.recordInvocation("DeleteBuildpackByNameAndStackAndLifecycle", []interface{}{arg1, arg2, arg3})
+	fake.deleteBuildpackByNameAndStackAndLifecycleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DeleteBuildpackByNameAndStackAndLifecycleCallCount() int {
+	fake.deleteBuildpackByNameAndStackAndLifecycleMutex.RLock()
+	defer fake.deleteBuildpackByNameAndStackAndLifecycleMutex.RUnlock()
+	return len(fake.deleteBuildpackByNameAndStackAndLifecycleArgsForCall)
+}
+
+func (fake *FakeActor) DeleteBuildpackByNameAndStackAndLifecycleCalls(stub func(string, string, string) (v7action.Warnings, error)) {
+	fake.deleteBuildpackByNameAndStackAndLifecycleMutex.Lock()
+	defer fake.deleteBuildpackByNameAndStackAndLifecycleMutex.Unlock()
+	fake.DeleteBuildpackByNameAndStackAndLifecycleStub = stub
+}
+
+func (fake *FakeActor) DeleteBuildpackByNameAndStackAndLifecycleArgsForCall(i int) (string, string, string) {
+	fake.deleteBuildpackByNameAndStackAndLifecycleMutex.RLock()
+	defer fake.deleteBuildpackByNameAndStackAndLifecycleMutex.RUnlock()
+	argsForCall := fake.deleteBuildpackByNameAndStackAndLifecycleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) DeleteBuildpackByNameAndStackAndLifecycleReturns(result1 v7action.Warnings, result2 error) {
 	fake.deleteBuildpackByNameAndStackAndLifecycleMutex.Lock()
 	defer fake.deleteBuildpackByNameAndStackAndLifecycleMutex.Unlock()
 	fake.DeleteBuildpackByNameAndStackAndLifecycleStub = nil
@@ -7972,144 +8421,68 @@ func (fake *FakeActor) DisableServiceAccessReturnsOnCall(i int, result1 v7action
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) DownloadCurrentDropletByAppName(arg1 string, arg2 string) ([]byte, string, v7action.Warnings, error) {
-	fake.downloadCurrentDropletByAppNameMutex.Lock()
-	ret, specificReturn := fake.downloadCurrentDropletByAppNameReturnsOnCall[len(fake.downloadCurrentDropletByAppNameArgsForCall)]
-	fake.downloadCurrentDropletByAppNameArgsForCall = append(fake.downloadCurrentDropletByAppNameArgsForCall, struct {
-		arg1 string
+func (fake *FakeActor) DownloadDroplet(arg1 resources.Droplet, arg2 string, arg3 v7action.DropletDownloader, arg4 download.ProgressBar) error {
+	fake.downloadDropletMutex.Lock()
+	ret, specificReturn := fake.downloadDropletReturnsOnCall[len(fake.downloadDropletArgsForCall)]
+	fake.downloadDropletArgsForCall = append(fake.downloadDropletArgsForCall, struct {
+		arg1 resources.Droplet
 		arg2 string
-	}{arg1, arg2})
-	stub := fake.DownloadCurrentDropletByAppNameStub
-	fakeReturns := fake.downloadCurrentDropletByAppNameReturns
-	fake.recordInvocation("DownloadCurrentDropletByAppName", []interface{}{arg1, arg2})
-	fake.downloadCurrentDropletByAppNameMutex.Unlock()
-	if stub != nil {
-		return stub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3, ret.result4
-	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
-}
-
-func (fake *FakeActor) DownloadCurrentDropletByAppNameCallCount() int {
-	fake.downloadCurrentDropletByAppNameMutex.RLock()
-	defer fake.downloadCurrentDropletByAppNameMutex.RUnlock()
-	return len(fake.downloadCurrentDropletByAppNameArgsForCall)
-}
-
-func (fake *FakeActor) DownloadCurrentDropletByAppNameCalls(stub func(string, string) ([]byte, string, v7action.Warnings, error)) {
-	fake.downloadCurrentDropletByAppNameMutex.Lock()
-	defer fake.downloadCurrentDropletByAppNameMutex.Unlock()
-	fake.DownloadCurrentDropletByAppNameStub = stub
-}
-
-func (fake *FakeActor) DownloadCurrentDropletByAppNameArgsForCall(i int) (string, string) {
-	fake.downloadCurrentDropletByAppNameMutex.RLock()
-	defer fake.downloadCurrentDropletByAppNameMutex.RUnlock()
-	argsForCall := fake.downloadCurrentDropletByAppNameArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
-func (fake *FakeActor) DownloadCurrentDropletByAppNameReturns(result1 []byte, result2 string, result3 v7action.Warnings, result4 error) {
-	fake.downloadCurrentDropletByAppNameMutex.Lock()
-	defer fake.downloadCurrentDropletByAppNameMutex.Unlock()
-	fake.DownloadCurrentDropletByAppNameStub = nil
-	fake.downloadCurrentDropletByAppNameReturns = struct {
-		result1 []byte
-		result2 string
-		result3 v7action.Warnings
-		result4 error
-	}{result1, result2, result3, result4}
-}
-
-func (fake *FakeActor) DownloadCurrentDropletByAppNameReturnsOnCall(i int, result1 []byte, result2 string, result3 v7action.Warnings, result4 error) {
-	fake.downloadCurrentDropletByAppNameMutex.Lock()
-	defer fake.downloadCurrentDropletByAppNameMutex.Unlock()
-	fake.DownloadCurrentDropletByAppNameStub = nil
-	if fake.downloadCurrentDropletByAppNameReturnsOnCall == nil {
-		fake.downloadCurrentDropletByAppNameReturnsOnCall = make(map[int]struct {
-			result1 []byte
-			result2 string
-			result3 v7action.Warnings
-			result4 error
-		})
-	}
-	fake.downloadCurrentDropletByAppNameReturnsOnCall[i] = struct {
-		result1 []byte
-		result2 string
-		result3 v7action.Warnings
-		result4 error
-	}{result1, result2, result3, result4}
-}
-
-func (fake *FakeActor) DownloadDropletByGUIDAndAppName(arg1 string, arg2 string, arg3 string) ([]byte, v7action.Warnings, error) {
-	fake.downloadDropletByGUIDAndAppNameMutex.Lock()
-	ret, specificReturn := fake.downloadDropletByGUIDAndAppNameReturnsOnCall[len(fake.downloadDropletByGUIDAndAppNameArgsForCall)]
-	fake.downloadDropletByGUIDAndAppNameArgsForCall = append(fake.downloadDropletByGUIDAndAppNameArgsForCall, struct {
-		arg1 string
-		arg2 string
-		arg3 string
-	}{arg1, arg2, arg3})
-	stub := fake.DownloadDropletByGUIDAndAppNameStub
-	fakeReturns := fake.downloadDropletByGUIDAndAppNameReturns
-	fake.recordInvocation("DownloadDropletByGUIDAndAppName", []interface{}{arg1, arg2, arg3})
-	fake.downloadDropletByGUIDAndAppNameMutex.Unlock()
+		arg3 v7action.DropletDownloader
+		arg4 download.ProgressBar
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DownloadDropletStub
+	fakeReturns := fake.downloadDropletReturns
+	fake.recordInvocation("DownloadDroplet", []interface{}{arg1, arg2, arg3, arg4})
+	fake.downloadDropletMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	return fakeReturns.result1
 }
 
-func (fake *FakeActor) DownloadDropletByGUIDAndAppNameCallCount() int {
-	fake.downloadDropletByGUIDAndAppNameMutex.RLock()
-	defer fake.downloadDropletByGUIDAndAppNameMutex.RUnlock()
-	return len(fake.downloadDropletByGUIDAndAppNameArgsForCall)
+func (fake *FakeActor) DownloadDropletCallCount() int {
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	return len(fake.downloadDropletArgsForCall)
 }
 
-func (fake *FakeActor) DownloadDropletByGUIDAndAppNameCalls(stub func(string, string, string) ([]byte, v7action.Warnings, error)) {
-	fake.downloadDropletByGUIDAndAppNameMutex.Lock()
-	defer fake.downloadDropletByGUIDAndAppNameMutex.Unlock()
-	fake.DownloadDropletByGUIDAndAppNameStub = stub
+func (fake *FakeActor) DownloadDropletCalls(stub func(resources.Droplet, string, v7action.DropletDownloader, download.ProgressBar) error) {
+	fake.downloadDropletMutex.Lock()
+	defer fake.downloadDropletMutex.Unlock()
+	fake.DownloadDropletStub = stub
 }
 
-func (fake *FakeActor) DownloadDropletByGUIDAndAppNameArgsForCall(i int) (string, string, string) {
-	fake.downloadDropletByGUIDAndAppNameMutex.RLock()
-	defer fake.downloadDropletByGUIDAndAppNameMutex.RUnlock()
-	argsForCall := fake.downloadDropletByGUIDAndAppNameArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeActor) DownloadDropletArgsForCall(i int) (resources.Droplet, string, v7action.DropletDownloader, download.ProgressBar) {
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	argsForCall := fake.downloadDropletArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeActor) DownloadDropletByGUIDAndAppNameReturns(result1 []byte, result2 v7action.Warnings, result3 error) {
-	fake.downloadDropletByGUIDAndAppNameMutex.Lock()
-	defer fake.downloadDropletByGUIDAndAppNameMutex.Unlock()
-	fake.DownloadDropletByGUIDAndAppNameStub = nil
-	fake.downloadDropletByGUIDAndAppNameReturns = struct {
-		result1 []byte
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeActor) DownloadDropletReturns(result1 error) {
+	fake.downloadDropletMutex.Lock()
+	defer fake.downloadDropletMutex.Unlock()
+	fake.DownloadDropletStub = nil
+	fake.downloadDropletReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeActor) DownloadDropletByGUIDAndAppNameReturnsOnCall(i int, result1 []byte, result2 v7action.Warnings, result3 error) {
-	fake.downloadDropletByGUIDAndAppNameMutex.Lock()
-	defer fake.downloadDropletByGUIDAndAppNameMutex.Unlock()
-	fake.DownloadDropletByGUIDAndAppNameStub = nil
-	if fake.downloadDropletByGUIDAndAppNameReturnsOnCall == nil {
-		fake.downloadDropletByGUIDAndAppNameReturnsOnCall = make(map[int]struct {
-			result1 []byte
-			result2 v7action.Warnings
-			result3 error
+func (fake *FakeActor) DownloadDropletReturnsOnCall(i int, result1 error) {
+	fake.downloadDropletMutex.Lock()
+	defer fake.downloadDropletMutex.Unlock()
+	fake.DownloadDropletStub = nil
+	if fake.downloadDropletReturnsOnCall == nil {
+		fake.downloadDropletReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.downloadDropletByGUIDAndAppNameReturnsOnCall[i] = struct {
-		result1 []byte
-		result2 v7action.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.downloadDropletReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
 func (fake *FakeActor) EnableFeatureFlag(arg1 string) (v7action.Warnings, error) {
@@ -8379,6 +8752,74 @@ func (fake *FakeActor) GetAppFeatureReturnsOnCall(i int, result1 resources.Appli
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetAppInstanceHours(arg1 time.Time, arg2 time.Time) ([]v7action.AppInstanceHours, v7action.Warnings, error) {
+	fake.getAppInstanceHoursMutex.Lock()
+	ret, specificReturn := fake.getAppInstanceHoursReturnsOnCall[len(fake.getAppInstanceHoursArgsForCall)]
+	fake.getAppInstanceHoursArgsForCall = append(fake.getAppInstanceHoursArgsForCall, struct {
+		arg1 time.Time
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.GetAppInstanceHoursStub
+	fakeReturns := fake.getAppInstanceHoursReturns
+	fake.recordInvocation("GetAppInstanceHours", []interface{}{arg1, arg2})
+	fake.getAppInstanceHoursMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAppInstanceHoursCallCount() int {
+	fake.getAppInstanceHoursMutex.RLock()
+	defer fake.getAppInstanceHoursMutex.RUnlock()
+	return len(fake.getAppInstanceHoursArgsForCall)
+}
+
+func (fake *FakeActor) GetAppInstanceHoursCalls(stub func(time.Time, time.Time) ([]v7action.AppInstanceHours, v7action.Warnings, error)) {
+	fake.getAppInstanceHoursMutex.Lock()
+	defer fake.getAppInstanceHoursMutex.Unlock()
+	fake.GetAppInstanceHoursStub = stub
+}
+
+func (fake *FakeActor) GetAppInstanceHoursArgsForCall(i int) (time.Time, time.Time) {
+	fake.getAppInstanceHoursMutex.RLock()
+	defer fake.getAppInstanceHoursMutex.RUnlock()
+	argsForCall := fake.getAppInstanceHoursArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetAppInstanceHoursReturns(result1 []v7action.AppInstanceHours, result2 v7action.Warnings, result3 error) {
+	fake.getAppInstanceHoursMutex.Lock()
+	defer fake.getAppInstanceHoursMutex.Unlock()
+	fake.GetAppInstanceHoursStub = nil
+	fake.getAppInstanceHoursReturns = struct {
+		result1 []v7action.AppInstanceHours
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppInstanceHoursReturnsOnCall(i int, result1 []v7action.AppInstanceHours, result2 v7action.Warnings, result3 error) {
+	fake.getAppInstanceHoursMutex.Lock()
+	defer fake.getAppInstanceHoursMutex.Unlock()
+	fake.GetAppInstanceHoursStub = nil
+	if fake.getAppInstanceHoursReturnsOnCall == nil {
+		fake.getAppInstanceHoursReturnsOnCall = make(map[int]struct {
+			result1 []v7action.AppInstanceHours
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAppInstanceHoursReturnsOnCall[i] = struct {
+		result1 []v7action.AppInstanceHours
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetAppSummariesForSpace(arg1 string, arg2 string, arg3 bool) ([]v7action.ApplicationSummary, v7action.Warnings, error) {
 	fake.getAppSummariesForSpaceMutex.Lock()
 	ret, specificReturn := fake.getAppSummariesForSpaceReturnsOnCall[len(fake.getAppSummariesForSpaceArgsForCall)]
@@ -8448,6 +8889,73 @@ func (fake *FakeActor) GetAppSummariesForSpaceReturnsOnCall(i int, result1 []v7a
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetAppUsageEvents(arg1 v7action.UsageEventFilter) ([]resources.AppUsageEvent, v7action.Warnings, error) {
+	fake.getAppUsageEventsMutex.Lock()
+	ret, specificReturn := fake.getAppUsageEventsReturnsOnCall[len(fake.getAppUsageEventsArgsForCall)]
+	fake.getAppUsageEventsArgsForCall = append(fake.getAppUsageEventsArgsForCall, struct {
+		arg1 v7action.UsageEventFilter
+	}{arg1})
+	stub := fake.GetAppUsageEventsStub
+	fakeReturns := fake.getAppUsageEventsReturns
+	fake.recordInvocation("GetAppUsageEvents", []interface{}{arg1})
+	fake.getAppUsageEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAppUsageEventsCallCount() int {
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
+	return len(fake.getAppUsageEventsArgsForCall)
+}
+
+func (fake *FakeActor) GetAppUsageEventsCalls(stub func(v7action.UsageEventFilter) ([]resources.AppUsageEvent, v7action.Warnings, error)) {
+	fake.getAppUsageEventsMutex.Lock()
+	defer fake.getAppUsageEventsMutex.Unlock()
+	fake.GetAppUsageEventsStub = stub
+}
+
+func (fake *FakeActor) GetAppUsageEventsArgsForCall(i int) v7action.UsageEventFilter {
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
+	argsForCall := fake.getAppUsageEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetAppUsageEventsReturns(result1 []resources.AppUsageEvent, result2 v7action.Warnings, result3 error) {
+	fake.getAppUsageEventsMutex.Lock()
+	defer fake.getAppUsageEventsMutex.Unlock()
+	fake.GetAppUsageEventsStub = nil
+	fake.getAppUsageEventsReturns = struct {
+		result1 []resources.AppUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppUsageEventsReturnsOnCall(i int, result1 []resources.AppUsageEvent, result2 v7action.Warnings, result3 error) {
+	fake.getAppUsageEventsMutex.Lock()
+	defer fake.getAppUsageEventsMutex.Unlock()
+	fake.GetAppUsageEventsStub = nil
+	if fake.getAppUsageEventsReturnsOnCall == nil {
+		fake.getAppUsageEventsReturnsOnCall = make(map[int]struct {
+			result1 []resources.AppUsageEvent
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAppUsageEventsReturnsOnCall[i] = struct {
+		result1 []resources.AppUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (resources.Application, v7action.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -9057,6 +9565,74 @@ func (fake *FakeActor) GetApplicationRoutesReturnsOnCall(i int, result1 []resour
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationSidecars(arg1 string, arg2 string) ([]resources.Sidecar, v7action.Warnings, error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	ret, specificReturn := fake.getApplicationSidecarsReturnsOnCall[len(fake.getApplicationSidecarsArgsForCall)]
+	fake.getApplicationSidecarsArgsForCall = append(fake.getApplicationSidecarsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetApplicationSidecarsStub
+	fakeReturns := fake.getApplicationSidecarsReturns
+	fake.recordInvocation("GetApplicationSidecars", []interface{}{arg1, arg2})
+	fake.getApplicationSidecarsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationSidecarsCallCount() int {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	return len(fake.getApplicationSidecarsArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationSidecarsCalls(stub func(string, string) ([]resources.Sidecar, v7action.Warnings, error)) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = stub
+}
+
+func (fake *FakeActor) GetApplicationSidecarsArgsForCall(i int) (string, string) {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	argsForCall := fake.getApplicationSidecarsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetApplicationSidecarsReturns(result1 []resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	fake.getApplicationSidecarsReturns = struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationSidecarsReturnsOnCall(i int, result1 []resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	if fake.getApplicationSidecarsReturnsOnCall == nil {
+		fake.getApplicationSidecarsReturnsOnCall = make(map[int]struct {
+			result1 []resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSidecarsReturnsOnCall[i] = struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationTasks(arg1 string, arg2 v7action.SortOrder) ([]resources.Task, v7action.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
@@ -9270,6 +9846,208 @@ func (fake *FakeActor) GetApplicationsByNamesAndSpaceReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationsBySpace(arg1 string) ([]resources.Application, v7action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetApplicationsBySpaceStub
+	fakeReturns := fake.getApplicationsBySpaceReturns
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{arg1})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceCalls(stub func(string) ([]resources.Application, v7action.Warnings, error)) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = stub
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationsBySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceReturns(result1 []resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = nil
+	if fake.getApplicationsBySpaceReturnsOnCall == nil {
+		fake.getApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []resources.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceAndLabelSelector(arg1 string, arg2 string) ([]resources.Application, v7action.Warnings, error) {
+	fake.getApplicationsBySpaceAndLabelSelectorMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceAndLabelSelectorReturnsOnCall[len(fake.getApplicationsBySpaceAndLabelSelectorArgsForCall)]
+	fake.getApplicationsBySpaceAndLabelSelectorArgsForCall = append(fake.getApplicationsBySpaceAndLabelSelectorArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetApplicationsBySpaceAndLabelSelectorStub
+	fakeReturns := fake.getApplicationsBySpaceAndLabelSelectorReturns
+	fake.recordInvocation("GetApplicationsBySpaceAndLabelSelector", []interface{}{arg1, arg2})
+	fake.getApplicationsBySpaceAndLabelSelectorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceAndLabelSelectorCallCount() int {
+	fake.getApplicationsBySpaceAndLabelSelectorMutex.RLock()
+	defer fake.getApplicationsBySpaceAndLabelSelectorMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceAndLabelSelectorArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceAndLabelSelectorCalls(stub func(string, string) ([]resources.Application, v7action.Warnings, error)) {
+	fake.getApplicationsBySpaceAndLabelSelectorMutex.Lock()
+	defer fake.getApplicationsBySpaceAndLabelSelectorMutex.Unlock()
+	fake.GetApplicationsBySpaceAndLabelSelectorStub = stub
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceAndLabelSelectorArgsForCall(i int) (string, string) {
+	fake.getApplicationsBySpaceAndLabelSelectorMutex.RLock()
+	defer fake.getApplicationsBySpaceAndLabelSelectorMutex.RUnlock()
+	argsForCall := fake.getApplicationsBySpaceAndLabelSelectorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceAndLabelSelectorReturns(result1 []resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceAndLabelSelectorMutex.Lock()
+	defer fake.getApplicationsBySpaceAndLabelSelectorMutex.Unlock()
+	fake.GetApplicationsBySpaceAndLabelSelectorStub = nil
+	fake.getApplicationsBySpaceAndLabelSelectorReturns = struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationsBySpaceAndLabelSelectorReturnsOnCall(i int, result1 []resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceAndLabelSelectorMutex.Lock()
+	defer fake.getApplicationsBySpaceAndLabelSelectorMutex.Unlock()
+	fake.GetApplicationsBySpaceAndLabelSelectorStub = nil
+	if fake.getApplicationsBySpaceAndLabelSelectorReturnsOnCall == nil {
+		fake.getApplicationsBySpaceAndLabelSelectorReturnsOnCall = make(map[int]struct {
+			result1 []resources.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceAndLabelSelectorReturnsOnCall[i] = struct {
+		result1 []resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAuditEvents(arg1 v7action.AuditEventFilter) ([]v7action.Event, v7action.Warnings, error) {
+	fake.getAuditEventsMutex.Lock()
+	ret, specificReturn := fake.getAuditEventsReturnsOnCall[len(fake.getAuditEventsArgsForCall)]
+	fake.getAuditEventsArgsForCall = append(fake.getAuditEventsArgsForCall, struct {
+		arg1 v7action.AuditEventFilter
+	}{arg1})
+	stub := fake.GetAuditEventsStub
+	fakeReturns := fake.getAuditEventsReturns
+	fake.recordInvocation("GetAuditEvents", []interface{}{arg1})
+	fake.getAuditEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAuditEventsCallCount() int {
+	fake.getAuditEventsMutex.RLock()
+	defer fake.getAuditEventsMutex.RUnlock()
+	return len(fake.getAuditEventsArgsForCall)
+}
+
+func (fake *FakeActor) GetAuditEventsCalls(stub func(v7action.AuditEventFilter) ([]v7action.Event, v7action.Warnings, error)) {
+	fake.getAuditEventsMutex.Lock()
+	defer fake.getAuditEventsMutex.Unlock()
+	fake.GetAuditEventsStub = stub
+}
+
+func (fake *FakeActor) GetAuditEventsArgsForCall(i int) v7action.AuditEventFilter {
+	fake.getAuditEventsMutex.RLock()
+	defer fake.getAuditEventsMutex.RUnlock()
+	argsForCall := fake.getAuditEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetAuditEventsReturns(result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getAuditEventsMutex.Lock()
+	defer fake.getAuditEventsMutex.Unlock()
+	fake.GetAuditEventsStub = nil
+	fake.getAuditEventsReturns = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAuditEventsReturnsOnCall(i int, result1 []v7action.Event, result2 v7action.Warnings, result3 error) {
+	fake.getAuditEventsMutex.Lock()
+	defer fake.getAuditEventsMutex.Unlock()
+	fake.GetAuditEventsStub = nil
+	if fake.getAuditEventsReturnsOnCall == nil {
+		fake.getAuditEventsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.Event
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAuditEventsReturnsOnCall[i] = struct {
+		result1 []v7action.Event
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetBuildpackLabels(arg1 string, arg2 string, arg3 string) (map[string]types.NullString, v7action.Warnings, error) {
 	fake.getBuildpackLabelsMutex.Lock()
 	ret, specificReturn := fake.getBuildpackLabelsReturnsOnCall[len(fake.getBuildpackLabelsArgsForCall)]
@@ -9407,6 +10185,74 @@ func (fake *FakeActor) GetBuildpacksReturnsOnCall(i int, result1 []resources.Bui
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetCurrentDropletByAppName(arg1 string, arg2 string) (resources.Droplet, v7action.Warnings, error) {
+	fake.getCurrentDropletByAppNameMutex.Lock()
+	ret, specificReturn := fake.getCurrentDropletByAppNameReturnsOnCall[len(fake.getCurrentDropletByAppNameArgsForCall)]
+	fake.getCurrentDropletByAppNameArgsForCall = append(fake.getCurrentDropletByAppNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetCurrentDropletByAppNameStub
+	fakeReturns := fake.getCurrentDropletByAppNameReturns
+	fake.recordInvocation("GetCurrentDropletByAppName", []interface{}{arg1, arg2})
+	fake.getCurrentDropletByAppNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetCurrentDropletByAppNameCallCount() int {
+	fake.getCurrentDropletByAppNameMutex.RLock()
+	defer fake.getCurrentDropletByAppNameMutex.RUnlock()
+	return len(fake.getCurrentDropletByAppNameArgsForCall)
+}
+
+func (fake *FakeActor) GetCurrentDropletByAppNameCalls(stub func(string, string) (resources.Droplet, v7action.Warnings, error)) {
+	fake.getCurrentDropletByAppNameMutex.Lock()
+	defer fake.getCurrentDropletByAppNameMutex.Unlock()
+	fake.GetCurrentDropletByAppNameStub = stub
+}
+
+func (fake *FakeActor) GetCurrentDropletByAppNameArgsForCall(i int) (string, string) {
+	fake.getCurrentDropletByAppNameMutex.RLock()
+	defer fake.getCurrentDropletByAppNameMutex.RUnlock()
+	argsForCall := fake.getCurrentDropletByAppNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetCurrentDropletByAppNameReturns(result1 resources.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getCurrentDropletByAppNameMutex.Lock()
+	defer fake.getCurrentDropletByAppNameMutex.Unlock()
+	fake.GetCurrentDropletByAppNameStub = nil
+	fake.getCurrentDropletByAppNameReturns = struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetCurrentDropletByAppNameReturnsOnCall(i int, result1 resources.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getCurrentDropletByAppNameMutex.Lock()
+	defer fake.getCurrentDropletByAppNameMutex.Unlock()
+	fake.GetCurrentDropletByAppNameStub = nil
+	if fake.getCurrentDropletByAppNameReturnsOnCall == nil {
+		fake.getCurrentDropletByAppNameReturnsOnCall = make(map[int]struct {
+			result1 resources.Droplet
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getCurrentDropletByAppNameReturnsOnCall[i] = struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetCurrentUser() (configv3.User, error) {
 	fake.getCurrentUserMutex.Lock()
 	ret, specificReturn := fake.getCurrentUserReturnsOnCall[len(fake.getCurrentUserArgsForCall)]
@@ -9800,6 +10646,75 @@ func (fake *FakeActor) GetDomainLabelsReturnsOnCall(i int, result1 map[string]ty
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetDropletByGUIDAndAppName(arg1 string, arg2 string, arg3 string) (resources.Droplet, v7action.Warnings, error) {
+	fake.getDropletByGUIDAndAppNameMutex.Lock()
+	ret, specificReturn := fake.getDropletByGUIDAndAppNameReturnsOnCall[len(fake.getDropletByGUIDAndAppNameArgsForCall)]
+	fake.getDropletByGUIDAndAppNameArgsForCall = append(fake.getDropletByGUIDAndAppNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetDropletByGUIDAndAppNameStub
+	fakeReturns := fake.getDropletByGUIDAndAppNameReturns
+	fake.recordInvocation("GetDropletByGUIDAndAppName", []interface{}{arg1, arg2, arg3})
+	fake.getDropletByGUIDAndAppNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetDropletByGUIDAndAppNameCallCount() int {
+	fake.getDropletByGUIDAndAppNameMutex.RLock()
+	defer fake.getDropletByGUIDAndAppNameMutex.RUnlock()
+	return len(fake.getDropletByGUIDAndAppNameArgsForCall)
+}
+
+func (fake *FakeActor) GetDropletByGUIDAndAppNameCalls(stub func(string, string, string) (resources.Droplet, v7action.Warnings, error)) {
+	fake.getDropletByGUIDAndAppNameMutex.Lock()
+	defer fake.getDropletByGUIDAndAppNameMutex.Unlock()
+	fake.GetDropletByGUIDAndAppNameStub = stub
+}
+
+func (fake *FakeActor) GetDropletByGUIDAndAppNameArgsForCall(i int) (string, string, string) {
+	fake.getDropletByGUIDAndAppNameMutex.RLock()
+	defer fake.getDropletByGUIDAndAppNameMutex.RUnlock()
+	argsForCall := fake.getDropletByGUIDAndAppNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetDropletByGUIDAndAppNameReturns(result1 resources.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getDropletByGUIDAndAppNameMutex.Lock()
+	defer fake.getDropletByGUIDAndAppNameMutex.Unlock()
+	fake.GetDropletByGUIDAndAppNameStub = nil
+	fake.getDropletByGUIDAndAppNameReturns = struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDropletByGUIDAndAppNameReturnsOnCall(i int, result1 resources.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getDropletByGUIDAndAppNameMutex.Lock()
+	defer fake.getDropletByGUIDAndAppNameMutex.Unlock()
+	fake.GetDropletByGUIDAndAppNameStub = nil
+	if fake.getDropletByGUIDAndAppNameReturnsOnCall == nil {
+		fake.getDropletByGUIDAndAppNameReturnsOnCall = make(map[int]struct {
+			result1 resources.Droplet
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDropletByGUIDAndAppNameReturnsOnCall[i] = struct {
+		result1 resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetEffectiveIsolationSegmentBySpace(arg1 string, arg2 string) (resources.IsolationSegment, v7action.Warnings, error) {
 	fake.getEffectiveIsolationSegmentBySpaceMutex.Lock()
 	ret, specificReturn := fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall[len(fake.getEffectiveIsolationSegmentBySpaceArgsForCall)]
@@ -11627,20 +12542,21 @@ func (fake *FakeActor) GetRecentEventsByApplicationNameAndSpaceReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error) {
+func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient, arg4 sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error) {
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)]
 	fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall, struct {
 		arg1 string
 		arg2 string
 		arg3 sharedaction.LogCacheClient
-	}{arg1, arg2, arg3})
+		arg4 sharedaction.LogFilter
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.GetRecentLogsForApplicationByNameAndSpaceStub
 	fakeReturns := fake.getRecentLogsForApplicationByNameAndSpaceReturns
-	fake.recordInvocation("GetRecentLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("GetRecentLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -11654,17 +12570,17 @@ func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceCallCount() int
 	return len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)
 }
 
-func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error)) {
+func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error)) {
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
 	fake.GetRecentLogsForApplicationByNameAndSpaceStub = stub
 }
 
-func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient) {
+func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) {
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	argsForCall := fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceReturns(result1 []sharedaction.LogMessage, result2 v7action.Warnings, result3 error) {
@@ -11696,6 +12612,77 @@ func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceReturnsOnCall(i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetRecentLogsForApplications(arg1 []resources.Application, arg2 sharedaction.LogCacheClient, arg3 sharedaction.LogFilter) ([]v7action.ApplicationLogMessage, error) {
+	var arg1Copy []resources.Application
+	if arg1 != nil {
+		arg1Copy = make([]resources.Application, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getRecentLogsForApplicationsMutex.Lock()
+	ret, specificReturn := fake.getRecentLogsForApplicationsReturnsOnCall[len(fake.getRecentLogsForApplicationsArgsForCall)]
+	fake.getRecentLogsForApplicationsArgsForCall = append(fake.getRecentLogsForApplicationsArgsForCall, struct {
+		arg1 []resources.Application
+		arg2 sharedaction.LogCacheClient
+		arg3 sharedaction.LogFilter
+	}{arg1Copy, arg2, arg3})
+	stub := fake.GetRecentLogsForApplicationsStub
+	fakeReturns := fake.getRecentLogsForApplicationsReturns
+	fake.recordInvocation("GetRecentLogsForApplications", []interface{}{arg1Copy, arg2, arg3})
+	fake.getRecentLogsForApplicationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) GetRecentLogsForApplicationsCallCount() int {
+	fake.getRecentLogsForApplicationsMutex.RLock()
+	defer fake.getRecentLogsForApplicationsMutex.RUnlock()
+	return len(fake.getRecentLogsForApplicationsArgsForCall)
+}
+
+func (fake *FakeActor) GetRecentLogsForApplicationsCalls(stub func([]resources.Application, sharedaction.LogCacheClient, sharedaction.LogFilter) ([]v7action.ApplicationLogMessage, error)) {
+	fake.getRecentLogsForApplicationsMutex.Lock()
+	defer fake.getRecentLogsForApplicationsMutex.Unlock()
+	fake.GetRecentLogsForApplicationsStub = stub
+}
+
+func (fake *FakeActor) GetRecentLogsForApplicationsArgsForCall(i int) ([]resources.Application, sharedaction.LogCacheClient, sharedaction.LogFilter) {
+	fake.getRecentLogsForApplicationsMutex.RLock()
+	defer fake.getRecentLogsForApplicationsMutex.RUnlock()
+	argsForCall := fake.getRecentLogsForApplicationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetRecentLogsForApplicationsReturns(result1 []v7action.ApplicationLogMessage, result2 error) {
+	fake.getRecentLogsForApplicationsMutex.Lock()
+	defer fake.getRecentLogsForApplicationsMutex.Unlock()
+	fake.GetRecentLogsForApplicationsStub = nil
+	fake.getRecentLogsForApplicationsReturns = struct {
+		result1 []v7action.ApplicationLogMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) GetRecentLogsForApplicationsReturnsOnCall(i int, result1 []v7action.ApplicationLogMessage, result2 error) {
+	fake.getRecentLogsForApplicationsMutex.Lock()
+	defer fake.getRecentLogsForApplicationsMutex.Unlock()
+	fake.GetRecentLogsForApplicationsStub = nil
+	if fake.getRecentLogsForApplicationsReturnsOnCall == nil {
+		fake.getRecentLogsForApplicationsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ApplicationLogMessage
+			result2 error
+		})
+	}
+	fake.getRecentLogsForApplicationsReturnsOnCall[i] = struct {
+		result1 []v7action.ApplicationLogMessage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) GetRevisionByApplicationAndVersion(arg1 string, arg2 int) (resources.Revision, v7action.Warnings, error) {
 	fake.getRevisionByApplicationAndVersionMutex.Lock()
 	ret, specificReturn := fake.getRevisionByApplicationAndVersionReturnsOnCall[len(fake.getRevisionByApplicationAndVersionArgsForCall)]
@@ -12828,6 +13815,81 @@ func (fake *FakeActor) GetSecureShellConfigurationByApplicationNameSpaceProcessT
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetSecureShellConfigurationsForProcessInstances(arg1 string, arg2 string, arg3 string, arg4 []uint) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error) {
+	var arg4Copy []uint
+	if arg4 != nil {
+		arg4Copy = make([]uint, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.getSecureShellConfigurationsForProcessInstancesMutex.Lock()
+	ret, specificReturn := fake.getSecureShellConfigurationsForProcessInstancesReturnsOnCall[len(fake.getSecureShellConfigurationsForProcessInstancesArgsForCall)]
+	fake.getSecureShellConfigurationsForProcessInstancesArgsForCall = append(fake.getSecureShellConfigurationsForProcessInstancesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 []uint
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.GetSecureShellConfigurationsForProcessInstancesStub
+	fakeReturns := fake.getSecureShellConfigurationsForProcessInstancesReturns
+	fake.recordInvocation("GetSecureShellConfigurationsForProcessInstances", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.getSecureShellConfigurationsForProcessInstancesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsForProcessInstancesCallCount() int {
+	fake.getSecureShellConfigurationsForProcessInstancesMutex.RLock()
+	defer fake.getSecureShellConfigurationsForProcessInstancesMutex.RUnlock()
+	return len(fake.getSecureShellConfigurationsForProcessInstancesArgsForCall)
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsForProcessInstancesCalls(stub func(string, string, string, []uint) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error)) {
+	fake.getSecureShellConfigurationsForProcessInstancesMutex.Lock()
+	defer fake.getSecureShellConfigurationsForProcessInstancesMutex.Unlock()
+	fake.GetSecureShellConfigurationsForProcessInstancesStub = stub
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsForProcessInstancesArgsForCall(i int) (string, string, string, []uint) {
+	fake.getSecureShellConfigurationsForProcessInstancesMutex.RLock()
+	defer fake.getSecureShellConfigurationsForProcessInstancesMutex.RUnlock()
+	argsForCall := fake.getSecureShellConfigurationsForProcessInstancesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsForProcessInstancesReturns(result1 []v7action.InstanceSSHAuthentication, result2 v7action.Warnings, result3 error) {
+	fake.getSecureShellConfigurationsForProcessInstancesMutex.Lock()
+	defer fake.getSecureShellConfigurationsForProcessInstancesMutex.Unlock()
+	fake.GetSecureShellConfigurationsForProcessInstancesStub = nil
+	fake.getSecureShellConfigurationsForProcessInstancesReturns = struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsForProcessInstancesReturnsOnCall(i int, result1 []v7action.InstanceSSHAuthentication, result2 v7action.Warnings, result3 error) {
+	fake.getSecureShellConfigurationsForProcessInstancesMutex.Lock()
+	defer fake.getSecureShellConfigurationsForProcessInstancesMutex.Unlock()
+	fake.GetSecureShellConfigurationsForProcessInstancesStub = nil
+	if fake.getSecureShellConfigurationsForProcessInstancesReturnsOnCall == nil {
+		fake.getSecureShellConfigurationsForProcessInstancesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.InstanceSSHAuthentication
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSecureShellConfigurationsForProcessInstancesReturnsOnCall[i] = struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetSecurityGroup(arg1 string) (resources.SecurityGroup, v7action.Warnings, error) {
 	fake.getSecurityGroupMutex.Lock()
 	ret, specificReturn := fake.getSecurityGroupReturnsOnCall[len(fake.getSecurityGroupArgsForCall)]
@@ -14103,6 +15165,73 @@ func (fake *FakeActor) GetServicePlanLabelsReturnsOnCall(i int, result1 map[stri
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceUsageEvents(arg1 v7action.UsageEventFilter) ([]resources.ServiceUsageEvent, v7action.Warnings, error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	ret, specificReturn := fake.getServiceUsageEventsReturnsOnCall[len(fake.getServiceUsageEventsArgsForCall)]
+	fake.getServiceUsageEventsArgsForCall = append(fake.getServiceUsageEventsArgsForCall, struct {
+		arg1 v7action.UsageEventFilter
+	}{arg1})
+	stub := fake.GetServiceUsageEventsStub
+	fakeReturns := fake.getServiceUsageEventsReturns
+	fake.recordInvocation("GetServiceUsageEvents", []interface{}{arg1})
+	fake.getServiceUsageEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceUsageEventsCallCount() int {
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
+	return len(fake.getServiceUsageEventsArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceUsageEventsCalls(stub func(v7action.UsageEventFilter) ([]resources.ServiceUsageEvent, v7action.Warnings, error)) {
+	fake.getServiceUsageEventsMutex.Lock()
+	defer fake.getServiceUsageEventsMutex.Unlock()
+	fake.GetServiceUsageEventsStub = stub
+}
+
+func (fake *FakeActor) GetServiceUsageEventsArgsForCall(i int) v7action.UsageEventFilter {
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
+	argsForCall := fake.getServiceUsageEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceUsageEventsReturns(result1 []resources.ServiceUsageEvent, result2 v7action.Warnings, result3 error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	defer fake.getServiceUsageEventsMutex.Unlock()
+	fake.GetServiceUsageEventsStub = nil
+	fake.getServiceUsageEventsReturns = struct {
+		result1 []resources.ServiceUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceUsageEventsReturnsOnCall(i int, result1 []resources.ServiceUsageEvent, result2 v7action.Warnings, result3 error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	defer fake.getServiceUsageEventsMutex.Unlock()
+	fake.GetServiceUsageEventsStub = nil
+	if fake.getServiceUsageEventsReturnsOnCall == nil {
+		fake.getServiceUsageEventsReturnsOnCall = make(map[int]struct {
+			result1 []resources.ServiceUsageEvent
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceUsageEventsReturnsOnCall[i] = struct {
+		result1 []resources.ServiceUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetSpaceByNameAndOrganization(arg1 string, arg2 string) (resources.Space, v7action.Warnings, error) {
 	fake.getSpaceByNameAndOrganizationMutex.Lock()
 	ret, specificReturn := fake.getSpaceByNameAndOrganizationReturnsOnCall[len(fake.getSpaceByNameAndOrganizationArgsForCall)]
@@ -14779,20 +15908,21 @@ func (fake *FakeActor) GetStacksReturnsOnCall(i int, result1 []resources.Stack,
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient, arg4 sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
 	fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall, struct {
 		arg1 string
 		arg2 string
 		arg3 sharedaction.LogCacheClient
-	}{arg1, arg2, arg3})
+		arg4 sharedaction.LogFilter
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.GetStreamingLogsForApplicationByNameAndSpaceStub
 	fakeReturns := fake.getStreamingLogsForApplicationByNameAndSpaceReturns
-	fake.recordInvocation("GetStreamingLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("GetStreamingLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
@@ -14806,17 +15936,17 @@ func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceCallCount() i
 	return len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)
 }
 
-func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)) {
+func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)) {
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
 	fake.GetStreamingLogsForApplicationByNameAndSpaceStub = stub
 }
 
-func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient) {
+func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) {
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	argsForCall := fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceReturns(result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
@@ -14854,6 +15984,149 @@ func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceReturnsOnCall
 	}{result1, result2, result3, result4, result5}
 }
 
+func (fake *FakeActor) GetStreamingLogsForApplications(arg1 []resources.Application, arg2 sharedaction.LogCacheClient, arg3 sharedaction.LogFilter) (<-chan v7action.ApplicationLogMessage, <-chan error, context.CancelFunc) {
+	var arg1Copy []resources.Application
+	if arg1 != nil {
+		arg1Copy = make([]resources.Application, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getStreamingLogsForApplicationsMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForApplicationsReturnsOnCall[len(fake.getStreamingLogsForApplicationsArgsForCall)]
+	fake.getStreamingLogsForApplicationsArgsForCall = append(fake.getStreamingLogsForApplicationsArgsForCall, struct {
+		arg1 []resources.Application
+		arg2 sharedaction.LogCacheClient
+		arg3 sharedaction.LogFilter
+	}{arg1Copy, arg2, arg3})
+	stub := fake.GetStreamingLogsForApplicationsStub
+	fakeReturns := fake.getStreamingLogsForApplicationsReturns
+	fake.recordInvocation("GetStreamingLogsForApplications", []interface{}{arg1Copy, arg2, arg3})
+	fake.getStreamingLogsForApplicationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationsCallCount() int {
+	fake.getStreamingLogsForApplicationsMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsMutex.RUnlock()
+	return len(fake.getStreamingLogsForApplicationsArgsForCall)
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationsCalls(stub func([]resources.Application, sharedaction.LogCacheClient, sharedaction.LogFilter) (<-chan v7action.ApplicationLogMessage, <-chan error, context.CancelFunc)) {
+	fake.getStreamingLogsForApplicationsMutex.Lock()
+	defer fake.getStreamingLogsForApplicationsMutex.Unlock()
+	fake.GetStreamingLogsForApplicationsStub = stub
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationsArgsForCall(i int) ([]resources.Application, sharedaction.LogCacheClient, sharedaction.LogFilter) {
+	fake.getStreamingLogsForApplicationsMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsMutex.RUnlock()
+	argsForCall := fake.getStreamingLogsForApplicationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationsReturns(result1 <-chan v7action.ApplicationLogMessage, result2 <-chan error, result3 context.CancelFunc) {
+	fake.getStreamingLogsForApplicationsMutex.Lock()
+	defer fake.getStreamingLogsForApplicationsMutex.Unlock()
+	fake.GetStreamingLogsForApplicationsStub = nil
+	fake.getStreamingLogsForApplicationsReturns = struct {
+		result1 <-chan v7action.ApplicationLogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetStreamingLogsForApplicationsReturnsOnCall(i int, result1 <-chan v7action.ApplicationLogMessage, result2 <-chan error, result3 context.CancelFunc) {
+	fake.getStreamingLogsForApplicationsMutex.Lock()
+	defer fake.getStreamingLogsForApplicationsMutex.Unlock()
+	fake.GetStreamingLogsForApplicationsStub = nil
+	if fake.getStreamingLogsForApplicationsReturnsOnCall == nil {
+		fake.getStreamingLogsForApplicationsReturnsOnCall = make(map[int]struct {
+			result1 <-chan v7action.ApplicationLogMessage
+			result2 <-chan error
+			result3 context.CancelFunc
+		})
+	}
+	fake.getStreamingLogsForApplicationsReturnsOnCall[i] = struct {
+		result1 <-chan v7action.ApplicationLogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetStreamingLogsForTask(arg1 string, arg2 resources.Task, arg3 sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForTaskReturnsOnCall[len(fake.getStreamingLogsForTaskArgsForCall)]
+	fake.getStreamingLogsForTaskArgsForCall = append(fake.getStreamingLogsForTaskArgsForCall, struct {
+		arg1 string
+		arg2 resources.Task
+		arg3 sharedaction.LogCacheClient
+	}{arg1, arg2, arg3})
+	stub := fake.GetStreamingLogsForTaskStub
+	fakeReturns := fake.getStreamingLogsForTaskReturns
+	fake.recordInvocation("GetStreamingLogsForTask", []interface{}{arg1, arg2, arg3})
+	fake.getStreamingLogsForTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskCallCount() int {
+	fake.getStreamingLogsForTaskMutex.RLock()
+	defer fake.getStreamingLogsForTaskMutex.RUnlock()
+	return len(fake.getStreamingLogsForTaskArgsForCall)
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskCalls(stub func(string, resources.Task, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc)) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	defer fake.getStreamingLogsForTaskMutex.Unlock()
+	fake.GetStreamingLogsForTaskStub = stub
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskArgsForCall(i int) (string, resources.Task, sharedaction.LogCacheClient) {
+	fake.getStreamingLogsForTaskMutex.RLock()
+	defer fake.getStreamingLogsForTaskMutex.RUnlock()
+	argsForCall := fake.getStreamingLogsForTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskReturns(result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	defer fake.getStreamingLogsForTaskMutex.Unlock()
+	fake.GetStreamingLogsForTaskStub = nil
+	fake.getStreamingLogsForTaskReturns = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskReturnsOnCall(i int, result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	defer fake.getStreamingLogsForTaskMutex.Unlock()
+	fake.GetStreamingLogsForTaskStub = nil
+	if fake.getStreamingLogsForTaskReturnsOnCall == nil {
+		fake.getStreamingLogsForTaskReturnsOnCall = make(map[int]struct {
+			result1 <-chan sharedaction.LogMessage
+			result2 <-chan error
+			result3 context.CancelFunc
+		})
+	}
+	fake.getStreamingLogsForTaskReturnsOnCall[i] = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetTaskBySequenceIDAndApplication(arg1 int, arg2 string) (resources.Task, v7action.Warnings, error) {
 	fake.getTaskBySequenceIDAndApplicationMutex.Lock()
 	ret, specificReturn := fake.getTaskBySequenceIDAndApplicationReturnsOnCall[len(fake.getTaskBySequenceIDAndApplicationArgsForCall)]
@@ -15851,18 +17124,19 @@ func (fake *FakeActor) PollStartForDeploymentReturnsOnCall(i int, result1 v7acti
 	}{result1, result2}
 }
 
-func (fake *FakeActor) PollTask(arg1 resources.Task) (resources.Task, v7action.Warnings, error) {
-	fake.pollTaskMutex.Lock()
-	ret, specificReturn := fake.pollTaskReturnsOnCall[len(fake.pollTaskArgsForCall)]
-	fake.pollTaskArgsForCall = append(fake.pollTaskArgsForCall, struct {
+func (fake *FakeActor) PollTaskWithTimeout(arg1 resources.Task, arg2 time.Duration) (resources.Task, v7action.Warnings, error) {
+	fake.pollTaskWithTimeoutMutex.Lock()
+	ret, specificReturn := fake.pollTaskWithTimeoutReturnsOnCall[len(fake.pollTaskWithTimeoutArgsForCall)]
+	fake.pollTaskWithTimeoutArgsForCall = append(fake.pollTaskWithTimeoutArgsForCall, struct {
 		arg1 resources.Task
-	}{arg1})
-	stub := fake.PollTaskStub
-	fakeReturns := fake.pollTaskReturns
-	fake.recordInvocation("PollTask", []interface{}{arg1})
-	fake.pollTaskMutex.Unlock()
+		arg2 time.Duration
+	}{arg1, arg2})
+	stub := fake.PollTaskWithTimeoutStub
+	fakeReturns := fake.pollTaskWithTimeoutReturns
+	fake.recordInvocation("PollTaskWithTimeout", []interface{}{arg1, arg2})
+	fake.pollTaskWithTimeoutMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -15870,48 +17144,48 @@ func (fake *FakeActor) PollTask(arg1 resources.Task) (resources.Task, v7action.W
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) PollTaskCallCount() int {
-	fake.pollTaskMutex.RLock()
-	defer fake.pollTaskMutex.RUnlock()
-	return len(fake.pollTaskArgsForCall)
+func (fake *FakeActor) PollTaskWithTimeoutCallCount() int {
+	fake.pollTaskWithTimeoutMutex.RLock()
+	defer fake.pollTaskWithTimeoutMutex.RUnlock()
+	return len(fake.pollTaskWithTimeoutArgsForCall)
 }
 
-func (fake *FakeActor) PollTaskCalls(stub func(resources.Task) (resources.Task, v7action.Warnings, error)) {
-	fake.pollTaskMutex.Lock()
-	defer fake.pollTaskMutex.Unlock()
-	fake.PollTaskStub = stub
+func (fake *FakeActor) PollTaskWithTimeoutCalls(stub func(resources.Task, time.Duration) (resources.Task, v7action.Warnings, error)) {
+	fake.pollTaskWithTimeoutMutex.Lock()
+	defer fake.pollTaskWithTimeoutMutex.Unlock()
+	fake.PollTaskWithTimeoutStub = stub
 }
 
-func (fake *FakeActor) PollTaskArgsForCall(i int) resources.Task {
-	fake.pollTaskMutex.RLock()
-	defer fake.pollTaskMutex.RUnlock()
-	argsForCall := fake.pollTaskArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeActor) PollTaskWithTimeoutArgsForCall(i int) (resources.Task, time.Duration) {
+	fake.pollTaskWithTimeoutMutex.RLock()
+	defer fake.pollTaskWithTimeoutMutex.RUnlock()
+	argsForCall := fake.pollTaskWithTimeoutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeActor) PollTaskReturns(result1 resources.Task, result2 v7action.Warnings, result3 error) {
-	fake.pollTaskMutex.Lock()
-	defer fake.pollTaskMutex.Unlock()
-	fake.PollTaskStub = nil
-	fake.pollTaskReturns = struct {
+func (fake *FakeActor) PollTaskWithTimeoutReturns(result1 resources.Task, result2 v7action.Warnings, result3 error) {
+	fake.pollTaskWithTimeoutMutex.Lock()
+	defer fake.pollTaskWithTimeoutMutex.Unlock()
+	fake.PollTaskWithTimeoutStub = nil
+	fake.pollTaskWithTimeoutReturns = struct {
 		result1 resources.Task
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) PollTaskReturnsOnCall(i int, result1 resources.Task, result2 v7action.Warnings, result3 error) {
-	fake.pollTaskMutex.Lock()
-	defer fake.pollTaskMutex.Unlock()
-	fake.PollTaskStub = nil
-	if fake.pollTaskReturnsOnCall == nil {
-		fake.pollTaskReturnsOnCall = make(map[int]struct {
+func (fake *FakeActor) PollTaskWithTimeoutReturnsOnCall(i int, result1 resources.Task, result2 v7action.Warnings, result3 error) {
+	fake.pollTaskWithTimeoutMutex.Lock()
+	defer fake.pollTaskWithTimeoutMutex.Unlock()
+	fake.PollTaskWithTimeoutStub = nil
+	if fake.pollTaskWithTimeoutReturnsOnCall == nil {
+		fake.pollTaskWithTimeoutReturnsOnCall = make(map[int]struct {
 			result1 resources.Task
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.pollTaskReturnsOnCall[i] = struct {
+	fake.pollTaskWithTimeoutReturnsOnCall[i] = struct {
 		result1 resources.Task
 		result2 v7action.Warnings
 		result3 error
@@ -18818,6 +20092,76 @@ func (fake *FakeActor) UpdateApplicationLabelsByApplicationNameReturnsOnCall(i i
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateApplicationSidecar(arg1 string, arg2 string, arg3 string, arg4 resources.Sidecar) (resources.Sidecar, v7action.Warnings, error) {
+	fake.updateApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.updateApplicationSidecarReturnsOnCall[len(fake.updateApplicationSidecarArgsForCall)]
+	fake.updateApplicationSidecarArgsForCall = append(fake.updateApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 resources.Sidecar
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateApplicationSidecarStub
+	fakeReturns := fake.updateApplicationSidecarReturns
+	fake.recordInvocation("UpdateApplicationSidecar", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateApplicationSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarCallCount() int {
+	fake.updateApplicationSidecarMutex.RLock()
+	defer fake.updateApplicationSidecarMutex.RUnlock()
+	return len(fake.updateApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarCalls(stub func(string, string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)) {
+	fake.updateApplicationSidecarMutex.Lock()
+	defer fake.updateApplicationSidecarMutex.Unlock()
+	fake.UpdateApplicationSidecarStub = stub
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarArgsForCall(i int) (string, string, string, resources.Sidecar) {
+	fake.updateApplicationSidecarMutex.RLock()
+	defer fake.updateApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.updateApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarReturns(result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.updateApplicationSidecarMutex.Lock()
+	defer fake.updateApplicationSidecarMutex.Unlock()
+	fake.UpdateApplicationSidecarStub = nil
+	fake.updateApplicationSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) UpdateApplicationSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.updateApplicationSidecarMutex.Lock()
+	defer fake.updateApplicationSidecarMutex.Unlock()
+	fake.UpdateApplicationSidecarStub = nil
+	if fake.updateApplicationSidecarReturnsOnCall == nil {
+		fake.updateApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.updateApplicationSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) UpdateBuildpackByNameAndStackAndLifecycle(arg1 string, arg2 string, arg3 string, arg4 resources.Buildpack) (resources.Buildpack, v7action.Warnings, error) {
 	fake.updateBuildpackByNameAndStackAndLifecycleMutex.Lock()
 	ret, specificReturn := fake.updateBuildpackByNameAndStackAndLifecycleReturnsOnCall[len(fake.updateBuildpackByNameAndStackAndLifecycleArgsForCall)]
@@ -10,12 +10,13 @@ import (
 )
 
 type FakeManifestParser struct {
-	InterpolateManifestStub        func(string, []string, []template.VarKV) ([]byte, error)
+	InterpolateManifestStub        func(string, []string, []template.VarKV, []string) ([]byte, error)
 	interpolateManifestMutex       sync.RWMutex
 	interpolateManifestArgsForCall []struct {
 		arg1 string
 		arg2 []string
 		arg3 []template.VarKV
+		arg4 []string
 	}
 	interpolateManifestReturns struct {
 		result1 []byte
@@ -52,11 +53,34 @@ type FakeManifestParser struct {
 		result1 manifestparser.Manifest
 		result2 error
 	}
+	ValidateInterpolatedManifestStub        func(string, []byte) error
+	validateInterpolatedManifestMutex       sync.RWMutex
+	validateInterpolatedManifestArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+	}
+	validateInterpolatedManifestReturns struct {
+		result1 error
+	}
+	validateInterpolatedManifestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateManifestStub        func(string) error
+	validateManifestMutex       sync.RWMutex
+	validateManifestArgsForCall []struct {
+		arg1 string
+	}
+	validateManifestReturns struct {
+		result1 error
+	}
+	validateManifestReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeManifestParser) InterpolateManifest(arg1 string, arg2 []string, arg3 []template.VarKV) ([]byte, error) {
+func (fake *FakeManifestParser) InterpolateManifest(arg1 string, arg2 []string, arg3 []template.VarKV, arg4 []string) ([]byte, error) {
 	var arg2Copy []string
 	if arg2 != nil {
 		arg2Copy = make([]string, len(arg2))
@@ -67,19 +91,25 @@ func (fake *FakeManifestParser) InterpolateManifest(arg1 string, arg2 []string,
 		arg3Copy = make([]template.VarKV, len(arg3))
 		copy(arg3Copy, arg3)
 	}
+	var arg4Copy []string
+	if arg4 != nil {
+		arg4Copy = make([]string, len(arg4))
+		copy(arg4Copy, arg4)
+	}
 	fake.interpolateManifestMutex.Lock()
 	ret, specificReturn := fake.interpolateManifestReturnsOnCall[len(fake.interpolateManifestArgsForCall)]
 	fake.interpolateManifestArgsForCall = append(fake.interpolateManifestArgsForCall, struct {
 		arg1 string
 		arg2 []string
 		arg3 []template.VarKV
-	}{arg1, arg2Copy, arg3Copy})
+		arg4 []string
+	}{arg1, arg2Copy, arg3Copy, arg4Copy})
 	stub := fake.InterpolateManifestStub
 	fakeReturns := fake.interpolateManifestReturns
-	fake.recordInvocation("InterpolateManifest", []interface{}{arg1, arg2Copy, arg3Copy})
+	fake.recordInvocation("InterpolateManifest", []interface{}{arg1, arg2Copy, arg3Copy, arg4Copy})
 	fake.interpolateManifestMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -93,17 +123,17 @@ func (fake *FakeManifestParser) InterpolateManifestCallCount() int {
 	return len(fake.interpolateManifestArgsForCall)
 }
 
-func (fake *FakeManifestParser) InterpolateManifestCalls(stub func(string, []string, []template.VarKV) ([]byte, error)) {
+func (fake *FakeManifestParser) InterpolateManifestCalls(stub func(string, []string, []template.VarKV, []string) ([]byte, error)) {
 	fake.interpolateManifestMutex.Lock()
 	defer fake.interpolateManifestMutex.Unlock()
 	fake.InterpolateManifestStub = stub
 }
 
-func (fake *FakeManifestParser) InterpolateManifestArgsForCall(i int) (string, []string, []template.VarKV) {
+func (fake *FakeManifestParser) InterpolateManifestArgsForCall(i int) (string, []string, []template.VarKV, []string) {
 	fake.interpolateManifestMutex.RLock()
 	defer fake.interpolateManifestMutex.RUnlock()
 	argsForCall := fake.interpolateManifestArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeManifestParser) InterpolateManifestReturns(result1 []byte, result2 error) {
@@ -266,6 +296,134 @@ func (fake *FakeManifestParser) ParseManifestReturnsOnCall(i int, result1 manife
 	}{result1, result2}
 }
 
+func (fake *FakeManifestParser) ValidateInterpolatedManifest(arg1 string, arg2 []byte) error {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.validateInterpolatedManifestMutex.Lock()
+	ret, specificReturn := fake.validateInterpolatedManifestReturnsOnCall[len(fake.validateInterpolatedManifestArgsForCall)]
+	fake.validateInterpolatedManifestArgsForCall = append(fake.validateInterpolatedManifestArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+	}{arg1, arg2Copy})
+	stub := fake.ValidateInterpolatedManifestStub
+	fakeReturns := fake.validateInterpolatedManifestReturns
+	fake.recordInvocation("ValidateInterpolatedManifest", []interface{}{arg1, arg2Copy})
+	fake.validateInterpolatedManifestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeManifestParser) ValidateInterpolatedManifestCallCount() int {
+	fake.validateInterpolatedManifestMutex.RLock()
+	defer fake.validateInterpolatedManifestMutex.RUnlock()
+	return len(fake.validateInterpolatedManifestArgsForCall)
+}
+
+func (fake *FakeManifestParser) ValidateInterpolatedManifestCalls(stub func(string, []byte) error) {
+	fake.validateInterpolatedManifestMutex.Lock()
+	defer fake.validateInterpolatedManifestMutex.Unlock()
+	fake.ValidateInterpolatedManifestStub = stub
+}
+
+func (fake *FakeManifestParser) ValidateInterpolatedManifestArgsForCall(i int) (string, []byte) {
+	fake.validateInterpolatedManifestMutex.RLock()
+	defer fake.validateInterpolatedManifestMutex.RUnlock()
+	argsForCall := fake.validateInterpolatedManifestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeManifestParser) ValidateInterpolatedManifestReturns(result1 error) {
+	fake.validateInterpolatedManifestMutex.Lock()
+	defer fake.validateInterpolatedManifestMutex.Unlock()
+	fake.ValidateInterpolatedManifestStub = nil
+	fake.validateInterpolatedManifestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManifestParser) ValidateInterpolatedManifestReturnsOnCall(i int, result1 error) {
+	fake.validateInterpolatedManifestMutex.Lock()
+	defer fake.validateInterpolatedManifestMutex.Unlock()
+	fake.ValidateInterpolatedManifestStub = nil
+	if fake.validateInterpolatedManifestReturnsOnCall == nil {
+		fake.validateInterpolatedManifestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateInterpolatedManifestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManifestParser) ValidateManifest(arg1 string) error {
+	fake.validateManifestMutex.Lock()
+	ret, specificReturn := fake.validateManifestReturnsOnCall[len(fake.validateManifestArgsForCall)]
+	fake.validateManifestArgsForCall = append(fake.validateManifestArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateManifestStub
+	fakeReturns := fake.validateManifestReturns
+	fake.recordInvocation("ValidateManifest", []interface{}{arg1})
+	fake.validateManifestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeManifestParser) ValidateManifestCallCount() int {
+	fake.validateManifestMutex.RLock()
+	defer fake.validateManifestMutex.RUnlock()
+	return len(fake.validateManifestArgsForCall)
+}
+
+func (fake *FakeManifestParser) ValidateManifestCalls(stub func(string) error) {
+	fake.validateManifestMutex.Lock()
+	defer fake.validateManifestMutex.Unlock()
+	fake.ValidateManifestStub = stub
+}
+
+func (fake *FakeManifestParser) ValidateManifestArgsForCall(i int) string {
+	fake.validateManifestMutex.RLock()
+	defer fake.validateManifestMutex.RUnlock()
+	argsForCall := fake.validateManifestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeManifestParser) ValidateManifestReturns(result1 error) {
+	fake.validateManifestMutex.Lock()
+	defer fake.validateManifestMutex.Unlock()
+	fake.ValidateManifestStub = nil
+	fake.validateManifestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManifestParser) ValidateManifestReturnsOnCall(i int, result1 error) {
+	fake.validateManifestMutex.Lock()
+	defer fake.validateManifestMutex.Unlock()
+	fake.ValidateManifestStub = nil
+	if fake.validateManifestReturnsOnCall == nil {
+		fake.validateManifestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateManifestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeManifestParser) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -0,0 +1,124 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/v9/actor/cfnetworkingaction"
+	v7 "code.cloudfoundry.org/cli/v9/command/v7"
+)
+
+type FakeNetworkPolicyGraphActor struct {
+	GetNetworkPolicyGraphStub        func(string, string, bool) (cfnetworkingaction.PolicyGraph, cfnetworkingaction.Warnings, error)
+	getNetworkPolicyGraphMutex       sync.RWMutex
+	getNetworkPolicyGraphArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}
+	getNetworkPolicyGraphReturns struct {
+		result1 cfnetworkingaction.PolicyGraph
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	getNetworkPolicyGraphReturnsOnCall map[int]struct {
+		result1 cfnetworkingaction.PolicyGraph
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeNetworkPolicyGraphActor) GetNetworkPolicyGraph(arg1 string, arg2 string, arg3 bool) (cfnetworkingaction.PolicyGraph, cfnetworkingaction.Warnings, error) {
+	fake.getNetworkPolicyGraphMutex.Lock()
+	ret, specificReturn := fake.getNetworkPolicyGraphReturnsOnCall[len(fake.getNetworkPolicyGraphArgsForCall)]
+	fake.getNetworkPolicyGraphArgsForCall = append(fake.getNetworkPolicyGraphArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.GetNetworkPolicyGraphStub
+	fakeReturns := fake.getNetworkPolicyGraphReturns
+	fake.recordInvocation("GetNetworkPolicyGraph", []interface{}{arg1, arg2, arg3})
+	fake.getNetworkPolicyGraphMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeNetworkPolicyGraphActor) GetNetworkPolicyGraphCallCount() int {
+	fake.getNetworkPolicyGraphMutex.RLock()
+	defer fake.getNetworkPolicyGraphMutex.RUnlock()
+	return len(fake.getNetworkPolicyGraphArgsForCall)
+}
+
+func (fake *FakeNetworkPolicyGraphActor) GetNetworkPolicyGraphCalls(stub func(string, string, bool) (cfnetworkingaction.PolicyGraph, cfnetworkingaction.Warnings, error)) {
+	fake.getNetworkPolicyGraphMutex.Lock()
+	defer fake.getNetworkPolicyGraphMutex.Unlock()
+	fake.GetNetworkPolicyGraphStub = stub
+}
+
+func (fake *FakeNetworkPolicyGraphActor) GetNetworkPolicyGraphArgsForCall(i int) (string, string, bool) {
+	fake.getNetworkPolicyGraphMutex.RLock()
+	defer fake.getNetworkPolicyGraphMutex.RUnlock()
+	argsForCall := fake.getNetworkPolicyGraphArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeNetworkPolicyGraphActor) GetNetworkPolicyGraphReturns(result1 cfnetworkingaction.PolicyGraph, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.getNetworkPolicyGraphMutex.Lock()
+	defer fake.getNetworkPolicyGraphMutex.Unlock()
+	fake.GetNetworkPolicyGraphStub = nil
+	fake.getNetworkPolicyGraphReturns = struct {
+		result1 cfnetworkingaction.PolicyGraph
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNetworkPolicyGraphActor) GetNetworkPolicyGraphReturnsOnCall(i int, result1 cfnetworkingaction.PolicyGraph, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.getNetworkPolicyGraphMutex.Lock()
+	defer fake.getNetworkPolicyGraphMutex.Unlock()
+	fake.GetNetworkPolicyGraphStub = nil
+	if fake.getNetworkPolicyGraphReturnsOnCall == nil {
+		fake.getNetworkPolicyGraphReturnsOnCall = make(map[int]struct {
+			result1 cfnetworkingaction.PolicyGraph
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.getNetworkPolicyGraphReturnsOnCall[i] = struct {
+		result1 cfnetworkingaction.PolicyGraph
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNetworkPolicyGraphActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeNetworkPolicyGraphActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.NetworkPolicyGraphActor = new(FakeNetworkPolicyGraphActor)
@@ -69,6 +69,21 @@ type FakePushActor struct {
 		result1 manifestparser.Manifest
 		result2 error
 	}
+	PreviewUploadStub        func(v7pushaction.PushPlan) ([]v7pushaction.UploadPreviewFile, v7pushaction.Warnings, error)
+	previewUploadMutex       sync.RWMutex
+	previewUploadArgsForCall []struct {
+		arg1 v7pushaction.PushPlan
+	}
+	previewUploadReturns struct {
+		result1 []v7pushaction.UploadPreviewFile
+		result2 v7pushaction.Warnings
+		result3 error
+	}
+	previewUploadReturnsOnCall map[int]struct {
+		result1 []v7pushaction.UploadPreviewFile
+		result2 v7pushaction.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -335,6 +350,73 @@ func (fake *FakePushActor) HandleFlagOverridesReturnsOnCall(i int, result1 manif
 	}{result1, result2}
 }
 
+func (fake *FakePushActor) PreviewUpload(arg1 v7pushaction.PushPlan) ([]v7pushaction.UploadPreviewFile, v7pushaction.Warnings, error) {
+	fake.previewUploadMutex.Lock()
+	ret, specificReturn := fake.previewUploadReturnsOnCall[len(fake.previewUploadArgsForCall)]
+	fake.previewUploadArgsForCall = append(fake.previewUploadArgsForCall, struct {
+		arg1 v7pushaction.PushPlan
+	}{arg1})
+	stub := fake.PreviewUploadStub
+	fakeReturns := fake.previewUploadReturns
+	fake.recordInvocation("PreviewUpload", []interface{}{arg1})
+	fake.previewUploadMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePushActor) PreviewUploadCallCount() int {
+	fake.previewUploadMutex.RLock()
+	defer fake.previewUploadMutex.RUnlock()
+	return len(fake.previewUploadArgsForCall)
+}
+
+func (fake *FakePushActor) PreviewUploadCalls(stub func(v7pushaction.PushPlan) ([]v7pushaction.UploadPreviewFile, v7pushaction.Warnings, error)) {
+	fake.previewUploadMutex.Lock()
+	defer fake.previewUploadMutex.Unlock()
+	fake.PreviewUploadStub = stub
+}
+
+func (fake *FakePushActor) PreviewUploadArgsForCall(i int) v7pushaction.PushPlan {
+	fake.previewUploadMutex.RLock()
+	defer fake.previewUploadMutex.RUnlock()
+	argsForCall := fake.previewUploadArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePushActor) PreviewUploadReturns(result1 []v7pushaction.UploadPreviewFile, result2 v7pushaction.Warnings, result3 error) {
+	fake.previewUploadMutex.Lock()
+	defer fake.previewUploadMutex.Unlock()
+	fake.PreviewUploadStub = nil
+	fake.previewUploadReturns = struct {
+		result1 []v7pushaction.UploadPreviewFile
+		result2 v7pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) PreviewUploadReturnsOnCall(i int, result1 []v7pushaction.UploadPreviewFile, result2 v7pushaction.Warnings, result3 error) {
+	fake.previewUploadMutex.Lock()
+	defer fake.previewUploadMutex.Unlock()
+	fake.PreviewUploadStub = nil
+	if fake.previewUploadReturnsOnCall == nil {
+		fake.previewUploadReturnsOnCall = make(map[int]struct {
+			result1 []v7pushaction.UploadPreviewFile
+			result2 v7pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.previewUploadReturnsOnCall[i] = struct {
+		result1 []v7pushaction.UploadPreviewFile
+		result2 v7pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
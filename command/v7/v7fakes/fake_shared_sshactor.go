@@ -2,6 +2,7 @@
 package v7fakes
 
 import (
+	"io"
 	"sync"
 
 	"code.cloudfoundry.org/cli/v9/actor/sharedaction"
@@ -9,6 +10,18 @@ import (
 )
 
 type FakeSharedSSHActor struct {
+	ExecuteSecureCopyStub        func(sharedaction.SecureShellClient, sharedaction.SecureCopyOptions) error
+	executeSecureCopyMutex       sync.RWMutex
+	executeSecureCopyArgsForCall []struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SecureCopyOptions
+	}
+	executeSecureCopyReturns struct {
+		result1 error
+	}
+	executeSecureCopyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ExecuteSecureShellStub        func(sharedaction.SecureShellClient, sharedaction.SSHOptions) error
 	executeSecureShellMutex       sync.RWMutex
 	executeSecureShellArgsForCall []struct {
@@ -21,10 +34,86 @@ type FakeSharedSSHActor struct {
 	executeSecureShellReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ExecuteSecureShellOnInstancesStub        func(func() sharedaction.SecureShellClient, []sharedaction.SSHInstanceOptions, int, func(instanceIndex uint) (io.Writer, io.Writer)) []sharedaction.SSHCommandResult
+	executeSecureShellOnInstancesMutex       sync.RWMutex
+	executeSecureShellOnInstancesArgsForCall []struct {
+		arg1 func() sharedaction.SecureShellClient
+		arg2 []sharedaction.SSHInstanceOptions
+		arg3 int
+		arg4 func(instanceIndex uint) (io.Writer, io.Writer)
+	}
+	executeSecureShellOnInstancesReturns struct {
+		result1 []sharedaction.SSHCommandResult
+	}
+	executeSecureShellOnInstancesReturnsOnCall map[int]struct {
+		result1 []sharedaction.SSHCommandResult
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeSharedSSHActor) ExecuteSecureCopy(arg1 sharedaction.SecureShellClient, arg2 sharedaction.SecureCopyOptions) error {
+	fake.executeSecureCopyMutex.Lock()
+	ret, specificReturn := fake.executeSecureCopyReturnsOnCall[len(fake.executeSecureCopyArgsForCall)]
+	fake.executeSecureCopyArgsForCall = append(fake.executeSecureCopyArgsForCall, struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SecureCopyOptions
+	}{arg1, arg2})
+	stub := fake.ExecuteSecureCopyStub
+	fakeReturns := fake.executeSecureCopyReturns
+	fake.recordInvocation("ExecuteSecureCopy", []interface{}{arg1, arg2})
+	fake.executeSecureCopyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureCopyCallCount() int {
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
+	return len(fake.executeSecureCopyArgsForCall)
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureCopyCalls(stub func(sharedaction.SecureShellClient, sharedaction.SecureCopyOptions) error) {
+	fake.executeSecureCopyMutex.Lock()
+	defer fake.executeSecureCopyMutex.Unlock()
+	fake.ExecuteSecureCopyStub = stub
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureCopyArgsForCall(i int) (sharedaction.SecureShellClient, sharedaction.SecureCopyOptions) {
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
+	argsForCall := fake.executeSecureCopyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureCopyReturns(result1 error) {
+	fake.executeSecureCopyMutex.Lock()
+	defer fake.executeSecureCopyMutex.Unlock()
+	fake.ExecuteSecureCopyStub = nil
+	fake.executeSecureCopyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureCopyReturnsOnCall(i int, result1 error) {
+	fake.executeSecureCopyMutex.Lock()
+	defer fake.executeSecureCopyMutex.Unlock()
+	fake.ExecuteSecureCopyStub = nil
+	if fake.executeSecureCopyReturnsOnCall == nil {
+		fake.executeSecureCopyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.executeSecureCopyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSharedSSHActor) ExecuteSecureShell(arg1 sharedaction.SecureShellClient, arg2 sharedaction.SSHOptions) error {
 	fake.executeSecureShellMutex.Lock()
 	ret, specificReturn := fake.executeSecureShellReturnsOnCall[len(fake.executeSecureShellArgsForCall)]
@@ -87,6 +176,75 @@ func (fake *FakeSharedSSHActor) ExecuteSecureShellReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstances(arg1 func() sharedaction.SecureShellClient, arg2 []sharedaction.SSHInstanceOptions, arg3 int, arg4 func(instanceIndex uint) (io.Writer, io.Writer)) []sharedaction.SSHCommandResult {
+	var arg2Copy []sharedaction.SSHInstanceOptions
+	if arg2 != nil {
+		arg2Copy = make([]sharedaction.SSHInstanceOptions, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	ret, specificReturn := fake.executeSecureShellOnInstancesReturnsOnCall[len(fake.executeSecureShellOnInstancesArgsForCall)]
+	fake.executeSecureShellOnInstancesArgsForCall = append(fake.executeSecureShellOnInstancesArgsForCall, struct {
+		arg1 func() sharedaction.SecureShellClient
+		arg2 []sharedaction.SSHInstanceOptions
+		arg3 int
+		arg4 func(instanceIndex uint) (io.Writer, io.Writer)
+	}{arg1, arg2Copy, arg3, arg4})
+	stub := fake.ExecuteSecureShellOnInstancesStub
+	fakeReturns := fake.executeSecureShellOnInstancesReturns
+	fake.recordInvocation("ExecuteSecureShellOnInstances", []interface{}{arg1, arg2Copy, arg3, arg4})
+	fake.executeSecureShellOnInstancesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesCallCount() int {
+	fake.executeSecureShellOnInstancesMutex.RLock()
+	defer fake.executeSecureShellOnInstancesMutex.RUnlock()
+	return len(fake.executeSecureShellOnInstancesArgsForCall)
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesCalls(stub func(func() sharedaction.SecureShellClient, []sharedaction.SSHInstanceOptions, int, func(instanceIndex uint) (io.Writer, io.Writer)) []sharedaction.SSHCommandResult) {
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	defer fake.executeSecureShellOnInstancesMutex.Unlock()
+	fake.ExecuteSecureShellOnInstancesStub = stub
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesArgsForCall(i int) (func() sharedaction.SecureShellClient, []sharedaction.SSHInstanceOptions, int, func(instanceIndex uint) (io.Writer, io.Writer)) {
+	fake.executeSecureShellOnInstancesMutex.RLock()
+	defer fake.executeSecureShellOnInstancesMutex.RUnlock()
+	argsForCall := fake.executeSecureShellOnInstancesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesReturns(result1 []sharedaction.SSHCommandResult) {
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	defer fake.executeSecureShellOnInstancesMutex.Unlock()
+	fake.ExecuteSecureShellOnInstancesStub = nil
+	fake.executeSecureShellOnInstancesReturns = struct {
+		result1 []sharedaction.SSHCommandResult
+	}{result1}
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesReturnsOnCall(i int, result1 []sharedaction.SSHCommandResult) {
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	defer fake.executeSecureShellOnInstancesMutex.Unlock()
+	fake.ExecuteSecureShellOnInstancesStub = nil
+	if fake.executeSecureShellOnInstancesReturnsOnCall == nil {
+		fake.executeSecureShellOnInstancesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.SSHCommandResult
+		})
+	}
+	fake.executeSecureShellOnInstancesReturnsOnCall[i] = struct {
+		result1 []sharedaction.SSHCommandResult
+	}{result1}
+}
+
 func (fake *FakeSharedSSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -0,0 +1,199 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/v9/actor/cfnetworkingaction"
+	v7 "code.cloudfoundry.org/cli/v9/command/v7"
+)
+
+type FakeSyncNetworkPoliciesActor struct {
+	ApplyNetworkPolicySyncStub        func(cfnetworkingaction.PolicySyncPlan) error
+	applyNetworkPolicySyncMutex       sync.RWMutex
+	applyNetworkPolicySyncArgsForCall []struct {
+		arg1 cfnetworkingaction.PolicySyncPlan
+	}
+	applyNetworkPolicySyncReturns struct {
+		result1 error
+	}
+	applyNetworkPolicySyncReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PlanNetworkPolicySyncStub        func([]cfnetworkingaction.DesiredPolicy, bool) (cfnetworkingaction.PolicySyncPlan, cfnetworkingaction.Warnings, error)
+	planNetworkPolicySyncMutex       sync.RWMutex
+	planNetworkPolicySyncArgsForCall []struct {
+		arg1 []cfnetworkingaction.DesiredPolicy
+		arg2 bool
+	}
+	planNetworkPolicySyncReturns struct {
+		result1 cfnetworkingaction.PolicySyncPlan
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	planNetworkPolicySyncReturnsOnCall map[int]struct {
+		result1 cfnetworkingaction.PolicySyncPlan
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSyncNetworkPoliciesActor) ApplyNetworkPolicySync(arg1 cfnetworkingaction.PolicySyncPlan) error {
+	fake.applyNetworkPolicySyncMutex.Lock()
+	ret, specificReturn := fake.applyNetworkPolicySyncReturnsOnCall[len(fake.applyNetworkPolicySyncArgsForCall)]
+	fake.applyNetworkPolicySyncArgsForCall = append(fake.applyNetworkPolicySyncArgsForCall, struct {
+		arg1 cfnetworkingaction.PolicySyncPlan
+	}{arg1})
+	stub := fake.ApplyNetworkPolicySyncStub
+	fakeReturns := fake.applyNetworkPolicySyncReturns
+	fake.recordInvocation("ApplyNetworkPolicySync", []interface{}{arg1})
+	fake.applyNetworkPolicySyncMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSyncNetworkPoliciesActor) ApplyNetworkPolicySyncCallCount() int {
+	fake.applyNetworkPolicySyncMutex.RLock()
+	defer fake.applyNetworkPolicySyncMutex.RUnlock()
+	return len(fake.applyNetworkPolicySyncArgsForCall)
+}
+
+func (fake *FakeSyncNetworkPoliciesActor) ApplyNetworkPolicySyncCalls(stub func(cfnetworkingaction.PolicySyncPlan) error) {
+	fake.applyNetworkPolicySyncMutex.Lock()
+	defer fake.applyNetworkPolicySyncMutex.Unlock()
+	fake.ApplyNetworkPolicySyncStub = stub
+}
+
+func (fake *FakeSyncNetworkPoliciesActor) ApplyNetworkPolicySyncArgsForCall(i int) cfnetworkingaction.PolicySyncPlan {
+	fake.applyNetworkPolicySyncMutex.RLock()
+	defer fake.applyNetworkPolicySyncMutex.RUnlock()
+	argsForCall := fake.applyNetworkPolicySyncArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSyncNetworkPoliciesActor) ApplyNetworkPolicySyncReturns(result1 error) {
+	fake.applyNetworkPolicySyncMutex.Lock()
+	defer fake.applyNetworkPolicySyncMutex.Unlock()
+	fake.ApplyNetworkPolicySyncStub = nil
+	fake.applyNetworkPolicySyncReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSyncNetworkPoliciesActor) ApplyNetworkPolicySyncReturnsOnCall(i int, result1 error) {
+	fake.applyNetworkPolicySyncMutex.Lock()
+	defer fake.applyNetworkPolicySyncMutex.Unlock()
+	fake.ApplyNetworkPolicySyncStub = nil
+	if fake.applyNetworkPolicySyncReturnsOnCall == nil {
+		fake.applyNetworkPolicySyncReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.applyNetworkPolicySyncReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSyncNetworkPoliciesActor) PlanNetworkPolicySync(arg1 []cfnetworkingaction.DesiredPolicy, arg2 bool) (cfnetworkingaction.PolicySyncPlan, cfnetworkingaction.Warnings, error) {
+	var arg1Copy []cfnetworkingaction.DesiredPolicy
+	if arg1 != nil {
+		arg1Copy = make([]cfnetworkingaction.DesiredPolicy, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.planNetworkPolicySyncMutex.Lock()
+	ret, specificReturn := fake.planNetworkPolicySyncReturnsOnCall[len(fake.planNetworkPolicySyncArgsForCall)]
+	fake.planNetworkPolicySyncArgsForCall = append(fake.planNetworkPolicySyncArgsForCall, struct {
+		arg1 []cfnetworkingaction.DesiredPolicy
+		arg2 bool
+	}{arg1Copy, arg2})
+	stub := fake.PlanNetworkPolicySyncStub
+	fakeReturns := fake.planNetworkPolicySyncReturns
+	fake.recordInvocation("PlanNetworkPolicySync", []interface{}{arg1Copy, arg2})
+	fake.planNetworkPolicySyncMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSyncNetworkPoliciesActor) PlanNetworkPolicySyncCallCount() int {
+	fake.planNetworkPolicySyncMutex.RLock()
+	defer fake.planNetworkPolicySyncMutex.RUnlock()
+	return len(fake.planNetworkPolicySyncArgsForCall)
+}
+
+func (fake *FakeSyncNetworkPoliciesActor) PlanNetworkPolicySyncCalls(stub func([]cfnetworkingaction.DesiredPolicy, bool) (cfnetworkingaction.PolicySyncPlan, cfnetworkingaction.Warnings, error)) {
+	fake.planNetworkPolicySyncMutex.Lock()
+	defer fake.planNetworkPolicySyncMutex.Unlock()
+	fake.PlanNetworkPolicySyncStub = stub
+}
+
+func (fake *FakeSyncNetworkPoliciesActor) PlanNetworkPolicySyncArgsForCall(i int) ([]cfnetworkingaction.DesiredPolicy, bool) {
+	fake.planNetworkPolicySyncMutex.RLock()
+	defer fake.planNetworkPolicySyncMutex.RUnlock()
+	argsForCall := fake.planNetworkPolicySyncArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSyncNetworkPoliciesActor) PlanNetworkPolicySyncReturns(result1 cfnetworkingaction.PolicySyncPlan, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.planNetworkPolicySyncMutex.Lock()
+	defer fake.planNetworkPolicySyncMutex.Unlock()
+	fake.PlanNetworkPolicySyncStub = nil
+	fake.planNetworkPolicySyncReturns = struct {
+		result1 cfnetworkingaction.PolicySyncPlan
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSyncNetworkPoliciesActor) PlanNetworkPolicySyncReturnsOnCall(i int, result1 cfnetworkingaction.PolicySyncPlan, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.planNetworkPolicySyncMutex.Lock()
+	defer fake.planNetworkPolicySyncMutex.Unlock()
+	fake.PlanNetworkPolicySyncStub = nil
+	if fake.planNetworkPolicySyncReturnsOnCall == nil {
+		fake.planNetworkPolicySyncReturnsOnCall = make(map[int]struct {
+			result1 cfnetworkingaction.PolicySyncPlan
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.planNetworkPolicySyncReturnsOnCall[i] = struct {
+		result1 cfnetworkingaction.PolicySyncPlan
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSyncNetworkPoliciesActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSyncNetworkPoliciesActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.SyncNetworkPoliciesActor = new(FakeSyncNetworkPoliciesActor)
@@ -0,0 +1,66 @@
+package v7
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/v9/command"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	"code.cloudfoundry.org/cli/v9/util/manifestparser"
+)
+
+type ValidateManifestCommand struct {
+	UI     command.UI
+	Config command.Config
+
+	PathToManifest  flag.ManifestPathWithExistenceCheck `short:"f" description:"Path to app manifest"`
+	usage           interface{}                         `usage:"CF_NAME validate-manifest [-f APP_MANIFEST_PATH]\n\n   Checks the manifest for unknown fields, values of the wrong type and invalid values without logging in.\n   Variables are not substituted; a value that is a single variable, e.g. ((instances)), is accepted for any field."`
+	relatedCommands interface{}                         `related_commands:"apply-manifest, create-app-manifest, push"`
+
+	ManifestLocator ManifestLocator
+	ManifestParser  ManifestParser
+	CWD             string
+}
+
+func (cmd *ValidateManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.ManifestLocator = manifestparser.NewLocator()
+	cmd.ManifestParser = manifestparser.ManifestParser{}
+
+	currentDir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	cmd.CWD = currentDir
+
+	return nil
+}
+
+func (cmd ValidateManifestCommand) Execute(args []string) error {
+	readPath := cmd.CWD
+	if cmd.PathToManifest != "" {
+		readPath = string(cmd.PathToManifest)
+	}
+
+	pathToManifest, exists, err := cmd.ManifestLocator.Path(readPath)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: readPath}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Validating manifest {{.ManifestPath}}...", map[string]interface{}{
+		"ManifestPath": pathToManifest,
+	})
+
+	err = cmd.ManifestParser.ValidateManifest(pathToManifest)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
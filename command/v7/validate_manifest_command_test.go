@@ -0,0 +1,120 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/v9/command/commandfakes"
+	"code.cloudfoundry.org/cli/v9/command/flag"
+	"code.cloudfoundry.org/cli/v9/command/translatableerror"
+	. "code.cloudfoundry.org/cli/v9/command/v7"
+	"code.cloudfoundry.org/cli/v9/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/v9/util/manifestparser"
+	"code.cloudfoundry.org/cli/v9/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("validate-manifest Command", func() {
+	var (
+		cmd         ValidateManifestCommand
+		testUI      *ui.UI
+		fakeConfig  *commandfakes.FakeConfig
+		fakeParser  *v7fakes.FakeManifestParser
+		fakeLocator *v7fakes.FakeManifestLocator
+		executeErr  error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeParser = new(v7fakes.FakeManifestParser)
+		fakeLocator = new(v7fakes.FakeManifestLocator)
+
+		cmd = ValidateManifestCommand{
+			UI:              testUI,
+			Config:          fakeConfig,
+			ManifestParser:  fakeParser,
+			ManifestLocator: fakeLocator,
+			CWD:             "fake-directory",
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the -f flag is not given", func() {
+		BeforeEach(func() {
+			fakeLocator.PathReturns("fake-directory/manifest.yml", true, nil)
+		})
+
+		It("validates the manifest in the current directory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeLocator.PathCallCount()).To(Equal(1))
+			Expect(fakeLocator.PathArgsForCall(0)).To(Equal("fake-directory"))
+
+			Expect(fakeParser.ValidateManifestCallCount()).To(Equal(1))
+			Expect(fakeParser.ValidateManifestArgsForCall(0)).To(Equal("fake-directory/manifest.yml"))
+
+			Expect(testUI.Out).To(Say(`Validating manifest fake-directory/manifest.yml\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("the -f flag is given", func() {
+		BeforeEach(func() {
+			cmd.PathToManifest = flag.ManifestPathWithExistenceCheck("some/path/manifest.yml")
+			fakeLocator.PathReturns("some/path/manifest.yml", true, nil)
+		})
+
+		It("validates the given manifest", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeLocator.PathArgsForCall(0)).To(Equal("some/path/manifest.yml"))
+			Expect(fakeParser.ValidateManifestArgsForCall(0)).To(Equal("some/path/manifest.yml"))
+		})
+	})
+
+	When("no manifest is found", func() {
+		BeforeEach(func() {
+			fakeLocator.PathReturns("", false, nil)
+		})
+
+		It("returns a ManifestFileNotFoundInDirectoryError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: "fake-directory"}))
+			Expect(fakeParser.ValidateManifestCallCount()).To(Equal(0))
+		})
+	})
+
+	When("locating the manifest fails", func() {
+		BeforeEach(func() {
+			fakeLocator.PathReturns("", false, errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(fakeParser.ValidateManifestCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the manifest is invalid", func() {
+		var validationErr manifestparser.ManifestValidationError
+
+		BeforeEach(func() {
+			fakeLocator.PathReturns("fake-directory/manifest.yml", true, nil)
+			validationErr = manifestparser.ManifestValidationError{
+				Path: "fake-directory/manifest.yml",
+				Errors: []manifestparser.ManifestFieldError{
+					{Line: 5, Column: 14, Field: "applications[0].instances", Message: "expected an integer, got 'two'"},
+				},
+			}
+			fakeParser.ValidateManifestReturns(validationErr)
+		})
+
+		It("returns the validation error", func() {
+			Expect(executeErr).To(MatchError(validationErr))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
@@ -44,8 +44,8 @@ var _ = Describe("install-plugin command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("install-plugin - Install CLI plugin"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say(`cf install-plugin PLUGIN_NAME \[-r REPO_NAME\] \[-f\]`))
-				Eventually(session).Should(Say(`cf install-plugin LOCAL-PATH/TO/PLUGIN | URL \[-f\]`))
+				Eventually(session).Should(Say(`cf install-plugin PLUGIN_NAME \[-r REPO_NAME\] \[-f\] \[--signature SIGNATURE_PATH \| URL\]`))
+				Eventually(session).Should(Say(`cf install-plugin LOCAL-PATH/TO/PLUGIN \| URL \[-f\] \[--signature SIGNATURE_PATH \| URL\]`))
 				Eventually(session).Should(Say(""))
 				Eventually(session).Should(Say("WARNING:"))
 				Eventually(session).Should(Say("Plugins are binaries written by potentially untrusted authors."))
@@ -55,11 +55,13 @@ var _ = Describe("install-plugin command", func() {
 				Eventually(session).Should(Say("cf install-plugin ~/Downloads/plugin-foobar"))
 				Eventually(session).Should(Say("cf install-plugin https://example.com/plugin-foobar_linux_amd64"))
 				Eventually(session).Should(Say("cf install-plugin -r My-Repo plugin-echo"))
+				Eventually(session).Should(Say("cf install-plugin ~/Downloads/plugin-foobar --signature ~/Downloads/plugin-foobar.minisig"))
 				Eventually(session).Should(Say("OPTIONS:"))
 				Eventually(session).Should(Say(`-f\s+Force install of plugin without confirmation`))
 				Eventually(session).Should(Say(`-r\s+Restrict search for plugin to this registered repository`))
+				Eventually(session).Should(Say(`--signature\s+Verify the plugin binary against the trusted plugin keys with this detached signature file or URL`))
 				Eventually(session).Should(Say("SEE ALSO:"))
-				Eventually(session).Should(Say("add-plugin-repo, list-plugin-repos, plugins"))
+				Eventually(session).Should(Say("add-plugin-key, add-plugin-repo, list-plugin-repos, plugins"))
 
 				Eventually(session).Should(Exit(0))
 			})
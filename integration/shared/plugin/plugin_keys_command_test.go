@@ -0,0 +1,91 @@
+package plugin
+
+import (
+	"code.cloudfoundry.org/cli/v9/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("plugin key commands", func() {
+	const publicKey = "RWQf6LRCGA9i53mlYecO4IzT51TGPpvWucNSCh1CBM0QTaLn73Y7GFO3"
+
+	Describe("help", func() {
+		When("--help flag is provided", func() {
+			It("displays add-plugin-key usage to output", func() {
+				session := helpers.CF("add-plugin-key", "--help")
+
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("add-plugin-key - Trust plugins signed with a public key"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say("cf add-plugin-key KEY_NAME PUBLIC_KEY"))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say("cf add-plugin-key release-key RWQf6LRCGA9i53mlYecO4IzT51TGPpvWucNSCh1CBM0QTaLn73Y7GFO3"))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("config, install-plugin, plugin-keys, remove-plugin-key"))
+				Eventually(session).Should(Exit(0))
+			})
+
+			It("displays remove-plugin-key usage to output", func() {
+				session := helpers.CF("remove-plugin-key", "--help")
+
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("remove-plugin-key - Stop trusting plugins signed with a public key"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say("cf remove-plugin-key KEY_NAME"))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("add-plugin-key, plugin-keys"))
+				Eventually(session).Should(Exit(0))
+			})
+
+			It("displays plugin-keys usage to output", func() {
+				session := helpers.CF("plugin-keys", "--help")
+
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("plugin-keys - List the public keys trusted to sign plugins"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say("cf plugin-keys"))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("add-plugin-key, config, remove-plugin-key"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("the public key is not valid", func() {
+		It("fails without adding the key", func() {
+			session := helpers.CF("add-plugin-key", "release-key", "not-a-key")
+			Eventually(session.Err).Should(Say("Plugin key 'release-key' is invalid: key is not base64 encoded"))
+			Eventually(session).Should(Say("FAILED"))
+			Eventually(session).Should(Exit(1))
+
+			session = helpers.CF("plugin-keys")
+			Eventually(session).Should(Say("No trusted plugin keys found."))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+
+	When("a key is added and removed", func() {
+		It("lists the key while it is trusted", func() {
+			session := helpers.CF("add-plugin-key", "release-key", publicKey)
+			Eventually(session).Should(Say("Plugins signed with release-key are now trusted."))
+			Eventually(session).Should(Exit(0))
+
+			session = helpers.CF("plugin-keys")
+			Eventually(session).Should(Say("Plugin signatures are optional."))
+			Eventually(session).Should(Say(`name\s+public key`))
+			Eventually(session).Should(Say(`release-key\s+%s`, publicKey))
+			Eventually(session).Should(Exit(0))
+
+			session = helpers.CF("remove-plugin-key", "release-key")
+			Eventually(session).Should(Say("Plugins signed with release-key are no longer trusted."))
+			Eventually(session).Should(Exit(0))
+
+			session = helpers.CF("remove-plugin-key", "release-key")
+			Eventually(session.Err).Should(Say("Plugin key release-key not found."))
+			Eventually(session).Should(Say("FAILED"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
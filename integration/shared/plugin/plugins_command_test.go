@@ -37,7 +37,7 @@ var _ = Describe("plugins command", func() {
 			session := helpers.CF("plugins")
 			Eventually(session).Should(Say("Listing installed plugins..."))
 			Eventually(session).Should(Say(""))
-			Eventually(session).Should(Say(`plugin\s+version\s+command name\s+command help`))
+			Eventually(session).Should(Say(`plugin\s+version\s+signed by\s+command name\s+command help`))
 			Eventually(session).Should(Say(""))
 			Eventually(session).Should(Say(`Use 'cf repo-plugins' to list plugins in registered repos available to install\.`))
 			Consistently(session).ShouldNot(Say("[a-za-z0-9]+"))
@@ -87,7 +87,7 @@ var _ = Describe("plugins command", func() {
 				session := helpers.CF("plugins")
 				Eventually(session).Should(Say("Listing installed plugins..."))
 				Eventually(session).Should(Say(""))
-				Eventually(session).Should(Say(`plugin\s+version\s+command name\s+command help`))
+				Eventually(session).Should(Say(`plugin\s+version\s+signed by\s+command name\s+command help`))
 				Eventually(session).Should(Say(`I-should-be-sorted-first\s+1\.2\.0\s+Better-command\s+some-better-command`))
 				Eventually(session).Should(Say(`I-should-be-sorted-first\s+1\.2\.0\s+command-1\s+some-command-1`))
 				Eventually(session).Should(Say(`I-should-be-sorted-first\s+1\.2\.0\s+command-2\s+some-command-2`))
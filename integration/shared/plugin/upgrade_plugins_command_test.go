@@ -0,0 +1,144 @@
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"code.cloudfoundry.org/cli/v9/integration/helpers"
+	"code.cloudfoundry.org/cli/v9/util/generic"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("upgrade-plugins command", func() {
+	Describe("help", func() {
+		When("--help flag is provided", func() {
+			It("displays command usage to output", func() {
+				session := helpers.CF("upgrade-plugins", "--help")
+
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("upgrade-plugins - Upgrade installed CLI plugins from the plugin repositories"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf upgrade-plugins \[PLUGIN_NAME\[@VERSION\]\.\.\.\] \[-r REPO_NAME\] \[-f\] \[--lock-file LOCK_FILE\]`))
+				Eventually(session).Should(Say("cf upgrade-plugins --from-lock-file LOCK_FILE \\[-f\\]"))
+				Eventually(session).Should(Say("A plugin upgraded as PLUGIN_NAME@VERSION stays pinned to that version until it is upgraded by name without a version."))
+				Eventually(session).Should(Say("WARNING:"))
+				Eventually(session).Should(Say("Plugins are binaries written by potentially untrusted authors."))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say("cf upgrade-plugins -r CF-Community plugin-echo plugin-foobar@1.2.0 --lock-file cf-plugins.lock"))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`-f\s+Force upgrade of plugins without confirmation`))
+				Eventually(session).Should(Say(`-r\s+Restrict search for new versions to this registered repository`))
+				Eventually(session).Should(Say(`--lock-file\s+After upgrading, record the versions, repositories and checksums of the installed plugins in this lock file`))
+				Eventually(session).Should(Say(`--from-lock-file\s+Install the plugin versions recorded in this lock file instead of the newest versions`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("install-plugin, plugins, repo-plugins"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("no plugin repositories are registered", func() {
+		It("fails with an error", func() {
+			session := helpers.CF("upgrade-plugins")
+			Eventually(session.Err).Should(Say("No plugin repositories registered to search for plugin updates."))
+			Eventually(session).Should(Say("FAILED"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+
+	When("an installed plugin has a newer version in a repository", func() {
+		var (
+			repoServer   *helpers.PluginRepositoryServerWithPlugin
+			lockFilePath string
+		)
+
+		BeforeEach(func() {
+			pluginPath := helpers.BuildConfigurablePlugin("configurable_plugin", "some-plugin", "1.0.0",
+				[]helpers.PluginCommand{
+					{Name: "some-command", Help: "some-command-help"},
+				},
+			)
+			Eventually(helpers.CF("install-plugin", pluginPath, "-f", "-k")).Should(Exit(0))
+
+			repoServer = helpers.NewPluginRepositoryServerWithPlugin("configurable_plugin", "some-plugin", "2.0.0", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
+			Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL(), "-k")).Should(Exit(0))
+
+			lockFilePath = filepath.Join(homeDir, "cf-plugins.lock")
+		})
+
+		AfterEach(func() {
+			repoServer.Cleanup()
+		})
+
+		It("upgrades the plugin and writes the lock file", func() {
+			session := helpers.CF("upgrade-plugins", "-f", "--lock-file", lockFilePath, "-k")
+
+			Eventually(session).Should(Say(`Searching kaka for newer versions of installed plugins\.\.\.`))
+			Eventually(session).Should(Say(`plugin\s+version\s+new version\s+repository`))
+			Eventually(session).Should(Say(`some-plugin\s+1\.0\.0\s+2\.0\.0\s+kaka`))
+			Eventually(session).Should(Say(`Installing plugin some-plugin 2\.0\.0 from repository kaka\.\.\.`))
+			Eventually(session).Should(Say("OK"))
+			Eventually(session).Should(Say(`Writing plugin lock file .*cf-plugins\.lock\.\.\.`))
+			Eventually(session).Should(Say("OK"))
+			Eventually(session).Should(Exit(0))
+
+			session = helpers.CF("plugins")
+			Eventually(session).Should(Say(`some-plugin\s+2\.0\.0`))
+			Eventually(session).Should(Exit(0))
+
+			contents, err := os.ReadFile(lockFilePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(ContainSubstring(`"name": "some-plugin"`))
+			Expect(string(contents)).To(ContainSubstring(`"version": "2.0.0"`))
+			Expect(string(contents)).To(ContainSubstring(`"repository": "kaka"`))
+
+			session = helpers.CF("upgrade-plugins", "-k")
+			Eventually(session).Should(Say(`All plugins are up to date\.`))
+			Eventually(session).Should(Exit(0))
+
+			Eventually(helpers.CF("uninstall-plugin", "some-plugin")).Should(Exit(0))
+
+			session = helpers.CF("upgrade-plugins", "--from-lock-file", lockFilePath, "-f", "-k")
+			Eventually(session).Should(Say(`Reading plugin versions from lock file .*cf-plugins\.lock\.\.\.`))
+			Eventually(session).Should(Say(`some-plugin\s+not installed\s+2\.0\.0\s+kaka`))
+			Eventually(session).Should(Say(`Installing plugin some-plugin 2\.0\.0 from repository kaka\.\.\.`))
+			Eventually(session).Should(Say("OK"))
+			Eventually(session).Should(Exit(0))
+		})
+
+		When("the plugin is upgraded to a pinned version", func() {
+			It("keeps the plugin at that version until it is upgraded by name", func() {
+				session := helpers.CF("upgrade-plugins", "some-plugin@2.0.0", "-f", "-k")
+				Eventually(session).Should(Say(`Installing plugin some-plugin 2\.0\.0 from repository kaka\.\.\.`))
+				Eventually(session).Should(Say(`Pinned plugin some-plugin to version 2\.0\.0\.`))
+				Eventually(session).Should(Exit(0))
+
+				session = helpers.CF("upgrade-plugins", "-k")
+				Eventually(session).Should(Say(`Plugin some-plugin is pinned to version 2\.0\.0\. Upgrade it by name without a version to unpin it\.`))
+				Eventually(session).Should(Say(`All plugins are up to date\.`))
+				Eventually(session).Should(Exit(0))
+
+				session = helpers.CF("upgrade-plugins", "some-plugin", "-k")
+				Eventually(session).Should(Say(`All plugins are up to date\.`))
+				Eventually(session).Should(Exit(0))
+
+				session = helpers.CF("upgrade-plugins", "-k")
+				Eventually(session).Should(Exit(0))
+				Expect(session.Out).ToNot(Say(`is pinned to version`))
+			})
+		})
+
+		When("the plugin is pinned to a version that is not in the repository", func() {
+			It("fails with an error", func() {
+				session := helpers.CF("upgrade-plugins", "some-plugin@3.0.0", "-f", "-k")
+				Eventually(session.Err).Should(Say(`Plugin some-plugin 3\.0\.0 not found in the plugin repositories\.`))
+				Eventually(session).Should(Say("FAILED"))
+				Eventually(session).Should(Exit(1))
+			})
+		})
+	})
+})
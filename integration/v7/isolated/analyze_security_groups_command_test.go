@@ -0,0 +1,40 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/v9/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/v9/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("analyze-security-groups command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("analyze-security-groups", "SECURITY GROUP", "Report overly broad, shadowed and unbound security group rules"))
+			})
+
+			It("Displays command usage to output", func() {
+				session := helpers.CF("analyze-security-groups", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("analyze-security-groups - Report overly broad, shadowed and unbound security group rules"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say("cf analyze-security-groups"))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("bind-security-group, security-group, security-groups"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("the environment is not setup correctly", func() {
+		It("fails with the appropriate errors", func() {
+			helpers.CheckEnvironmentTargetedCorrectly(false, false, "", "analyze-security-groups")
+		})
+	})
+})
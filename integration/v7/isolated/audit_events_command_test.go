@@ -0,0 +1,54 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/v9/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/v9/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("audit-events command", func() {
+	Context("Help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("audit-events", "ORG ADMIN", "List audit events in a space, an org or across all orgs"))
+			})
+
+			It("displays the help information", func() {
+				session := helpers.CF("audit-events", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("audit-events - List audit events in a space, an org or across all orgs"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf audit-events \[-o ORG\] \[-s SPACE \| --all\] \[--type TYPE\]\.\.\. \[--actor ACTOR\]\.\.\.`))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--actor\s+Only list events performed by this user or client`))
+				Eventually(session).Should(Say(`--all\s+List events across all orgs the user can see`))
+				Eventually(session).Should(Say(`--end\s+Only list events created before this time`))
+				Eventually(session).Should(Say(`--follow\s+Keep polling for new events until interrupted`))
+				Eventually(session).Should(Say(`--org, -o\s+List events in this org`))
+				Eventually(session).Should(Say(`--space, -s\s+List events in this space`))
+				Eventually(session).Should(Say(`--start\s+Only list events created at or after this time`))
+				Eventually(session).Should(Say(`--target-guid\s+Only list events whose target has this GUID`))
+				Eventually(session).Should(Say(`--target-type\s+Only list events whose target is of this type`))
+				Eventually(session).Should(Say(`--type\s+Only list events of this type`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("app-usage-events, events"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("--all is combined with --org", func() {
+		It("fails with an argument combination error", func() {
+			session := helpers.CF("audit-events", "--all", "-o", "some-org")
+			Eventually(session.Err).Should(Say(`Incorrect Usage: The following arguments cannot be used together: --all, --org, -o, --space, -s`))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
@@ -0,0 +1,49 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/v9/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/v9/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("diff-manifest command", func() {
+	Context("Help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("diff-manifest", "APPS", "Show how the apps in a space differ from a manifest"))
+			})
+
+			It("displays the help information", func() {
+				session := helpers.CF("diff-manifest", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("diff-manifest - Show how the apps in a space differ from a manifest"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf diff-manifest \[-f APP_MANIFEST_PATH\] \[--redact-env\]`))
+				Eventually(session).Should(Say(`\[--var KEY=VALUE\] \[--vars-file VARS_FILE_PATH\]\.\.\. \[--ops-file OPS_FILE_PATH\]\.\.\.`))
+				Eventually(session).Should(Say("Exits with status 0 if every app in the space matches the manifest, 2 if at least one app differs and 1 on errors."))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`-f\s+Path to app manifest`))
+				Eventually(session).Should(Say(`--redact-env\s+Do not print values for environment vars set in the application manifest`))
+				Eventually(session).Should(Say(`--var\s+Variable key value pair for variable substitution`))
+				Eventually(session).Should(Say(`--vars-file\s+Path to a variable substitution file for manifest`))
+				Eventually(session).Should(Say(`--ops-file\s+Path to an operations file applied to the manifest after variable substitution`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("apply-manifest, create-app-manifest, push, validate-manifest"))
+
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("the environment is not setup correctly", func() {
+		It("fails with the appropriate errors", func() {
+			helpers.CheckEnvironmentTargetedCorrectly(true, true, ReadOnlyOrg, "diff-manifest")
+		})
+	})
+})
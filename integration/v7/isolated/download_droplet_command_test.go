@@ -28,6 +28,7 @@ var _ = Describe("download-droplet command", func() {
 			Eventually(session).Should(Say("download-droplet - Download an application droplet"))
 			Eventually(session).Should(Say("USAGE:"))
 			Eventually(session).Should(Say(`cf download-droplet APP_NAME \[--droplet DROPLET_GUID\] \[--path /path/to/droplet.tgz\]`))
+			Eventually(session).Should(Say("An interrupted download is resumed when the command is run again with the same path."))
 			Eventually(session).Should(Say("OPTIONS:"))
 			Eventually(session).Should(Say(`--droplet\s+The guid of the droplet to download \(default: app's current droplet\).`))
 			Eventually(session).Should(Say(`--path, -p\s+File path to download droplet to \(default: current working directory\).`))
@@ -0,0 +1,36 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/v9/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/v9/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("export-network-policies command", func() {
+	Context("Help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("export-network-policies", "NETWORK POLICIES", "Export the network policies of the targeted space as a network policy file"))
+			})
+
+			It("displays the help information", func() {
+				session := helpers.CF("export-network-policies", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("export-network-policies - Export the network policies of the targeted space as a network policy file"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf export-network-policies \[-f FILE\]`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`-f\s+Write the policies to this file instead of printing them`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("network-policies, sync-network-policies"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+})
@@ -29,9 +29,16 @@ var _ = Describe("logs Command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("logs - Tail or show recent logs for an app"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say("cf logs APP_NAME"))
+				Eventually(session).Should(Say(`cf logs \(APP_NAME\.\.\. \| --labels SELECTOR \| --all-apps\)`))
 				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--labels\s+Show logs of all apps that match this label selector`))
+				Eventually(session).Should(Say(`--all-apps\s+Show logs of all apps in the targeted space`))
 				Eventually(session).Should(Say(`--recent\s+Dump recent logs instead of tailing`))
+				Eventually(session).Should(Say(`--source-type\s+Only show logs from this source`))
+				Eventually(session).Should(Say(`--instance\s+Only show logs from the instance with this index`))
+				Eventually(session).Should(Say(`--process\s+Only show logs from this process type`))
+				Eventually(session).Should(Say(`--since\s+Show logs after this time`))
+				Eventually(session).Should(Say(`--until\s+Show logs before this time`))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("app, apps, ssh"))
 				Eventually(session).Should(Exit(0))
@@ -69,7 +76,7 @@ var _ = Describe("logs Command", func() {
 					Eventually(session).Should(Say("NAME:"))
 					Eventually(session).Should(Say("logs - Tail or show recent logs for an app"))
 					Eventually(session).Should(Say("USAGE:"))
-					Eventually(session).Should(Say("cf logs APP_NAME"))
+					Eventually(session).Should(Say(`cf logs \(APP_NAME\.\.\. \| --labels SELECTOR \| --all-apps\)`))
 					Eventually(session).Should(Say("OPTIONS:"))
 					Eventually(session).Should(Say(`--recent\s+Dump recent logs instead of tailing`))
 					Eventually(session).Should(Say("SEE ALSO:"))
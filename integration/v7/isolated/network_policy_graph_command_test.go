@@ -0,0 +1,56 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/v9/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/v9/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("network-policy-graph command", func() {
+	Context("Help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("network-policy-graph", "NETWORK POLICIES", "Print the graph of network policies as Graphviz DOT or Mermaid"))
+			})
+
+			It("displays the help information", func() {
+				session := helpers.CF("network-policy-graph", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("network-policy-graph - Print the graph of network policies as Graphviz DOT or Mermaid"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf network-policy-graph \[--format \(dot \| mermaid\)\] \[-o ORG\] \[-s SPACE\] \[--route-policies\]`))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--format\s+Format of the graph: dot or mermaid \(Default: dot\)`))
+				Eventually(session).Should(Say(`-o\s+Only include policies from or to apps in this org`))
+				Eventually(session).Should(Say(`-s\s+Only include policies from or to apps in this space`))
+				Eventually(session).Should(Say(`--route-policies\s+Add the route policies of domains that enforce them`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("export-network-policies, network-policies, route-policies"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("the format is not supported", func() {
+		It("fails with a usage error", func() {
+			session := helpers.CF("network-policy-graph", "--format", "svg")
+			Eventually(session.Err).Should(Say(`Incorrect Usage: FORMAT must be "dot" or "mermaid"`))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+
+	When("--format and --output-format are both given", func() {
+		It("fails with an argument combination error", func() {
+			session := helpers.CF("network-policy-graph", "--format", "mermaid", "--output-format", "json")
+			Eventually(session.Err).Should(Say("Incorrect Usage: The following arguments cannot be used together: --format, --output-format"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
@@ -20,7 +20,7 @@ var _ = Describe("run-task command", func() {
 			Expect(session).To(Say("NAME:"))
 			Expect(session).To(Say("   run-task - Run a one-off task on an app"))
 			Expect(session).To(Say("USAGE:"))
-			Expect(session).To(Say(`   cf run-task APP_NAME \[--command COMMAND\] \[-k DISK] \[-m MEMORY\] \[-l LOG_RATE_LIMIT\] \[--name TASK_NAME\] \[--process PROCESS_TYPE\]`))
+			Expect(session).To(Say(`   cf run-task APP_NAME \[--command COMMAND\] \[-k DISK] \[-m MEMORY\] \[-l LOG_RATE_LIMIT\] \[--name TASK_NAME\] \[--process PROCESS_TYPE\] \[--wait \[--timeout MINUTES\]\]`))
 			Expect(session).To(Say("TIP:"))
 			Expect(session).To(Say("   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs."))
 			Expect(session).To(Say("EXAMPLES:"))
@@ -34,6 +34,8 @@ var _ = Describe("run-task command", func() {
 			Expect(session).To(Say(`   -m                 Memory limit \(e\.g\. 256M, 1024M, 1G\)`))
 			Expect(session).To(Say(`   --name             Name to give the task \(generated if omitted\)`))
 			Expect(session).To(Say(`   --process          Process type to use as a template for command, memory, and disk for the created task`))
+			Expect(session).To(Say(`   --timeout          Time in minutes to wait for the task to complete before cancelling it. Requires --wait`))
+			Expect(session).To(Say(`   --wait, -w         Wait for the task to complete before exiting, streaming its logs. Exits with an error if the task fails`))
 			Expect(session).To(Say("SEE ALSO:"))
 			Expect(session).To(Say("   logs, tasks, task, terminate-task"))
 		})
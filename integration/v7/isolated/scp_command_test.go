@@ -0,0 +1,61 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/v9/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/v9/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("scp command", func() {
+	BeforeEach(func() {
+		helpers.SkipIfClientCredentialsTestMode()
+	})
+
+	When("--help flag is set", func() {
+		It("appears in cf help -a", func() {
+			session := helpers.CF("help", "-a")
+			Eventually(session).Should(Exit(0))
+			Expect(session).To(HaveCommandInCategoryWithDescription("scp", "APPS", "Copy files and directories to or from an application container instance"))
+		})
+
+		It("Displays command usage to output", func() {
+			session := helpers.CF("scp", "--help")
+
+			Eventually(session).Should(Say(`NAME:`))
+			Eventually(session).Should(Say(`scp - Copy files and directories to or from an application container instance`))
+			Eventually(session).Should(Say(`USAGE:`))
+			Eventually(session).Should(Say(`cf scp \[APP_NAME:\]SOURCE \[APP_NAME:\]DESTINATION \[--process PROCESS\] \[-i INDEX\] \[--skip-host-validation\]`))
+			Eventually(session).Should(Say(`EXAMPLES:`))
+			Eventually(session).Should(Say(`cf scp my-app:/home/vcap/app/heap\.hprof \./heap\.hprof`))
+			Eventually(session).Should(Say(`OPTIONS:`))
+			Eventually(session).Should(Say(`--app-instance-index, -i\s+App process instance index \(Default: 0\)`))
+			Eventually(session).Should(Say(`--process\s+App process name \(Default: web\)`))
+			Eventually(session).Should(Say(`--skip-host-validation, -k\s+Skip host key validation\. Not recommended!`))
+			Eventually(session).Should(Say(`ENVIRONMENT:`))
+			Eventually(session).Should(Say(`all_proxy=\s+Specify a proxy server to enable proxying for all requests`))
+			Eventually(session).Should(Say(`SEE ALSO:`))
+			Eventually(session).Should(Say(`ssh, ssh-code, ssh-enabled`))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+
+	When("neither path is in an app", func() {
+		It("tells the user how to name an app path, prints help text, and exits 1", func() {
+			session := helpers.CF("scp", "./some-file", "./other-file")
+
+			Eventually(session.Err).Should(Say("Incorrect Usage: exactly one of SOURCE and DESTINATION must be an app path in the form APP_NAME:PATH"))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+
+	When("the environment is not setup correctly", func() {
+		It("fails with the appropriate errors", func() {
+			helpers.CheckEnvironmentTargetedCorrectly(true, true, ReadOnlyOrg, "scp", "some-app:/tmp", "./tmp")
+		})
+	})
+})
@@ -0,0 +1,51 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/v9/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/v9/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("sidecars command", func() {
+	When("--help flag is set", func() {
+		It("appears in cf help -a", func() {
+			session := helpers.CF("help", "-a")
+			Eventually(session).Should(Exit(0))
+			Expect(session).To(HaveCommandInCategoryWithDescription("sidecars", "APPS", "List sidecars of an app"))
+		})
+
+		It("Displays command usage to output", func() {
+			session := helpers.CF("sidecars", "--help")
+
+			Eventually(session).Should(Say(`NAME:`))
+			Eventually(session).Should(Say(`sidecars - List sidecars of an app`))
+			Eventually(session).Should(Say(`USAGE:`))
+			Eventually(session).Should(Say(`cf sidecars APP_NAME`))
+			Eventually(session).Should(Say(`EXAMPLES:`))
+			Eventually(session).Should(Say(`cf sidecars my-app`))
+			Eventually(session).Should(Say(`SEE ALSO:`))
+			Eventually(session).Should(Say(`app, create-sidecar, delete-sidecar, update-sidecar`))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+
+	When("the app name is not provided", func() {
+		It("tells the user that the app name is required, prints help text, and exits 1", func() {
+			session := helpers.CF("sidecars")
+
+			Eventually(session.Err).Should(Say("Incorrect Usage: the required argument `APP_NAME` was not provided"))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+
+	When("the environment is not setup correctly", func() {
+		It("fails with the appropriate errors", func() {
+			helpers.CheckEnvironmentTargetedCorrectly(true, true, ReadOnlyOrg, "sidecars", "some-app")
+		})
+	})
+})
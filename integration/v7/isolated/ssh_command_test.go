@@ -45,12 +45,17 @@ var _ = Describe("ssh command", func() {
 			Eventually(session).Should(Say(`cf ssh APP_NAME \[--process PROCESS\] \[-i INDEX\] \[-c COMMAND\]...\n`))
 			Eventually(session).Should(Say(`\[-L \[BIND_ADDRESS:\]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT\]\.\.\. \[--skip-remote-execution\]`))
 			Eventually(session).Should(Say(`\[--disable-pseudo-tty \| --force-pseudo-tty \| --request-pseudo-tty\] \[--skip-host-validation\]`))
+			Eventually(session).Should(Say(`cf ssh APP_NAME \(--all-instances \| --instances INSTANCES\) -c COMMAND\.\.\.\n`))
+			Eventually(session).Should(Say(`\[--process PROCESS\] \[--max-in-flight MAX_IN_FLIGHT\] \[--skip-host-validation\]`))
 			Eventually(session).Should(Say(`OPTIONS:`))
+			Eventually(session).Should(Say(`--all-instances\s+Run the command on all running instances of the process`))
 			Eventually(session).Should(Say(`--app-instance-index, -i\s+App process instance index \(Default: 0\)`))
 			Eventually(session).Should(Say(`--command, -c\s+Command to run`))
 			Eventually(session).Should(Say(`--disable-pseudo-tty, -T\s+Disable pseudo-tty allocation`))
 			Eventually(session).Should(Say(`--force-pseudo-tty\s+Force pseudo-tty allocation`))
+			Eventually(session).Should(Say(`--instances\s+Run the command on the given instances of the process, such as 0-2,5`))
 			Eventually(session).Should(Say(`-L\s+Local port forward specification`))
+			Eventually(session).Should(Say(`--max-in-flight\s+Maximum number of instances to run the command on at once, with --all-instances or --instances \(Default: 4\)`))
 			Eventually(session).Should(Say(`--process\s+App process name \(Default: web\)`))
 			Eventually(session).Should(Say(`--request-pseudo-tty, -t\s+Request pseudo-tty allocation`))
 			Eventually(session).Should(Say(`--skip-host-validation, -k\s+Skip host key validation\. Not recommended!`))
@@ -0,0 +1,47 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/v9/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/v9/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("sync-network-policies command", func() {
+	Context("Help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("sync-network-policies", "NETWORK POLICIES", "Make network policies match a network policy file"))
+			})
+
+			It("displays the help information", func() {
+				session := helpers.CF("sync-network-policies", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("sync-network-policies - Make network policies match a network policy file"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf sync-network-policies -f FILE \[--prune\] \[--dry-run\]`))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`-f\s+Path to the network policy file`))
+				Eventually(session).Should(Say(`--prune\s+Remove policies that are not in the file from apps in the spaces of the source apps in the file`))
+				Eventually(session).Should(Say(`--dry-run\s+Show the changes without making them`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("add-network-policy, export-network-policies, network-policies, remove-network-policy"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("the file is not provided", func() {
+		It("fails with a usage error", func() {
+			session := helpers.CF("sync-network-policies")
+			Eventually(session.Err).Should(Say("Incorrect Usage: the required flag `-f' was not specified"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
@@ -0,0 +1,91 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/v9/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/v9/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("usage reporting commands", func() {
+	Describe("usage-report", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("usage-report", "ORG ADMIN", "Report instance-hours used by each app over a time window"))
+			})
+
+			It("displays the help information", func() {
+				session := helpers.CF("usage-report", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("usage-report - Report instance-hours used by each app over a time window"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf usage-report \[--start TIME\] \[--end TIME\] \[--csv\]`))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--csv\s+Export the report as CSV`))
+				Eventually(session).Should(Say(`--end\s+End of the reporting window`))
+				Eventually(session).Should(Say(`--start\s+Start of the reporting window`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("app-usage-events, service-usage-events"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+
+		When("--csv is combined with a structured output format", func() {
+			It("fails with an error", func() {
+				session := helpers.CF("usage-report", "--csv", "--output-format", "json")
+				Eventually(session.Err).Should(Say(`Incorrect Usage: The following arguments cannot be used together: --csv, --output-format`))
+				Eventually(session).Should(Exit(1))
+			})
+		})
+	})
+
+	Describe("app-usage-events", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("app-usage-events", "ORG ADMIN", "List app usage events"))
+			})
+
+			It("displays the help information", func() {
+				session := helpers.CF("app-usage-events", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("app-usage-events - List app usage events"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf app-usage-events \[--start TIME\] \[--end TIME\] \[--state STATE\]\.\.\. \[--csv\]`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--state\s+Only list events in this state`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("service-usage-events, usage-report"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	Describe("service-usage-events", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("service-usage-events", "ORG ADMIN", "List service usage events"))
+			})
+
+			It("displays the help information", func() {
+				session := helpers.CF("service-usage-events", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("service-usage-events - List service usage events"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf service-usage-events \[--start TIME\] \[--end TIME\] \[--state STATE\]\.\.\. \[--csv\]`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("app-usage-events, usage-report"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+})
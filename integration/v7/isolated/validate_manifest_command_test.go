@@ -0,0 +1,86 @@
+package isolated
+
+import (
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/v9/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/v9/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("validate-manifest command", func() {
+	var (
+		tempDir      string
+		manifestPath string
+	)
+
+	BeforeEach(func() {
+		tempDir = helpers.TempDirAbsolutePath("", "validate-manifest")
+		manifestPath = filepath.Join(tempDir, "manifest.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	Context("Help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("validate-manifest", "APPS", "Check an app manifest for errors without pushing"))
+			})
+
+			It("displays the help information", func() {
+				session := helpers.CF("validate-manifest", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("validate-manifest - Check an app manifest for errors without pushing"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf validate-manifest \[-f APP_MANIFEST_PATH\]`))
+				Eventually(session).Should(Say("Checks the manifest for unknown fields, values of the wrong type and invalid values without logging in."))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`-f\s+Path to app manifest`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("apply-manifest, create-app-manifest, push"))
+
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("the manifest is valid", func() {
+		BeforeEach(func() {
+			helpers.WriteManifest(manifestPath, map[string]interface{}{
+				"applications": []map[string]interface{}{
+					{"name": "some-app", "instances": 2},
+				},
+			})
+		})
+
+		It("succeeds without being logged in", func() {
+			session := helpers.CF("validate-manifest", "-f", manifestPath)
+			Eventually(session).Should(Say(`Validating manifest %s\.\.\.`, manifestPath))
+			Eventually(session).Should(Say("OK"))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+
+	When("the manifest is invalid", func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(manifestPath, []byte("applications:\n- name: some-app\n  instances: two\n"), 0600)).To(Succeed())
+		})
+
+		It("reports the problems and fails", func() {
+			session := helpers.CF("validate-manifest", "-f", manifestPath)
+			Eventually(session.Err).Should(Say(`Manifest %s is invalid:`, manifestPath))
+			Eventually(session.Err).Should(Say(`line 3, column 14: applications\[0\]\.instances: expected an integer, got 'two'`))
+			Eventually(session).Should(Say("FAILED"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
@@ -78,6 +78,7 @@ var _ = Describe("help", func() {
 			Eventually(session).Should(Say(`--docker-username`))
 			Eventually(session).Should(Say(`--droplet`))
 			Eventually(session).Should(Say(`--endpoint`))
+			Eventually(session).Should(Say(`--fail-fast`))
 			Eventually(session).Should(Say(`--health-check-type, -u`))
 			Eventually(session).Should(Say(`--instances, -i`))
 			Eventually(session).Should(Say(`--instance-steps`))
@@ -89,11 +90,15 @@ var _ = Describe("help", func() {
 			Eventually(session).Should(Say(`--no-route`))
 			Eventually(session).Should(Say(`--no-start`))
 			Eventually(session).Should(Say(`--no-wait`))
+			Eventually(session).Should(Say(`--ops-file`))
+			Eventually(session).Should(Say(`--parallel`))
 			Eventually(session).Should(Say(`--path, -p`))
+			Eventually(session).Should(Say(`--preview-upload`))
 			Eventually(session).Should(Say(`--random-route`))
 			Eventually(session).Should(Say(`--stack, -s`))
 			Eventually(session).Should(Say(`--start-command, -c`))
 			Eventually(session).Should(Say(`--strategy`))
+			Eventually(session).Should(Say(`--strict-manifest-validation`))
 			Eventually(session).Should(Say(`--task`))
 			Eventually(session).Should(Say(`--var`))
 			Eventually(session).Should(Say(`--vars-file`))
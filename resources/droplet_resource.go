@@ -14,6 +14,8 @@ type Droplet struct {
 	AppGUID string `json:"app_guid"`
 	// Buildpacks are the detected buildpacks from the staging process.
 	Buildpacks []DropletBuildpack `json:"buildpacks,omitempty"`
+	// Checksum is the digest of the droplet bits.
+	Checksum DropletChecksum `json:"checksum"`
 	// CreatedAt is the timestamp that the Cloud Controller created the droplet.
 	CreatedAt string `json:"created_at"`
 	// GUID is the unique droplet identifier.
@@ -43,6 +45,15 @@ type DropletBuildpack struct {
 	Version string `json:"version"`
 }
 
+// DropletChecksum is the hash algorithm and hex encoded digest of the bits of
+// a droplet.
+type DropletChecksum struct {
+	// Type is the hash algorithm, either "sha256" or "sha1".
+	Type string `json:"type"`
+	// Value is the digest of the droplet bits.
+	Value string `json:"value"`
+}
+
 // An object describing the lifecycle that was used when staging the droplet
 // possible values for type: "buildpack", "cnb", "docker"
 type DropletLifecycle struct {
@@ -106,6 +117,7 @@ func (d *Droplet) UnmarshalJSON(data []byte) error {
 	var alias struct {
 		GUID          string                `json:"guid,omitempty"`
 		Buildpacks    []DropletBuildpack    `json:"buildpacks,omitempty"`
+		Checksum      DropletChecksum       `json:"checksum"`
 		CreatedAt     string                `json:"created_at,omitempty"`
 		Image         string                `json:"image,omitempty"`
 		Lifecycle     DropletLifecycle      `json:"lifecycle,omitempty"`
@@ -127,6 +139,7 @@ func (d *Droplet) UnmarshalJSON(data []byte) error {
 
 	d.GUID = alias.GUID
 	d.Buildpacks = alias.Buildpacks
+	d.Checksum = alias.Checksum
 	d.CreatedAt = alias.CreatedAt
 	d.Image = alias.Image
 	d.Lifecycle = alias.Lifecycle
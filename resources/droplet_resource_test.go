@@ -0,0 +1,33 @@
+package resources_test
+
+import (
+	"encoding/json"
+
+	. "code.cloudfoundry.org/cli/v9/resources"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("droplet resource", func() {
+	DescribeTable(
+		"Unmarshaling",
+		func(serialized string, expected Droplet) {
+			var parsed Droplet
+			Expect(json.Unmarshal([]byte(serialized), &parsed)).To(Succeed())
+			Expect(parsed).To(Equal(expected))
+		},
+		Entry("guid", `{"guid": "some-guid"}`, Droplet{GUID: "some-guid"}),
+		Entry(
+			"checksum",
+			`{"checksum": {"type": "sha256", "value": "some-digest"}}`,
+			Droplet{Checksum: DropletChecksum{Type: "sha256", Value: "some-digest"}},
+		),
+		Entry("null checksum", `{"checksum": null}`, Droplet{}),
+		Entry(
+			"app relationship",
+			`{"relationships": {"app": {"data": {"guid": "some-app-guid"}}}}`,
+			Droplet{AppGUID: "some-app-guid"},
+		),
+	)
+})
@@ -1,9 +1,79 @@
 package resources
 
-import "code.cloudfoundry.org/cli/v9/types"
+import (
+	"encoding/json"
+	"fmt"
 
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/v9/types"
+)
+
+// Sidecar represents a Cloud Controller V3 Sidecar, an additional process that
+// runs in the same container as one or more processes of an app.
 type Sidecar struct {
-	GUID    string               `json:"guid"`
-	Name    string               `json:"name"`
-	Command types.FilteredString `json:"command"`
+	GUID    string
+	Name    string
+	Command types.FilteredString
+	// ProcessTypes are the types of the app's processes that the sidecar runs
+	// alongside.
+	ProcessTypes []string
+	// MemoryInMB is the memory reserved for the sidecar out of the memory of
+	// each process it runs with. When unset, the sidecar shares the process's
+	// memory.
+	MemoryInMB types.NullUint64
+	// UnsetMemory sends an explicit null for the memory when the sidecar is
+	// updated, so that it shares the memory of its processes again.
+	UnsetMemory bool
+	// Origin is "user" for sidecars created through the API or a manifest and
+	// "buildpack" for sidecars added by a buildpack.
+	Origin  string
+	AppGUID string
+}
+
+func (s Sidecar) MarshalJSON() ([]byte, error) {
+	var ccSidecar struct {
+		Name         string      `json:"name,omitempty"`
+		Command      string      `json:"command,omitempty"`
+		ProcessTypes []string    `json:"process_types,omitempty"`
+		MemoryInMB   interface{} `json:"memory_in_mb,omitempty"`
+	}
+
+	ccSidecar.Name = s.Name
+	ccSidecar.Command = s.Command.Value
+	ccSidecar.ProcessTypes = s.ProcessTypes
+	if s.MemoryInMB.IsSet {
+		ccSidecar.MemoryInMB = json.Number(fmt.Sprint(s.MemoryInMB.Value))
+	} else if s.UnsetMemory {
+		ccSidecar.MemoryInMB = json.RawMessage(types.JsonNull)
+	}
+
+	return json.Marshal(ccSidecar)
+}
+
+func (s *Sidecar) UnmarshalJSON(data []byte) error {
+	var ccSidecar struct {
+		GUID          string               `json:"guid"`
+		Name          string               `json:"name"`
+		Command       types.FilteredString `json:"command"`
+		ProcessTypes  []string             `json:"process_types"`
+		MemoryInMB    types.NullUint64     `json:"memory_in_mb"`
+		Origin        string               `json:"origin"`
+		Relationships Relationships        `json:"relationships"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccSidecar)
+	if err != nil {
+		return err
+	}
+
+	s.GUID = ccSidecar.GUID
+	s.Name = ccSidecar.Name
+	s.Command = ccSidecar.Command
+	s.ProcessTypes = ccSidecar.ProcessTypes
+	s.MemoryInMB = ccSidecar.MemoryInMB
+	s.Origin = ccSidecar.Origin
+	s.AppGUID = ccSidecar.Relationships[constant.RelationshipTypeApplication].GUID
+
+	return nil
 }
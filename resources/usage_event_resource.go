@@ -0,0 +1,62 @@
+package resources
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/jsonry"
+)
+
+// AppUsageEvent represents a Cloud Controller V3 app usage event. An event is
+// recorded whenever a process or task starts, stops or is scaled, and carries
+// the state and size of the process before and after the change.
+type AppUsageEvent struct {
+	GUID      string    `jsonry:"guid"`
+	CreatedAt time.Time `jsonry:"created_at"`
+	// State is STARTED, STOPPED, BUILDPACK_SET, TASK_STARTED or
+	// TASK_STOPPED.
+	State                         string `jsonry:"state.current"`
+	PreviousState                 string `jsonry:"state.previous"`
+	AppGUID                       string `jsonry:"app.guid"`
+	AppName                       string `jsonry:"app.name"`
+	ProcessGUID                   string `jsonry:"process.guid"`
+	ProcessType                   string `jsonry:"process.type"`
+	TaskGUID                      string `jsonry:"task.guid"`
+	TaskName                      string `jsonry:"task.name"`
+	SpaceGUID                     string `jsonry:"space.guid"`
+	SpaceName                     string `jsonry:"space.name"`
+	OrganizationGUID              string `jsonry:"organization.guid"`
+	BuildpackName                 string `jsonry:"buildpack.name"`
+	InstanceCount                 int    `jsonry:"instance_count.current"`
+	PreviousInstanceCount         int    `jsonry:"instance_count.previous"`
+	MemoryInMBPerInstance         int    `jsonry:"memory_in_mb_per_instance.current"`
+	PreviousMemoryInMBPerInstance int    `jsonry:"memory_in_mb_per_instance.previous"`
+}
+
+func (e *AppUsageEvent) UnmarshalJSON(data []byte) error {
+	return jsonry.Unmarshal(data, e)
+}
+
+// ServiceUsageEvent represents a Cloud Controller V3 service usage event,
+// which is recorded whenever a service instance is created, updated or
+// deleted.
+type ServiceUsageEvent struct {
+	GUID      string    `jsonry:"guid"`
+	CreatedAt time.Time `jsonry:"created_at"`
+	// State is CREATED, UPDATED or DELETED.
+	State               string `jsonry:"state"`
+	SpaceGUID           string `jsonry:"space.guid"`
+	SpaceName           string `jsonry:"space.name"`
+	OrganizationGUID    string `jsonry:"organization.guid"`
+	ServiceInstanceGUID string `jsonry:"service_instance.guid"`
+	ServiceInstanceName string `jsonry:"service_instance.name"`
+	// ServiceInstanceType is managed_service_instance or
+	// user_provided_service_instance.
+	ServiceInstanceType string `jsonry:"service_instance.type"`
+	ServicePlanName     string `jsonry:"service_plan.name"`
+	ServiceOfferingName string `jsonry:"service_offering.name"`
+	ServiceBrokerName   string `jsonry:"service_broker.name"`
+}
+
+func (e *ServiceUsageEvent) UnmarshalJSON(data []byte) error {
+	return jsonry.Unmarshal(data, e)
+}
@@ -34,6 +34,17 @@ type FakeSecureSession struct {
 	requestPtyReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RequestSubsystemStub        func(string) error
+	requestSubsystemMutex       sync.RWMutex
+	requestSubsystemArgsForCall []struct {
+		arg1 string
+	}
+	requestSubsystemReturns struct {
+		result1 error
+	}
+	requestSubsystemReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SendRequestStub        func(string, bool, []byte) (bool, error)
 	sendRequestMutex       sync.RWMutex
 	sendRequestArgsForCall []struct {
@@ -237,6 +248,67 @@ func (fake *FakeSecureSession) RequestPtyReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureSession) RequestSubsystem(arg1 string) error {
+	fake.requestSubsystemMutex.Lock()
+	ret, specificReturn := fake.requestSubsystemReturnsOnCall[len(fake.requestSubsystemArgsForCall)]
+	fake.requestSubsystemArgsForCall = append(fake.requestSubsystemArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RequestSubsystemStub
+	fakeReturns := fake.requestSubsystemReturns
+	fake.recordInvocation("RequestSubsystem", []interface{}{arg1})
+	fake.requestSubsystemMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureSession) RequestSubsystemCallCount() int {
+	fake.requestSubsystemMutex.RLock()
+	defer fake.requestSubsystemMutex.RUnlock()
+	return len(fake.requestSubsystemArgsForCall)
+}
+
+func (fake *FakeSecureSession) RequestSubsystemCalls(stub func(string) error) {
+	fake.requestSubsystemMutex.Lock()
+	defer fake.requestSubsystemMutex.Unlock()
+	fake.RequestSubsystemStub = stub
+}
+
+func (fake *FakeSecureSession) RequestSubsystemArgsForCall(i int) string {
+	fake.requestSubsystemMutex.RLock()
+	defer fake.requestSubsystemMutex.RUnlock()
+	argsForCall := fake.requestSubsystemArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSecureSession) RequestSubsystemReturns(result1 error) {
+	fake.requestSubsystemMutex.Lock()
+	defer fake.requestSubsystemMutex.Unlock()
+	fake.RequestSubsystemStub = nil
+	fake.requestSubsystemReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureSession) RequestSubsystemReturnsOnCall(i int, result1 error) {
+	fake.requestSubsystemMutex.Lock()
+	defer fake.requestSubsystemMutex.Unlock()
+	fake.RequestSubsystemStub = nil
+	if fake.requestSubsystemReturnsOnCall == nil {
+		fake.requestSubsystemReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestSubsystemReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureSession) SendRequest(arg1 string, arg2 bool, arg3 []byte) (bool, error) {
 	var arg3Copy []byte
 	if arg3 != nil {
@@ -646,24 +718,6 @@ func (fake *FakeSecureSession) WaitReturnsOnCall(i int, result1 error) {
 func (fake *FakeSecureSession) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.closeMutex.RLock()
-	defer fake.closeMutex.RUnlock()
-	fake.requestPtyMutex.RLock()
-	defer fake.requestPtyMutex.RUnlock()
-	fake.sendRequestMutex.RLock()
-	defer fake.sendRequestMutex.RUnlock()
-	fake.shellMutex.RLock()
-	defer fake.shellMutex.RUnlock()
-	fake.startMutex.RLock()
-	defer fake.startMutex.RUnlock()
-	fake.stderrPipeMutex.RLock()
-	defer fake.stderrPipeMutex.RUnlock()
-	fake.stdinPipeMutex.RLock()
-	defer fake.stdinPipeMutex.RUnlock()
-	fake.stdoutPipeMutex.RLock()
-	defer fake.stdoutPipeMutex.RUnlock()
-	fake.waitMutex.RLock()
-	defer fake.waitMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
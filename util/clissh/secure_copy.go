@@ -0,0 +1,206 @@
+package clissh
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/sftp"
+)
+
+const sftpSubsystem = "sftp"
+
+// CopyToRemote copies a local file or directory to the remote path over the
+// SFTP subsystem. Directories are copied recursively. If the remote path is an
+// existing directory, the source is copied into it.
+func (c *SecureShell) CopyToRemote(localPath string, remotePath string) error {
+	client, closeClient, err := c.newSFTPClient()
+	if err != nil {
+		return err
+	}
+	defer closeClient()
+
+	if info, statErr := client.Stat(remotePath); statErr == nil && info.IsDir() {
+		remotePath = path.Join(remotePath, filepath.Base(localPath))
+	}
+
+	var directories []string
+	directoryModes := map[string]os.FileMode{}
+	err = filepath.Walk(localPath, func(localFile string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(localPath, localFile)
+		if err != nil {
+			return err
+		}
+		remoteFile := path.Join(remotePath, filepath.ToSlash(relativePath))
+
+		switch {
+		case info.IsDir():
+			directories = append(directories, remoteFile)
+			directoryModes[remoteFile] = info.Mode().Perm()
+			return client.MkdirAll(remoteFile)
+		case info.Mode().IsRegular():
+			return uploadFile(client, localFile, remoteFile, info.Mode().Perm())
+		default:
+			return nil
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	// The modes of the directories are only applied once their contents have
+	// been copied, deepest first, so that read-only directories can be filled.
+	for i := len(directories) - 1; i >= 0; i-- {
+		err = client.Chmod(directories[i], directoryModes[directories[i]])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CopyFromRemote copies a remote file or directory to the local path over the
+// SFTP subsystem. Directories are copied recursively. If the local path is an
+// existing directory, the source is copied into it.
+func (c *SecureShell) CopyFromRemote(remotePath string, localPath string) error {
+	client, closeClient, err := c.newSFTPClient()
+	if err != nil {
+		return err
+	}
+	defer closeClient()
+
+	remotePath = path.Clean(remotePath)
+	if info, statErr := os.Stat(localPath); statErr == nil && info.IsDir() {
+		localPath = filepath.Join(localPath, path.Base(remotePath))
+	}
+
+	var directories []string
+	directoryModes := map[string]os.FileMode{}
+	walker := client.Walk(remotePath)
+	for walker.Step() {
+		if walker.Err() != nil {
+			return walker.Err()
+		}
+
+		relativePath := strings.TrimPrefix(walker.Path(), remotePath)
+		localFile := filepath.Join(localPath, filepath.FromSlash(relativePath))
+		info := walker.Stat()
+
+		switch {
+		case info.IsDir():
+			directories = append(directories, localFile)
+			directoryModes[localFile] = info.Mode().Perm()
+			err = os.MkdirAll(localFile, 0700)
+			if err != nil {
+				return err
+			}
+		case info.Mode().IsRegular():
+			err = downloadFile(client, walker.Path(), localFile, info.Mode().Perm())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// As in CopyToRemote, the directory modes are applied deepest first once
+	// the directories have been filled.
+	for i := len(directories) - 1; i >= 0; i-- {
+		err = os.Chmod(directories[i], directoryModes[directories[i]])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *SecureShell) newSFTPClient() (*sftp.Client, func(), error) {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return nil, nil, fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+
+	inPipe, err := session.StdinPipe()
+	if err != nil {
+		session.Close()
+		return nil, nil, err
+	}
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		session.Close()
+		return nil, nil, err
+	}
+
+	err = session.RequestSubsystem(sftpSubsystem)
+	if err != nil {
+		session.Close()
+		return nil, nil, fmt.Errorf("SFTP subsystem request failed: %s", err.Error())
+	}
+
+	client, err := sftp.NewClientPipe(outPipe, inPipe)
+	if err != nil {
+		session.Close()
+		return nil, nil, err
+	}
+
+	return client, func() {
+		client.Close()
+		session.Close()
+	}, nil
+}
+
+func uploadFile(client *sftp.Client, localFile string, remoteFile string, mode os.FileMode) error {
+	source, err := os.Open(localFile)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	destination, err := client.OpenFile(remoteFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(destination, source)
+	if err == nil {
+		err = destination.Chmod(mode)
+	}
+	if err != nil {
+		destination.Close()
+		return err
+	}
+
+	// Closing the remote file flushes the outstanding writes, so its error
+	// is the last chance to notice that the upload failed.
+	return destination.Close()
+}
+
+func downloadFile(client *sftp.Client, remoteFile string, localFile string, mode os.FileMode) error {
+	source, err := client.Open(remoteFile)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	destination, err := os.OpenFile(localFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(destination, source)
+	if err != nil {
+		destination.Close()
+		return err
+	}
+
+	return destination.Close()
+}
@@ -0,0 +1,209 @@
+package clissh_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/v9/util/clissh"
+	"code.cloudfoundry.org/cli/v9/util/clissh/clisshfakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/sftp"
+)
+
+type pipeConn struct {
+	io.Reader
+	io.WriteCloser
+}
+
+var _ = Describe("Secure Copy", func() {
+	var (
+		fakeSecureDialer  *clisshfakes.FakeSecureDialer
+		fakeSecureClient  *clisshfakes.FakeSecureClient
+		fakeSecureSession *clisshfakes.FakeSecureSession
+		secureShell       *SecureShell
+
+		localDir  string
+		remoteDir string
+	)
+
+	BeforeEach(func() {
+		fakeSecureDialer = new(clisshfakes.FakeSecureDialer)
+		fakeSecureClient = new(clisshfakes.FakeSecureClient)
+		fakeSecureSession = new(clisshfakes.FakeSecureSession)
+
+		fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+		fakeSecureClient.NewSessionReturns(fakeSecureSession, nil)
+
+		clientReader, serverWriter := io.Pipe()
+		serverReader, clientWriter := io.Pipe()
+		fakeSecureSession.StdinPipeReturns(clientWriter, nil)
+		fakeSecureSession.StdoutPipeReturns(clientReader, nil)
+		fakeSecureSession.RequestSubsystemStub = func(string) error {
+			server, err := sftp.NewServer(pipeConn{Reader: serverReader, WriteCloser: serverWriter})
+			Expect(err).ToNot(HaveOccurred())
+			go func() {
+				defer GinkgoRecover()
+				_ = server.Serve()
+				server.Close()
+			}()
+			return nil
+		}
+
+		localDir = GinkgoT().TempDir()
+		remoteDir = GinkgoT().TempDir()
+
+		secureShell = NewSecureShell(
+			fakeSecureDialer,
+			new(clisshfakes.FakeTerminalHelper),
+			new(clisshfakes.FakeListenerFactory),
+			DefaultKeepAliveInterval,
+		)
+		Expect(secureShell.Connect("some-user", "some-passcode", "some-endpoint", "some-fingerprint", false)).To(Succeed())
+	})
+
+	Describe("CopyToRemote", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Join(localDir, "config", "nested"), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(localDir, "config", "app.yml"), []byte("some-config"), 0600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(localDir, "config", "nested", "run.sh"), []byte("some-script"), 0755)).To(Succeed())
+		})
+
+		It("requests the sftp subsystem", func() {
+			Expect(secureShell.CopyToRemote(filepath.Join(localDir, "config", "app.yml"), filepath.Join(remoteDir, "app.yml"))).To(Succeed())
+
+			Expect(fakeSecureSession.RequestSubsystemCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.RequestSubsystemArgsForCall(0)).To(Equal("sftp"))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		It("copies a file to the remote path", func() {
+			Expect(secureShell.CopyToRemote(filepath.Join(localDir, "config", "app.yml"), filepath.Join(remoteDir, "copied.yml"))).To(Succeed())
+
+			contents, err := os.ReadFile(filepath.Join(remoteDir, "copied.yml"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-config"))
+		})
+
+		It("copies a directory recursively into an existing remote directory", func() {
+			Expect(secureShell.CopyToRemote(filepath.Join(localDir, "config"), remoteDir)).To(Succeed())
+
+			contents, err := os.ReadFile(filepath.Join(remoteDir, "config", "app.yml"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-config"))
+
+			info, err := os.Stat(filepath.Join(remoteDir, "config", "nested", "run.sh"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0755)))
+		})
+
+		When("a directory is read-only", func() {
+			BeforeEach(func() {
+				Expect(os.Chmod(filepath.Join(localDir, "config", "nested"), 0555)).To(Succeed())
+				DeferCleanup(func() {
+					Expect(os.Chmod(filepath.Join(localDir, "config", "nested"), 0755)).To(Succeed())
+					Expect(os.Chmod(filepath.Join(remoteDir, "config", "nested"), 0755)).To(Succeed())
+				})
+			})
+
+			It("copies its contents before applying its mode", func() {
+				Expect(secureShell.CopyToRemote(filepath.Join(localDir, "config"), remoteDir)).To(Succeed())
+
+				contents, err := os.ReadFile(filepath.Join(remoteDir, "config", "nested", "run.sh"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-script"))
+
+				info, err := os.Stat(filepath.Join(remoteDir, "config", "nested"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0555)))
+
+				info, err = os.Stat(filepath.Join(remoteDir, "config"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0755)))
+			})
+		})
+
+		When("the local path does not exist", func() {
+			It("returns the error", func() {
+				err := secureShell.CopyToRemote(filepath.Join(localDir, "missing"), remoteDir)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("CopyFromRemote", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Join(remoteDir, "dumps", "nested"), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(remoteDir, "dumps", "heap.hprof"), []byte("some-heap"), 0644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(remoteDir, "dumps", "nested", "thread.txt"), []byte("some-threads"), 0600)).To(Succeed())
+		})
+
+		It("copies a file to the local path", func() {
+			Expect(secureShell.CopyFromRemote(filepath.Join(remoteDir, "dumps", "heap.hprof"), filepath.Join(localDir, "copied.hprof"))).To(Succeed())
+
+			contents, err := os.ReadFile(filepath.Join(localDir, "copied.hprof"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-heap"))
+		})
+
+		It("copies a directory recursively into an existing local directory", func() {
+			Expect(secureShell.CopyFromRemote(filepath.Join(remoteDir, "dumps"), localDir)).To(Succeed())
+
+			contents, err := os.ReadFile(filepath.Join(localDir, "dumps", "heap.hprof"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-heap"))
+
+			info, err := os.Stat(filepath.Join(localDir, "dumps", "nested", "thread.txt"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+
+		When("a directory is read-only", func() {
+			BeforeEach(func() {
+				Expect(os.Chmod(filepath.Join(remoteDir, "dumps", "nested"), 0555)).To(Succeed())
+				DeferCleanup(func() {
+					Expect(os.Chmod(filepath.Join(remoteDir, "dumps", "nested"), 0755)).To(Succeed())
+					Expect(os.Chmod(filepath.Join(localDir, "dumps", "nested"), 0755)).To(Succeed())
+				})
+			})
+
+			It("copies its contents before applying its mode", func() {
+				Expect(secureShell.CopyFromRemote(filepath.Join(remoteDir, "dumps"), localDir)).To(Succeed())
+
+				contents, err := os.ReadFile(filepath.Join(localDir, "dumps", "nested", "thread.txt"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-threads"))
+
+				info, err := os.Stat(filepath.Join(localDir, "dumps", "nested"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0555)))
+
+				info, err = os.Stat(filepath.Join(localDir, "dumps"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0755)))
+			})
+		})
+
+		When("the remote path does not exist", func() {
+			It("returns the error", func() {
+				err := secureShell.CopyFromRemote(filepath.Join(remoteDir, "missing"), localDir)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	When("the sftp subsystem cannot be requested", func() {
+		BeforeEach(func() {
+			fakeSecureSession.RequestSubsystemStub = nil
+			fakeSecureSession.RequestSubsystemReturns(errors.New("subsystem denied"))
+		})
+
+		It("returns an error and closes the session", func() {
+			err := secureShell.CopyToRemote(localDir, remoteDir)
+			Expect(err).To(MatchError("SFTP subsystem request failed: subsystem denied"))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+	})
+})
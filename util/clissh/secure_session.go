@@ -10,6 +10,7 @@ import (
 
 type SecureSession interface {
 	RequestPty(term string, height, width int, termModes ssh.TerminalModes) error
+	RequestSubsystem(subsystem string) error
 	SendRequest(name string, wantReply bool, payload []byte) (bool, error)
 	StdinPipe() (io.WriteCloser, error)
 	StdoutPipe() (io.Reader, error)
@@ -8,11 +8,13 @@
 package clissh_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -842,6 +844,72 @@ var _ = Describe("CLI SSH", Serial, FlakeAttempts(9), func() {
 		})
 	})
 
+	Describe("RunCommand", Serial, func() {
+		var (
+			stdout, stderr *bytes.Buffer
+			runErr         error
+		)
+
+		BeforeEach(func() {
+			stdout = new(bytes.Buffer)
+			stderr = new(bytes.Buffer)
+			commands = []string{"df", "-h"}
+
+			fakeSecureSession.StdoutPipeReturns(strings.NewReader("some-output"), nil)
+			fakeSecureSession.StderrPipeReturns(strings.NewReader("some-error-output"), nil)
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+			runErr = secureShell.RunCommand(commands, stdout, stderr)
+		})
+
+		It("runs the commands without a terminal and copies their output", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("df -h"))
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StdinPipeCallCount()).To(Equal(0))
+
+			Expect(stdout.String()).To(Equal("some-output"))
+			Expect(stderr.String()).To(Equal("some-error-output"))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		When("creating the session fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.NewSessionReturns(nil, errors.New("no session"))
+			})
+
+			It("returns an error", func() {
+				Expect(runErr).To(MatchError("SSH session allocation failed: no session"))
+			})
+		})
+
+		When("starting the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StartReturns(errors.New("start failed"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("start failed"))
+				Expect(fakeSecureSession.WaitCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the command exits with an error", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("exited 1"))
+			})
+
+			It("returns the error from waiting on the session", func() {
+				Expect(runErr).To(MatchError("exited 1"))
+			})
+		})
+	})
+
 	Describe("LocalPortForward", Serial, func() {
 		var (
 			forwardErr error
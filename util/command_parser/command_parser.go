@@ -55,7 +55,14 @@ func (p *CommandParser) ParseCommandFromArgs(ui *ui.UI, args []string) (int, err
 func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig := p.Config
 	cfConfig.Flags = configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		Verbose:      common.Commands.VerboseOrVersion,
+		OutputFormat: string(common.Commands.OutputFormat),
+		Context:      common.Commands.Context,
+	}
+	if _, ok := cmd.(command.StructuredOutputCommand); ok {
+		p.UI.SetOutputFormat(cfConfig.OutputFormat())
+	} else {
+		p.UI.SetOutputFormat(configv3.OutputFormatText)
 	}
 	defer p.UI.FlushDeferred()
 
@@ -64,6 +71,16 @@ func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) err
 		return p.handleError(err)
 	}
 
+	if contextName := cfConfig.Flags.Context; contextName != "" {
+		if !cfConfig.HasContext(contextName) {
+			return p.handleError(translatableerror.ContextNotFoundError{Name: contextName})
+		}
+		err = cfConfig.UseContext(contextName)
+		if err != nil {
+			return p.handleError(err)
+		}
+	}
+
 	err = cfConfig.CreatePluginHome()
 	if err != nil {
 		return p.handleError(err)
@@ -121,6 +138,11 @@ func (p *CommandParser) handleError(passedErr error) error {
 	case translatableerror.CurlExit22Error:
 		p.UI.DisplayError(translatedErr)
 		return passedErr
+	case translatableerror.SSHCommandFailedOnInstancesError:
+		p.UI.DisplayError(translatedErr)
+		return passedErr
+	case translatableerror.ManifestDriftError:
+		return passedErr
 	}
 
 	p.UI.DisplayError(translatedErr)
@@ -233,6 +255,10 @@ func (p *CommandParser) parse(args []string, commandList interface{}) (int, erro
 		return exitError.ExitStatus(), nil
 	} else if curlError, ok := err.(translatableerror.CurlExit22Error); ok {
 		return 22, curlError
+	} else if sshError, ok := err.(translatableerror.SSHCommandFailedOnInstancesError); ok {
+		return sshError.ExitStatus, nil
+	} else if _, ok := err.(translatableerror.ManifestDriftError); ok {
+		return 2, nil
 	}
 
 	fmt.Fprintf(os.Stderr, "Unexpected error: %s\n", err.Error())
@@ -78,4 +78,33 @@ var _ = Describe("Command 'Parser'", func() {
 		})
 
 	})
+
+	Describe("the output format flag", func() {
+		var parser command_parser.CommandParser
+
+		BeforeEach(func() {
+			common.Commands.OutputFormat = ""
+			var err error
+
+			parser, err = command_parser.NewCommandParser(v3Config)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			common.Commands.OutputFormat = ""
+		})
+
+		It("switches commands that support structured output to the format", func() {
+			_, _ = parser.ParseCommandFromArgs(pluginUI, []string{"contexts", "--output-format", "json"})
+			Expect(parser.Config.Flags.OutputFormat).To(Equal("json"))
+			Expect(pluginUI.IsStructuredOutput()).To(BeTrue())
+		})
+
+		It("keeps text output and errors for other commands", func() {
+			exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"help", "--output-format", "json"})
+			Expect(exitCode).To(Equal(0))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pluginUI.IsStructuredOutput()).To(BeFalse())
+		})
+	})
 })
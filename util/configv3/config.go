@@ -30,6 +30,16 @@ type Config struct {
 	// detectedSettings are settings detected when the config is loaded.
 	detectedSettings detectedSettings
 
+	// activeContext is the name of the context in use by this invocation.
+	activeContext string
+
+	// credentialHelper keeps the secrets of each context when configured.
+	credentialHelper *credentialHelper
+
+	// storedCredentials are the credentials last read from or handed to the
+	// credential helper, by context name.
+	storedCredentials map[string]Credentials
+
 	pluginsConfig PluginsConfig
 
 	UserConfig
@@ -0,0 +1,222 @@
+package configv3
+
+import "sort"
+
+// DefaultContextName is the name of the context that an existing single
+// target config is migrated into.
+const DefaultContextName = "default"
+
+// TargetContext is a named set of target information, allowing a single CF_HOME to
+// hold the endpoints, tokens and targeted org/space of several foundations.
+type TargetContext struct {
+	Name                     string       `json:"-"`
+	Target                   string       `json:"Target"`
+	APIVersion               string       `json:"APIVersion"`
+	AuthorizationEndpoint    string       `json:"AuthorizationEndpoint"`
+	CFOnK8s                  CFOnK8s      `json:"CFOnK8s"`
+	DopplerEndpoint          string       `json:"DopplerEndPoint"`
+	LogCacheEndpoint         string       `json:"LogCacheEndPoint"`
+	MinCLIVersion            string       `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string       `json:"MinRecommendedCLIVersion"`
+	NetworkPolicyV1Endpoint  string       `json:"NetworkPolicyV1Endpoint"`
+	RoutingEndpoint          string       `json:"RoutingAPIEndpoint"`
+	UAAEndpoint              string       `json:"UaaEndpoint"`
+	SkipSSLValidation        bool         `json:"SSLDisabled"`
+	AccessToken              string       `json:"AccessToken"`
+	RefreshToken             string       `json:"RefreshToken"`
+	SSHOAuthClient           string       `json:"SSHOAuthClient"`
+	UAAGrantType             string       `json:"UAAGrantType"`
+	UAAOAuthClient           string       `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string       `json:"UAAOAuthClientSecret"`
+	TargetedOrganization     Organization `json:"OrganizationFields"`
+	TargetedSpace            Space        `json:"SpaceFields"`
+}
+
+// Contexts returns all saved contexts sorted by name.
+func (config *Config) Contexts() []TargetContext {
+	config.saveActiveContext()
+
+	contexts := make([]TargetContext, 0, len(config.ConfigFile.Contexts))
+	for name, context := range config.ConfigFile.Contexts {
+		context.Name = name
+		contexts = append(contexts, context)
+	}
+	sort.Slice(contexts, func(i, j int) bool { return contexts[i].Name < contexts[j].Name })
+
+	return contexts
+}
+
+// CurrentContextName returns the name of the context last switched to. The
+// '--context' global flag does not change the current context.
+func (config *Config) CurrentContextName() string {
+	return config.ConfigFile.CurrentContext
+}
+
+// HasContext returns true if a context with the given name exists.
+func (config *Config) HasContext(name string) bool {
+	config.saveActiveContext()
+	_, exists := config.ConfigFile.Contexts[name]
+	return exists
+}
+
+// AddContext saves a new, empty context with the given name. An empty context
+// has no API endpoint targeted and no user logged in.
+func (config *Config) AddContext(name string) {
+	config.saveActiveContext()
+	if config.ConfigFile.Contexts == nil {
+		config.ConfigFile.Contexts = map[string]TargetContext{}
+	}
+	config.ConfigFile.Contexts[name] = TargetContext{
+		SSHOAuthClient:       DefaultSSHOAuthClient,
+		UAAOAuthClient:       DefaultUAAOAuthClient,
+		UAAOAuthClientSecret: DefaultUAAOAuthClientSecret,
+	}
+}
+
+// SwitchContext makes the named context the one used by this and all
+// subsequent invocations.
+func (config *Config) SwitchContext(name string) error {
+	err := config.UseContext(name)
+	if err != nil {
+		return err
+	}
+
+	config.ConfigFile.CurrentContext = name
+	return nil
+}
+
+// UseContext makes the named context the one used by this invocation only.
+// The current context recorded in the config file is left unchanged.
+func (config *Config) UseContext(name string) error {
+	config.saveActiveContext()
+	err := config.loadCredentials(name)
+	if err != nil {
+		return err
+	}
+
+	config.ConfigFile.applyContext(config.ConfigFile.Contexts[name])
+	config.activeContext = name
+	return nil
+}
+
+// RenameContext renames a context, keeping it current if it was current.
+func (config *Config) RenameContext(oldName string, newName string) error {
+	config.saveActiveContext()
+	err := config.loadCredentials(oldName)
+	if err != nil {
+		return err
+	}
+
+	config.ConfigFile.Contexts[newName] = config.ConfigFile.Contexts[oldName]
+	delete(config.ConfigFile.Contexts, oldName)
+
+	if config.ConfigFile.CurrentContext == oldName {
+		config.ConfigFile.CurrentContext = newName
+	}
+	if config.activeContext == oldName {
+		config.activeContext = newName
+	}
+	return nil
+}
+
+// RemoveContext deletes the named context. If the context is the one used by
+// this invocation, the current context is used for the rest of it.
+func (config *Config) RemoveContext(name string) error {
+	config.saveActiveContext()
+	err := config.loadCredentials(name)
+	if err != nil {
+		return err
+	}
+
+	delete(config.ConfigFile.Contexts, name)
+
+	if config.activeContext == name {
+		config.activeContext = config.ConfigFile.CurrentContext
+		config.ConfigFile.applyContext(config.ConfigFile.Contexts[config.activeContext])
+	}
+	return nil
+}
+
+// saveActiveContext copies the target information currently in use back into
+// the active context. A config without any context that has an API endpoint
+// targeted is migrated into the default context.
+func (config *Config) saveActiveContext() {
+	if config.activeContext == "" {
+		if config.ConfigFile.Target == "" {
+			return
+		}
+		config.activeContext = DefaultContextName
+		if config.ConfigFile.CurrentContext == "" {
+			config.ConfigFile.CurrentContext = DefaultContextName
+		}
+	}
+
+	if config.ConfigFile.Contexts == nil {
+		config.ConfigFile.Contexts = map[string]TargetContext{}
+	}
+	config.ConfigFile.Contexts[config.activeContext] = config.ConfigFile.targetContext()
+}
+
+// fileContents returns the config as it should be persisted: the top level
+// target information always reflects the current context, even when another
+// context was used for this invocation, and secrets kept by a credential
+// helper are left out.
+func (config *Config) fileContents() (JSONConfig, error) {
+	config.saveActiveContext()
+
+	contents := config.ConfigFile
+	if config.activeContext != contents.CurrentContext {
+		contents.applyContext(contents.Contexts[contents.CurrentContext])
+	}
+
+	err := config.storeCredentials(&contents)
+	return contents, err
+}
+
+func (jsonConfig JSONConfig) targetContext() TargetContext {
+	return TargetContext{
+		Target:                   jsonConfig.Target,
+		APIVersion:               jsonConfig.APIVersion,
+		AuthorizationEndpoint:    jsonConfig.AuthorizationEndpoint,
+		CFOnK8s:                  jsonConfig.CFOnK8s,
+		DopplerEndpoint:          jsonConfig.DopplerEndpoint,
+		LogCacheEndpoint:         jsonConfig.LogCacheEndpoint,
+		MinCLIVersion:            jsonConfig.MinCLIVersion,
+		MinRecommendedCLIVersion: jsonConfig.MinRecommendedCLIVersion,
+		NetworkPolicyV1Endpoint:  jsonConfig.NetworkPolicyV1Endpoint,
+		RoutingEndpoint:          jsonConfig.RoutingEndpoint,
+		UAAEndpoint:              jsonConfig.UAAEndpoint,
+		SkipSSLValidation:        jsonConfig.SkipSSLValidation,
+		AccessToken:              jsonConfig.AccessToken,
+		RefreshToken:             jsonConfig.RefreshToken,
+		SSHOAuthClient:           jsonConfig.SSHOAuthClient,
+		UAAGrantType:             jsonConfig.UAAGrantType,
+		UAAOAuthClient:           jsonConfig.UAAOAuthClient,
+		UAAOAuthClientSecret:     jsonConfig.UAAOAuthClientSecret,
+		TargetedOrganization:     jsonConfig.TargetedOrganization,
+		TargetedSpace:            jsonConfig.TargetedSpace,
+	}
+}
+
+func (jsonConfig *JSONConfig) applyContext(context TargetContext) {
+	jsonConfig.Target = context.Target
+	jsonConfig.APIVersion = context.APIVersion
+	jsonConfig.AuthorizationEndpoint = context.AuthorizationEndpoint
+	jsonConfig.CFOnK8s = context.CFOnK8s
+	jsonConfig.DopplerEndpoint = context.DopplerEndpoint
+	jsonConfig.LogCacheEndpoint = context.LogCacheEndpoint
+	jsonConfig.MinCLIVersion = context.MinCLIVersion
+	jsonConfig.MinRecommendedCLIVersion = context.MinRecommendedCLIVersion
+	jsonConfig.NetworkPolicyV1Endpoint = context.NetworkPolicyV1Endpoint
+	jsonConfig.RoutingEndpoint = context.RoutingEndpoint
+	jsonConfig.UAAEndpoint = context.UAAEndpoint
+	jsonConfig.SkipSSLValidation = context.SkipSSLValidation
+	jsonConfig.AccessToken = context.AccessToken
+	jsonConfig.RefreshToken = context.RefreshToken
+	jsonConfig.SSHOAuthClient = context.SSHOAuthClient
+	jsonConfig.UAAGrantType = context.UAAGrantType
+	jsonConfig.UAAOAuthClient = context.UAAOAuthClient
+	jsonConfig.UAAOAuthClientSecret = context.UAAOAuthClientSecret
+	jsonConfig.TargetedOrganization = context.TargetedOrganization
+	jsonConfig.TargetedSpace = context.TargetedSpace
+}
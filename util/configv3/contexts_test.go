@@ -0,0 +1,170 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/v9/util/configv3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Contexts", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	loadConfig := func() *Config {
+		loadedConfig, err := LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+		return loadedConfig
+	}
+
+	When("the config has a target but no contexts", func() {
+		BeforeEach(func() {
+			rawConfig := `{
+				"ConfigVersion": 4,
+				"Target": "https://api.foo.com",
+				"AccessToken": "foo-access-token",
+				"OrganizationFields": {"GUID": "foo-org-guid", "Name": "foo-org"}
+			}`
+			setConfig(homeDir, rawConfig)
+			config = loadConfig()
+		})
+
+		It("migrates the target into the default context", func() {
+			Expect(config.CurrentContextName()).To(Equal(DefaultContextName))
+
+			contexts := config.Contexts()
+			Expect(contexts).To(HaveLen(1))
+			Expect(contexts[0].Name).To(Equal(DefaultContextName))
+			Expect(contexts[0].Target).To(Equal("https://api.foo.com"))
+			Expect(contexts[0].AccessToken).To(Equal("foo-access-token"))
+			Expect(contexts[0].TargetedOrganization.Name).To(Equal("foo-org"))
+		})
+
+		It("persists the migrated context", func() {
+			Expect(config.WriteConfig()).To(Succeed())
+
+			config = loadConfig()
+			Expect(config.CurrentContextName()).To(Equal(DefaultContextName))
+			Expect(config.HasContext(DefaultContextName)).To(BeTrue())
+		})
+	})
+
+	When("the config has no target and no contexts", func() {
+		BeforeEach(func() {
+			config = loadConfig()
+		})
+
+		It("has no contexts", func() {
+			Expect(config.CurrentContextName()).To(BeEmpty())
+			Expect(config.Contexts()).To(BeEmpty())
+		})
+	})
+
+	When("there are several contexts", func() {
+		BeforeEach(func() {
+			config = loadConfig()
+			config.SetTargetInformation(TargetInformationArgs{Api: "https://api.foo.com", ApiVersion: "3.100.0"})
+			config.SetAccessToken("foo-access-token")
+
+			config.AddContext("bar")
+			Expect(config.SwitchContext("bar")).To(Succeed())
+			config.SetTargetInformation(TargetInformationArgs{Api: "https://api.bar.com", ApiVersion: "3.200.0"})
+			config.SetAccessToken("bar-access-token")
+			Expect(config.WriteConfig()).To(Succeed())
+
+			config = loadConfig()
+		})
+
+		It("lists them sorted by name", func() {
+			contexts := config.Contexts()
+			Expect(contexts).To(HaveLen(2))
+			Expect(contexts[0].Name).To(Equal("bar"))
+			Expect(contexts[0].Target).To(Equal("https://api.bar.com"))
+			Expect(contexts[1].Name).To(Equal(DefaultContextName))
+			Expect(contexts[1].Target).To(Equal("https://api.foo.com"))
+		})
+
+		It("uses the context last switched to", func() {
+			Expect(config.CurrentContextName()).To(Equal("bar"))
+			Expect(config.Target()).To(Equal("https://api.bar.com"))
+			Expect(config.AccessToken()).To(Equal("bar-access-token"))
+		})
+
+		Describe("SwitchContext", func() {
+			It("applies the context and persists the switch", func() {
+				Expect(config.SwitchContext(DefaultContextName)).To(Succeed())
+				Expect(config.Target()).To(Equal("https://api.foo.com"))
+				Expect(config.AccessToken()).To(Equal("foo-access-token"))
+				Expect(config.WriteConfig()).To(Succeed())
+
+				config = loadConfig()
+				Expect(config.CurrentContextName()).To(Equal(DefaultContextName))
+				Expect(config.Target()).To(Equal("https://api.foo.com"))
+			})
+		})
+
+		Describe("UseContext", func() {
+			It("applies the context without changing the current context", func() {
+				Expect(config.UseContext(DefaultContextName)).To(Succeed())
+				Expect(config.CurrentContextName()).To(Equal("bar"))
+				Expect(config.Target()).To(Equal("https://api.foo.com"))
+
+				config.SetAccessToken("new-foo-access-token")
+				Expect(config.WriteConfig()).To(Succeed())
+
+				config = loadConfig()
+				Expect(config.CurrentContextName()).To(Equal("bar"))
+				Expect(config.Target()).To(Equal("https://api.bar.com"))
+				Expect(config.AccessToken()).To(Equal("bar-access-token"))
+
+				contexts := config.Contexts()
+				Expect(contexts[1].AccessToken).To(Equal("new-foo-access-token"))
+			})
+		})
+
+		Describe("RenameContext", func() {
+			It("renames the context and keeps it current", func() {
+				Expect(config.RenameContext("bar", "baz")).To(Succeed())
+				Expect(config.CurrentContextName()).To(Equal("baz"))
+				Expect(config.HasContext("bar")).To(BeFalse())
+				Expect(config.WriteConfig()).To(Succeed())
+
+				config = loadConfig()
+				Expect(config.CurrentContextName()).To(Equal("baz"))
+				Expect(config.Target()).To(Equal("https://api.bar.com"))
+			})
+		})
+
+		Describe("RemoveContext", func() {
+			It("removes the context", func() {
+				Expect(config.RemoveContext(DefaultContextName)).To(Succeed())
+				Expect(config.WriteConfig()).To(Succeed())
+
+				config = loadConfig()
+				Expect(config.HasContext(DefaultContextName)).To(BeFalse())
+				Expect(config.Contexts()).To(HaveLen(1))
+			})
+
+			When("the context is the one used by this invocation", func() {
+				It("falls back to the current context", func() {
+					Expect(config.UseContext(DefaultContextName)).To(Succeed())
+					Expect(config.RemoveContext(DefaultContextName)).To(Succeed())
+					Expect(config.Target()).To(Equal("https://api.bar.com"))
+					Expect(config.WriteConfig()).To(Succeed())
+
+					config = loadConfig()
+					Expect(config.HasContext(DefaultContextName)).To(BeFalse())
+				})
+			})
+		})
+	})
+})
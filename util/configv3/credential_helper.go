@@ -0,0 +1,293 @@
+package configv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// CredentialHelperPrefix is prepended to the configured credential helper name
+// to find the helper executable on the PATH.
+const CredentialHelperPrefix = "cf-credential-"
+
+// Credentials are the secrets of a context that are kept by a credential
+// helper instead of being written to config.json.
+//
+// The helper is invoked with one of 'get', 'store' or 'erase' as its only
+// argument and the credentials as a JSON document on STDIN. Credentials are
+// identified by their Context; the ServerURL is provided for information. For
+// 'get' the helper writes the stored credentials as a JSON document to STDOUT,
+// or an empty document if there are none. A non-zero exit status is treated as
+// a failure and the helper's STDERR is reported to the user.
+type Credentials struct {
+	Context              string `json:"Context"`
+	ServerURL            string `json:"ServerURL"`
+	AccessToken          string `json:"AccessToken,omitempty"`
+	RefreshToken         string `json:"RefreshToken,omitempty"`
+	UAAOAuthClientSecret string `json:"UAAOAuthClientSecret,omitempty"`
+}
+
+// CredentialHelperError is returned when a credential helper fails.
+type CredentialHelperError struct {
+	Helper  string
+	Action  string
+	Message string
+}
+
+func (e CredentialHelperError) Error() string {
+	return fmt.Sprintf("credential helper '%s%s' failed to %s credentials: %s", CredentialHelperPrefix, e.Helper, e.Action, e.Message)
+}
+
+// CredentialHelper returns the name of the credential helper that stores the
+// secrets of each context.
+func (config *Config) CredentialHelper() string {
+	return config.ConfigFile.CredentialHelper
+}
+
+// SetCredentialHelper moves the secrets of every context to the named
+// credential helper. An empty name writes them to config.json again.
+func (config *Config) SetCredentialHelper(name string) error {
+	config.saveActiveContext()
+	for contextName := range config.ConfigFile.Contexts {
+		err := config.loadCredentials(contextName)
+		if err != nil {
+			return err
+		}
+	}
+
+	if config.credentialHelper != nil {
+		for _, credentials := range config.storedCredentials {
+			if credentials.HasSecrets() {
+				err := config.credentialHelper.erase(credentials)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	config.ConfigFile.CredentialHelper = name
+	config.initCredentialHelper()
+	return nil
+}
+
+func (config *Config) initCredentialHelper() {
+	config.credentialHelper = nil
+	config.storedCredentials = map[string]Credentials{}
+	if config.ConfigFile.CredentialHelper != "" {
+		config.credentialHelper = &credentialHelper{name: config.ConfigFile.CredentialHelper}
+	}
+}
+
+// loadCredentials gets the secrets of the named context from the credential
+// helper. Contexts whose secrets are still in config.json are left alone so
+// that they are moved to the helper on the next write.
+func (config *Config) loadCredentials(contextName string) error {
+	if config.credentialHelper == nil {
+		return nil
+	}
+
+	if _, loaded := config.storedCredentials[contextName]; loaded {
+		return nil
+	}
+
+	context, exists := config.ConfigFile.Contexts[contextName]
+	if !exists || context.credentials(contextName).HasSecrets() {
+		return nil
+	}
+
+	credentials, err := config.credentialHelper.get(contextName, context.Target)
+	if err != nil {
+		return err
+	}
+
+	context.AccessToken = credentials.AccessToken
+	context.RefreshToken = credentials.RefreshToken
+	context.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+	config.ConfigFile.Contexts[contextName] = context
+	config.storedCredentials[contextName] = credentials
+
+	if contextName == config.activeContext {
+		config.ConfigFile.applyContext(context)
+	}
+
+	return nil
+}
+
+// storeCredentials hands the secrets of every context to the credential helper
+// and removes them from the contents written to config.json.
+func (config *Config) storeCredentials(contents *JSONConfig) error {
+	if config.credentialHelper == nil {
+		return nil
+	}
+
+	contexts := map[string]TargetContext{}
+	for contextName, context := range contents.Contexts {
+		err := config.syncCredentials(context.credentials(contextName))
+		if err != nil {
+			return err
+		}
+
+		context.AccessToken = ""
+		context.RefreshToken = ""
+		context.UAAOAuthClientSecret = ""
+		contexts[contextName] = context
+	}
+
+	for contextName, stored := range config.storedCredentials {
+		if _, exists := contents.Contexts[contextName]; exists {
+			continue
+		}
+		if stored.HasSecrets() {
+			err := config.credentialHelper.erase(stored)
+			if err != nil {
+				return err
+			}
+		}
+		delete(config.storedCredentials, contextName)
+	}
+
+	contents.Contexts = contexts
+	contents.AccessToken = ""
+	contents.RefreshToken = ""
+	contents.UAAOAuthClientSecret = ""
+	return nil
+}
+
+// PersistTokens hands the secrets of the context in use to the credential
+// helper straight away rather than when the config is written, so that
+// refreshed tokens are kept and cleared tokens are erased even if the config is
+// never written. Without a credential helper it does nothing.
+func (config *Config) PersistTokens() error {
+	if config.credentialHelper == nil {
+		return nil
+	}
+
+	config.saveActiveContext()
+	if config.activeContext == "" {
+		return nil
+	}
+
+	return config.syncCredentials(config.ConfigFile.Contexts[config.activeContext].credentials(config.activeContext))
+}
+
+// syncCredentials stores changed secrets with the credential helper and erases
+// cleared ones. A context without secrets whose credentials were never loaded
+// is left alone, since the helper may still hold its secrets.
+func (config *Config) syncCredentials(credentials Credentials) error {
+	stored, loaded := config.storedCredentials[credentials.Context]
+
+	var err error
+	switch {
+	case loaded && stored.sameSecrets(credentials):
+	case !loaded && !credentials.HasSecrets():
+	case !credentials.HasSecrets():
+		err = config.credentialHelper.erase(credentials)
+	default:
+		err = config.credentialHelper.store(credentials)
+	}
+	if err != nil {
+		return err
+	}
+
+	config.storedCredentials[credentials.Context] = credentials
+	return nil
+}
+
+func (context TargetContext) credentials(name string) Credentials {
+	return Credentials{
+		Context:              name,
+		ServerURL:            context.Target,
+		AccessToken:          context.AccessToken,
+		RefreshToken:         context.RefreshToken,
+		UAAOAuthClientSecret: context.UAAOAuthClientSecret,
+	}
+}
+
+// HasSecrets returns true if any of the secrets is set.
+func (credentials Credentials) HasSecrets() bool {
+	return credentials.AccessToken != "" || credentials.RefreshToken != "" || credentials.UAAOAuthClientSecret != ""
+}
+
+func (credentials Credentials) sameSecrets(other Credentials) bool {
+	return credentials.AccessToken == other.AccessToken &&
+		credentials.RefreshToken == other.RefreshToken &&
+		credentials.UAAOAuthClientSecret == other.UAAOAuthClientSecret
+}
+
+// GetCredentials gets the secrets of the named context from the named
+// credential helper.
+func GetCredentials(helperName string, contextName string, serverURL string) (Credentials, error) {
+	return credentialHelper{name: helperName}.get(contextName, serverURL)
+}
+
+// StoreCredentials hands the credentials to the named credential helper.
+func StoreCredentials(helperName string, credentials Credentials) error {
+	return credentialHelper{name: helperName}.store(credentials)
+}
+
+// EraseCredentials removes the credentials of a context from the named
+// credential helper.
+func EraseCredentials(helperName string, credentials Credentials) error {
+	return credentialHelper{name: helperName}.erase(credentials)
+}
+
+// credentialHelper runs the cf-credential-<name> executable.
+type credentialHelper struct {
+	name string
+}
+
+func (helper credentialHelper) get(contextName string, serverURL string) (Credentials, error) {
+	output, err := helper.run("get", Credentials{Context: contextName, ServerURL: serverURL})
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	var credentials Credentials
+	if len(bytes.TrimSpace(output)) > 0 {
+		err = json.Unmarshal(output, &credentials)
+		if err != nil {
+			return Credentials{}, CredentialHelperError{Helper: helper.name, Action: "get", Message: err.Error()}
+		}
+	}
+	credentials.Context = contextName
+	credentials.ServerURL = serverURL
+
+	return credentials, nil
+}
+
+func (helper credentialHelper) store(credentials Credentials) error {
+	_, err := helper.run("store", credentials)
+	return err
+}
+
+func (helper credentialHelper) erase(credentials Credentials) error {
+	_, err := helper.run("erase", Credentials{Context: credentials.Context, ServerURL: credentials.ServerURL})
+	return err
+}
+
+func (helper credentialHelper) run(action string, input Credentials) ([]byte, error) {
+	rawInput, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(CredentialHelperPrefix+helper.name, action)
+	cmd.Stdin = bytes.NewReader(rawInput)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err = cmd.Run()
+	if err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = err.Error()
+		}
+		return nil, CredentialHelperError{Helper: helper.name, Action: action, Message: message}
+	}
+
+	return stdout.Bytes(), nil
+}
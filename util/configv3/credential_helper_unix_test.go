@@ -0,0 +1,234 @@
+//go:build !windows
+// +build !windows
+
+package configv3_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/v9/util/configv3"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeHelperScript keeps credentials as files named after their context in
+// $CF_TEST_CREDENTIAL_STORE and records every invocation in its 'calls' file.
+const fakeHelperScript = `#!/bin/sh
+input=$(cat)
+context=$(printf '%s' "$input" | sed -n 's/.*"Context":"\([^"]*\)".*/\1/p')
+echo "$1 $context" >> "$CF_TEST_CREDENTIAL_STORE/calls"
+case "$1" in
+get) cat "$CF_TEST_CREDENTIAL_STORE/$context.json" 2>/dev/null || echo '{}' ;;
+store) printf '%s' "$input" > "$CF_TEST_CREDENTIAL_STORE/$context.json" ;;
+erase) rm -f "$CF_TEST_CREDENTIAL_STORE/$context.json" ;;
+esac
+`
+
+var _ = Describe("Credential helper", func() {
+	var (
+		homeDir   string
+		helperDir string
+		storeDir  string
+		oldPath   string
+		config    *Config
+	)
+
+	loadConfig := func() *Config {
+		loadedConfig, err := LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+		return loadedConfig
+	}
+
+	calls := func() []string {
+		rawCalls, err := os.ReadFile(filepath.Join(storeDir, "calls"))
+		if os.IsNotExist(err) {
+			return nil
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return strings.Split(strings.TrimSpace(string(rawCalls)), "\n")
+	}
+
+	resetCalls := func() {
+		Expect(os.RemoveAll(filepath.Join(storeDir, "calls"))).To(Succeed())
+	}
+
+	storedCredentials := func(contextName string) string {
+		rawCredentials, err := os.ReadFile(filepath.Join(storeDir, contextName+".json"))
+		if os.IsNotExist(err) {
+			return ""
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return string(rawCredentials)
+	}
+
+	configFile := func() string {
+		rawConfig, err := os.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(err).ToNot(HaveOccurred())
+		return string(rawConfig)
+	}
+
+	BeforeEach(func() {
+		homeDir = setup()
+
+		var err error
+		helperDir, err = os.MkdirTemp("", "cli-credential-helper")
+		Expect(err).ToNot(HaveOccurred())
+		storeDir, err = os.MkdirTemp("", "cli-credential-store")
+		Expect(err).ToNot(HaveOccurred())
+
+		err = os.WriteFile(filepath.Join(helperDir, CredentialHelperPrefix+"fake"), []byte(fakeHelperScript), 0700)
+		Expect(err).ToNot(HaveOccurred())
+		err = os.WriteFile(filepath.Join(helperDir, CredentialHelperPrefix+"broken"), []byte("#!/bin/sh\necho 'vault is sealed' >&2\nexit 1\n"), 0700)
+		Expect(err).ToNot(HaveOccurred())
+
+		oldPath = os.Getenv("PATH")
+		Expect(os.Setenv("PATH", helperDir+string(os.PathListSeparator)+oldPath)).To(Succeed())
+		Expect(os.Setenv("CF_TEST_CREDENTIAL_STORE", storeDir)).To(Succeed())
+
+		setConfig(homeDir, `{
+			"ConfigVersion": 4,
+			"Target": "https://api.foo.com",
+			"AccessToken": "foo-access-token",
+			"RefreshToken": "foo-refresh-token"
+		}`)
+		config = loadConfig()
+	})
+
+	AfterEach(func() {
+		Expect(os.Setenv("PATH", oldPath)).To(Succeed())
+		Expect(os.Unsetenv("CF_TEST_CREDENTIAL_STORE")).To(Succeed())
+		Expect(os.RemoveAll(helperDir)).To(Succeed())
+		Expect(os.RemoveAll(storeDir)).To(Succeed())
+		teardown(homeDir)
+	})
+
+	When("a credential helper is set", func() {
+		BeforeEach(func() {
+			Expect(config.SetCredentialHelper("fake")).To(Succeed())
+			Expect(config.WriteConfig()).To(Succeed())
+		})
+
+		It("moves the secrets out of config.json", func() {
+			Expect(config.CredentialHelper()).To(Equal("fake"))
+			Expect(calls()).To(Equal([]string{"store default"}))
+			Expect(storedCredentials(DefaultContextName)).To(ContainSubstring(`"AccessToken":"foo-access-token"`))
+			Expect(storedCredentials(DefaultContextName)).To(ContainSubstring(`"ServerURL":"https://api.foo.com"`))
+
+			Expect(configFile()).To(ContainSubstring(`"CredentialHelper": "fake"`))
+			Expect(configFile()).ToNot(ContainSubstring("foo-access-token"))
+			Expect(configFile()).ToNot(ContainSubstring("foo-refresh-token"))
+		})
+
+		It("gets the secrets from the helper when the config is loaded", func() {
+			resetCalls()
+			config = loadConfig()
+			Expect(calls()).To(Equal([]string{"get default"}))
+			Expect(config.AccessToken()).To(Equal("foo-access-token"))
+			Expect(config.RefreshToken()).To(Equal("foo-refresh-token"))
+		})
+
+		It("does not store unchanged secrets again", func() {
+			config = loadConfig()
+			resetCalls()
+			Expect(config.WriteConfig()).To(Succeed())
+			Expect(calls()).To(BeEmpty())
+		})
+
+		It("stores refreshed tokens with the helper", func() {
+			config = loadConfig()
+			config.SetAccessToken("refreshed-access-token")
+			config.SetRefreshToken("refreshed-refresh-token")
+			Expect(config.WriteConfig()).To(Succeed())
+
+			Expect(storedCredentials(DefaultContextName)).To(ContainSubstring(`"AccessToken":"refreshed-access-token"`))
+			Expect(configFile()).ToNot(ContainSubstring("refreshed-access-token"))
+		})
+
+		It("stores refreshed tokens right away when they are persisted", func() {
+			config = loadConfig()
+			config.SetAccessToken("refreshed-access-token")
+			config.SetRefreshToken("refreshed-refresh-token")
+			resetCalls()
+			Expect(config.PersistTokens()).To(Succeed())
+
+			Expect(calls()).To(Equal([]string{"store default"}))
+			Expect(storedCredentials(DefaultContextName)).To(ContainSubstring(`"RefreshToken":"refreshed-refresh-token"`))
+
+			resetCalls()
+			Expect(config.WriteConfig()).To(Succeed())
+			Expect(calls()).To(BeEmpty())
+		})
+
+		It("erases the secrets right away when cleared tokens are persisted", func() {
+			config = loadConfig()
+			config.UnsetUserInformation()
+			resetCalls()
+			Expect(config.PersistTokens()).To(Succeed())
+
+			Expect(calls()).To(Equal([]string{"erase default"}))
+			Expect(storedCredentials(DefaultContextName)).To(BeEmpty())
+		})
+
+		It("erases the secrets from the helper on logout", func() {
+			config = loadConfig()
+			config.UnsetUserInformation()
+			resetCalls()
+			Expect(config.WriteConfig()).To(Succeed())
+
+			Expect(calls()).To(Equal([]string{"erase default"}))
+			Expect(storedCredentials(DefaultContextName)).To(BeEmpty())
+		})
+
+		It("erases the secrets of deleted contexts", func() {
+			config = loadConfig()
+			config.AddContext("bar")
+			Expect(config.SwitchContext("bar")).To(Succeed())
+			Expect(config.RemoveContext(DefaultContextName)).To(Succeed())
+			Expect(config.WriteConfig()).To(Succeed())
+
+			Expect(storedCredentials(DefaultContextName)).To(BeEmpty())
+		})
+
+		It("moves the secrets of renamed contexts", func() {
+			config = loadConfig()
+			config.AddContext("bar")
+			Expect(config.SwitchContext("bar")).To(Succeed())
+			Expect(config.RenameContext(DefaultContextName, "foo")).To(Succeed())
+			Expect(config.WriteConfig()).To(Succeed())
+
+			Expect(storedCredentials(DefaultContextName)).To(BeEmpty())
+			Expect(storedCredentials("foo")).To(ContainSubstring(`"AccessToken":"foo-access-token"`))
+		})
+
+		When("the credential helper is cleared", func() {
+			BeforeEach(func() {
+				config = loadConfig()
+				Expect(config.SetCredentialHelper("")).To(Succeed())
+				Expect(config.WriteConfig()).To(Succeed())
+			})
+
+			It("writes the secrets to config.json again", func() {
+				Expect(storedCredentials(DefaultContextName)).To(BeEmpty())
+				Expect(configFile()).To(ContainSubstring("foo-access-token"))
+				Expect(configFile()).ToNot(ContainSubstring("CredentialHelper"))
+			})
+		})
+	})
+
+	When("the credential helper fails", func() {
+		BeforeEach(func() {
+			Expect(config.SetCredentialHelper("broken")).To(Succeed())
+		})
+
+		It("returns a CredentialHelperError", func() {
+			Expect(config.WriteConfig()).To(MatchError(CredentialHelperError{
+				Helper:  "broken",
+				Action:  "store",
+				Message: "vault is sealed",
+			}))
+		})
+	})
+})
@@ -19,9 +19,17 @@ const (
 	// Developer Note: Due to bugs in using MaxInt64 during comparison, the above
 	// was chosen as a replacement.
 
+	// DefaultPaginationConcurrency is the default number of pages of a list
+	// request fetched at once.
+	DefaultPaginationConcurrency = 1
+
 	// DefaultPollingInterval is the time between consecutive polls of a status.
 	DefaultPollingInterval = 3 * time.Second
 
+	// DefaultRetryTimeout is the default maximum time spent retrying a failed
+	// request.
+	DefaultRetryTimeout = time.Minute
+
 	// DefaultStagingTimeout is the default timeout for application staging.
 	DefaultStagingTimeout = 15 * time.Minute
 
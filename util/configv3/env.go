@@ -2,6 +2,8 @@ package configv3
 
 import (
 	"encoding/json"
+	"path/filepath"
+	"runtime"
 	"strconv"
 	"strings"
 	"time"
@@ -11,25 +13,31 @@ import (
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFDialTimeout    string
-	CFHome           string
-	CFLogLevel       string
-	CFPassword       string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	CFUsername       string
-	CFB3TraceID      string
-	DockerPassword   string
-	CNBCredentials   string
-	Experimental     string
-	ForceTTY         string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
+	BinaryName              string
+	CFColor                 string
+	CFDialTimeout           string
+	CFHashCache             string
+	CFHashConcurrency       string
+	CFHome                  string
+	CFLogLevel              string
+	CFOutputFormat          string
+	CFPaginationConcurrency string
+	CFPassword              string
+	CFPluginHome            string
+	CFRetryTimeout          string
+	CFStagingTimeout        string
+	CFStartupTimeout        string
+	CFTrace                 string
+	CFUseGitIgnore          string
+	CFUsername              string
+	CFB3TraceID             string
+	DockerPassword          string
+	CNBCredentials          string
+	Experimental            string
+	ForceTTY                string
+	HTTPSProxy              string
+	Lang                    string
+	LCAll                   string
 }
 
 // BinaryName returns the running name of the CF CLI
@@ -96,6 +104,37 @@ func (config *Config) Experimental() bool {
 	return false
 }
 
+// HashCacheFile returns the file in which the hashes of pushed files are
+// cached between pushes. This is based off of:
+//  1. The $CF_HASH_CACHE environment variable if set to true
+//  2. Defaults to the empty string, which disables the cache
+func (config *Config) HashCacheFile() string {
+	if config.ENV.CFHashCache != "" {
+		envVal, err := strconv.ParseBool(config.ENV.CFHashCache)
+		if err == nil && envVal {
+			return filepath.Join(configDirectory(), "hash_cache.json")
+		}
+	}
+
+	return ""
+}
+
+// HashConcurrency returns the maximum number of files hashed at once when
+// gathering the resources of a directory. This value is based off of:
+//  1. The $CF_HASH_CONCURRENCY environment variable if set to a positive
+//     integer
+//  2. Defaults to the number of CPUs
+func (config *Config) HashConcurrency() int {
+	if config.ENV.CFHashConcurrency != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFHashConcurrency)
+		if err == nil && envVal > 0 {
+			return envVal
+		}
+	}
+
+	return runtime.NumCPU()
+}
+
 // HTTPSProxy returns the proxy url that the CLI should use. The url is based
 // off of:
 //  1. The $https_proxy environment variable if set
@@ -132,6 +171,54 @@ func (config *Config) LogLevel() int {
 	return 0
 }
 
+// PaginationConcurrency returns the maximum number of pages of a list request
+// fetched at once. This value is based off of:
+//  1. The $CF_PAGINATION_CONCURRENCY environment variable if set to a positive
+//     integer
+//  2. Defaults to fetching pages one after another
+func (config *Config) PaginationConcurrency() int {
+	if config.ENV.CFPaginationConcurrency != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFPaginationConcurrency)
+		if err == nil && envVal > 0 {
+			return envVal
+		}
+	}
+
+	return DefaultPaginationConcurrency
+}
+
+// RetryTimeout returns the max time spent retrying a failed request. This is
+// based off of:
+//  1. The $CF_RETRY_TIMEOUT environment variable if set, in seconds, where 0
+//     does not limit the retry time
+//  2. Defaults to the DefaultRetryTimeout
+func (config *Config) RetryTimeout() time.Duration {
+	if config.ENV.CFRetryTimeout != "" {
+		envVal, err := strconv.ParseInt(config.ENV.CFRetryTimeout, 10, 64)
+		if err == nil && envVal >= 0 {
+			return time.Duration(envVal) * time.Second
+		}
+	}
+
+	return DefaultRetryTimeout
+}
+
+// UseGitIgnore returns whether the .gitignore file of a directory is used when
+// pushing an app and the directory has no .cfignore file. This is based on the
+// following:
+//  1. The $CF_USE_GITIGNORE environment variable if set
+//  2. Defaults to false
+func (config *Config) UseGitIgnore() bool {
+	if config.ENV.CFUseGitIgnore != "" {
+		envVal, err := strconv.ParseBool(config.ENV.CFUseGitIgnore)
+		if err == nil {
+			return envVal
+		}
+	}
+
+	return false
+}
+
 // StagingTimeout returns the max time an application staging should take. The
 // time is based off of:
 //  1. The $CF_STAGING_TIMEOUT environment variable if set
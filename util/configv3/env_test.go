@@ -1,6 +1,8 @@
 package configv3_test
 
 import (
+	"path/filepath"
+	"runtime"
 	"time"
 
 	. "code.cloudfoundry.org/cli/v9/util/configv3"
@@ -71,6 +73,65 @@ var _ = Describe("Config", func() {
 		})
 	})
 
+	DescribeTable("HashCacheFile",
+		func(envVal string, enabled bool) {
+			config.ENV.CFHashCache = envVal
+			if enabled {
+				Expect(config.HashCacheFile()).To(Equal(filepath.Join(filepath.Dir(ConfigFilePath()), "hash_cache.json")))
+			} else {
+				Expect(config.HashCacheFile()).To(BeEmpty())
+			}
+		},
+		Entry("is disabled by default", "", false),
+		Entry("is enabled when the env is true", "true", true),
+		Entry("is disabled when the env is false", "false", false),
+		Entry("ignores a non-boolean value", "sometimes", false),
+	)
+
+	DescribeTable("HashConcurrency",
+		func(envVal string, expected int) {
+			config.ENV.CFHashConcurrency = envVal
+			Expect(config.HashConcurrency()).To(Equal(expected))
+		},
+		Entry("defaults to the number of CPUs", "", runtime.NumCPU()),
+		Entry("uses a positive value from the env", "8", 8),
+		Entry("ignores zero", "0", runtime.NumCPU()),
+		Entry("ignores a non-integer value", "lots", runtime.NumCPU()),
+	)
+
+	DescribeTable("PaginationConcurrency",
+		func(envVal string, expected int) {
+			config.ENV.CFPaginationConcurrency = envVal
+			Expect(config.PaginationConcurrency()).To(Equal(expected))
+		},
+		Entry("defaults to sequential pagination", "", DefaultPaginationConcurrency),
+		Entry("uses a positive value from the env", "8", 8),
+		Entry("ignores zero", "0", DefaultPaginationConcurrency),
+		Entry("ignores a non-integer value", "lots", DefaultPaginationConcurrency),
+	)
+
+	DescribeTable("UseGitIgnore",
+		func(envVal string, expected bool) {
+			config.ENV.CFUseGitIgnore = envVal
+			Expect(config.UseGitIgnore()).To(Equal(expected))
+		},
+		Entry("defaults to false", "", false),
+		Entry("is true when the env is true", "true", true),
+		Entry("ignores a non-boolean value", "sometimes", false),
+	)
+
+	DescribeTable("RetryTimeout",
+		func(envVal string, expected time.Duration) {
+			config.ENV.CFRetryTimeout = envVal
+			Expect(config.RetryTimeout()).To(Equal(expected))
+		},
+		Entry("defaults to a minute", "", DefaultRetryTimeout),
+		Entry("uses the value from the env in seconds", "300", 5*time.Minute),
+		Entry("allows zero to not limit the retry time", "0", time.Duration(0)),
+		Entry("ignores a negative value", "-1", DefaultRetryTimeout),
+		Entry("ignores a non-integer value", "forever", DefaultRetryTimeout),
+	)
+
 	DescribeTable("Experimental",
 		func(envVal string, expected bool) {
 			config.ENV.Experimental = envVal
@@ -116,25 +116,40 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		config.ConfigFile.UAAOAuthClientSecret = DefaultUAAOAuthClientSecret
 	}
 
+	config.activeContext = config.ConfigFile.CurrentContext
+	config.saveActiveContext()
+
+	config.initCredentialHelper()
+	err = config.loadCredentials(config.activeContext)
+	if err != nil {
+		return nil, err
+	}
+
 	config.ENV = EnvOverride{
-		BinaryName:       filepath.Base(os.Args[0]),
-		CFColor:          os.Getenv("CF_COLOR"),
-		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
-		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
-		CFPassword:       os.Getenv("CF_PASSWORD"),
-		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:          os.Getenv("CF_TRACE"),
-		CFUsername:       os.Getenv("CF_USERNAME"),
-		CFB3TraceID:      os.Getenv("CF_B3_TRACE_ID"),
-		DockerPassword:   os.Getenv("CF_DOCKER_PASSWORD"),
-		CNBCredentials:   os.Getenv("CF_CNB_REGISTRY_CREDS"),
-		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
-		ForceTTY:         os.Getenv("FORCE_TTY"),
-		HTTPSProxy:       os.Getenv("https_proxy"),
-		Lang:             os.Getenv("LANG"),
-		LCAll:            os.Getenv("LC_ALL"),
+		BinaryName:              filepath.Base(os.Args[0]),
+		CFColor:                 os.Getenv("CF_COLOR"),
+		CFDialTimeout:           os.Getenv("CF_DIAL_TIMEOUT"),
+		CFHashCache:             os.Getenv("CF_HASH_CACHE"),
+		CFHashConcurrency:       os.Getenv("CF_HASH_CONCURRENCY"),
+		CFLogLevel:              os.Getenv("CF_LOG_LEVEL"),
+		CFOutputFormat:          os.Getenv("CF_OUTPUT_FORMAT"),
+		CFPaginationConcurrency: os.Getenv("CF_PAGINATION_CONCURRENCY"),
+		CFPassword:              os.Getenv("CF_PASSWORD"),
+		CFPluginHome:            os.Getenv("CF_PLUGIN_HOME"),
+		CFRetryTimeout:          os.Getenv("CF_RETRY_TIMEOUT"),
+		CFStagingTimeout:        os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:        os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTrace:                 os.Getenv("CF_TRACE"),
+		CFUseGitIgnore:          os.Getenv("CF_USE_GITIGNORE"),
+		CFUsername:              os.Getenv("CF_USERNAME"),
+		CFB3TraceID:             os.Getenv("CF_B3_TRACE_ID"),
+		DockerPassword:          os.Getenv("CF_DOCKER_PASSWORD"),
+		CNBCredentials:          os.Getenv("CF_CNB_REGISTRY_CREDS"),
+		Experimental:            os.Getenv("CF_CLI_EXPERIMENTAL"),
+		ForceTTY:                os.Getenv("FORCE_TTY"),
+		HTTPSProxy:              os.Getenv("https_proxy"),
+		Lang:                    os.Getenv("LANG"),
+		LCAll:                   os.Getenv("LC_ALL"),
 	}
 
 	err = config.loadPluginConfig()
@@ -0,0 +1,44 @@
+package configv3
+
+import "strings"
+
+const (
+	// OutputFormatText means commands render human readable tables and text.
+	OutputFormatText OutputFormat = ""
+
+	// OutputFormatJSON means commands render a single JSON document.
+	OutputFormatJSON OutputFormat = "json"
+
+	// OutputFormatYAML means commands render a single YAML document.
+	OutputFormatYAML OutputFormat = "yaml"
+)
+
+// OutputFormat represents the document format commands write to STDOUT.
+type OutputFormat string
+
+// OutputFormat returns the output format based off:
+//  1. The '--output-format' global flag if set (json/yaml)
+//  2. The $CF_OUTPUT_FORMAT environment variable if set (json/yaml)
+//  3. Defaults to OutputFormatText if nothing is set
+func (config *Config) OutputFormat() OutputFormat {
+	if format, ok := parseOutputFormat(config.Flags.OutputFormat); ok {
+		return format
+	}
+
+	if format, ok := parseOutputFormat(config.ENV.CFOutputFormat); ok {
+		return format
+	}
+
+	return OutputFormatText
+}
+
+func parseOutputFormat(val string) (OutputFormat, bool) {
+	switch strings.ToLower(val) {
+	case string(OutputFormatJSON):
+		return OutputFormatJSON, true
+	case string(OutputFormatYAML):
+		return OutputFormatYAML, true
+	default:
+		return OutputFormatText, false
+	}
+}
@@ -0,0 +1,43 @@
+package configv3_test
+
+import (
+	"os"
+
+	. "code.cloudfoundry.org/cli/v9/util/configv3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	DescribeTable("OutputFormat",
+		func(flagVal string, envVal string, expected OutputFormat) {
+			defer os.Unsetenv("CF_OUTPUT_FORMAT")
+			if envVal == "" {
+				os.Unsetenv("CF_OUTPUT_FORMAT")
+			} else {
+				os.Setenv("CF_OUTPUT_FORMAT", envVal)
+			}
+
+			config, err := LoadConfig(FlagOverride{OutputFormat: flagVal})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).ToNot(BeNil())
+
+			Expect(config.OutputFormat()).To(Equal(expected))
+		},
+		Entry("flag=json  env=unset json", "json", "", OutputFormatJSON),
+		Entry("flag=yaml  env=json  yaml", "yaml", "json", OutputFormatYAML),
+		Entry("flag=unset env=YAML  yaml", "", "YAML", OutputFormatYAML),
+		Entry("flag=unset env=bogus text", "", "bogus", OutputFormatText),
+		Entry("flag=unset env=unset falls back to default", "", "", OutputFormatText),
+	)
+})
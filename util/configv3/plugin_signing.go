@@ -0,0 +1,67 @@
+package configv3
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	// PluginSignaturesOptional installs unsigned plugins, but still verifies
+	// the signature of signed ones. This is the default policy.
+	PluginSignaturesOptional = "optional"
+
+	// PluginSignaturesRequired refuses to install plugins that are not signed
+	// by a trusted key.
+	PluginSignaturesRequired = "required"
+)
+
+// TrustedPluginKey is a saved public key that plugin binaries may be signed
+// with.
+type TrustedPluginKey struct {
+	Name      string `json:"Name"`
+	PublicKey string `json:"PublicKey"`
+}
+
+// AddTrustedPluginKey adds a key to the trusted plugin keys. It does not check
+// for duplicates.
+func (config *Config) AddTrustedPluginKey(name string, publicKey string) {
+	config.ConfigFile.TrustedPluginKeys = append(config.ConfigFile.TrustedPluginKeys,
+		TrustedPluginKey{Name: name, PublicKey: publicKey})
+}
+
+// RemoveTrustedPluginKey removes the key with the given name, ignoring case,
+// and returns true if it existed.
+func (config *Config) RemoveTrustedPluginKey(name string) bool {
+	keys := config.ConfigFile.TrustedPluginKeys
+	for i, key := range keys {
+		if strings.EqualFold(key.Name, name) {
+			config.ConfigFile.TrustedPluginKeys = append(keys[:i:i], keys[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// TrustedPluginKeys returns the trusted plugin keys sorted by name.
+func (config *Config) TrustedPluginKeys() []TrustedPluginKey {
+	keys := config.ConfigFile.TrustedPluginKeys
+	sort.Slice(keys, func(i, j int) bool {
+		return strings.ToLower(keys[i].Name) < strings.ToLower(keys[j].Name)
+	})
+	return keys
+}
+
+// PluginSignaturePolicy returns whether plugins must be signed by a trusted
+// key to be installed. It defaults to PluginSignaturesOptional.
+func (config *Config) PluginSignaturePolicy() string {
+	if config.ConfigFile.PluginSignaturePolicy == "" {
+		return PluginSignaturesOptional
+	}
+	return config.ConfigFile.PluginSignaturePolicy
+}
+
+// SetPluginSignaturePolicy sets whether plugins must be signed by a trusted
+// key to be installed.
+func (config *Config) SetPluginSignaturePolicy(policy string) {
+	config.ConfigFile.PluginSignaturePolicy = policy
+}
@@ -0,0 +1,64 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/v9/util/configv3"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plugin signing", func() {
+	var config Config
+
+	BeforeEach(func() {
+		config = Config{
+			ConfigFile: JSONConfig{
+				TrustedPluginKeys: []TrustedPluginKey{
+					{Name: "S-key", PublicKey: "s-public-key"},
+					{Name: "key-1", PublicKey: "public-key-1"},
+				},
+			},
+		}
+	})
+
+	Describe("TrustedPluginKeys", func() {
+		It("returns the keys sorted by name", func() {
+			Expect(config.TrustedPluginKeys()).To(Equal([]TrustedPluginKey{
+				{Name: "key-1", PublicKey: "public-key-1"},
+				{Name: "S-key", PublicKey: "s-public-key"},
+			}))
+		})
+	})
+
+	Describe("AddTrustedPluginKey", func() {
+		It("adds the key", func() {
+			config.AddTrustedPluginKey("key-2", "public-key-2")
+			Expect(config.TrustedPluginKeys()).To(ContainElement(TrustedPluginKey{Name: "key-2", PublicKey: "public-key-2"}))
+		})
+	})
+
+	Describe("RemoveTrustedPluginKey", func() {
+		It("removes the key with the name in any case", func() {
+			Expect(config.RemoveTrustedPluginKey("s-KEY")).To(BeTrue())
+			Expect(config.TrustedPluginKeys()).To(Equal([]TrustedPluginKey{
+				{Name: "key-1", PublicKey: "public-key-1"},
+			}))
+		})
+
+		It("returns false when there is no such key", func() {
+			Expect(config.RemoveTrustedPluginKey("key-2")).To(BeFalse())
+			Expect(config.TrustedPluginKeys()).To(HaveLen(2))
+		})
+	})
+
+	Describe("PluginSignaturePolicy", func() {
+		It("defaults to optional", func() {
+			Expect(config.PluginSignaturePolicy()).To(Equal(PluginSignaturesOptional))
+		})
+
+		It("returns the policy that was set", func() {
+			config.SetPluginSignaturePolicy(PluginSignaturesRequired)
+			Expect(config.PluginSignaturePolicy()).To(Equal(PluginSignaturesRequired))
+		})
+	})
+})
@@ -25,6 +25,10 @@ type Plugin struct {
 	Version        PluginVersion   `json:"Version"`
 	LibraryVersion PluginVersion   `json:"LibraryVersion"`
 	Commands       []PluginCommand `json:"Commands"`
+	SignedBy       string          `json:"SignedBy,omitempty"`
+	// PinnedVersion is the version that upgrade-plugins keeps the plugin at
+	// after it was upgraded with PLUGIN_NAME@VERSION.
+	PinnedVersion string `json:"PinnedVersion,omitempty"`
 }
 
 // CalculateSHA1 returns the SHA1 value of the plugin executable. If an error
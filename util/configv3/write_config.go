@@ -12,7 +12,12 @@ import (
 // location of .cf directory is written in the same way LoadConfig reads .cf
 // directory.
 func (c *Config) WriteConfig() error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	contents, err := c.fileContents()
+	if err != nil {
+		return err
+	}
+
+	rawConfig, err := json.MarshalIndent(contents, "", "  ")
 	if err != nil {
 		return err
 	}
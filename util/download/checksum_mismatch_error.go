@@ -0,0 +1,15 @@
+package download
+
+import "fmt"
+
+// ChecksumMismatchError is returned when a downloaded file does not match the
+// expected checksum. The partially downloaded file is removed.
+type ChecksumMismatchError struct {
+	Algorithm string
+	Expected  string
+	Actual    string
+}
+
+func (e ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("%s checksum mismatch: expected %s, got %s", e.Algorithm, e.Expected, e.Actual)
+}
@@ -0,0 +1,16 @@
+package download
+
+import "time"
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Clock
+
+// Clock waits between the attempts of a download.
+type Clock interface {
+	Sleep(d time.Duration)
+}
+
+type realClock struct{}
+
+func (realClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
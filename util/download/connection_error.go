@@ -0,0 +1,18 @@
+package download
+
+import "fmt"
+
+// ConnectionError is returned when every attempt of a download failed before
+// any of the file was received.
+type ConnectionError struct {
+	URL string
+	Err error
+}
+
+func (e ConnectionError) Error() string {
+	return fmt.Sprintf("unable to download %s: %s", e.URL, e.Err)
+}
+
+func (e ConnectionError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,265 @@
+package download
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// PartialFileSuffix is appended to the destination of a download while it is
+// in progress.
+const PartialFileSuffix = ".part"
+
+const (
+	// maxDownloadAttempts is how many requests are made for a file before an
+	// interrupted download is given up on.
+	maxDownloadAttempts = 5
+
+	// retryBaseDelay is the delay before the first retry of an interrupted
+	// download. It doubles with every further retry.
+	retryBaseDelay = 500 * time.Millisecond
+)
+
+// Checksum is the expected digest of a downloaded file, hex encoded. Algorithm
+// is either "sha256" or "sha1". Verification is skipped when Value is empty.
+type Checksum struct {
+	Algorithm string
+	Value     string
+}
+
+// DownloadToFile streams the file at url to path, sending header with every
+// request. The bytes are written to path with PartialFileSuffix appended
+// until the download completes, and an existing partial file is resumed with
+// an HTTP range request; so is a download that fails part way through. The
+// completed file is verified against checksum before it is moved to path.
+// progressBar is optional. Only downloads cut off by the connection are
+// retried, with an exponential backoff between the attempts.
+func (downloader Downloader) DownloadToFile(url string, header http.Header, path string, checksum Checksum, progressBar ProgressBar) error {
+	var digest hash.Hash
+	if checksum.Value != "" {
+		var err error
+		digest, err = newDigest(checksum.Algorithm)
+		if err != nil {
+			return err
+		}
+	}
+
+	partialPath := path + PartialFileSuffix
+	file, err := os.OpenFile(partialPath, os.O_CREATE|os.O_RDWR, 0666)
+	if err != nil {
+		return err
+	}
+
+	err = downloader.downloadWithRetries(url, header, file, progressBar)
+	if err == nil && digest != nil {
+		err = verifyChecksum(file, digest, checksum)
+	}
+	closeErr := file.Close()
+
+	if _, ok := err.(ChecksumMismatchError); ok {
+		_ = os.Remove(partialPath)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	return os.Rename(partialPath, path)
+}
+
+func (downloader Downloader) downloadWithRetries(url string, header http.Header, file *os.File, progressBar ProgressBar) error {
+	progress := &downloadProgress{bar: progressBar}
+	defer progress.finish()
+
+	for attempt := 1; ; attempt++ {
+		offset, err := file.Seek(0, io.SeekEnd)
+		if err != nil {
+			return err
+		}
+
+		err = downloader.downloadFrom(url, header, file, offset, progress)
+		if err == nil || !isRetryable(err) {
+			return err
+		}
+
+		if attempt == maxDownloadAttempts {
+			received, _ := file.Seek(0, io.SeekEnd)
+			if received == 0 {
+				return ConnectionError{URL: url, Err: err}
+			}
+			return InterruptedError{BytesReceived: received, Err: err}
+		}
+
+		downloader.clock().Sleep(retryBaseDelay << uint(attempt-1))
+	}
+}
+
+func (downloader Downloader) clock() Clock {
+	if downloader.Clock == nil {
+		return realClock{}
+	}
+	return downloader.Clock
+}
+
+// downloadFrom appends the bytes of the file from offset onwards. The partial
+// file is truncated when the server cannot resume from offset.
+func (downloader Downloader) downloadFrom(url string, header http.Header, file *os.File, offset int64, progress *downloadProgress) error {
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	for key, values := range header {
+		request.Header[key] = values
+	}
+	if offset > 0 {
+		request.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	response, err := downloader.HTTPClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode == http.StatusPartialContent:
+		var start int64
+		_, err = fmt.Sscanf(response.Header.Get("Content-Range"), "bytes %d-", &start)
+		if err != nil || start != offset {
+			return restartDownload(file, "server resumed the download at an unexpected offset")
+		}
+	case response.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0:
+		var size int64
+		_, err = fmt.Sscanf(response.Header.Get("Content-Range"), "bytes */%d", &size)
+		if err == nil && size == offset {
+			return nil
+		}
+		return restartDownload(file, "server cannot resume the download")
+	case response.StatusCode >= 400:
+		rawBytes, readErr := io.ReadAll(response.Body)
+		if readErr != nil {
+			return readErr
+		}
+		return RawHTTPStatusError{
+			Status:      response.Status,
+			RawResponse: rawBytes,
+		}
+	default:
+		if offset > 0 {
+			if err = file.Truncate(0); err != nil {
+				return err
+			}
+			if _, err = file.Seek(0, io.SeekStart); err != nil {
+				return err
+			}
+			offset = 0
+		}
+	}
+
+	body := progress.track(response.Body, offset, response.ContentLength)
+	_, err = io.Copy(file, body)
+	return err
+}
+
+func verifyChecksum(file *os.File, digest hash.Hash, checksum Checksum) error {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := io.Copy(digest, file); err != nil {
+		return err
+	}
+
+	actual := hex.EncodeToString(digest.Sum(nil))
+	if !strings.EqualFold(actual, checksum.Value) {
+		return ChecksumMismatchError{
+			Algorithm: checksum.Algorithm,
+			Expected:  checksum.Value,
+			Actual:    actual,
+		}
+	}
+
+	return nil
+}
+
+func newDigest(algorithm string) (hash.Hash, error) {
+	switch strings.ToLower(algorithm) {
+	case "sha256":
+		return sha256.New(), nil
+	case "sha1":
+		return sha1.New(), nil
+	default:
+		return nil, UnsupportedChecksumAlgorithmError{Algorithm: algorithm}
+	}
+}
+
+// restartableError is returned when the partial file had to be discarded and
+// the download should start over.
+type restartableError struct {
+	reason string
+}
+
+func (e restartableError) Error() string {
+	return e.reason
+}
+
+func restartDownload(file *os.File, reason string) error {
+	if err := file.Truncate(0); err != nil {
+		return err
+	}
+	return restartableError{reason: reason}
+}
+
+// isRetryable reports whether the download should be attempted again: the
+// partial file had to be discarded, or the connection was reset or closed
+// before the whole file was received.
+func isRetryable(err error) bool {
+	if _, ok := err.(restartableError); ok {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// downloadProgress drives an optional progress bar across the requests of a
+// single download.
+type downloadProgress struct {
+	bar     ProgressBar
+	started bool
+}
+
+func (p *downloadProgress) track(body io.Reader, offset int64, contentLength int64) io.Reader {
+	if p.bar == nil {
+		return body
+	}
+
+	if contentLength >= 0 {
+		p.bar.SetTotal(int(offset + contentLength))
+	}
+	p.bar.Set(int(offset))
+	if !p.started {
+		p.bar.Start()
+		p.started = true
+	}
+
+	return p.bar.NewProxyReader(body)
+}
+
+func (p *downloadProgress) finish() {
+	if p.started {
+		p.bar.Finish()
+	}
+}
@@ -0,0 +1,311 @@
+package download_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "gopkg.in/cheggaaa/pb.v1"
+
+	. "code.cloudfoundry.org/cli/v9/util/download"
+	"code.cloudfoundry.org/cli/v9/util/download/downloadfakes"
+)
+
+// newFailingReader returns the first n bytes of content and then an error.
+func newFailingReader(content string, n int) io.ReadCloser {
+	return io.NopCloser(io.MultiReader(strings.NewReader(content[:n]), errorReader{}))
+}
+
+type errorReader struct{}
+
+func (errorReader) Read([]byte) (int, error) {
+	return 0, io.ErrUnexpectedEOF
+}
+
+var _ = Describe("DownloadToFile", func() {
+	const content = "some droplet contents"
+
+	var (
+		fakeHTTPClient  *downloadfakes.FakeHTTPClient
+		fakeProgressBar *downloadfakes.FakeProgressBar
+		fakeClock       *downloadfakes.FakeClock
+		downloader      *Downloader
+
+		destination string
+		checksum    Checksum
+		executeErr  error
+	)
+
+	// serve responds to requests like a server that supports range requests.
+	serve := func(request *http.Request) (*http.Response, error) {
+		var offset int
+		_, err := fmt.Sscanf(request.Header.Get("Range"), "bytes=%d-", &offset)
+		if err != nil {
+			return &http.Response{
+				StatusCode:    http.StatusOK,
+				ContentLength: int64(len(content)),
+				Body:          io.NopCloser(strings.NewReader(content)),
+			}, nil
+		}
+
+		if offset >= len(content) {
+			return &http.Response{
+				StatusCode: http.StatusRequestedRangeNotSatisfiable,
+				Header:     http.Header{"Content-Range": {fmt.Sprintf("bytes */%d", len(content))}},
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil
+		}
+
+		return &http.Response{
+			StatusCode:    http.StatusPartialContent,
+			ContentLength: int64(len(content) - offset),
+			Header:        http.Header{"Content-Range": {fmt.Sprintf("bytes %d-%d/%d", offset, len(content)-1, len(content))}},
+			Body:          io.NopCloser(strings.NewReader(content[offset:])),
+		}, nil
+	}
+
+	BeforeEach(func() {
+		fakeHTTPClient = new(downloadfakes.FakeHTTPClient)
+		fakeHTTPClient.DoStub = serve
+		fakeProgressBar = new(downloadfakes.FakeProgressBar)
+		fakeProgressBar.NewProxyReaderStub = func(r io.Reader) *pb.Reader {
+			return pb.New(0).NewProxyReader(r)
+		}
+		fakeClock = new(downloadfakes.FakeClock)
+		downloader = &Downloader{HTTPClient: fakeHTTPClient, Clock: fakeClock}
+
+		destination = filepath.Join(GinkgoT().TempDir(), "droplet.tgz")
+		sum := sha256.Sum256([]byte(content))
+		checksum = Checksum{Algorithm: "sha256", Value: hex.EncodeToString(sum[:])}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = downloader.DownloadToFile(
+			"https://some.url/droplet",
+			http.Header{"Authorization": {"bearer some-token"}},
+			destination,
+			checksum,
+			fakeProgressBar,
+		)
+	})
+
+	It("streams the file to the destination", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		raw, err := os.ReadFile(destination)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(raw)).To(Equal(content))
+		Expect(destination + PartialFileSuffix).ToNot(BeAnExistingFile())
+
+		Expect(fakeHTTPClient.DoCallCount()).To(Equal(1))
+		request := fakeHTTPClient.DoArgsForCall(0)
+		Expect(request.URL.String()).To(Equal("https://some.url/droplet"))
+		Expect(request.Header.Get("Authorization")).To(Equal("bearer some-token"))
+		Expect(request.Header.Get("Range")).To(BeEmpty())
+	})
+
+	It("shows the progress of the download", func() {
+		Expect(fakeProgressBar.SetTotalArgsForCall(0)).To(Equal(len(content)))
+		Expect(fakeProgressBar.StartCallCount()).To(Equal(1))
+		Expect(fakeProgressBar.FinishCallCount()).To(Equal(1))
+	})
+
+	When("a partial download exists", func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(destination+PartialFileSuffix, []byte(content[:5]), 0600)).To(Succeed())
+		})
+
+		It("resumes the download with a range request", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeHTTPClient.DoArgsForCall(0).Header.Get("Range")).To(Equal("bytes=5-"))
+			raw, err := os.ReadFile(destination)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(Equal(content))
+
+			Expect(fakeProgressBar.SetTotalArgsForCall(0)).To(Equal(len(content)))
+			Expect(fakeProgressBar.SetArgsForCall(0)).To(Equal(5))
+		})
+
+		When("the server ignores the range", func() {
+			BeforeEach(func() {
+				fakeHTTPClient.DoStub = func(request *http.Request) (*http.Response, error) {
+					request.Header.Del("Range")
+					return serve(request)
+				}
+			})
+
+			It("downloads the whole file again", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				raw, err := os.ReadFile(destination)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).To(Equal(content))
+			})
+		})
+	})
+
+	When("the partial download is already complete", func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(destination+PartialFileSuffix, []byte(content), 0600)).To(Succeed())
+		})
+
+		It("verifies and keeps it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			raw, err := os.ReadFile(destination)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(Equal(content))
+		})
+	})
+
+	When("the connection drops part way through", func() {
+		BeforeEach(func() {
+			fakeHTTPClient.DoStub = func(request *http.Request) (*http.Response, error) {
+				if fakeHTTPClient.DoCallCount() == 1 {
+					return &http.Response{
+						StatusCode:    http.StatusOK,
+						ContentLength: int64(len(content)),
+						Body:          newFailingReader(content, 8),
+					}, nil
+				}
+				return serve(request)
+			}
+		})
+
+		It("resumes from the bytes already received", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeHTTPClient.DoCallCount()).To(Equal(2))
+			Expect(fakeHTTPClient.DoArgsForCall(1).Header.Get("Range")).To(Equal("bytes=8-"))
+			raw, err := os.ReadFile(destination)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(Equal(content))
+		})
+
+		It("waits before resuming", func() {
+			Expect(fakeClock.SleepCallCount()).To(Equal(1))
+			Expect(fakeClock.SleepArgsForCall(0)).To(Equal(500 * time.Millisecond))
+		})
+	})
+
+	When("the connection keeps dropping part way through", func() {
+		BeforeEach(func() {
+			fakeHTTPClient.DoStub = func(request *http.Request) (*http.Response, error) {
+				if request.Header.Get("Range") != "" {
+					return nil, syscall.ECONNRESET
+				}
+				return &http.Response{
+					StatusCode:    http.StatusOK,
+					ContentLength: int64(len(content)),
+					Body:          newFailingReader(content, 8),
+				}, nil
+			}
+		})
+
+		It("gives up with an interrupted error and keeps the partial file", func() {
+			Expect(executeErr).To(MatchError(InterruptedError{BytesReceived: 8, Err: syscall.ECONNRESET}))
+			Expect(fakeHTTPClient.DoCallCount()).To(Equal(5))
+			Expect(destination + PartialFileSuffix).To(BeAnExistingFile())
+			Expect(destination).ToNot(BeAnExistingFile())
+		})
+
+		It("backs off exponentially between the attempts", func() {
+			Expect(fakeClock.SleepCallCount()).To(Equal(4))
+			Expect(fakeClock.SleepArgsForCall(0)).To(Equal(500 * time.Millisecond))
+			Expect(fakeClock.SleepArgsForCall(1)).To(Equal(time.Second))
+			Expect(fakeClock.SleepArgsForCall(2)).To(Equal(2 * time.Second))
+			Expect(fakeClock.SleepArgsForCall(3)).To(Equal(4 * time.Second))
+		})
+	})
+
+	When("the connection keeps being reset before any bytes are received", func() {
+		BeforeEach(func() {
+			fakeHTTPClient.DoStub = nil
+			fakeHTTPClient.DoReturns(nil, syscall.ECONNRESET)
+		})
+
+		It("gives up with a connection error", func() {
+			Expect(executeErr).To(MatchError(ConnectionError{URL: "https://some.url/droplet", Err: syscall.ECONNRESET}))
+			Expect(fakeHTTPClient.DoCallCount()).To(Equal(5))
+		})
+	})
+
+	When("the request fails for another reason", func() {
+		BeforeEach(func() {
+			fakeHTTPClient.DoStub = nil
+			fakeHTTPClient.DoReturns(nil, errors.New("no route to host"))
+		})
+
+		It("returns the error without retrying", func() {
+			Expect(executeErr).To(MatchError("no route to host"))
+			Expect(fakeHTTPClient.DoCallCount()).To(Equal(1))
+			Expect(fakeClock.SleepCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the server responds with an error", func() {
+		BeforeEach(func() {
+			fakeHTTPClient.DoStub = nil
+			fakeHTTPClient.DoReturns(&http.Response{
+				StatusCode: http.StatusForbidden,
+				Status:     "403 Forbidden",
+				Body:       io.NopCloser(strings.NewReader("signature expired")),
+			}, nil)
+		})
+
+		It("returns the error without retrying", func() {
+			Expect(executeErr).To(MatchError(RawHTTPStatusError{Status: "403 Forbidden", RawResponse: []byte("signature expired")}))
+			Expect(fakeHTTPClient.DoCallCount()).To(Equal(1))
+		})
+	})
+
+	When("the file does not match the checksum", func() {
+		BeforeEach(func() {
+			checksum.Value = strings.Repeat("0", 64)
+		})
+
+		It("returns a checksum mismatch error and removes the file", func() {
+			sum := sha256.Sum256([]byte(content))
+			Expect(executeErr).To(MatchError(ChecksumMismatchError{
+				Algorithm: "sha256",
+				Expected:  checksum.Value,
+				Actual:    hex.EncodeToString(sum[:]),
+			}))
+			Expect(destination + PartialFileSuffix).ToNot(BeAnExistingFile())
+			Expect(destination).ToNot(BeAnExistingFile())
+		})
+	})
+
+	When("the checksum algorithm is not supported", func() {
+		BeforeEach(func() {
+			checksum.Algorithm = "md5"
+		})
+
+		It("returns an error before downloading", func() {
+			Expect(executeErr).To(MatchError(UnsupportedChecksumAlgorithmError{Algorithm: "md5"}))
+			Expect(fakeHTTPClient.DoCallCount()).To(Equal(0))
+		})
+	})
+
+	When("no checksum is given", func() {
+		BeforeEach(func() {
+			checksum = Checksum{}
+		})
+
+		It("downloads the file without verifying it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(destination).To(BeAnExistingFile())
+		})
+	})
+})
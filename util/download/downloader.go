@@ -13,6 +13,10 @@ import (
 
 type Downloader struct {
 	HTTPClient HTTPClient
+
+	// Clock waits between the attempts of an interrupted download. The real
+	// clock is used when it is nil.
+	Clock Clock
 }
 
 func NewDownloader(dialTimeout time.Duration) *Downloader {
@@ -25,6 +25,17 @@ type FakeProgressBar struct {
 	newProxyReaderReturnsOnCall map[int]struct {
 		result1 *pb.Reader
 	}
+	SetStub        func(int) *pb.ProgressBar
+	setMutex       sync.RWMutex
+	setArgsForCall []struct {
+		arg1 int
+	}
+	setReturns struct {
+		result1 *pb.ProgressBar
+	}
+	setReturnsOnCall map[int]struct {
+		result1 *pb.ProgressBar
+	}
 	SetTotalStub        func(int) *pb.ProgressBar
 	setTotalMutex       sync.RWMutex
 	setTotalArgsForCall []struct {
@@ -135,6 +146,67 @@ func (fake *FakeProgressBar) NewProxyReaderReturnsOnCall(i int, result1 *pb.Read
 	}{result1}
 }
 
+func (fake *FakeProgressBar) Set(arg1 int) *pb.ProgressBar {
+	fake.setMutex.Lock()
+	ret, specificReturn := fake.setReturnsOnCall[len(fake.setArgsForCall)]
+	fake.setArgsForCall = append(fake.setArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.SetStub
+	fakeReturns := fake.setReturns
+	fake.recordInvocation("Set", []interface{}{arg1})
+	fake.setMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProgressBar) SetCallCount() int {
+	fake.setMutex.RLock()
+	defer fake.setMutex.RUnlock()
+	return len(fake.setArgsForCall)
+}
+
+func (fake *FakeProgressBar) SetCalls(stub func(int) *pb.ProgressBar) {
+	fake.setMutex.Lock()
+	defer fake.setMutex.Unlock()
+	fake.SetStub = stub
+}
+
+func (fake *FakeProgressBar) SetArgsForCall(i int) int {
+	fake.setMutex.RLock()
+	defer fake.setMutex.RUnlock()
+	argsForCall := fake.setArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeProgressBar) SetReturns(result1 *pb.ProgressBar) {
+	fake.setMutex.Lock()
+	defer fake.setMutex.Unlock()
+	fake.SetStub = nil
+	fake.setReturns = struct {
+		result1 *pb.ProgressBar
+	}{result1}
+}
+
+func (fake *FakeProgressBar) SetReturnsOnCall(i int, result1 *pb.ProgressBar) {
+	fake.setMutex.Lock()
+	defer fake.setMutex.Unlock()
+	fake.SetStub = nil
+	if fake.setReturnsOnCall == nil {
+		fake.setReturnsOnCall = make(map[int]struct {
+			result1 *pb.ProgressBar
+		})
+	}
+	fake.setReturnsOnCall[i] = struct {
+		result1 *pb.ProgressBar
+	}{result1}
+}
+
 func (fake *FakeProgressBar) SetTotal(arg1 int) *pb.ProgressBar {
 	fake.setTotalMutex.Lock()
 	ret, specificReturn := fake.setTotalReturnsOnCall[len(fake.setTotalArgsForCall)]
@@ -252,14 +324,6 @@ func (fake *FakeProgressBar) StartReturnsOnCall(i int, result1 *pb.ProgressBar)
 func (fake *FakeProgressBar) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.finishMutex.RLock()
-	defer fake.finishMutex.RUnlock()
-	fake.newProxyReaderMutex.RLock()
-	defer fake.newProxyReaderMutex.RUnlock()
-	fake.setTotalMutex.RLock()
-	defer fake.setTotalMutex.RUnlock()
-	fake.startMutex.RLock()
-	defer fake.startMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
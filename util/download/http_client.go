@@ -5,5 +5,6 @@ import "net/http"
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . HTTPClient
 
 type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
 	Get(url string) (resp *http.Response, err error)
 }
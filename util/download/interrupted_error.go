@@ -0,0 +1,19 @@
+package download
+
+import "fmt"
+
+// InterruptedError is returned when a download keeps failing part way
+// through. The bytes received so far are kept next to the destination so a
+// later download of the same file resumes where this one stopped.
+type InterruptedError struct {
+	BytesReceived int64
+	Err           error
+}
+
+func (e InterruptedError) Error() string {
+	return fmt.Sprintf("download interrupted after %d bytes: %s", e.BytesReceived, e.Err)
+}
+
+func (e InterruptedError) Unwrap() error {
+	return e.Err
+}
@@ -11,6 +11,7 @@ import (
 type ProgressBar interface {
 	Finish()
 	NewProxyReader(r io.Reader) *pb.Reader
+	Set(current int) *pb.ProgressBar
 	SetTotal(total int) *pb.ProgressBar
 	Start() *pb.ProgressBar
 }
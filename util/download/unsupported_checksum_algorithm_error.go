@@ -0,0 +1,13 @@
+package download
+
+import "fmt"
+
+// UnsupportedChecksumAlgorithmError is returned when a file cannot be verified
+// because its checksum uses an unknown algorithm.
+type UnsupportedChecksumAlgorithmError struct {
+	Algorithm string
+}
+
+func (e UnsupportedChecksumAlgorithmError) Error() string {
+	return fmt.Sprintf("unsupported checksum algorithm: %s", e.Algorithm)
+}
@@ -40,6 +40,37 @@ func (application Application) HasBuildpacks() bool {
 	return ok
 }
 
+// RouteNames returns the routes listed for the application.
+func (application Application) RouteNames() []string {
+	routes, _ := application.RemainingManifestFields["routes"].([]interface{})
+
+	var names []string
+	for _, route := range routes {
+		if name, ok := mapValue(route, "route").(string); ok && name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// ServiceInstanceNames returns the names of the service instances that the
+// application is bound to.
+func (application Application) ServiceInstanceNames() []string {
+	services, _ := application.RemainingManifestFields["services"].([]interface{})
+
+	var names []string
+	for _, service := range services {
+		name, ok := service.(string)
+		if !ok {
+			name, _ = mapValue(service, "name").(string)
+		}
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 func (application *Application) SetBuildpacks(buildpacks []string) {
 	if application.RemainingManifestFields == nil {
 		application.RemainingManifestFields = map[string]interface{}{}
@@ -95,3 +126,14 @@ func (application *Application) UnmarshalYAML(unmarshal func(v interface{}) erro
 
 	return nil
 }
+
+func mapValue(value interface{}, key string) interface{} {
+	switch typedValue := value.(type) {
+	case map[interface{}]interface{}:
+		return typedValue[key]
+	case map[string]interface{}:
+		return typedValue[key]
+	default:
+		return nil
+	}
+}
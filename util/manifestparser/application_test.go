@@ -534,4 +534,39 @@ log-rate-limit-per-second: 5K
 			})
 		})
 	})
+
+	Describe("RouteNames and ServiceInstanceNames", func() {
+		var app Application
+
+		BeforeEach(func() {
+			err := yaml.Unmarshal([]byte(`
+name: some-app
+routes:
+- route: some-app.example.com
+- route: shared.example.com/path
+services:
+- some-service
+- name: other-service
+  parameters:
+    foo: bar
+`), &app)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the routes and service instances of the app", func() {
+			Expect(app.RouteNames()).To(Equal([]string{"some-app.example.com", "shared.example.com/path"}))
+			Expect(app.ServiceInstanceNames()).To(Equal([]string{"some-service", "other-service"}))
+		})
+
+		When("the app has no routes or services", func() {
+			BeforeEach(func() {
+				app = Application{}
+			})
+
+			It("returns nothing", func() {
+				Expect(app.RouteNames()).To(BeEmpty())
+				Expect(app.ServiceInstanceNames()).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -0,0 +1,30 @@
+package manifestparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InvalidOpsFileError is returned when an ops file is not a valid list of
+// operations.
+type InvalidOpsFileError struct {
+	Path string
+	Err  error
+}
+
+func (e InvalidOpsFileError) Error() string {
+	return fmt.Sprintf("The option --ops-file expects a valid YAML file of operations. Ops file %s: %s", e.Path, strings.Replace(e.Err.Error(), "\n", " ", -1))
+}
+
+// OpsFileOperationError is returned when an operation of an ops file cannot
+// be applied to the manifest. Index starts at 1.
+type OpsFileOperationError struct {
+	Path      string
+	Index     int
+	Operation string
+	Err       error
+}
+
+func (e OpsFileOperationError) Error() string {
+	return fmt.Sprintf("Unable to apply operation #%d (%s) from ops file %s: %s", e.Index, e.Operation, e.Path, e.Err)
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/cppforlife/go-patch/patch"
 	"gopkg.in/yaml.v2"
 )
 
@@ -18,7 +19,7 @@ type ManifestParser struct{}
 // For manifests with multiple applications, appName will filter the
 // applications and leave only a single application in the resulting parsed
 // manifest structure.
-func (m ManifestParser) InterpolateManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]byte, error) {
+func (m ManifestParser) InterpolateManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV, pathsToOpsFiles []string) ([]byte, error) {
 	rawManifest, err := os.ReadFile(pathToManifest)
 	if err != nil {
 		return nil, err
@@ -49,14 +50,75 @@ func (m ManifestParser) InterpolateManifest(pathToManifest string, pathsToVarsFi
 		fileVars[kv.Name] = kv.Value
 	}
 
-	rawManifest, err = tpl.Evaluate(fileVars, nil, template.EvaluateOpts{ExpectAllKeys: true})
+	ops, err := readOpsFiles(pathsToOpsFiles)
 	if err != nil {
+		return nil, err
+	}
+
+	rawManifest, err = tpl.Evaluate(fileVars, nil, template.EvaluateOpts{ExpectAllKeys: true, PostVarSubstitutionOp: ops})
+	if err != nil {
+		if _, ok := err.(OpsFileOperationError); ok {
+			return nil, err
+		}
 		return nil, InterpolationError{Err: err}
 	}
 
 	return rawManifest, nil
 }
 
+// readOpsFiles returns the operations of the ops files in order. They are
+// applied to the manifest after its variables have been interpolated.
+func readOpsFiles(pathsToOpsFiles []string) (patch.Op, error) {
+	if len(pathsToOpsFiles) == 0 {
+		return nil, nil
+	}
+
+	var ops patch.Ops
+	for _, path := range pathsToOpsFiles {
+		rawOpsFile, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var opDefs []patch.OpDefinition
+		err = yaml.Unmarshal(rawOpsFile, &opDefs)
+		if err != nil {
+			return nil, InvalidOpsFileError{Path: path, Err: err}
+		}
+
+		fileOps, err := patch.NewOpsFromDefinitions(opDefs)
+		if err != nil {
+			return nil, InvalidOpsFileError{Path: path, Err: err}
+		}
+
+		for i, op := range fileOps {
+			operation := opDefs[i].Type
+			if opDefs[i].Path != nil {
+				operation += " " + *opDefs[i].Path
+			}
+			ops = append(ops, opsFileOp{op: op, path: path, index: i + 1, operation: operation})
+		}
+	}
+
+	return ops, nil
+}
+
+// opsFileOp is an operation that reports which ops file and operation failed.
+type opsFileOp struct {
+	op        patch.Op
+	path      string
+	index     int
+	operation string
+}
+
+func (o opsFileOp) Apply(doc interface{}) (interface{}, error) {
+	doc, err := o.op.Apply(doc)
+	if err != nil {
+		return nil, OpsFileOperationError{Path: o.path, Index: o.index, Operation: o.operation, Err: err}
+	}
+	return doc, nil
+}
+
 func (m ManifestParser) ParseManifest(pathToManifest string, rawManifest []byte) (Manifest, error) {
 	var parsedManifest Manifest
 	err := yaml.Unmarshal(rawManifest, &parsedManifest)
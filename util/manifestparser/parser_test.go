@@ -2,6 +2,7 @@ package manifestparser_test
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 
@@ -21,6 +22,7 @@ var _ = Describe("ManifestParser", func() {
 			pathToManifest   string
 			pathsToVarsFiles []string
 			vars             []template.VarKV
+			pathsToOpsFiles  []string
 
 			interpolatedManifest []byte
 			executeErr           error
@@ -34,6 +36,7 @@ var _ = Describe("ManifestParser", func() {
 			vars = nil
 
 			pathsToVarsFiles = nil
+			pathsToOpsFiles = nil
 		})
 
 		AfterEach(func() {
@@ -44,7 +47,7 @@ var _ = Describe("ManifestParser", func() {
 		})
 
 		JustBeforeEach(func() {
-			interpolatedManifest, executeErr = parser.InterpolateManifest(pathToManifest, pathsToVarsFiles, vars)
+			interpolatedManifest, executeErr = parser.InterpolateManifest(pathToManifest, pathsToVarsFiles, vars, pathsToOpsFiles)
 		})
 
 		When("the manifest does *not* need interpolation", func() {
@@ -226,6 +229,101 @@ applications:
 				})
 			})
 		})
+
+		When("ops files are provided", func() {
+			writeOpsFile := func(contents string) string {
+				path := filepath.Join(GinkgoT().TempDir(), "ops.yml")
+				Expect(os.WriteFile(path, []byte(contents), 0666)).To(Succeed())
+				return path
+			}
+
+			BeforeEach(func() {
+				givenManifest = []byte(`---
+applications:
+- name: ((app_name))
+  instances: 1
+`)
+				Expect(os.WriteFile(pathToManifest, givenManifest, 0666)).To(Succeed())
+				vars = []template.VarKV{{Name: "app_name", Value: "spark"}}
+			})
+
+			When("the operations apply", func() {
+				BeforeEach(func() {
+					pathsToOpsFiles = []string{
+						writeOpsFile(`
+- type: replace
+  path: /applications/name=spark/instances
+  value: 2
+- type: replace
+  path: /applications/name=spark/memory?
+  value: 256M
+`),
+						writeOpsFile(`
+- type: replace
+  path: /applications/name=spark/instances
+  value: 3
+`),
+					}
+				})
+
+				It("applies them in order after interpolating the variables", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(string(interpolatedManifest)).To(Equal(`applications:
+- instances: 3
+  memory: 256M
+  name: spark
+`))
+				})
+			})
+
+			When("an operation cannot be applied", func() {
+				var opsFile string
+
+				BeforeEach(func() {
+					opsFile = writeOpsFile(`
+- type: replace
+  path: /applications/name=spark/instances
+  value: 2
+- type: remove
+  path: /applications/name=flame
+`)
+					pathsToOpsFiles = []string{opsFile}
+				})
+
+				It("returns an error naming the ops file and operation", func() {
+					Expect(executeErr).To(BeAssignableToTypeOf(OpsFileOperationError{}))
+					Expect(executeErr.Error()).To(HavePrefix(fmt.Sprintf("Unable to apply operation #2 (remove /applications/name=flame) from ops file %s: ", opsFile)))
+				})
+			})
+
+			When("the ops file is not a list of operations", func() {
+				var opsFile string
+
+				BeforeEach(func() {
+					opsFile = writeOpsFile(`
+- type: upsert
+  path: /applications
+`)
+					pathsToOpsFiles = []string{opsFile}
+				})
+
+				It("returns an invalid ops file error", func() {
+					Expect(executeErr).To(BeAssignableToTypeOf(InvalidOpsFileError{}))
+					Expect(executeErr.Error()).To(ContainSubstring(opsFile))
+					Expect(executeErr.Error()).To(ContainSubstring("Unknown operation [0] with type 'upsert'"))
+				})
+			})
+
+			When("the ops file does not exist", func() {
+				BeforeEach(func() {
+					pathsToOpsFiles = []string{"/does/not/exist.yml"}
+				})
+
+				It("returns an error", func() {
+					Expect(os.IsNotExist(executeErr)).To(BeTrue())
+				})
+			})
+		})
 	})
 
 	Describe("ParseManifest", func() {
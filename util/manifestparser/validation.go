@@ -0,0 +1,403 @@
+package manifestparser
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"code.cloudfoundry.org/cli/v9/api/cloudcontroller/ccv3/constant"
+	yaml "go.yaml.in/yaml/v3"
+)
+
+type fieldType int
+
+const (
+	// scalarField accepts any scalar; YAML numbers and booleans end up as
+	// strings on the Cloud Controller anyway.
+	scalarField fieldType = iota
+	intField
+	boolField
+	scalarListField
+	scalarMapField
+	mapField
+	objectField
+	objectListField
+	serviceListField
+)
+
+type fieldSchema struct {
+	Type   fieldType
+	Enum   []string
+	Object *objectSchema
+}
+
+type objectSchema struct {
+	Fields   map[string]fieldSchema
+	Required []string
+	// AllowUnknownFields accepts fields that are not in Fields, e.g. top
+	// level fields that only hold YAML anchors.
+	AllowUnknownFields bool
+}
+
+var (
+	healthCheckTypes = []string{
+		string(constant.Port),
+		string(constant.Process),
+		string(constant.HTTP),
+	}
+
+	processFields = map[string]fieldSchema{
+		"command":                                   {Type: scalarField},
+		"disk_quota":                                {Type: scalarField},
+		"disk-quota":                                {Type: scalarField},
+		"health-check-http-endpoint":                {Type: scalarField},
+		"health-check-interval":                     {Type: intField},
+		"health-check-invocation-timeout":           {Type: intField},
+		"health-check-type":                         {Type: scalarField, Enum: healthCheckTypes},
+		"instances":                                 {Type: intField},
+		"log-rate-limit-per-second":                 {Type: scalarField},
+		"memory":                                    {Type: scalarField},
+		"readiness-health-check-http-endpoint":      {Type: scalarField},
+		"readiness-health-check-interval":           {Type: intField},
+		"readiness-health-check-invocation-timeout": {Type: intField},
+		"readiness-health-check-type":               {Type: scalarField, Enum: healthCheckTypes},
+		"timeout":                                   {Type: intField},
+		"user":                                      {Type: scalarField},
+	}
+
+	processSchema = objectSchema{
+		Fields:   withFields(processFields, map[string]fieldSchema{"type": {Type: scalarField}}),
+		Required: []string{"type"},
+	}
+
+	routeSchema = objectSchema{
+		Fields: map[string]fieldSchema{
+			"route":    {Type: scalarField},
+			"protocol": {Type: scalarField, Enum: []string{"http1", "http2", "tcp"}},
+			"options": {Type: objectField, Object: &objectSchema{
+				Fields: map[string]fieldSchema{
+					"loadbalancing": {Type: scalarField, Enum: []string{"round-robin", "least-connection", "hash"}},
+					"hash_header":   {Type: scalarField},
+					"hash_balance":  {Type: scalarField},
+				},
+			}},
+		},
+		Required: []string{"route"},
+	}
+
+	serviceSchema = objectSchema{
+		Fields: map[string]fieldSchema{
+			"name":         {Type: scalarField},
+			"binding_name": {Type: scalarField},
+			"parameters":   {Type: mapField},
+		},
+		Required: []string{"name"},
+	}
+
+	sidecarSchema = objectSchema{
+		Fields: map[string]fieldSchema{
+			"name":          {Type: scalarField},
+			"command":       {Type: scalarField},
+			"memory":        {Type: scalarField},
+			"process_types": {Type: scalarListField},
+		},
+		Required: []string{"name", "command", "process_types"},
+	}
+
+	applicationSchema = objectSchema{
+		Fields: withFields(processFields, map[string]fieldSchema{
+			"name":            {Type: scalarField},
+			"buildpack":       {Type: scalarField},
+			"buildpacks":      {Type: scalarListField},
+			"cnb-credentials": {Type: mapField},
+			"default-route":   {Type: boolField},
+			"docker": {Type: objectField, Object: &objectSchema{
+				Fields: map[string]fieldSchema{
+					"image":    {Type: scalarField},
+					"username": {Type: scalarField},
+				},
+				Required: []string{"image"},
+			}},
+			"env":      {Type: mapField},
+			"features": {Type: scalarMapField},
+			"lifecycle": {Type: scalarField, Enum: []string{
+				string(constant.AppLifecycleTypeBuildpack),
+				string(constant.AppLifecycleTypeDocker),
+				string(constant.AppLifecycleTypeCNB),
+			}},
+			"metadata": {Type: objectField, Object: &objectSchema{
+				Fields: map[string]fieldSchema{
+					"labels":      {Type: scalarMapField},
+					"annotations": {Type: scalarMapField},
+				},
+			}},
+			"no-route":     {Type: boolField},
+			"path":         {Type: scalarField},
+			"processes":    {Type: objectListField, Object: &processSchema},
+			"random-route": {Type: boolField},
+			"routes":       {Type: objectListField, Object: &routeSchema},
+			"services":     {Type: serviceListField, Object: &serviceSchema},
+			"sidecars":     {Type: objectListField, Object: &sidecarSchema},
+			"stack":        {Type: scalarField},
+		}),
+		Required: []string{"name"},
+	}
+
+	manifestSchema = objectSchema{
+		Fields: map[string]fieldSchema{
+			"applications": {Type: objectListField, Object: &applicationSchema},
+			"version":      {Type: intField},
+		},
+		AllowUnknownFields: true,
+	}
+
+	// variablePattern matches values that are replaced as a whole when the
+	// manifest is interpolated, so their type is not known beforehand.
+	variablePattern = regexp.MustCompile(`^\(\((!?[-/\.\w\pL]+)\)\)$`)
+)
+
+func withFields(base map[string]fieldSchema, extra map[string]fieldSchema) map[string]fieldSchema {
+	fields := map[string]fieldSchema{}
+	for name, field := range base {
+		fields[name] = field
+	}
+	for name, field := range extra {
+		fields[name] = field
+	}
+	return fields
+}
+
+// ValidateManifest checks the manifest at pathToManifest against the schema
+// of app manifests without contacting the Cloud Controller. Unknown fields,
+// values of the wrong type and values outside of the allowed set are returned
+// as a ManifestValidationError, in the order they appear in the file.
+// Variables are not interpolated: a value that is a single variable is
+// accepted for any field.
+func (m ManifestParser) ValidateManifest(pathToManifest string) error {
+	rawManifest, err := os.ReadFile(pathToManifest)
+	if err != nil {
+		return err
+	}
+
+	return validateRawManifest(pathToManifest, rawManifest, true)
+}
+
+// ValidateInterpolatedManifest checks rawManifest, the manifest at
+// pathToManifest after its variables have been interpolated and its ops files
+// applied, like ValidateManifest. The lines and columns of rawManifest do not
+// match the file, so the errors only name the field.
+func (m ManifestParser) ValidateInterpolatedManifest(pathToManifest string, rawManifest []byte) error {
+	return validateRawManifest(pathToManifest, rawManifest, false)
+}
+
+func validateRawManifest(pathToManifest string, rawManifest []byte, withPositions bool) error {
+	var document yaml.Node
+	err := yaml.Unmarshal(rawManifest, &document)
+	if err != nil {
+		return InvalidManifestYAMLError{Path: pathToManifest, Err: err}
+	}
+
+	if len(document.Content) == 0 {
+		return nil
+	}
+
+	v := validator{withPositions: withPositions}
+	v.validateObject(document.Content[0], "", manifestSchema)
+	if len(v.errs) > 0 {
+		return ManifestValidationError{Path: pathToManifest, Errors: v.errs}
+	}
+	return nil
+}
+
+type validator struct {
+	withPositions bool
+	errs          []ManifestFieldError
+}
+
+func (v *validator) addError(node *yaml.Node, field string, message string, args ...interface{}) {
+	fieldErr := ManifestFieldError{
+		Field:   field,
+		Message: fmt.Sprintf(message, args...),
+	}
+	if v.withPositions {
+		fieldErr.Line = node.Line
+		fieldErr.Column = node.Column
+	}
+	v.errs = append(v.errs, fieldErr)
+}
+
+func (v *validator) validateObject(node *yaml.Node, field string, schema objectSchema) {
+	node = resolveAlias(node)
+	if isNullOrVariable(node) {
+		return
+	}
+	if node.Kind != yaml.MappingNode {
+		v.addTypeError(node, field, "a map")
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, pair := range mappingPairs(node) {
+		key, value := pair[0], pair[1]
+		if seen[key.Value] {
+			continue
+		}
+		seen[key.Value] = true
+
+		fieldSchema, ok := schema.Fields[key.Value]
+		if !ok {
+			if !schema.AllowUnknownFields {
+				v.addError(key, field, "unknown field '%s'", key.Value)
+			}
+			continue
+		}
+		v.validateField(value, joinField(field, key.Value), fieldSchema)
+	}
+
+	for _, name := range schema.Required {
+		if !seen[name] {
+			v.addError(node, field, "missing required field '%s'", name)
+		}
+	}
+}
+
+func (v *validator) validateField(node *yaml.Node, field string, schema fieldSchema) {
+	node = resolveAlias(node)
+	if isNullOrVariable(node) {
+		return
+	}
+
+	switch schema.Type {
+	case scalarField:
+		if node.Kind != yaml.ScalarNode {
+			v.addTypeError(node, field, "a string")
+			return
+		}
+		if len(schema.Enum) > 0 && !contains(schema.Enum, node.Value) {
+			v.addError(node, field, "invalid value '%s', must be one of: %s", node.Value, strings.Join(schema.Enum, ", "))
+		}
+	case intField:
+		if node.Kind != yaml.ScalarNode || node.ShortTag() != "!!int" {
+			v.addTypeError(node, field, "an integer")
+		}
+	case boolField:
+		if node.Kind != yaml.ScalarNode || node.ShortTag() != "!!bool" {
+			v.addTypeError(node, field, "a boolean")
+		}
+	case scalarListField:
+		if node.Kind != yaml.SequenceNode {
+			v.addTypeError(node, field, "a list")
+			return
+		}
+		for i, item := range node.Content {
+			v.validateField(item, indexField(field, i), fieldSchema{Type: scalarField})
+		}
+	case scalarMapField:
+		if node.Kind != yaml.MappingNode {
+			v.addTypeError(node, field, "a map")
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			v.validateField(node.Content[i+1], joinField(field, node.Content[i].Value), fieldSchema{Type: scalarField})
+		}
+	case mapField:
+		if node.Kind != yaml.MappingNode {
+			v.addTypeError(node, field, "a map")
+		}
+	case objectField:
+		v.validateObject(node, field, *schema.Object)
+	case objectListField, serviceListField:
+		if node.Kind != yaml.SequenceNode {
+			v.addTypeError(node, field, "a list")
+			return
+		}
+		for i, item := range node.Content {
+			item = resolveAlias(item)
+			// services can be given by name only
+			if schema.Type == serviceListField && item.Kind == yaml.ScalarNode {
+				continue
+			}
+			v.validateObject(item, indexField(field, i), *schema.Object)
+		}
+	}
+}
+
+func (v *validator) addTypeError(node *yaml.Node, field string, expected string) {
+	v.addError(node, field, "expected %s, got %s", expected, describeNode(node))
+}
+
+func describeNode(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "a map"
+	case yaml.SequenceNode:
+		return "a list"
+	default:
+		return fmt.Sprintf("'%s'", node.Value)
+	}
+}
+
+// mappingPairs returns the keys and values of a mapping node with the fields
+// of merge keys ('<<: *defaults') in place of the merge key. Fields that are
+// set both directly and through a merge key are returned more than once; the
+// first one wins.
+func mappingPairs(node *yaml.Node) [][2]*yaml.Node {
+	var pairs, mergedPairs [][2]*yaml.Node
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if key.ShortTag() != "!!merge" {
+			pairs = append(pairs, [2]*yaml.Node{key, value})
+			continue
+		}
+
+		value = resolveAlias(value)
+		merged := []*yaml.Node{value}
+		if value.Kind == yaml.SequenceNode {
+			merged = value.Content
+		}
+		for _, mergedNode := range merged {
+			mergedNode = resolveAlias(mergedNode)
+			if mergedNode.Kind == yaml.MappingNode {
+				mergedPairs = append(mergedPairs, mappingPairs(mergedNode)...)
+			}
+		}
+	}
+	return append(pairs, mergedPairs...)
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+	return node
+}
+
+// isNullOrVariable returns true for values that cannot be checked: empty
+// values, which unset a field, and variables.
+func isNullOrVariable(node *yaml.Node) bool {
+	if node.Kind != yaml.ScalarNode {
+		return false
+	}
+	return node.ShortTag() == "!!null" || variablePattern.MatchString(node.Value)
+}
+
+func joinField(parent string, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+func indexField(parent string, index int) string {
+	return fmt.Sprintf("%s[%d]", parent, index)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
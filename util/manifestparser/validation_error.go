@@ -0,0 +1,55 @@
+package manifestparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ManifestFieldError is a problem with a single field of a manifest. Line and
+// Column point at the offending key or value in the manifest file and start
+// at 1; they are 0 when the position in the file is not known.
+type ManifestFieldError struct {
+	Line    int
+	Column  int
+	Field   string
+	Message string
+}
+
+func (e ManifestFieldError) Error() string {
+	if e.Line == 0 {
+		if e.Field == "" {
+			return e.Message
+		}
+		return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	if e.Field == "" {
+		return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+	}
+	return fmt.Sprintf("line %d, column %d: %s: %s", e.Line, e.Column, e.Field, e.Message)
+}
+
+// ManifestValidationError is returned when a manifest does not match the
+// schema of app manifests.
+type ManifestValidationError struct {
+	Path   string
+	Errors []ManifestFieldError
+}
+
+func (e ManifestValidationError) Error() string {
+	lines := []string{fmt.Sprintf("Manifest %s is invalid:", e.Path)}
+	for _, fieldErr := range e.Errors {
+		lines = append(lines, "  "+fieldErr.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// InvalidManifestYAMLError is returned when a manifest cannot be validated
+// because it is not valid YAML.
+type InvalidManifestYAMLError struct {
+	Path string
+	Err  error
+}
+
+func (e InvalidManifestYAMLError) Error() string {
+	return fmt.Sprintf("Manifest %s is not valid YAML: %s", e.Path, e.Err)
+}
@@ -0,0 +1,215 @@
+package manifestparser_test
+
+import (
+	"os"
+
+	. "code.cloudfoundry.org/cli/v9/util/manifestparser"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateManifest", func() {
+	var (
+		parser         ManifestParser
+		givenManifest  string
+		pathToManifest string
+		executeErr     error
+	)
+
+	BeforeEach(func() {
+		tempFile, err := os.CreateTemp("", "manifest-validation-test-")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tempFile.Close()).ToNot(HaveOccurred())
+		pathToManifest = tempFile.Name()
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(pathToManifest)).ToNot(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		Expect(os.WriteFile(pathToManifest, []byte(givenManifest), 0666)).To(Succeed())
+		executeErr = parser.ValidateManifest(pathToManifest)
+	})
+
+	When("the manifest matches the schema", func() {
+		BeforeEach(func() {
+			givenManifest = `---
+version: 1
+applications:
+- name: some-app
+  buildpacks: [ruby_buildpack]
+  cnb-credentials:
+    registry.example.com:
+      username: some-user
+  disk_quota: 1G
+  memory: 256M
+  instances: 2
+  no-route: false
+  health-check-type: http
+  health-check-http-endpoint: /health
+  lifecycle: buildpack
+  env:
+    SOME_FLAG: true
+    SOME_NUMBER: 5
+  metadata:
+    labels:
+      tier: web
+  routes:
+  - route: example.com
+    protocol: http2
+    options:
+      loadbalancing: least-connection
+  services:
+  - some-db
+  - name: some-queue
+    parameters:
+      size: small
+  processes:
+  - type: worker
+    command: ./worker
+    instances: ((worker_instances))
+  sidecars:
+  - name: some-sidecar
+    command: ./sidecar
+    process_types: [web]
+  stack: ~
+`
+		})
+
+		It("does not return an error", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+		})
+	})
+
+	When("the manifest is empty", func() {
+		BeforeEach(func() {
+			givenManifest = ""
+		})
+
+		It("does not return an error", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+		})
+	})
+
+	When("the manifest does not match the schema", func() {
+		BeforeEach(func() {
+			givenManifest = `---
+applications:
+- name: some-app
+  helth-check-type: http
+  instances: two
+  no-route: "yes"
+  health-check-type: tcp
+  routes: example.com
+  processes:
+  - command: ./worker
+    lifecycle: docker
+  services:
+  - binding_name: db
+`
+		})
+
+		It("returns every problem with its line and column in file order", func() {
+			Expect(executeErr).To(Equal(ManifestValidationError{
+				Path: pathToManifest,
+				Errors: []ManifestFieldError{
+					{Line: 4, Column: 3, Field: "applications[0]", Message: "unknown field 'helth-check-type'"},
+					{Line: 5, Column: 14, Field: "applications[0].instances", Message: "expected an integer, got 'two'"},
+					{Line: 6, Column: 13, Field: "applications[0].no-route", Message: "expected a boolean, got 'yes'"},
+					{Line: 7, Column: 22, Field: "applications[0].health-check-type", Message: "invalid value 'tcp', must be one of: port, process, http"},
+					{Line: 8, Column: 11, Field: "applications[0].routes", Message: "expected a list, got 'example.com'"},
+					{Line: 11, Column: 5, Field: "applications[0].processes[0]", Message: "unknown field 'lifecycle'"},
+					{Line: 10, Column: 5, Field: "applications[0].processes[0]", Message: "missing required field 'type'"},
+					{Line: 13, Column: 5, Field: "applications[0].services[0]", Message: "missing required field 'name'"},
+				},
+			}))
+		})
+
+		It("lists the problems in the error message", func() {
+			Expect(executeErr.Error()).To(HavePrefix("Manifest " + pathToManifest + " is invalid:\n"))
+			Expect(executeErr.Error()).To(ContainSubstring("\n  line 4, column 3: applications[0]: unknown field 'helth-check-type'\n"))
+		})
+	})
+
+	When("the manifest uses the deprecated buildpack field", func() {
+		BeforeEach(func() {
+			givenManifest = `applications:
+- name: some-app
+  buildpack: ruby_buildpack
+`
+		})
+
+		It("does not return an error", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+		})
+	})
+
+	When("the manifest has top level fields that hold YAML anchors", func() {
+		BeforeEach(func() {
+			givenManifest = `defaults: &defaults
+  memory: 256M
+  instances: two
+applications:
+- name: some-app
+  <<: *defaults
+  instances: 2
+- name: other-app
+  <<: *defaults
+  helth-check-type: http
+`
+		})
+
+		It("accepts the top level fields and validates the merged fields", func() {
+			Expect(executeErr).To(Equal(ManifestValidationError{
+				Path: pathToManifest,
+				Errors: []ManifestFieldError{
+					{Line: 10, Column: 3, Field: "applications[1]", Message: "unknown field 'helth-check-type'"},
+					{Line: 3, Column: 14, Field: "applications[1].instances", Message: "expected an integer, got 'two'"},
+				},
+			}))
+		})
+	})
+
+	When("the manifest is not valid YAML", func() {
+		BeforeEach(func() {
+			givenManifest = "applications: [\n"
+		})
+
+		It("returns an InvalidManifestYAMLError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(InvalidManifestYAMLError{}))
+			Expect(executeErr.(InvalidManifestYAMLError).Path).To(Equal(pathToManifest))
+		})
+	})
+
+	When("the manifest does not exist", func() {
+		BeforeEach(func() {
+			givenManifest = ""
+		})
+
+		JustBeforeEach(func() {
+			Expect(os.RemoveAll(pathToManifest)).To(Succeed())
+			executeErr = parser.ValidateManifest(pathToManifest)
+		})
+
+		It("returns the error", func() {
+			Expect(os.IsNotExist(executeErr)).To(BeTrue())
+		})
+	})
+
+	Describe("ValidateInterpolatedManifest", func() {
+		It("validates the given manifest and names the fields without positions", func() {
+			err := parser.ValidateInterpolatedManifest(pathToManifest, []byte(`applications:
+- name: some-app
+  instances: two
+`))
+			Expect(err).To(Equal(ManifestValidationError{
+				Path: pathToManifest,
+				Errors: []ManifestFieldError{
+					{Field: "applications[0].instances", Message: "expected an integer, got 'two'"},
+				},
+			}))
+			Expect(err.Error()).To(ContainSubstring("\n  applications[0].instances: expected an integer, got 'two'"))
+		})
+	})
+})
@@ -1,11 +1,15 @@
 package ui
 
 import (
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"strings"
 	"time"
 
+	"code.cloudfoundry.org/cli/v9/util/configv3"
 	"github.com/fatih/color"
+	"gopkg.in/yaml.v2"
 )
 
 // LogTimestampFormat is the timestamp formatting for log lines.
@@ -23,8 +27,27 @@ type LogMessage interface {
 	SourceInstance() string
 }
 
+// appNameColors are the colors that DisplayLogMessageForApp picks from to
+// show app names in.
+var appNameColors = []color.Attribute{color.FgCyan, color.FgMagenta, color.FgYellow, color.FgGreen, color.FgBlue}
+
 // DisplayLogMessage formats and outputs a given log message.
 func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
+	ui.displayLogMessage("", message, displayHeader)
+}
+
+// DisplayLogMessageForApp formats and outputs a given log message prefixed
+// with the name of the app that sent it. An app name is always shown in the
+// same color, so that the logs of several apps can be told apart.
+func (ui *UI) DisplayLogMessageForApp(appName string, message LogMessage, displayHeader bool) {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(appName))
+	appColor := appNameColors[hash.Sum32()%uint32(len(appNameColors))]
+
+	ui.displayLogMessage(ui.modifyColor(appName, color.New(appColor, color.Bold))+" ", message, displayHeader)
+}
+
+func (ui *UI) displayLogMessage(prefix string, message LogMessage, displayHeader bool) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -45,6 +68,51 @@ func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 		if message.Type() == "ERR" {
 			logLine = ui.modifyColor(logLine, color.New(color.FgRed))
 		}
-		fmt.Fprintf(ui.Out, "   %s\n", logLine)
+		fmt.Fprintf(ui.Out, "   %s%s\n", prefix, logLine)
+	}
+}
+
+type logMessageDocument struct {
+	App            string    `json:"app,omitempty" yaml:"app,omitempty"`
+	Timestamp      time.Time `json:"timestamp" yaml:"timestamp"`
+	SourceType     string    `json:"source_type" yaml:"source_type"`
+	SourceInstance string    `json:"source_instance" yaml:"source_instance"`
+	Type           string    `json:"type" yaml:"type"`
+	Message        string    `json:"message" yaml:"message"`
+}
+
+// DisplayLogMessageStructured outputs a given log message of the named app
+// using the configured output format. In JSON every message is a single line
+// object, so that a stream of log messages can be processed line by line. In
+// YAML every message is an item of a sequence, so that the stream forms a
+// single YAML document.
+func (ui *UI) DisplayLogMessageStructured(appName string, message LogMessage) error {
+	document := logMessageDocument{
+		App:            appName,
+		Timestamp:      message.Timestamp().UTC(),
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+		Type:           message.Type(),
+		Message:        strings.TrimRight(message.Message(), "\r\n"),
+	}
+
+	var (
+		raw []byte
+		err error
+	)
+	if ui.outputFormat == configv3.OutputFormatYAML {
+		raw, err = yaml.Marshal([]logMessageDocument{document})
+	} else {
+		raw, err = json.Marshal(document)
+		raw = append(raw, '\n')
+	}
+	if err != nil {
+		return err
 	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	_, err = ui.Out.Write(raw)
+	return err
 }
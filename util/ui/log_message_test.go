@@ -1,6 +1,7 @@
 package ui_test
 
 import (
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/v9/util/configv3"
@@ -9,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
+	"gopkg.in/yaml.v2"
 )
 
 var _ = Describe("Log Message", func() {
@@ -115,4 +117,84 @@ var _ = Describe("Log Message", func() {
 			})
 		})
 	})
+
+	Describe("DisplayLogMessageForApp", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			ui.TimezoneLocation = time.UTC
+
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a log message\nThis is also a log message")
+			message.TypeReturns("OUT")
+			message.TimestampReturns(time.Unix(1468969692, 0))
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		It("prefixes every line with the colored app name", func() {
+			ui.DisplayLogMessageForApp("some-app", message, true)
+			Expect(out).To(Say("   \x1b\\[3\\d;1msome-app\x1b\\[[0-9;]*m 2016-07-19T23:08:12.00\\+0000 \\[APP/PROC/WEB/12\\] OUT This is a log message\n"))
+			Expect(out).To(Say("   \x1b\\[3\\d;1msome-app\x1b\\[[0-9;]*m 2016-07-19T23:08:12.00\\+0000 \\[APP/PROC/WEB/12\\] OUT This is also a log message\n"))
+		})
+
+		It("always shows an app name in the same color", func() {
+			ui.DisplayLogMessageForApp("some-app", message, false)
+			ui.DisplayLogMessageForApp("some-app", message, false)
+
+			lines := strings.Split(string(out.Contents()), "\n")
+			Expect(lines[0][:10]).To(Equal(lines[2][:10]))
+		})
+	})
+
+	Describe("DisplayLogMessageStructured", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a log message\nwith two lines\r\n")
+			message.TypeReturns("ERR")
+			message.TimestampReturns(time.Unix(1468969692, 0))
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		When("the output format is json", func() {
+			BeforeEach(func() {
+				ui.SetOutputFormat(configv3.OutputFormatJSON)
+			})
+
+			It("prints the message as a line of JSON to STDOUT", func() {
+				Expect(ui.DisplayLogMessageStructured("some-app", message)).To(Succeed())
+				Expect(out.Contents()).To(MatchJSON(`{
+					"app": "some-app",
+					"timestamp": "2016-07-19T23:08:12Z",
+					"source_type": "APP/PROC/WEB",
+					"source_instance": "12",
+					"type": "ERR",
+					"message": "This is a log message\nwith two lines"
+				}`))
+				Expect(string(out.Contents())).To(MatchRegexp(`^[^\n]*\n$`))
+			})
+		})
+
+		When("the output format is yaml", func() {
+			BeforeEach(func() {
+				ui.SetOutputFormat(configv3.OutputFormatYAML)
+			})
+
+			It("prints each message as an item of a YAML sequence to STDOUT", func() {
+				Expect(ui.DisplayLogMessageStructured("some-app", message)).To(Succeed())
+				Expect(ui.DisplayLogMessageStructured("other-app", message)).To(Succeed())
+
+				var documents []map[string]interface{}
+				Expect(yaml.Unmarshal(out.Contents(), &documents)).To(Succeed())
+				Expect(documents).To(HaveLen(2))
+				Expect(documents[0]).To(HaveKeyWithValue("app", "some-app"))
+				Expect(documents[0]).To(HaveKeyWithValue("source_type", "APP/PROC/WEB"))
+				Expect(documents[0]).To(HaveKeyWithValue("message", "This is a log message\nwith two lines"))
+				Expect(documents[1]).To(HaveKeyWithValue("app", "other-app"))
+			})
+		})
+	})
 })
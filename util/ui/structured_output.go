@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	"gopkg.in/yaml.v2"
+)
+
+// SetOutputFormat sets the document format used by DisplayStructured. Any
+// format other than configv3.OutputFormatText also turns warnings and errors
+// into structured objects on ui.Err, so that ui.Out only contains the
+// document.
+func (ui *UI) SetOutputFormat(format configv3.OutputFormat) {
+	ui.outputFormat = format
+}
+
+// IsStructuredOutput returns true when commands should render a JSON or YAML
+// document instead of human readable text.
+func (ui *UI) IsStructuredOutput() bool {
+	return ui.outputFormat != configv3.OutputFormatText
+}
+
+// DisplayStructured encodes the document using the configured output format
+// and outputs the result to ui.Out. Documents are expected to carry both
+// `json` and `yaml` struct tags.
+func (ui *UI) DisplayStructured(document interface{}) error {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	var (
+		raw []byte
+		err error
+	)
+
+	switch ui.outputFormat {
+	case configv3.OutputFormatYAML:
+		raw, err = yaml.Marshal(document)
+	default:
+		buff := new(bytes.Buffer)
+		encoder := json.NewEncoder(buff)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(document)
+		raw = buff.Bytes()
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = ui.Out.Write(raw)
+	return err
+}
+
+// displayStructuredMessage writes a single line JSON object to ui.Err. A one
+// line JSON object is also valid YAML, so the same encoding is used for both
+// output formats.
+func (ui *UI) displayStructuredMessage(kind string, message string) {
+	raw, err := json.Marshal(map[string]string{kind: message})
+	if err != nil {
+		raw = []byte(fmt.Sprintf("%q", message))
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	fmt.Fprintf(ui.Err, "%s\n", raw)
+}
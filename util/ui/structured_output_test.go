@@ -0,0 +1,97 @@
+package ui_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/v9/util/configv3"
+	. "code.cloudfoundry.org/cli/v9/util/ui"
+	"code.cloudfoundry.org/cli/v9/util/ui/uifakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Structured output", func() {
+	type document struct {
+		Name  string   `json:"name" yaml:"name"`
+		Items []string `json:"items" yaml:"items"`
+	}
+
+	var (
+		ui         *UI
+		fakeConfig *uifakes.FakeConfig
+		out        *Buffer
+		errBuff    *Buffer
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(uifakes.FakeConfig)
+
+		var err error
+		ui, err = NewUI(fakeConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		out = NewBuffer()
+		ui.Out = out
+		errBuff = NewBuffer()
+		ui.Err = errBuff
+	})
+
+	Describe("IsStructuredOutput", func() {
+		It("defaults to false", func() {
+			Expect(ui.IsStructuredOutput()).To(BeFalse())
+		})
+
+		It("is true for json and yaml", func() {
+			ui.SetOutputFormat(configv3.OutputFormatJSON)
+			Expect(ui.IsStructuredOutput()).To(BeTrue())
+			ui.SetOutputFormat(configv3.OutputFormatYAML)
+			Expect(ui.IsStructuredOutput()).To(BeTrue())
+		})
+	})
+
+	Describe("DisplayStructured", func() {
+		When("the output format is json", func() {
+			BeforeEach(func() {
+				ui.SetOutputFormat(configv3.OutputFormatJSON)
+			})
+
+			It("outputs an indented JSON document", func() {
+				err := ui.DisplayStructured(document{Name: "some-name", Items: []string{"a&b"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(out.Contents())).To(Equal("{\n  \"name\": \"some-name\",\n  \"items\": [\n    \"a&b\"\n  ]\n}\n"))
+			})
+		})
+
+		When("the output format is yaml", func() {
+			BeforeEach(func() {
+				ui.SetOutputFormat(configv3.OutputFormatYAML)
+			})
+
+			It("outputs a YAML document", func() {
+				err := ui.DisplayStructured(document{Name: "some-name", Items: []string{"a"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(out.Contents())).To(Equal("name: some-name\nitems:\n- a\n"))
+			})
+		})
+	})
+
+	When("the output format is structured", func() {
+		BeforeEach(func() {
+			ui.SetOutputFormat(configv3.OutputFormatYAML)
+		})
+
+		It("displays warnings as JSON objects on ui.Err", func() {
+			ui.DisplayWarnings([]string{"warning-1", `warning "2"`})
+			ui.DisplayWarning("warning-{{.Number}}", map[string]interface{}{"Number": 3})
+			Expect(string(errBuff.Contents())).To(Equal(`{"warning":"warning-1"}` + "\n" + `{"warning":"warning \"2\""}` + "\n" + `{"warning":"warning-3"}` + "\n"))
+			Expect(out.Contents()).To(BeEmpty())
+		})
+
+		It("displays errors as JSON objects on ui.Err without FAILED", func() {
+			ui.DisplayError(errors.New("I am an error"))
+			Expect(string(errBuff.Contents())).To(Equal(`{"error":"I am an error"}` + "\n"))
+			Expect(out.Contents()).To(BeEmpty())
+		})
+	})
+})
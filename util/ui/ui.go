@@ -82,6 +82,7 @@ type UI struct {
 	Err io.Writer
 
 	colorEnabled configv3.ColorSetting
+	outputFormat configv3.OutputFormat
 	translate    TranslateFunc
 	Exiter       Exiter
 
@@ -191,7 +192,8 @@ func (ui *UI) DisplayDeprecationWarning() {
 
 // DisplayError outputs the translated error message to ui.Err if the error
 // satisfies TranslatableError, otherwise it outputs the original error message
-// to ui.Err. It also outputs "FAILED" in bold red to ui.Out.
+// to ui.Err. It also outputs "FAILED" in bold red to ui.Out. When a structured
+// output format is set, only a structured error object is written to ui.Err.
 func (ui *UI) DisplayError(err error) {
 	var errMsg string
 	if translatableError, ok := err.(translatableerror.TranslatableError); ok {
@@ -199,6 +201,12 @@ func (ui *UI) DisplayError(err error) {
 	} else {
 		errMsg = err.Error()
 	}
+
+	if ui.IsStructuredOutput() {
+		ui.displayStructuredMessage("error", errMsg)
+		return
+	}
+
 	fmt.Fprintf(ui.Err, "%s\n", errMsg)
 
 	ui.terminalLock.Lock()
@@ -8,6 +8,10 @@ import (
 // outputs to ui.Err. Only the first map in templateValues is used.
 // This command has one fewer newline than DisplayWarning. Use it before an OK message in V7.
 func (ui *UI) DisplayWarning(template string, templateValues ...map[string]interface{}) {
+	if ui.IsStructuredOutput() {
+		ui.displayStructuredMessage("warning", ui.TranslateText(template, templateValues...))
+		return
+	}
 	fmt.Fprintf(ui.Err, "%s\n", ui.TranslateText(template, templateValues...))
 }
 
@@ -15,6 +19,10 @@ func (ui *UI) DisplayWarning(template string, templateValues ...map[string]inter
 // Prints each warning with a trailing newline.
 func (ui *UI) DisplayWarnings(warnings []string) {
 	for _, warning := range warnings {
+		if ui.IsStructuredOutput() {
+			ui.displayStructuredMessage("warning", ui.TranslateText(warning))
+			continue
+		}
 		fmt.Fprintf(ui.Err, "%s\n", ui.TranslateText(warning))
 	}
 }